@@ -7,4 +7,5 @@ import (
 // OracleKeeper for feeder validation
 type OracleKeeper interface {
 	ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error
+	VotePeriod(ctx sdk.Context) uint64
 }
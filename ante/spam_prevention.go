@@ -11,7 +11,8 @@ import (
 
 // SpamPreventionDecorator defines a custom Umee AnteHandler decorator that is
 // responsible for preventing oracle message spam. Specifically, it prohibits
-// oracle feeders from submitting multiple oracle messages in a single block.
+// validators from submitting multiple oracle prevotes or votes in a single vote
+// period, across all of their feeders.
 type SpamPreventionDecorator struct {
 	oracleKeeper     OracleKeeper
 	oraclePrevoteMap map[string]int64
@@ -48,20 +49,21 @@ func (spd *SpamPreventionDecorator) AnteHandle(
 }
 
 // CheckOracleSpam performs the check of whether or not we've seen an oracle
-// message from an oracle feeder in the current block or not. If we have, we
-// return an error which prohibits the transaction from being processed.
+// message of a validator, from any of its feeders, in the current vote period or
+// not. If we have, we return an error which prohibits the transaction from being
+// processed.
 func (spd *SpamPreventionDecorator) CheckOracleSpam(ctx sdk.Context, msgs []sdk.Msg) error {
 	spd.mu.Lock()
 	defer spd.mu.Unlock()
 
-	curHeight := ctx.BlockHeight()
+	curPeriod := ctx.BlockHeight() / int64(spd.oracleKeeper.VotePeriod(ctx))
 	for _, msg := range msgs {
 		var err error
 		switch msg := msg.(type) {
 		case *oracletypes.MsgAggregateExchangeRatePrevote:
-			err = spd.validate(ctx, msg.Feeder, msg.Validator, spd.oraclePrevoteMap, curHeight, "pre-vote")
+			err = spd.validate(ctx, msg.Feeder, msg.Validator, spd.oraclePrevoteMap, curPeriod, "pre-vote")
 		case *oracletypes.MsgAggregateExchangeRateVote:
-			err = spd.validate(ctx, msg.Feeder, msg.Validator, spd.oracleVoteMap, curHeight, "vote")
+			err = spd.validate(ctx, msg.Feeder, msg.Validator, spd.oracleVoteMap, curPeriod, "vote")
 		default:
 			// non oracle msg: stop validation!
 			// NOTE: only tx which contains only oracle Msgs are considered oracle-prioritized
@@ -80,7 +82,7 @@ func (spd *SpamPreventionDecorator) validate(
 	feeder,
 	validator string,
 	cache map[string]int64,
-	curHeight int64,
+	curPeriod int64,
 	txType string,
 ) error {
 	feederAddr, err := sdk.AccAddressFromBech32(feeder)
@@ -94,10 +96,10 @@ func (spd *SpamPreventionDecorator) validate(
 	if err = spd.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
 		return err
 	}
-	if lastSubmitted, ok := cache[validator]; ok && lastSubmitted == curHeight {
+	if lastSubmitted, ok := cache[validator]; ok && lastSubmitted == curPeriod {
 		return sdkerrors.ErrInvalidRequest.Wrapf(
-			"validator has already submitted a %s message at the current height", txType)
+			"validator has already submitted a %s message in the current vote period", txType)
 	}
-	cache[validator] = curHeight
+	cache[validator] = curPeriod
 	return nil
 }
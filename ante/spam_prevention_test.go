@@ -15,11 +15,12 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 
 	priv1, _, addr1 := testdata.KeyTestPubAddr()
 	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, standby1 := testdata.KeyTestPubAddr()
 
 	spd := ante.NewSpamPreventionDecorator(dummyOracleKeeper{
-		feeders: map[string]string{
-			sdk.ValAddress(addr1).String(): addr1.String(),
-			sdk.ValAddress(addr2).String(): addr2.String(),
+		feeders: map[string][]string{
+			sdk.ValAddress(addr1).String(): {addr1.String(), standby1.String()},
+			sdk.ValAddress(addr2).String(): {addr2.String()},
 		},
 	})
 	antehandler := sdk.ChainAnteDecorators(spd)
@@ -43,10 +44,26 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().Error(err)
 
-	// next block
+	// next block in the same vote period, gets blocked
 	suite.ctx = suite.ctx.WithBlockHeight(101)
 	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorContains(err, "in the current vote period")
+
+	// standby feeder of the same validator, gets blocked
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", standby1, sdk.ValAddress(addr1)),
+	))
+	standbyTx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, standbyTx, false)
+	suite.Require().ErrorContains(err, "in the current vote period")
+
+	// next vote period
+	suite.ctx = suite.ctx.WithBlockHeight(105)
+	_, err = antehandler(suite.ctx, standbyTx, false)
 	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorContains(err, "vote message in the current vote period")
 
 	// catch wrong feeder
 	suite.Require().NoError(suite.txBuilder.SetMsgs(
@@ -56,19 +73,25 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
-	suite.ctx = suite.ctx.WithBlockHeight(102)
+	suite.ctx = suite.ctx.WithBlockHeight(110)
 	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().Error(err)
 }
 
 type dummyOracleKeeper struct {
-	feeders map[string]string
+	feeders map[string][]string
 }
 
 func (ok dummyOracleKeeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
-	if val, ok := ok.feeders[validatorAddr.String()]; ok && val == feederAddr.String() {
-		return nil
+	for _, f := range ok.feeders[validatorAddr.String()] {
+		if f == feederAddr.String() {
+			return nil
+		}
 	}
 
 	return sdkerrors.ErrUnauthorized.Wrap("feeder is not authorized")
 }
+
+func (ok dummyOracleKeeper) VotePeriod(ctx sdk.Context) uint64 {
+	return 5
+}
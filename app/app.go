@@ -476,6 +476,7 @@ func New(
 		app.DistrKeeper,
 		app.StakingKeeper,
 		distrtypes.ModuleName,
+		app.UGovKeeperB.EmergencyGroup,
 	)
 
 	rewardsAuctionAccs := auctionmodule.SubAccounts()
@@ -676,6 +677,8 @@ func New(
 
 	// Register stargate queries
 	wasmOpts = append(wasmOpts, uwasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec)...)
+	// Register custom messages
+	wasmOpts = append(wasmOpts, uwasm.RegisterCustomMessages()...)
 	availableCapabilities := strings.Join(AllCapabilities(), ",")
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -24,6 +24,7 @@ import (
 	"github.com/umee-network/umee/v6/util"
 	"github.com/umee-network/umee/v6/x/auction"
 	leveragetypes "github.com/umee-network/umee/v6/x/leverage/types"
+	"github.com/umee-network/umee/v6/x/metoken"
 )
 
 // RegisterUpgradeHandlersregisters upgrade handlers.
@@ -63,6 +64,40 @@ func (app UmeeApp) RegisterUpgradeHandlers() {
 
 	// v6.7.4-rc1
 	app.registerUpgrade("v6.7.4-rc1", upgradeInfo, nil, nil, nil)
+
+	app.registerUpgrade6_8(upgradeInfo)
+}
+
+func (app *UmeeApp) registerUpgrade6_8(_ upgradetypes.Plan) {
+	planName := "v6.8"
+
+	app.UpgradeKeeper.SetUpgradeHandler(planName,
+		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			printPlanName(planName, ctx.Logger())
+
+			// new leverage params introduced in v6.8
+			lparams := app.LeverageKeeper.GetParams(ctx)
+			lparams.ReferralRewardFactor = sdk.ZeroDec()
+			lparams.DeleverageFee = sdk.MustNewDecFromStr("0.005")
+			lparams.MaxDeleverageOrdersPerBlock = 50
+			if err := app.LeverageKeeper.SetParams(ctx, lparams); err != nil {
+				return nil, err
+			}
+
+			// new metoken params introduced in v6.8
+			mekeeper := app.MetokenKeeperB.Keeper(&ctx)
+			meparams := mekeeper.GetParams()
+			meparams.MaxHistorySnapshots = metoken.DefaultParams().MaxHistorySnapshots
+			if err := mekeeper.SetParams(meparams); err != nil {
+				return nil, err
+			}
+
+			// validators bonded before the oracle offence history was introduced get no grace period
+			app.OracleKeeper.InitOffenceHistories(ctx)
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
 }
 
 func (app *UmeeApp) registerUpgrade6_7_3(_ upgradetypes.Plan) {
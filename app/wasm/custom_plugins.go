@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 
+	"github.com/umee-network/umee/v6/app/wasm/msg"
 	"github.com/umee-network/umee/v6/app/wasm/query"
 )
 
@@ -18,3 +19,14 @@ func RegisterStargateQueries(queryRouter baseapp.GRPCQueryRouter, codec codec.Co
 		queryPluginOpt,
 	}
 }
+
+// RegisterCustomMessages exposes the umee custom messages
+func RegisterCustomMessages() []wasmkeeper.Option {
+	messageEncoderOpt := wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+		Custom: msg.CustomMessageEncoder,
+	})
+
+	return []wasmkeeper.Option{
+		messageEncoderOpt,
+	}
+}
@@ -0,0 +1,64 @@
+package msg
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lvtypes "github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+// CustomMessageEncoder decodes an UmeeMsg sent by a contract into the sdk.Msg it represents,
+// with the contract as its signer. The message is then routed like any other transaction message.
+func CustomMessageEncoder(sender sdk.AccAddress, rawMsg json.RawMessage) ([]sdk.Msg, error) {
+	var m UmeeMsg
+	if err := json.Unmarshal(rawMsg, &m); err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid umee msg: %s", err), Request: rawMsg}
+	}
+
+	msg, err := m.Encode(sender)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: rawMsg}
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode returns the single message set in an UmeeMsg as an sdk.Msg signed by sender.
+func (m UmeeMsg) Encode(sender sdk.AccAddress) (sdk.Msg, error) {
+	msgs := []sdk.Msg{}
+	if m.Supply != nil {
+		msgs = append(msgs, lvtypes.NewMsgSupply(sender, m.Supply.Asset))
+	}
+	if m.Withdraw != nil {
+		msgs = append(msgs, lvtypes.NewMsgWithdraw(sender, m.Withdraw.Asset))
+	}
+	if m.Collateralize != nil {
+		msgs = append(msgs, lvtypes.NewMsgCollateralize(sender, m.Collateralize.Asset))
+	}
+	if m.Decollateralize != nil {
+		msgs = append(msgs, lvtypes.NewMsgDecollateralize(sender, m.Decollateralize.Asset))
+	}
+	if m.Borrow != nil {
+		msgs = append(msgs, lvtypes.NewMsgBorrow(sender, m.Borrow.Asset))
+	}
+	if m.Repay != nil {
+		msgs = append(msgs, lvtypes.NewMsgRepay(sender, m.Repay.Asset))
+	}
+	if m.Liquidate != nil {
+		borrower, err := sdk.AccAddressFromBech32(m.Liquidate.Borrower)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, lvtypes.NewMsgLiquidate(sender, borrower, m.Liquidate.Repayment, m.Liquidate.Reward.Denom))
+	}
+	if m.SupplyCollateral != nil {
+		msgs = append(msgs, lvtypes.NewMsgSupplyCollateral(sender, m.SupplyCollateral.Asset))
+	}
+
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("umee msg must contain exactly one message, got %d", len(msgs))
+	}
+	return msgs[0], nil
+}
@@ -0,0 +1,10 @@
+package msg
+
+import (
+	_ "embed"
+)
+
+// Schema is the JSON schema of UmeeMsg, for generating contract bindings.
+//
+//go:embed schema/umee_msg.json
+var Schema []byte
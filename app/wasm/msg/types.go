@@ -0,0 +1,84 @@
+package msg
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UmeeMsg wraps all the messages available for cosmwasm smartcontracts. It is compatible with
+// the StructUmeeMsg of the cw-umee-types crate, and is described by the JSON schema in
+// schema/umee_msg.json. Exactly one message must be set. Messages are always sent on behalf of
+// the contract, so they do not contain a supplier, borrower or liquidator address.
+type UmeeMsg struct {
+	// AssignedMsg is set by cw-umee-types to the name of the message. It is not required.
+	AssignedMsg string `json:"assigned_msg,omitempty"`
+
+	// Leverage messages
+	// Used to supply coins to the lending pool.
+	Supply *SupplyParams `json:"supply,omitempty"`
+	// Used to withdraw supplied uTokens from the lending pool.
+	Withdraw *WithdrawParams `json:"withdraw,omitempty"`
+	// Used to enable uTokens as collateral.
+	Collateralize *CollateralizeParams `json:"collateralize,omitempty"`
+	// Used to disable uTokens as collateral.
+	Decollateralize *DecollateralizeParams `json:"decollateralize,omitempty"`
+	// Used to borrow coins from the lending pool.
+	Borrow *BorrowParams `json:"borrow,omitempty"`
+	// Used to repay borrowed coins.
+	Repay *RepayParams `json:"repay,omitempty"`
+	// Used to liquidate an undercollateralized borrower.
+	Liquidate *LiquidateParams `json:"liquidate,omitempty"`
+	// Used to supply coins and collateralize the resulting uTokens in one message.
+	SupplyCollateral *SupplyCollateralParams `json:"supply_collateral,omitempty"`
+}
+
+// SupplyParams are the parameters of leverage MsgSupply.
+type SupplyParams struct {
+	// Asset is the base token to supply.
+	Asset sdk.Coin `json:"asset"`
+}
+
+// WithdrawParams are the parameters of leverage MsgWithdraw.
+type WithdrawParams struct {
+	// Asset is the uToken to withdraw.
+	Asset sdk.Coin `json:"asset"`
+}
+
+// CollateralizeParams are the parameters of leverage MsgCollateralize.
+type CollateralizeParams struct {
+	// Asset is the uToken to collateralize.
+	Asset sdk.Coin `json:"asset"`
+}
+
+// DecollateralizeParams are the parameters of leverage MsgDecollateralize.
+type DecollateralizeParams struct {
+	// Asset is the uToken to decollateralize.
+	Asset sdk.Coin `json:"asset"`
+}
+
+// BorrowParams are the parameters of leverage MsgBorrow.
+type BorrowParams struct {
+	// Asset is the base token to borrow.
+	Asset sdk.Coin `json:"asset"`
+}
+
+// RepayParams are the parameters of leverage MsgRepay.
+type RepayParams struct {
+	// Asset is the base token to repay.
+	Asset sdk.Coin `json:"asset"`
+}
+
+// LiquidateParams are the parameters of leverage MsgLiquidate.
+type LiquidateParams struct {
+	// Borrower is the address of the account to liquidate.
+	Borrower string `json:"borrower"`
+	// Repayment is the maximum amount of base tokens to repay.
+	Repayment sdk.Coin `json:"repayment"`
+	// Reward selects the reward denom, which can be a base token or uToken. Its amount is ignored.
+	Reward sdk.Coin `json:"reward"`
+}
+
+// SupplyCollateralParams are the parameters of leverage MsgSupplyCollateral.
+type SupplyCollateralParams struct {
+	// Asset is the base token to supply and collateralize.
+	Asset sdk.Coin `json:"asset"`
+}
@@ -106,6 +106,7 @@ func init() {
 	setWhitelistedQuery(oracleBaseQueryPath+"ExchangeRates", &otypes.QueryExchangeRatesResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"ActiveExchangeRates", &otypes.QueryActiveExchangeRatesResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"FeederDelegation", &otypes.QueryFeederDelegationResponse{})
+	setWhitelistedQuery(oracleBaseQueryPath+"Feeders", &otypes.QueryFeedersResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"MissCounter", &otypes.QueryMissCounterResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"SlashWindow", &otypes.QuerySlashWindowResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"AggregatePrevote", &otypes.QueryAggregatePrevoteResponse{})
@@ -116,6 +117,7 @@ func init() {
 	setWhitelistedQuery(oracleBaseQueryPath+"Medians", &otypes.QueryMediansResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"MedianDeviations", &otypes.QueryMedianDeviationsResponse{})
 	setWhitelistedQuery(oracleBaseQueryPath+"AvgPrice", &otypes.QueryAvgPriceResponse{})
+	setWhitelistedQuery(oracleBaseQueryPath+"PriceWindow", &otypes.QueryPriceWindowResponse{})
 
 	// uibc
 	setWhitelistedQuery(uibcBaseQueryPath+params, &uibctypes.QueryParamsResponse{})
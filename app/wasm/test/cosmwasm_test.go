@@ -20,4 +20,7 @@ func TestCosmwasm(t *testing.T) {
 	its.InitiateUmeeCosmwasm()
 	// stargate queries
 	its.TestStargateQueries()
+	// custom messages
+	its.TestLeverageMessages()
+	its.TestUmeeMsgSchema()
 }
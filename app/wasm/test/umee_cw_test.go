@@ -2,6 +2,8 @@ package wasm_test
 
 import (
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -10,8 +12,10 @@ import (
 	"gotest.tools/v3/assert"
 
 	appparams "github.com/umee-network/umee/v6/app/params"
+	wm "github.com/umee-network/umee/v6/app/wasm/msg"
 	lvtypes "github.com/umee-network/umee/v6/x/leverage/types"
 	"github.com/umee-network/umee/v6/x/metoken"
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
 )
 
 func (s *IntegrationTestSuite) TestStargateQueries() {
@@ -82,6 +86,29 @@ func (s *IntegrationTestSuite) TestStargateQueries() {
 				assert.Equal(s.T, "UMEE", rr.SymbolDenom)
 			},
 		},
+		{
+			name: "stargate: oracle price window",
+			sq: func() StargateQuery {
+				s.app.OracleKeeper.SetHistoricPrice(s.ctx, "UMEE", 5, sdk.NewDec(2))
+				s.app.OracleKeeper.SetHistoricPrice(s.ctx, "UMEE", 7, sdk.NewDec(4))
+				data := otypes.QueryPriceWindow{Denom: "UMEE", WindowBlocks: 6}
+				d, err := data.Marshal()
+				assert.NilError(s.T, err)
+				sq := StargateQuery{}
+				sq.Chain.Stargate = wasmvmtypes.StargateQuery{
+					Path: "/umee.oracle.v1.Query/PriceWindow",
+					Data: d,
+				}
+				return sq
+			},
+			resp: func(resp wasmtypes.QuerySmartContractStateResponse) {
+				var rr otypes.QueryPriceWindowResponse
+				err := s.encfg.Codec.UnmarshalJSON(resp.Data, &rr)
+				assert.NilError(s.T, err)
+				assert.DeepEqual(s.T, sdk.NewDec(3), rr.Window.Twap)
+				assert.Equal(s.T, uint32(2), rr.Window.NumStamps)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -96,3 +123,161 @@ func (s *IntegrationTestSuite) TestStargateQueries() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestLeverageMessages() {
+	contract := sdk.MustAccAddressFromBech32(s.contractAddr)
+	assert.NilError(s.T, s.app.BankKeeper.SendCoins(s.ctx, addr, contract,
+		sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1000_000000))))
+	uDenom := "u/" + appparams.BondDenom
+
+	// borrowing and liquidating require a recent UMEE price, without historic medians
+	umee, err := s.app.LeverageKeeper.GetTokenSettings(s.ctx, appparams.BondDenom)
+	assert.NilError(s.T, err)
+	umee.HistoricMedians = 0
+	assert.NilError(s.T, s.app.LeverageKeeper.SetTokenSettings(s.ctx, umee))
+	s.app.OracleKeeper.SetExchangeRate(s.ctx, umee.SymbolDenom, sdk.MustNewDecFromStr("4.21"))
+	assert.NilError(s.T, s.app.LeverageKeeper.AccrueAllInterest(s.ctx))
+
+	// addr2 borrows 60 UMEE against 100 UMEE collateral, above its 0.5 liquidation threshold
+	_, err = s.app.LeverageKeeper.Supply(s.ctx, addr2, sdk.NewInt64Coin(appparams.BondDenom, 100_000000))
+	assert.NilError(s.T, err)
+	assert.NilError(s.T, s.app.LeverageKeeper.Collateralize(s.ctx, addr2, sdk.NewInt64Coin(uDenom, 100_000000)))
+	assert.NilError(s.T, s.app.LeverageKeeper.Borrow(s.ctx, addr2, sdk.NewInt64Coin(appparams.BondDenom, 60_000000)))
+
+	tests := []struct {
+		name   string
+		msg    wm.UmeeMsg
+		err    string
+		verify func(t *testing.T)
+	}{
+		{
+			name: "supply",
+			msg:  wm.UmeeMsg{Supply: &wm.SupplyParams{Asset: sdk.NewInt64Coin(appparams.BondDenom, 600_000000)}},
+			verify: func(t *testing.T) {
+				supplied, err := s.app.LeverageKeeper.GetSupplied(s.ctx, contract, appparams.BondDenom)
+				assert.NilError(t, err)
+				assert.Equal(t, "600000000uumee", supplied.String())
+			},
+		},
+		{
+			name: "collateralize",
+			msg:  wm.UmeeMsg{Collateralize: &wm.CollateralizeParams{Asset: sdk.NewInt64Coin(uDenom, 500_000000)}},
+			verify: func(t *testing.T) {
+				collateral := s.app.LeverageKeeper.GetCollateral(s.ctx, contract, uDenom)
+				assert.Equal(t, "500000000u/uumee", collateral.String())
+			},
+		},
+		{
+			name: "decollateralize",
+			msg:  wm.UmeeMsg{Decollateralize: &wm.DecollateralizeParams{Asset: sdk.NewInt64Coin(uDenom, 200_000000)}},
+			verify: func(t *testing.T) {
+				collateral := s.app.LeverageKeeper.GetCollateral(s.ctx, contract, uDenom)
+				assert.Equal(t, "300000000u/uumee", collateral.String())
+			},
+		},
+		{
+			name: "withdraw",
+			msg:  wm.UmeeMsg{Withdraw: &wm.WithdrawParams{Asset: sdk.NewInt64Coin(uDenom, 100_000000)}},
+			verify: func(t *testing.T) {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, contract, uDenom)
+				assert.Equal(t, "200000000u/uumee", balance.String())
+			},
+		},
+		{
+			name: "supply collateral",
+			msg: wm.UmeeMsg{
+				SupplyCollateral: &wm.SupplyCollateralParams{Asset: sdk.NewInt64Coin(appparams.BondDenom, 100_000000)},
+			},
+			verify: func(t *testing.T) {
+				collateral := s.app.LeverageKeeper.GetCollateral(s.ctx, contract, uDenom)
+				assert.Equal(t, "400000000u/uumee", collateral.String())
+			},
+		},
+		{
+			name: "borrow",
+			msg:  wm.UmeeMsg{Borrow: &wm.BorrowParams{Asset: sdk.NewInt64Coin(appparams.BondDenom, 2_000000)}},
+			verify: func(t *testing.T) {
+				borrowed := s.app.LeverageKeeper.GetBorrow(s.ctx, contract, appparams.BondDenom)
+				assert.Equal(t, "2000000uumee", borrowed.String())
+			},
+		},
+		{
+			name: "repay",
+			msg:  wm.UmeeMsg{Repay: &wm.RepayParams{Asset: sdk.NewInt64Coin(appparams.BondDenom, 1_000000)}},
+			verify: func(t *testing.T) {
+				borrowed := s.app.LeverageKeeper.GetBorrow(s.ctx, contract, appparams.BondDenom)
+				assert.Equal(t, "1000000uumee", borrowed.String())
+			},
+		},
+		{
+			name: "liquidate",
+			msg: wm.UmeeMsg{Liquidate: &wm.LiquidateParams{
+				Borrower:  addr2.String(),
+				Repayment: sdk.NewInt64Coin(appparams.BondDenom, 1_000000),
+				Reward:    sdk.NewInt64Coin(appparams.BondDenom, 0),
+			}},
+			verify: func(t *testing.T) {
+				borrowed := s.app.LeverageKeeper.GetBorrow(s.ctx, addr2, appparams.BondDenom)
+				assert.Equal(t, "59000000uumee", borrowed.String())
+				collateral := s.app.LeverageKeeper.GetCollateral(s.ctx, addr2, uDenom)
+				assert.Assert(t, collateral.Amount.LT(sdk.NewInt(99_000000)), collateral.String())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.T.Run(test.name, func(t *testing.T) {
+			_, err := s.execUmeeMsg(test.msg)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			assert.NilError(t, err)
+			test.verify(t)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestUmeeMsgSchema() {
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	assert.NilError(s.T, json.Unmarshal(wm.Schema, &schema))
+
+	// every message field is described by the schema, and vice versa
+	fields := map[string]json.RawMessage{}
+	msgType := reflect.TypeOf(wm.UmeeMsg{})
+	for i := 0; i < msgType.NumField(); i++ {
+		tag := strings.Split(msgType.Field(i).Tag.Get("json"), ",")[0]
+		fields[tag] = schema.Properties[tag]
+		assert.Assert(s.T, schema.Properties[tag] != nil, "%s is missing from the schema", tag)
+	}
+	assert.Equal(s.T, len(fields), len(schema.Properties))
+
+	// exactly one message must be set
+	_, err := s.execUmeeMsg(wm.UmeeMsg{})
+	assert.ErrorContains(s.T, err, "umee msg must contain exactly one message, got 0")
+}
+
+// execUmeeMsg executes the umee contract, which sends an UmeeMsg built by cw-umee-types.
+func (s *IntegrationTestSuite) execUmeeMsg(msg wm.UmeeMsg) (*wasmtypes.MsgExecuteContractResponse, error) {
+	leverageMsg := map[string]interface{}{}
+	bz, err := json.Marshal(msg)
+	assert.NilError(s.T, err)
+	assert.NilError(s.T, json.Unmarshal(bz, &leverageMsg))
+	if len(leverageMsg) == 0 {
+		// the contract cannot send an empty message, so encode it directly
+		_, err := wm.CustomMessageEncoder(sdk.MustAccAddressFromBech32(s.contractAddr), bz)
+		return nil, err
+	}
+
+	execMsg, err := json.Marshal(map[string]interface{}{
+		"umee": map[string]interface{}{"leverage": leverageMsg},
+	})
+	assert.NilError(s.T, err)
+	return s.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(s.ctx), &wasmtypes.MsgExecuteContract{
+		Sender:   addr2.String(),
+		Contract: s.contractAddr,
+		Msg:      execMsg,
+	})
+}
@@ -0,0 +1,231 @@
+// Package pricefeeder provides a minimal oracle price feeder, running the prevote/vote cycle
+// of x/oracle with prices provided by a pluggable PriceSource. It's meant for small
+// validators and test networks, which don't need a full price feeder deployment.
+package pricefeeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+
+	"github.com/umee-network/umee/v6/sdkclient"
+	oracletypes "github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// saltLength is the number of random bytes of a vote salt, hex encoded to the
+// oracletypes.SaltLength characters required by MsgAggregateExchangeRateVote.
+const saltLength = oracletypes.SaltLength / 2
+
+// Broadcaster signs and broadcasts transactions. It's implemented by sdkclient/tx.Client.
+type Broadcaster interface {
+	BroadcastTx(idx int, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+	SetAccSeq(seq uint64)
+}
+
+// Config of the Feeder.
+type Config struct {
+	// Validator on behalf of which the prices are voted.
+	Validator sdk.ValAddress
+	// Feeder is the account signing the votes: the validator operator or its delegated feeder.
+	Feeder sdk.AccAddress
+	// KeyIdx is the index of the feeder key in the Broadcaster keyring.
+	KeyIdx int
+	// Retries is the number of additional attempts made to fetch prices or broadcast votes.
+	Retries int
+	// RetryDelay is the delay between two attempts.
+	RetryDelay time.Duration
+}
+
+// prevote is a prevote broadcasted by the feeder and not yet revealed.
+type prevote struct {
+	period        uint64
+	salt          string
+	exchangeRates string
+}
+
+// Feeder votes the prices of its PriceSource at the beginning of every oracle vote period:
+// it reveals the prevote of the previous period and submits a new prevote, in a single tx.
+type Feeder struct {
+	cfg    Config
+	source PriceSource
+	tx     Broadcaster
+	oracle oracletypes.QueryClient
+	logger zerolog.Logger
+
+	queryTimeout time.Duration
+	prevote      *prevote
+}
+
+// New creates a Feeder using the sdkclient transaction and query clients.
+func New(c sdkclient.Client, source PriceSource, cfg Config, logger zerolog.Logger) *Feeder {
+	return &Feeder{
+		cfg:          cfg,
+		source:       source,
+		tx:           c.Tx,
+		oracle:       oracletypes.NewQueryClient(c.Query.GrpcConn),
+		logger:       logger.With().Str("module", "price_feeder").Logger(),
+		queryTimeout: c.QueryTimeout(),
+	}
+}
+
+// Run votes at the beginning of every vote period, tracked with the chain height listener,
+// until the context is canceled. Errors of a period are logged and don't stop the feeder.
+func (f *Feeder) Run(ctx context.Context, heights *sdkclient.ChainHeightListener) error {
+	params, err := f.queryParams(ctx)
+	if err != nil {
+		return err
+	}
+	var lastPeriod uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case height := <-heights.HeightChanged:
+			period := uint64(height) / params.VotePeriod
+			if period == lastPeriod {
+				continue
+			}
+			lastPeriod = period
+			// accept list and vote period can be updated by governance
+			if p, err := f.queryParams(ctx); err != nil {
+				f.logger.Warn().Err(err).Msg("failed to query oracle params, using the previous ones")
+			} else {
+				params = p
+			}
+			if err := f.Vote(ctx, uint64(height)/params.VotePeriod, params.AcceptList); err != nil {
+				f.logger.Error().Err(err).Int64("height", height).Msg("failed to vote")
+			}
+		}
+	}
+}
+
+// Vote fetches the prices of the accept list and broadcasts the reveal of the previous
+// period prevote, if any, together with the prevote of the given period.
+func (f *Feeder) Vote(ctx context.Context, period uint64, acceptList oracletypes.DenomList) error {
+	var tuples oracletypes.ExchangeRateTuples
+	err := f.retry(ctx, func() (err error) {
+		tuples, err = f.source.Prices(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch prices: %w", err)
+	}
+	tuples = FilterAcceptList(tuples, acceptList)
+	if len(tuples) < len(acceptList) {
+		f.logger.Warn().Int("prices", len(tuples)).Int("accept_list", len(acceptList)).
+			Msg("the price source doesn't provide all accepted denoms")
+	}
+	salt, err := GenerateSalt()
+	if err != nil {
+		return err
+	}
+
+	next := &prevote{period: period, salt: salt, exchangeRates: FormatExchangeRates(tuples)}
+	msgs := f.voteMsgs(next)
+	f.prevote = nil
+	err = f.retry(ctx, func() error {
+		resp, err := f.tx.BroadcastTx(f.cfg.KeyIdx, msgs...)
+		if err != nil {
+			// the account sequence will be queried again
+			f.tx.SetAccSeq(0)
+			return err
+		}
+		if resp.Code != 0 {
+			f.tx.SetAccSeq(0)
+			return fmt.Errorf("tx %s failed with code %d: %s", resp.TxHash, resp.Code, resp.RawLog)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to broadcast votes: %w", err)
+	}
+	f.prevote = next
+	f.logger.Info().Uint64("period", period).Int("messages", len(msgs)).Msg("votes broadcasted")
+	return nil
+}
+
+// voteMsgs returns the messages revealing the pending prevote, when it was submitted in the
+// previous period, and submitting the next prevote.
+func (f *Feeder) voteMsgs(next *prevote) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, 2)
+	if p := f.prevote; p != nil && p.period+1 == next.period {
+		msgs = append(msgs, oracletypes.NewMsgAggregateExchangeRateVote(
+			p.salt, p.exchangeRates, f.cfg.Feeder, f.cfg.Validator))
+	}
+	hash := oracletypes.GetAggregateVoteHash(next.salt, next.exchangeRates, f.cfg.Validator)
+	return append(msgs, oracletypes.NewMsgAggregateExchangeRatePrevote(hash, f.cfg.Feeder, f.cfg.Validator))
+}
+
+func (f *Feeder) queryParams(ctx context.Context) (oracletypes.Params, error) {
+	var params oracletypes.Params
+	err := f.retry(ctx, func() error {
+		qctx, cancel := context.WithTimeout(ctx, f.queryTimeout)
+		defer cancel()
+		resp, err := f.oracle.Params(qctx, &oracletypes.QueryParams{})
+		if err != nil {
+			return err
+		}
+		params = resp.Params
+		return nil
+	})
+	if err == nil && params.VotePeriod == 0 {
+		err = fmt.Errorf("invalid oracle vote period: 0")
+	}
+	return params, err
+}
+
+// retry calls fn until it succeeds, up to 1+Retries times.
+func (f *Feeder) retry(ctx context.Context, fn func() error) error {
+	var err error
+	for i := 0; i <= f.cfg.Retries; i++ {
+		if i > 0 {
+			f.logger.Debug().Err(err).Int("attempt", i+1).Msg("retrying")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(f.cfg.RetryDelay):
+			}
+		}
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// GenerateSalt returns a random hex encoded vote salt.
+func GenerateSalt() (string, error) {
+	bz := make([]byte, saltLength)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
+
+// FormatExchangeRates formats the exchange rate tuples as expected by
+// MsgAggregateExchangeRateVote: `DENOM1:rate1,DENOM2:rate2`.
+func FormatExchangeRates(tuples oracletypes.ExchangeRateTuples) string {
+	parts := make([]string, len(tuples))
+	for i, t := range tuples {
+		parts[i] = t.Denom + ":" + t.ExchangeRate.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// FilterAcceptList returns the tuples of the accept list denoms.
+func FilterAcceptList(tuples oracletypes.ExchangeRateTuples, acceptList oracletypes.DenomList,
+) oracletypes.ExchangeRateTuples {
+	filtered := make(oracletypes.ExchangeRateTuples, 0, len(tuples))
+	for _, t := range tuples {
+		if acceptList.Contains(t.Denom) {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
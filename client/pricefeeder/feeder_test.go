@@ -0,0 +1,125 @@
+package pricefeeder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	oracletypes "github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+const pricesJSON = `{"umee": "0.0105", "ATOM": "9.81", "BTC": "30000"}`
+
+type mockBroadcaster struct {
+	msgs  [][]sdk.Msg
+	fails int
+}
+
+func (b *mockBroadcaster) BroadcastTx(_ int, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if b.fails > 0 {
+		b.fails--
+		return nil, errors.New("account sequence mismatch")
+	}
+	b.msgs = append(b.msgs, msgs)
+	return &sdk.TxResponse{}, nil
+}
+
+func (b *mockBroadcaster) SetAccSeq(uint64) {}
+
+func TestParsePrices(t *testing.T) {
+	tuples, err := ParsePrices([]byte(pricesJSON))
+	require.NoError(t, err)
+	require.Equal(t, "ATOM:9.810000000000000000,BTC:30000.000000000000000000,UMEE:0.010500000000000000",
+		FormatExchangeRates(tuples))
+
+	_, err = ParsePrices([]byte(`{"UMEE": "-1"}`))
+	require.ErrorContains(t, err, "must be positive")
+	_, err = ParsePrices([]byte(`{"UMEE": "1", "umee": "2"}`))
+	require.ErrorContains(t, err, "duplicated denom")
+	_, err = ParsePrices([]byte(`["UMEE"]`))
+	require.ErrorContains(t, err, "invalid prices")
+}
+
+func TestPriceSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(pricesJSON), 0o600))
+	fromFile, err := NewPriceSource(path, 0).Prices(context.Background())
+	require.NoError(t, err)
+	require.Len(t, fromFile, 3)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(pricesJSON))
+	}))
+	defer srv.Close()
+	src := NewPriceSource(srv.URL, 0)
+	require.IsType(t, HTTPSource{}, src)
+	fromHTTP, err := src.Prices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, fromFile, fromHTTP)
+}
+
+func TestVote(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(pricesJSON), 0o600))
+	valAddr := sdk.ValAddress([]byte("validator"))
+	feederAddr := sdk.AccAddress([]byte("feeder"))
+	tx := &mockBroadcaster{}
+	f := &Feeder{
+		cfg:    Config{Validator: valAddr, Feeder: feederAddr, Retries: 1},
+		source: StaticFileSource{Path: path},
+		tx:     tx,
+		logger: zerolog.Nop(),
+	}
+	acceptList := oracletypes.DenomList{{SymbolDenom: "UMEE"}, {SymbolDenom: "ATOM"}}
+	ctx := context.Background()
+
+	// first period: prevote only
+	require.NoError(t, f.Vote(ctx, 10, acceptList))
+	require.Len(t, tx.msgs, 1)
+	require.Len(t, tx.msgs[0], 1)
+	prevote := tx.msgs[0][0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+
+	// next period: the vote reveals the previous prevote, and a new prevote is sent
+	tx.fails = 1
+	require.NoError(t, f.Vote(ctx, 11, acceptList))
+	require.Len(t, tx.msgs, 2)
+	require.Len(t, tx.msgs[1], 2)
+	vote := tx.msgs[1][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.Equal(t, "ATOM:9.810000000000000000,UMEE:0.010500000000000000", vote.ExchangeRates)
+	require.Equal(t, prevote.Hash, oracletypes.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, valAddr).String())
+	require.Equal(t, feederAddr.String(), vote.Feeder)
+	require.Equal(t, valAddr.String(), vote.Validator)
+	require.NoError(t, vote.ValidateBasic())
+	require.NoError(t, tx.msgs[1][1].ValidateBasic())
+
+	// a skipped period can't be revealed anymore
+	require.NoError(t, f.Vote(ctx, 13, acceptList))
+	require.Len(t, tx.msgs[2], 1)
+
+	// failed broadcasts drop the pending prevote
+	tx.fails = 2
+	require.Error(t, f.Vote(ctx, 14, acceptList))
+	require.NoError(t, f.Vote(ctx, 15, acceptList))
+	require.Len(t, tx.msgs[3], 1)
+}
+
+func TestGenerateSalt(t *testing.T) {
+	salt, err := GenerateSalt()
+	require.NoError(t, err)
+	require.Len(t, salt, oracletypes.SaltLength)
+	other, err := GenerateSalt()
+	require.NoError(t, err)
+	require.NotEqual(t, salt, other)
+
+	valAddr := sdk.ValAddress([]byte("validator"))
+	msg := oracletypes.NewMsgAggregateExchangeRateVote(salt, "UMEE:0.0105", sdk.AccAddress([]byte("feeder")), valAddr)
+	require.NoError(t, msg.ValidateBasic())
+}
@@ -0,0 +1,114 @@
+package pricefeeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// PriceSource provides the exchange rates, in USD, voted by the feeder.
+type PriceSource interface {
+	Prices(ctx context.Context) (oracletypes.ExchangeRateTuples, error)
+}
+
+// StaticFileSource reads the exchange rates from a JSON file mapping symbol denoms to
+// rates, eg: `{"UMEE": "0.0105", "ATOM": "9.81"}`. The file is read on every call, so
+// the rates can be updated without restarting the feeder.
+type StaticFileSource struct {
+	Path string
+}
+
+// Prices implements PriceSource.
+func (s StaticFileSource) Prices(_ context.Context) (oracletypes.ExchangeRateTuples, error) {
+	bz, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrices(bz)
+}
+
+// HTTPSource fetches the exchange rates from an HTTP endpoint returning the same JSON
+// object as StaticFileSource.
+type HTTPSource struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewHTTPSource creates an HTTPSource with the given request timeout.
+func NewHTTPSource(url string, timeout time.Duration) HTTPSource {
+	return HTTPSource{URL: url, Client: &http.Client{Timeout: timeout}}
+}
+
+// Prices implements PriceSource.
+func (s HTTPSource) Prices(ctx context.Context) (oracletypes.ExchangeRateTuples, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := s.Client
+	if c == nil {
+		c = http.DefaultClient
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %s", s.URL, resp.Status)
+	}
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrices(bz)
+}
+
+// NewPriceSource returns an HTTPSource for http(s) URLs and a StaticFileSource otherwise.
+func NewPriceSource(location string, timeout time.Duration) PriceSource {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		return NewHTTPSource(location, timeout)
+	}
+	return StaticFileSource{Path: location}
+}
+
+// ParsePrices parses a JSON object mapping symbol denoms to exchange rates. Denoms are
+// uppercased and the returned tuples are sorted by denom.
+func ParsePrices(bz []byte) (oracletypes.ExchangeRateTuples, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("invalid prices: %w", err)
+	}
+	tuples := make(oracletypes.ExchangeRateTuples, 0, len(raw))
+	seen := make(map[string]struct{}, len(raw))
+	for denom, rate := range raw {
+		denom = strings.ToUpper(strings.TrimSpace(denom))
+		if denom == "" {
+			return nil, fmt.Errorf("empty denom")
+		}
+		if _, ok := seen[denom]; ok {
+			return nil, fmt.Errorf("duplicated denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+		r, err := sdk.NewDecFromStr(strings.TrimSpace(rate))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s exchange rate: %w", denom, err)
+		}
+		if !r.IsPositive() {
+			return nil, fmt.Errorf("%s exchange rate must be positive, got %s", denom, r)
+		}
+		tuples = append(tuples, oracletypes.NewExchangeRateTuple(denom, r))
+	}
+	sort.Slice(tuples, func(i, j int) bool { return tuples[i].Denom < tuples[j].Denom })
+	return tuples, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v6/app"
+	"github.com/umee-network/umee/v6/client/pricefeeder"
+	"github.com/umee-network/umee/v6/sdkclient"
+)
+
+const (
+	flagChainID       = "chain-id"
+	flagNode          = "node"
+	flagGrpc          = "grpc"
+	flagHome          = "home"
+	flagValidator     = "validator"
+	flagPrices        = "prices"
+	flagRetries       = "retries"
+	flagRetryDelay    = "retry-delay"
+	flagTimeout       = "timeout"
+	flagGasAdjustment = "gas-adjustment"
+
+	// envMnemonic is the environment variable with the mnemonic of the feeder account.
+	envMnemonic = "PRICE_FEEDER_MNEMONIC"
+	keyName     = "feeder"
+)
+
+func main() {
+	rootCmd := newRootCmd()
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-feeder",
+		Args:  cobra.NoArgs,
+		Short: "a minimal oracle price feeder for small validators and test networks",
+		Long: strings.TrimSpace(`
+Vote oracle prices at every vote period, with the prices of a static JSON file or of an HTTP
+endpoint returning the same JSON object, eg: {"UMEE": "0.0105", "ATOM": "9.81"}.
+The mnemonic of the feeder account (the validator operator or its delegated feeder) is read
+from the ` + envMnemonic + ` environment variable.
+
+$ price-feeder --chain-id umee-local --validator umeevaloper1... --prices prices.json
+`),
+		RunE: run,
+	}
+	cmd.Flags().String(flagChainID, "", "chain ID")
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "CometBFT RPC endpoint")
+	cmd.Flags().String(flagGrpc, "tcp://localhost:9090", "gRPC endpoint")
+	cmd.Flags().String(flagHome, os.TempDir(), "directory of the test keyring")
+	cmd.Flags().String(flagValidator, "", "validator operator address")
+	cmd.Flags().String(flagPrices, "", "prices JSON file path or http(s) URL")
+	cmd.Flags().Int(flagRetries, 3, "number of retries of failed price fetches and broadcasts")
+	cmd.Flags().Duration(flagRetryDelay, time.Second, "delay between retries")
+	cmd.Flags().Duration(flagTimeout, 5*time.Second, "timeout of HTTP price requests")
+	cmd.Flags().Float64(flagGasAdjustment, 1.5, "gas adjustment of vote transactions")
+	for _, f := range []string{flagChainID, flagValidator, flagPrices} {
+		_ = cmd.MarkFlagRequired(f)
+	}
+	return cmd
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	mnemonic := os.Getenv(envMnemonic)
+	if mnemonic == "" {
+		return fmt.Errorf("%s is not set", envMnemonic)
+	}
+	flags := cmd.Flags()
+	chainID, _ := flags.GetString(flagChainID)
+	node, _ := flags.GetString(flagNode)
+	grpc, _ := flags.GetString(flagGrpc)
+	home, _ := flags.GetString(flagHome)
+	validator, _ := flags.GetString(flagValidator)
+	prices, _ := flags.GetString(flagPrices)
+	retries, _ := flags.GetInt(flagRetries)
+	retryDelay, _ := flags.GetDuration(flagRetryDelay)
+	timeout, _ := flags.GetDuration(flagTimeout)
+	gasAdjustment, _ := flags.GetFloat64(flagGasAdjustment)
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+	c, err := sdkclient.NewClient(home, chainID, node, grpc, map[string]string{keyName: mnemonic},
+		gasAdjustment, app.MakeEncodingConfig())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Logger()
+	heights, err := c.NewChainHeightListener(ctx, logger)
+	if err != nil {
+		return err
+	}
+	feeder := pricefeeder.New(c, pricefeeder.NewPriceSource(prices, timeout), pricefeeder.Config{
+		Validator:  valAddr,
+		Feeder:     c.Tx.SenderAddr(),
+		Retries:    retries,
+		RetryDelay: retryDelay,
+	}, logger)
+	return feeder.Run(ctx, heights)
+}
@@ -25,6 +25,7 @@ import (
 	umeeapp "github.com/umee-network/umee/v6/app"
 	appparams "github.com/umee-network/umee/v6/app/params"
 	"github.com/umee-network/umee/v6/x/leverage"
+	oraclecli "github.com/umee-network/umee/v6/x/oracle/client/cli"
 )
 
 // NewRootCmd returns the root command handler for the Umee daemon.
@@ -156,6 +157,7 @@ func initRootCmd(rootCmd *cobra.Command, a appCreator) {
 		),
 		genutilcli.ValidateGenesisCmd(a.moduleManager),
 		addGenesisAccountCmd(umeeapp.DefaultNodeHome),
+		oraclecli.PatchGenesisHistoryCmd(umeeapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debugCmd(),
 		config.Cmd(),
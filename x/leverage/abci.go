@@ -12,6 +12,7 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	util.Panic(k.SweepBadDebts(ctx))
 	util.Panic(k.AccrueAllInterest(ctx))
+	util.Panic(k.ExecuteDeleverageOrders(ctx))
 
 	return []abci.ValidatorUpdate{}
 }
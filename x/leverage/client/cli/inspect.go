@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+// Output formats which export every page of the inspect query
+const (
+	OutputCSV   = "csv"
+	OutputJSONL = "jsonl"
+)
+
+// sortByFlagValues maps the values of the --sort-by flag to account sort keys.
+var sortByFlagValues = map[string]types.AccountSortKey{
+	"":         types.AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED,
+	"address":  types.AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS,
+	"borrowed": types.AccountSortKey_ACCOUNT_SORT_KEY_BORROWED_VALUE,
+	"ltv":      types.AccountSortKey_ACCOUNT_SORT_KEY_LTV,
+	"danger":   types.AccountSortKey_ACCOUNT_SORT_KEY_DANGER,
+}
+
+func addSortByFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSortBy, "", "Sort accounts by address, borrowed, ltv or danger (highest first)")
+}
+
+func readSortBy(cmd *cobra.Command) (types.AccountSortKey, error) {
+	s, err := cmd.Flags().GetString(FlagSortBy)
+	if err != nil {
+		return types.AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED, err
+	}
+	sortBy, ok := sortByFlagValues[strings.ToLower(s)]
+	if !ok {
+		return sortBy, fmt.Errorf("invalid --%s value %q: expected address, borrowed, ltv or danger", FlagSortBy, s)
+	}
+	return sortBy, nil
+}
+
+// exportInspect pages through the inspect query starting from the request's page, writing each account
+// to the command's output as a CSV row or JSON line. Addresses whose positions could not be calculated
+// are reported on stderr.
+func exportInspect(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	queryClient types.QueryClient,
+	req *types.QueryInspect,
+) error {
+	out := cmd.OutOrStdout()
+	var csvWriter *csv.Writer
+	if clientCtx.OutputFormat == OutputCSV {
+		csvWriter = csv.NewWriter(out)
+		if err := csvWriter.Write(inspectCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	for {
+		resp, err := queryClient.Inspect(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, addr := range resp.Failures {
+			cmd.PrintErrln("failed to calculate position:", addr)
+		}
+		for _, account := range resp.Borrowers {
+			if csvWriter != nil {
+				err = csvWriter.Write(inspectCSVRow(account))
+			} else {
+				err = writeJSONLine(out, clientCtx, &account)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+		}
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return nil
+		}
+		req.Pagination.Key = resp.Pagination.NextKey
+		req.Pagination.Offset = 0
+		req.Pagination.CountTotal = false
+	}
+}
+
+var inspectCSVHeader = []string{
+	"address", "borrowed_value", "liquidation_threshold", "collateral_value", "danger", "ltv", "borrowed", "collateral",
+}
+
+func inspectCSVRow(a types.InspectAccount) []string {
+	return []string{
+		a.Address,
+		formatFloat(a.Analysis.Borrowed),
+		formatFloat(a.Analysis.Liquidation),
+		formatFloat(a.Analysis.Value),
+		formatFloat(a.Analysis.Borrowed / a.Analysis.Liquidation),
+		formatFloat(a.Analysis.Borrowed / a.Analysis.Value),
+		formatBalances(a.Position.Borrowed),
+		formatBalances(a.Position.Collateral),
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatBalances joins position balances into a single CSV field, such as "1.5UMEE;20ATOM".
+func formatBalances(balances []types.PositionBalance) string {
+	s := make([]string, len(balances))
+	for i, b := range balances {
+		s[i] = b.Amount.String() + b.Denom
+	}
+	return strings.Join(s, ";")
+}
+
+func writeJSONLine(w io.Writer, clientCtx client.Context, account *types.InspectAccount) error {
+	bz, err := clientCtx.Codec.MarshalJSON(account)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bz))
+	return err
+}
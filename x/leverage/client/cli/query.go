@@ -14,7 +14,8 @@ import (
 
 // Flag constants
 const (
-	FlagDenom = "denom"
+	FlagDenom  = "denom"
+	FlagSortBy = "sort-by"
 )
 
 // GetQueryCmd returns the CLI query commands for the x/leverage module.
@@ -41,6 +42,9 @@ func GetQueryCmd() *cobra.Command {
 		QueryMaxBorrow(),
 		QueryInspect(),
 		QueryInspectAccount(),
+		QueryReferralRewards(),
+		QueryAllowList(),
+		QueryDeleverageOrders(),
 	)
 
 	return cmd
@@ -225,9 +229,15 @@ func QueryAccountSummaries() *cobra.Command {
 				return err
 			}
 
+			sortBy, err := readSortBy(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.QueryAccountSummaries{
 				Pagination: pageReq,
+				SortBy:     sortBy,
 			}
 			resp, err := queryClient.AccountSummaries(cmd.Context(), req)
 			return cli.PrintOrErr(resp, err, clientCtx)
@@ -236,6 +246,7 @@ func QueryAccountSummaries() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "account-summaries")
+	addSortByFlag(cmd)
 
 	return cmd
 }
@@ -356,10 +367,17 @@ func QueryMaxBorrow() *cobra.Command {
 // QueryInspect creates a Cobra command to query for the inspector command.
 func QueryInspect() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "inspect [symbol] [borrowed] [collateral [danger] [ltv]",
-		Args:    cobra.MinimumNArgs(2),
-		Short:   "Inspect accounts with the leverage module, filtered with various minimum values.",
-		Example: "umeed q leverage inspect OSMO 100 0 0.9 0",
+		Use:   "inspect [symbol] [borrowed] [collateral [danger] [ltv]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Inspect accounts with the leverage module, filtered with various minimum values.",
+		Long: "Inspect accounts with the leverage module, filtered with various minimum values.\n" +
+			"Results are paginated: without --limit, only the first 100 borrowers are returned, " +
+			"and --page-key or --offset select the next pages.\n" +
+			"With --output csv or jsonl, pages through all results and writes one account per line. " +
+			"Results are exported in address order unless --sort-by is set, and sorted exports " +
+			"stop after the first 1000 accounts.",
+		Example: "umeed q leverage inspect OSMO 100 0 0.9 0\n" +
+			"umeed q leverage inspect all 0 --output csv --limit 500 > borrowers.csv",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -392,12 +410,30 @@ func QueryInspect() *cobra.Command {
 					return err
 				}
 			}
+			if req.SortBy, err = readSortBy(cmd); err != nil {
+				return err
+			}
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			switch clientCtx.OutputFormat {
+			case OutputCSV, OutputJSONL:
+				if req.SortBy == types.AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED {
+					// address order streams pages without evaluating every borrower per page
+					req.SortBy = types.AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS
+				}
+				return exportInspect(cmd, clientCtx, queryClient, req)
+			}
 			resp, err := queryClient.Inspect(cmd.Context(), req)
 			return cli.PrintOrErr(resp, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "inspect")
+	addSortByFlag(cmd)
+	cmd.Flags().Lookup(flags.FlagOutput).Usage = "Output format (text|json|csv|jsonl)"
 
 	return cmd
 }
@@ -427,3 +463,82 @@ func QueryInspectAccount() *cobra.Command {
 
 	return cmd
 }
+
+// QueryReferralRewards creates a Cobra command to query for the unclaimed
+// referral rewards of a referrer.
+func QueryReferralRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral-rewards [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the unclaimed referral rewards of a referrer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryReferralRewards{
+				Address: args[0],
+			}
+			resp, err := queryClient.ReferralRewards(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryAllowList creates a Cobra command to query for the allow list of a permissioned token.
+func QueryAllowList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allow-list [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the admin and allow-listed accounts of a permissioned token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAllowList{
+				Denom: args[0],
+			}
+			resp, err := queryClient.AllowList(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDeleverageOrders creates a Cobra command to query for the deleverage orders of a borrower.
+func QueryDeleverageOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deleverage-orders [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the deleverage orders of a borrower",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDeleverageOrders{
+				Address: args[0],
+			}
+			resp, err := queryClient.DeleverageOrders(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
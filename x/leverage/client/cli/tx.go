@@ -13,6 +13,15 @@ import (
 	"github.com/umee-network/umee/v6/x/leverage/types"
 )
 
+const (
+	// FlagReferrer is the optional referrer address of supply and borrow transactions.
+	FlagReferrer = "referrer"
+	// FlagAdd is the list of addresses to add to an allow list.
+	FlagAdd = "add"
+	// FlagRemove is the list of addresses to remove from an allow list.
+	FlagRemove = "remove"
+)
+
 // GetTxCmd returns the CLI transaction commands for the x/leverage module.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -35,6 +44,10 @@ func GetTxCmd() *cobra.Command {
 		Liquidate(),
 		LeveragedLiquidate(),
 		SupplyCollateral(),
+		ClaimReferralRewards(),
+		UpdateAllowList(),
+		CreateDeleverageOrder(),
+		CancelDeleverageOrder(),
 	)
 
 	return cmd
@@ -59,11 +72,15 @@ func Supply() *cobra.Command {
 			}
 
 			msg := types.NewMsgSupply(clientCtx.GetFromAddress(), asset)
+			if msg.Referrer, err = cmd.Flags().GetString(FlagReferrer); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferrer, "", "Optional address of the frontend which referred the account")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -205,11 +222,15 @@ func Borrow() *cobra.Command {
 			}
 
 			msg := types.NewMsgBorrow(clientCtx.GetFromAddress(), asset)
+			if msg.Referrer, err = cmd.Flags().GetString(FlagReferrer); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferrer, "", "Optional address of the frontend which referred the account")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -396,6 +417,132 @@ func SupplyCollateral() *cobra.Command {
 			}
 
 			msg := types.NewMsgSupplyCollateral(clientCtx.GetFromAddress(), asset)
+			if msg.Referrer, err = cmd.Flags().GetString(FlagReferrer); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReferrer, "", "Optional address of the frontend which referred the account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ClaimReferralRewards creates a Cobra command to generate or broadcast a
+// transaction with a MsgClaimReferralRewards message.
+func ClaimReferralRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-referral-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Claim all referral rewards accumulated by the sender",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimReferralRewards(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// UpdateAllowList creates a Cobra command to generate or broadcast a
+// transaction with a MsgUpdateAllowList message.
+func UpdateAllowList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-allow-list [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add or remove accounts from the allow list of a permissioned token",
+		Example: "umeed tx leverage update-allow-list uumee --add umee1...,umee1... --remove umee1... " +
+			"--from admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateAllowList{
+				Admin: clientCtx.GetFromAddress().String(),
+				Denom: args[0],
+			}
+			if msg.Add, err = cmd.Flags().GetStringSlice(FlagAdd); err != nil {
+				return err
+			}
+			if msg.Remove, err = cmd.Flags().GetStringSlice(FlagRemove); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAdd, nil, "Comma separated addresses to add to the allow list")
+	cmd.Flags().StringSlice(FlagRemove, nil, "Comma separated addresses to remove from the allow list")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CreateDeleverageOrder creates a Cobra command to generate or broadcast a
+// transaction with a MsgCreateDeleverageOrder message.
+func CreateDeleverageOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-deleverage-order [denom] [trigger-ratio] [target-ratio]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create or replace an order to repay a borrow using collateral",
+		Long: "Create or replace an order to repay a borrow using collateral of the same token, " +
+			"when the ratio of borrowed value to liquidation threshold reaches the trigger ratio. " +
+			"Enough is repaid to bring the ratio back to the target ratio.",
+		Example: "umeed tx leverage create-deleverage-order ibc/abcd 0.9 0.75 --from borrower",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			trigger, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			target, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDeleverageOrder(clientCtx.GetFromAddress(), args[0], trigger, target)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CancelDeleverageOrder creates a Cobra command to generate or broadcast a
+// transaction with a MsgCancelDeleverageOrder message.
+func CancelDeleverageOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-deleverage-order [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the sender's deleverage order for a token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDeleverageOrder(clientCtx.GetFromAddress(), args[0])
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -13,6 +13,9 @@ func Params() types.Params {
 		MinimumCloseFactor:           sdk.MustNewDecFromStr("0.01"),
 		OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
 		RewardsAuctionFee:            sdk.MustNewDecFromStr("0.02"),
+		ReferralRewardFactor:         sdk.ZeroDec(),
+		DeleverageFee:                sdk.MustNewDecFromStr("0.005"),
+		MaxDeleverageOrdersPerBlock:  50,
 		SmallLiquidationSize:         sdk.MustNewDecFromStr("100.00"),
 		DirectLiquidationFee:         sdk.MustNewDecFromStr("0.1"),
 	}
@@ -97,6 +97,11 @@ func (q Querier) AccountSummaries(goCtx context.Context, req *types.QueryAccount
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.SortBy != types.AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED &&
+		req.SortBy != types.AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS {
+		return q.sortedAccountSummaries(ctx, req)
+	}
+
 	// get the all accounts
 	store := ctx.KVStore(q.akStoreKey)
 	accountsStore := prefix.NewStore(store, authtypes.AddressStoreKeyPrefix)
@@ -140,3 +145,42 @@ func (q Querier) AccountSummary(
 	}
 	return q.accountSummary(ctx, addr)
 }
+
+// sortedAccountSummaries returns a page of account summaries of all borrowers, sorted by the requested sort key.
+// Every borrower is evaluated, but only the accounts up to the end of the page are kept, so pages must end within
+// the first MaxSortedAccounts accounts.
+// Missing liquidation thresholds are treated as zero, which sorts those accounts first by danger.
+func (q Querier) sortedAccountSummaries(ctx sdk.Context, req *types.QueryAccountSummaries,
+) (*types.QueryAccountSummariesResponse, error) {
+	value := func(a *types.AccountSummary) float64 {
+		liquidation := 0.0
+		if a.AccountSummary.LiquidationThreshold != nil {
+			liquidation = a.AccountSummary.LiquidationThreshold.MustFloat64()
+		}
+		return accountSortValue(
+			req.SortBy,
+			a.AccountSummary.BorrowedValue.MustFloat64(),
+			a.AccountSummary.CollateralValue.MustFloat64(),
+			liquidation,
+		)
+	}
+	less := accountLess(value, func(a *types.AccountSummary) string { return a.Address })
+
+	page, pageRes, err := paginateSorted(req.Pagination, less, func(add func(*types.AccountSummary)) error {
+		return q.iterateBorrowers(ctx, func(addr sdk.AccAddress) error {
+			accSummary, err := q.accountSummary(ctx, addr)
+			if err != nil {
+				return err
+			}
+			add(&types.AccountSummary{
+				Address:        addr.String(),
+				AccountSummary: accSummary,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAccountSummariesResponse{AccountSummaries: page, Pagination: pageRes}, nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+// IsAllowListed returns true if an account is in the allow list of a Token.
+func (k Keeper) IsAllowListed(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyAllowListed(denom, addr))
+}
+
+// setAllowListed adds or removes an account from the allow list of a Token.
+func (k Keeper) setAllowListed(ctx sdk.Context, denom string, addr sdk.AccAddress, allowed bool) error {
+	if err := types.ValidateBaseDenom(denom); err != nil {
+		return err
+	}
+	if addr.Empty() {
+		return types.ErrEmptyAddress
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyAllowListed(denom, addr)
+
+	if allowed {
+		store.Set(key, []byte{0x01})
+	} else {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// assertAllowListed returns an error if a Token is permissioned and the account is not in its allow list.
+func (k Keeper) assertAllowListed(ctx sdk.Context, token types.Token, addr sdk.AccAddress) error {
+	if !token.IsPermissioned() || k.IsAllowListed(ctx, token.BaseDenom, addr) {
+		return nil
+	}
+	return types.ErrNotAllowListed.Wrapf("%s: %s", token.BaseDenom, addr)
+}
+
+// UpdateAllowList adds and removes accounts from the allow list of a permissioned Token.
+// The admin must match the Token's AllowListAdmin.
+func (k Keeper) UpdateAllowList(ctx sdk.Context, admin sdk.AccAddress, denom string, add, remove []string) error {
+	token, err := k.GetTokenSettings(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if !token.IsPermissioned() || token.AllowListAdmin != admin.String() {
+		return types.ErrNotAllowListAdmin.Wrapf("%s: %s", denom, admin)
+	}
+
+	for _, a := range add {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return err
+		}
+		if err := k.setAllowListed(ctx, denom, addr, true); err != nil {
+			return err
+		}
+	}
+	for _, a := range remove {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return err
+		}
+		if err := k.setAllowListed(ctx, denom, addr, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllowList returns all accounts in the allow list of a Token.
+func (k Keeper) GetAllowList(ctx sdk.Context, denom string) []string {
+	prefix := types.KeyAllowListNoAddress(denom)
+	addresses := []string{}
+
+	iterator := func(key, _ []byte) error {
+		addresses = append(addresses, types.AddressFromKey(key, prefix).String())
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return addresses
+}
+
+// getAllAllowLists returns the allow lists of all Tokens. Uses the AllowList struct found in GenesisState.
+func (k Keeper) getAllAllowLists(ctx sdk.Context) []types.AllowList {
+	prefix := types.KeyPrefixAllowList
+	lists := []types.AllowList{}
+
+	iterator := func(key, _ []byte) error {
+		denom, addr := types.DenomAndAddressFromKey(key, prefix)
+		// keys are sorted by denom, so a new denom starts a new list
+		if len(lists) == 0 || lists[len(lists)-1].Denom != denom {
+			lists = append(lists, types.NewAllowList(denom, []string{}))
+		}
+		last := &lists[len(lists)-1]
+		last.Addresses = append(last.Addresses, addr.String())
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return lists
+}
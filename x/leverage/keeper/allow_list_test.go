@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/coin"
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+func (s *IntegrationTestSuite) TestPermissionedMarket() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	admin := s.newAccount()
+	lender := s.newAccount(coin.New(atomDenom, 100_000000))
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+
+	token, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	token.AllowListAdmin = admin.String()
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, token))
+
+	// accounts which are not allow-listed cannot supply
+	_, err = srv.Supply(ctx, types.NewMsgSupply(lender, coin.New(atomDenom, 10_000000)))
+	require.ErrorIs(err, types.ErrNotAllowListed)
+	_, err = srv.SupplyCollateral(ctx, types.NewMsgSupplyCollateral(borrower, coin.New(atomDenom, 10_000000)))
+	require.ErrorIs(err, types.ErrNotAllowListed)
+
+	// only the admin can update the allow list
+	msg := types.NewMsgUpdateAllowList(lender, atomDenom, []sdk.AccAddress{lender}, nil)
+	_, err = srv.UpdateAllowList(ctx, msg)
+	require.ErrorIs(err, types.ErrNotAllowListAdmin)
+	msg = types.NewMsgUpdateAllowList(admin, umeeDenom, []sdk.AccAddress{lender}, nil)
+	_, err = srv.UpdateAllowList(ctx, msg)
+	require.ErrorIs(err, types.ErrNotAllowListAdmin, "token is not permissioned")
+
+	msg = types.NewMsgUpdateAllowList(admin, atomDenom, []sdk.AccAddress{lender, borrower}, nil)
+	_, err = srv.UpdateAllowList(ctx, msg)
+	require.NoError(err)
+	require.True(app.LeverageKeeper.IsAllowListed(ctx, atomDenom, lender))
+
+	resp, err := s.queryClient.AllowList(ctx, &types.QueryAllowList{Denom: atomDenom})
+	require.NoError(err)
+	require.Equal(admin.String(), resp.Admin)
+	require.ElementsMatch([]string{lender.String(), borrower.String()}, resp.Addresses)
+
+	// allow-listed accounts can supply, collateralize and borrow
+	s.supply(lender, coin.New(atomDenom, 50_000000))
+	s.supply(borrower, coin.New(atomDenom, 10_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 10_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(borrower, coin.New(atomDenom, 5_000000))
+
+	// removed accounts can no longer borrow, but can still repay and withdraw
+	msg = types.NewMsgUpdateAllowList(admin, atomDenom, nil, []sdk.AccAddress{borrower})
+	_, err = srv.UpdateAllowList(ctx, msg)
+	require.NoError(err)
+	require.False(app.LeverageKeeper.IsAllowListed(ctx, atomDenom, borrower))
+
+	_, err = srv.Borrow(ctx, types.NewMsgBorrow(borrower, coin.New(atomDenom, 1_000000)))
+	require.ErrorIs(err, types.ErrNotAllowListed)
+	_, err = srv.Repay(ctx, types.NewMsgRepay(borrower, coin.New(atomDenom, 5_000000)))
+	require.NoError(err)
+	s.decollateralize(borrower, coin.New("u/"+atomDenom, 10_000000))
+	s.withdraw(borrower, coin.New("u/"+atomDenom, 10_000000))
+
+	// allow lists are exported in genesis
+	genesis := app.LeverageKeeper.ExportGenesis(ctx)
+	require.Equal([]types.AllowList{types.NewAllowList(atomDenom, []string{lender.String()})}, genesis.AllowLists)
+
+	s.checkInvariants("permissioned market")
+}
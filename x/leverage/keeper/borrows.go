@@ -81,12 +81,14 @@ func (k Keeper) GetTotalBorrowed(ctx sdk.Context, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, total)
 }
 
-// AvailableLiquidity gets the unreserved module balance of a given token.
+// AvailableLiquidity gets the unreserved module balance of a given token,
+// excluding unclaimed referral rewards.
 func (k Keeper) AvailableLiquidity(ctx sdk.Context, denom string) sdkmath.Int {
 	moduleBalance := k.ModuleBalance(ctx, denom).Amount
 	reserveAmount := k.GetReserves(ctx, denom).Amount
+	referralRewards := k.GetTotalReferralRewards(ctx, denom).Amount
 
-	return sdk.MaxInt(moduleBalance.Sub(reserveAmount), sdk.ZeroInt())
+	return sdk.MaxInt(moduleBalance.Sub(reserveAmount).Sub(referralRewards), sdk.ZeroInt())
 }
 
 // SupplyUtilization calculates the current supply utilization of a token denom.
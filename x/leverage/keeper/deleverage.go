@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/coin"
+	"github.com/umee-network/umee/v6/util/sdkutil"
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+// GetDeleverageOrders returns all deleverage orders of a borrower.
+func (k Keeper) GetDeleverageOrders(ctx sdk.Context, borrower sdk.AccAddress) []types.DeleverageOrder {
+	return store.MustLoadAll[*types.DeleverageOrder](
+		ctx.KVStore(k.storeKey), types.KeyDeleverageOrderNoDenom(borrower),
+	)
+}
+
+// SetDeleverageOrder creates or replaces the deleverage order of a borrower for a token.
+func (k Keeper) SetDeleverageOrder(ctx sdk.Context, order types.DeleverageOrder) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+	key := types.KeyDeleverageOrder(sdk.MustAccAddressFromBech32(order.Borrower), order.Denom)
+	return store.SetValue(ctx.KVStore(k.storeKey), key, &order, "deleverage order")
+}
+
+// DeleteDeleverageOrder removes the deleverage order of a borrower for a token.
+func (k Keeper) DeleteDeleverageOrder(ctx sdk.Context, borrower sdk.AccAddress, denom string) error {
+	kv := ctx.KVStore(k.storeKey)
+	key := types.KeyDeleverageOrder(borrower, denom)
+	if !kv.Has(key) {
+		return types.ErrNoDeleverageOrder.Wrapf("%s: %s", borrower, denom)
+	}
+	kv.Delete(key)
+	return nil
+}
+
+// getAllDeleverageOrders returns the deleverage orders of all borrowers.
+func (k Keeper) getAllDeleverageOrders(ctx sdk.Context) []types.DeleverageOrder {
+	return store.MustLoadAll[*types.DeleverageOrder](ctx.KVStore(k.storeKey), types.KeyPrefixDeleverageOrder)
+}
+
+// ExecuteDeleverageOrders is called by EndBlock. It evaluates up to MaxDeleverageOrdersPerBlock
+// orders, continuing after the last order evaluated in the previous block, and executes those
+// which are triggered. Orders which fail to execute are skipped without affecting state.
+func (k Keeper) ExecuteDeleverageOrders(ctx sdk.Context) error {
+	budget := k.GetParams(ctx).MaxDeleverageOrdersPerBlock
+	if budget == 0 {
+		return nil
+	}
+
+	orders, lastKey, err := k.nextDeleverageOrders(ctx, budget)
+	if err != nil {
+		return err
+	}
+	for _, order := range orders {
+		cacheCtx, write := ctx.CacheContext()
+		executed, err := k.executeDeleverageOrder(cacheCtx, order)
+		if err != nil {
+			k.Logger(ctx).Debug(
+				"deleverage order skipped",
+				"borrower", order.Borrower,
+				"denom", order.Denom,
+				"error", err.Error(),
+			)
+			continue
+		}
+		if executed {
+			write()
+		}
+	}
+
+	if lastKey != nil {
+		ctx.KVStore(k.storeKey).Set(types.KeyDeleverageCursor, lastKey)
+	}
+	return nil
+}
+
+// nextDeleverageOrders collects up to limit deleverage orders, starting after the stored cursor
+// and wrapping around to the first order. Each order is collected at most once. Also returns
+// the key of the last order collected.
+func (k Keeper) nextDeleverageOrders(ctx sdk.Context, limit uint32) ([]types.DeleverageOrder, []byte, error) {
+	kv := ctx.KVStore(k.storeKey)
+	prefix := types.KeyPrefixDeleverageOrder
+	prefixEnd := storetypes.PrefixEndBytes(prefix)
+	cursor := kv.Get(types.KeyDeleverageCursor)
+
+	// keys are null-terminated, so cursor | 0x00 is the first possible key after the cursor
+	ranges := [][2][]byte{{prefix, prefixEnd}}
+	if cursor != nil {
+		next := append(append([]byte{}, cursor...), 0x00)
+		ranges = [][2][]byte{{next, prefixEnd}, {prefix, next}}
+	}
+
+	orders := []types.DeleverageOrder{}
+	var lastKey []byte
+	for _, r := range ranges {
+		iter := kv.Iterator(r[0], r[1])
+		for ; iter.Valid() && len(orders) < int(limit); iter.Next() {
+			var order types.DeleverageOrder
+			if err := order.Unmarshal(iter.Value()); err != nil {
+				iter.Close()
+				return nil, nil, err
+			}
+			orders = append(orders, order)
+			lastKey = iter.Key()
+		}
+		iter.Close()
+	}
+	return orders, lastKey, nil
+}
+
+// executeDeleverageOrder checks whether a deleverage order is triggered, and if so burns some of the
+// borrower's u/denom collateral to repay its denom borrow, charging the deleverage fee into reserves.
+// The amount repaid aims to bring the ratio of borrowed value to liquidation threshold back to the
+// order's target ratio, limited by the borrow and unbonded collateral available. The base tokens backing
+// the burned collateral repay the borrow and fund the fee, so suppliers are not affected.
+//
+// Orders of fully repaid borrows are deleted. Returns true if the order was executed or deleted.
+func (k Keeper) executeDeleverageOrder(ctx sdk.Context, order types.DeleverageOrder) (bool, error) {
+	borrower, err := sdk.AccAddressFromBech32(order.Borrower)
+	if err != nil {
+		return false, err
+	}
+	borrowed := k.GetBorrow(ctx, borrower, order.Denom)
+	if borrowed.IsZero() {
+		return true, k.DeleteDeleverageOrder(ctx, borrower, order.Denom)
+	}
+	uDenom := coin.ToUTokenDenom(order.Denom)
+	collateral := k.unbondedCollateral(ctx, borrower, uDenom)
+	if collateral.IsZero() {
+		return false, nil
+	}
+
+	position, err := k.GetAccountPosition(ctx, borrower, true)
+	if err != nil {
+		return false, err
+	}
+	borrowedValue := position.BorrowedValue()
+	limit := position.Limit()
+	if borrowedValue.LT(order.TriggerRatio.Mul(limit)) {
+		// order not triggered
+		return false, nil
+	}
+
+	token, err := k.GetTokenSettings(ctx, order.Denom)
+	if err != nil {
+		return false, err
+	}
+	if err := token.AssertNotBlacklisted(); err != nil {
+		return false, err
+	}
+
+	// repaying value x reduces borrowed value by x and liquidation threshold by (approximately)
+	// x * (1 + fee) * liquidation threshold of the token, so solve for
+	// (borrowed - x) / (limit - x * (1 + fee) * threshold) = target
+	// If the denominator is not positive, repaying does not lower the ratio to the target.
+	one := sdk.OneDec()
+	feeRate := k.GetParams(ctx).DeleverageFee
+	denominator := one.Sub(order.TargetRatio.Mul(one.Add(feeRate)).Mul(token.LiquidationThreshold))
+	if !denominator.IsPositive() {
+		return false, types.ErrUnreachableTarget.Wrapf("target %s with collateral %s", order.TargetRatio, order.Denom)
+	}
+	repayValue := borrowedValue.Sub(order.TargetRatio.Mul(limit)).Quo(denominator)
+	repay, err := k.TokenWithValue(ctx, order.Denom, repayValue, types.PriceModeSpot)
+	if err != nil {
+		return false, err
+	}
+	repay.Amount = sdk.MinInt(repay.Amount, borrowed.Amount)
+	if !repay.Amount.IsPositive() {
+		return false, nil
+	}
+
+	// burned uTokens cover the repayment and the deleverage fee, rounding up
+	collateralBase := sdk.NewCoin(order.Denom, toDec(repay.Amount).Mul(one.Add(feeRate)).Ceil().TruncateInt())
+	rate := k.DeriveExchangeRate(ctx, order.Denom)
+	uTokenAmount := toDec(collateralBase.Amount).Quo(rate).Ceil().TruncateInt()
+	if uTokenAmount.GT(collateral.Amount) {
+		uTokenAmount = collateral.Amount
+		collateralBase.Amount = toDec(uTokenAmount).Mul(rate).TruncateInt()
+		repay.Amount = toDec(collateralBase.Amount).Quo(one.Add(feeRate)).TruncateInt()
+		if !repay.Amount.IsPositive() {
+			return false, nil
+		}
+	}
+	fee := collateralBase.Sub(repay)
+	uToken := sdk.NewCoin(uDenom, uTokenAmount)
+
+	// the base tokens backing the burned uTokens remain in the module, repaying the borrow and funding the fee
+	if err := k.burnCollateral(ctx, borrower, uToken); err != nil {
+		return false, err
+	}
+	remaining := borrowed.Sub(repay)
+	if err := k.setBorrow(ctx, borrower, remaining); err != nil {
+		return false, err
+	}
+	if err := k.setReserves(ctx, k.GetReserves(ctx, order.Denom).Add(fee)); err != nil {
+		return false, err
+	}
+	if remaining.IsZero() {
+		if err := k.DeleteDeleverageOrder(ctx, borrower, order.Denom); err != nil {
+			return false, err
+		}
+	}
+
+	k.Logger(ctx).Debug(
+		"deleverage order executed",
+		"borrower", order.Borrower,
+		"repaid", repay.String(),
+		"collateral", uToken.String(),
+		"fee", fee.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventDeleverage{
+		Borrower:   order.Borrower,
+		Repaid:     repay,
+		Collateral: uToken,
+		Fee:        fee,
+	})
+	return true, nil
+}
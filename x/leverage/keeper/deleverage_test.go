@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/coin"
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+func (s *IntegrationTestSuite) TestDeleverageOrders() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	lender := s.newAccount(coin.New(stableDenom, 100_000000))
+	s.supply(lender, coin.New(stableDenom, 100_000000))
+
+	// stable has liquidation threshold 0.9, so 100 STABLE collateral can cover up to 90 STABLE borrowed
+	borrower := s.newAccount(coin.New(stableDenom, 100_000000))
+	s.supply(borrower, coin.New(stableDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+stableDenom, 100_000000))
+	s.borrow(borrower, coin.New(stableDenom, 70_000000))
+
+	// a second borrower whose order never triggers
+	other := s.newAccount(coin.New(stableDenom, 100_000000))
+	s.supply(other, coin.New(stableDenom, 100_000000))
+	s.collateralize(other, coin.New("u/"+stableDenom, 100_000000))
+	s.borrow(other, coin.New(stableDenom, 10_000000))
+
+	ratio := func(addr sdk.AccAddress) sdk.Dec {
+		position, err := app.LeverageKeeper.GetAccountPosition(ctx, addr, true)
+		require.NoError(err)
+		return position.BorrowedValue().Quo(position.Limit())
+	}
+
+	// orders require accepted tokens and valid ratios
+	_, err := srv.CreateDeleverageOrder(ctx, types.NewMsgCreateDeleverageOrder(
+		borrower, "uabcd", sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.75"),
+	))
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = srv.CancelDeleverageOrder(ctx, types.NewMsgCancelDeleverageOrder(borrower, stableDenom))
+	require.ErrorIs(err, types.ErrNoDeleverageOrder)
+
+	for _, addr := range []sdk.AccAddress{borrower, other} {
+		_, err = srv.CreateDeleverageOrder(ctx, types.NewMsgCreateDeleverageOrder(
+			addr, stableDenom, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.75"),
+		))
+		require.NoError(err)
+	}
+	resp, err := s.queryClient.DeleverageOrders(ctx, &types.QueryDeleverageOrders{Address: borrower.String()})
+	require.NoError(err)
+	require.Equal([]types.DeleverageOrder{types.NewDeleverageOrder(
+		borrower.String(), stableDenom, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.75"),
+	)}, resp.Orders)
+
+	// orders which are not triggered have no effect
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+	require.Equal(coin.New(stableDenom, 70_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, stableDenom))
+
+	// borrowed value reaches 85 / 90 of liquidation threshold, triggering the order
+	s.forceBorrow(borrower, coin.New(stableDenom, 15_000000))
+	require.True(ratio(borrower).GTE(sdk.MustNewDecFromStr("0.9")))
+	reserves := app.LeverageKeeper.GetReserves(ctx, stableDenom)
+
+	// with a budget of one order per block, the first block only evaluates the first order
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.MaxDeleverageOrdersPerBlock = 1
+	require.NoError(app.LeverageKeeper.SetParams(ctx, params))
+	borrowerFirst := bytes.Compare(
+		types.KeyDeleverageOrder(borrower, stableDenom),
+		types.KeyDeleverageOrder(other, stableDenom),
+	) < 0
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+	executed := app.LeverageKeeper.GetBorrow(ctx, borrower, stableDenom).Amount.LT(sdk.NewInt(85_000000))
+	require.Equal(borrowerFirst, executed)
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+
+	// repaying (85 - 0.75 * 90) / (1 - 0.75 * 1.005 * 0.9) = 54.411193 STABLE restores the target ratio,
+	// burning collateral which includes a 0.5% deleverage fee
+	repaid := sdk.NewInt(54_411193)
+	fee := sdk.NewInt(272_055)
+	require.Equal(
+		coin.New(stableDenom, 85_000000).Sub(sdk.NewCoin(stableDenom, repaid)),
+		app.LeverageKeeper.GetBorrow(ctx, borrower, stableDenom),
+	)
+	require.Equal(
+		coin.New("u/"+stableDenom, 100_000000).Sub(sdk.NewCoin("u/"+stableDenom, repaid.Add(fee).AddRaw(1))),
+		app.LeverageKeeper.GetCollateral(ctx, borrower, "u/"+stableDenom),
+	)
+	// reserves also keep the rounding of the burned collateral
+	require.Equal(reserves.Add(sdk.NewCoin(stableDenom, fee.AddRaw(1))), app.LeverageKeeper.GetReserves(ctx, stableDenom))
+	require.InDelta(0.75, ratio(borrower).MustFloat64(), 0.0001)
+	require.Equal(coin.New(stableDenom, 10_000000), app.LeverageKeeper.GetBorrow(ctx, other, stableDenom))
+
+	// orders remain after execution, and are not executed again until triggered
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+	require.Equal(
+		coin.New(stableDenom, 85_000000).Sub(sdk.NewCoin(stableDenom, repaid)),
+		app.LeverageKeeper.GetBorrow(ctx, borrower, stableDenom),
+	)
+
+	// orders are exported in genesis
+	genesis := app.LeverageKeeper.ExportGenesis(ctx)
+	require.Len(genesis.DeleverageOrders, 2)
+
+	_, err = srv.CancelDeleverageOrder(ctx, types.NewMsgCancelDeleverageOrder(borrower, stableDenom))
+	require.NoError(err)
+	require.Empty(app.LeverageKeeper.GetDeleverageOrders(ctx, borrower))
+
+	s.checkInvariants("deleverage orders")
+}
+
+func (s *IntegrationTestSuite) TestDeleverageOrderLifecycle() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	lender := s.newAccount(coin.New(stableDenom, 100_000000))
+	s.supply(lender, coin.New(stableDenom, 100_000000))
+
+	borrower := s.newAccount(coin.New(stableDenom, 100_000000))
+	s.supply(borrower, coin.New(stableDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+stableDenom, 100_000000))
+	s.forceBorrow(borrower, coin.New(stableDenom, 95_000000))
+
+	// an order whose target ratio can't be reached by repaying is skipped: 0.93 * 1.1 * 0.99 > 1
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.DeleverageFee = sdk.MustNewDecFromStr("0.1")
+	require.NoError(app.LeverageKeeper.SetParams(ctx, params))
+	stable, err := app.LeverageKeeper.GetTokenSettings(ctx, stableDenom)
+	require.NoError(err)
+	stable.LiquidationThreshold = sdk.MustNewDecFromStr("0.99")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, stable))
+	_, err = srv.CreateDeleverageOrder(ctx, types.NewMsgCreateDeleverageOrder(
+		borrower, stableDenom, sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.93"),
+	))
+	require.NoError(err)
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+	require.Equal(coin.New(stableDenom, 95_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, stableDenom))
+
+	// the order of a fully repaid borrow is deleted, instead of being evaluated in every block
+	params.DeleverageFee = sdk.MustNewDecFromStr("0.005")
+	require.NoError(app.LeverageKeeper.SetParams(ctx, params))
+	_, err = srv.Repay(ctx, types.NewMsgRepay(borrower, coin.New(stableDenom, 95_000000)))
+	require.NoError(err)
+	require.True(app.LeverageKeeper.GetBorrow(ctx, borrower, stableDenom).IsZero())
+	require.Len(app.LeverageKeeper.GetDeleverageOrders(ctx, borrower), 1)
+	require.NoError(app.LeverageKeeper.ExecuteDeleverageOrders(ctx))
+	require.Empty(app.LeverageKeeper.GetDeleverageOrders(ctx, borrower))
+
+	s.checkInvariants("deleverage order lifecycle")
+}
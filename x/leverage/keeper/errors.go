@@ -11,7 +11,8 @@ import (
 )
 
 // nonOracleError returns true if an error is non-nil
-// and also not one of ErrEmptyList, ErrUnknownDenom, or ErrNoHistoricMedians
+// and also not one of ErrEmptyList, ErrUnknownDenom, ErrStalePrice, ErrLowConfidence or
+// ErrNoHistoricMedians
 // which are errors which can result from missing prices
 func nonOracleError(err error) bool {
 	if err == nil {
@@ -23,6 +24,8 @@ func nonOracleError(err error) bool {
 		leveragetypes.ErrNoHistoricMedians,
 		leveragetypes.ErrExpiredOraclePrice,
 		oracletypes.ErrUnknownDenom,
+		oracletypes.ErrStalePrice,
+		oracletypes.ErrLowConfidence,
 	) {
 		return false
 	}
@@ -65,17 +65,18 @@ func (k Keeper) ToTokens(ctx sdk.Context, uTokens sdk.Coins) (sdk.Coins, error)
 // DeriveExchangeRate calculated the token:uToken exchange rate of a base token denom.
 func (k Keeper) DeriveExchangeRate(ctx sdk.Context, denom string) sdk.Dec {
 	// uToken exchange rate is equal to the token supply (including borrowed
-	// tokens yet to be repaid and excluding tokens reserved) divided by total
-	// uTokens in circulation.
+	// tokens yet to be repaid and excluding tokens reserved or owed to referrers)
+	// divided by total uTokens in circulation.
 
 	// Get relevant quantities
 	moduleBalance := toDec(k.ModuleBalance(ctx, denom).Amount)
 	reserveAmount := toDec(k.GetReserves(ctx, denom).Amount)
+	referralRewards := toDec(k.GetTotalReferralRewards(ctx, denom).Amount)
 	totalBorrowed := k.getAdjustedTotalBorrowed(ctx, denom).Mul(k.getInterestScalar(ctx, denom))
 	uTokenSupply := k.GetUTokenSupply(ctx, coin.ToUTokenDenom(denom)).Amount
 
 	// Derive effective token supply
-	tokenSupply := moduleBalance.Add(totalBorrowed).Sub(reserveAmount)
+	tokenSupply := moduleBalance.Add(totalBorrowed).Sub(reserveAmount).Sub(referralRewards)
 
 	// Handle uToken supply == 0 case
 	if !uTokenSupply.IsPositive() {
@@ -47,21 +47,55 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, pair := range genState.SpecialPairs {
 		util.Panic(k.SetSpecialAssetPair(ctx, pair))
 	}
+
+	for _, referral := range genState.Referrals {
+		addr, err := sdk.AccAddressFromBech32(referral.Address)
+		util.Panic(err)
+		referrer, err := sdk.AccAddressFromBech32(referral.Referrer)
+		util.Panic(err)
+		util.Panic(k.setReferrer(ctx, addr, referrer))
+	}
+
+	for _, reward := range genState.ReferralRewards {
+		referrer, err := sdk.AccAddressFromBech32(reward.Referrer)
+		util.Panic(err)
+		for _, c := range reward.Rewards {
+			util.Panic(k.setReferralReward(ctx, referrer, c))
+		}
+	}
+
+	for _, list := range genState.AllowLists {
+		for _, a := range list.Addresses {
+			addr, err := sdk.AccAddressFromBech32(a)
+			util.Panic(err)
+			util.Panic(k.setAllowListed(ctx, list.Denom, addr, true))
+		}
+	}
+
+	for _, order := range genState.DeleverageOrders {
+		util.Panic(k.SetDeleverageOrder(ctx, order))
+	}
 }
 
 // ExportGenesis returns the x/leverage module's exported genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	// referral indexes are not exported, so pending referral rewards are settled in the export
+	referralRewards, settled := k.getAllReferralRewards(ctx)
 	return types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAllRegisteredTokens(ctx),
 		k.getAllAdjustedBorrows(ctx),
 		k.getAllCollateral(ctx),
-		k.GetAllReserves(ctx),
+		k.GetAllReserves(ctx).Sub(settled...),
 		k.getLastInterestTime(ctx),
 		k.getAllBadDebts(ctx),
 		k.getAllInterestScalars(ctx),
 		k.GetAllUTokenSupply(ctx),
 		k.GetAllSpecialAssetPairs(ctx),
+		k.getAllReferrals(ctx),
+		referralRewards,
+		k.getAllAllowLists(ctx),
+		k.getAllDeleverageOrders(ctx),
 	)
 }
 
@@ -241,6 +241,76 @@ func (q Querier) AccountBalances(
 	}, nil
 }
 
+func (q Querier) ReferralRewards(
+	goCtx context.Context,
+	req *types.QueryReferralRewards,
+) (*types.QueryReferralRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReferralRewardsResponse{
+		Rewards: q.PendingReferralRewards(ctx, addr),
+	}, nil
+}
+
+func (q Querier) AllowList(
+	goCtx context.Context,
+	req *types.QueryAllowList,
+) (*types.QueryAllowListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.GetTokenSettings(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowListResponse{
+		Admin:     token.AllowListAdmin,
+		Addresses: q.GetAllowList(ctx, req.Denom),
+	}, nil
+}
+
+func (q Querier) DeleverageOrders(
+	goCtx context.Context,
+	req *types.QueryDeleverageOrders,
+) (*types.QueryDeleverageOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeleverageOrdersResponse{
+		Orders: q.GetDeleverageOrders(ctx, addr),
+	}, nil
+}
+
 func (q Querier) LiquidationTargets(
 	goCtx context.Context,
 	req *types.QueryLiquidationTargets,
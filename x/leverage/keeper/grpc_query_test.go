@@ -2,14 +2,19 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gotest.tools/v3/assert"
 
 	appparams "github.com/umee-network/umee/v6/app/params"
 	"github.com/umee-network/umee/v6/util/coin"
 	"github.com/umee-network/umee/v6/x/leverage/fixtures"
+	"github.com/umee-network/umee/v6/x/leverage/keeper"
 	"github.com/umee-network/umee/v6/x/leverage/types"
 )
 
@@ -302,6 +307,7 @@ func (s *IntegrationTestSuite) TestQuerier_Inspect() {
 				},
 			},
 		},
+		Pagination: &query.PageResponse{},
 	}
 	require.Equal(expected, *resp)
 
@@ -310,6 +316,152 @@ func (s *IntegrationTestSuite) TestQuerier_Inspect() {
 	resp, err = s.queryClient.Inspect(ctx, req)
 	require.NoError(err)
 	require.Equal(expected, *resp)
+
+	// sorted pages: danger is highest for addr3 (63.15 / 656), then addr2 (6.31 / 65.67), then addr1
+	req = &types.QueryInspect{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_DANGER,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	resp, err = s.queryClient.Inspect(ctx, req)
+	require.NoError(err)
+	require.Equal([]string{addr3.String(), addr2.String()}, inspectedAddresses(resp.Borrowers))
+	require.Equal(uint64(3), resp.Pagination.Total)
+	req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey}
+	resp, err = s.queryClient.Inspect(ctx, req)
+	require.NoError(err)
+	require.Equal([]string{addr1.String()}, inspectedAddresses(resp.Borrowers))
+	require.Nil(resp.Pagination.NextKey)
+
+	// address order streams every borrower exactly once
+	addresses := []string{}
+	req = &types.QueryInspect{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS,
+		Pagination: &query.PageRequest{Limit: 2},
+	}
+	for {
+		resp, err = s.queryClient.Inspect(ctx, req)
+		require.NoError(err)
+		require.LessOrEqual(len(resp.Borrowers), 2)
+		addresses = append(addresses, inspectedAddresses(resp.Borrowers)...)
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination.Key = resp.Pagination.NextKey
+	}
+	require.ElementsMatch([]string{addr1.String(), addr2.String(), addr3.String()}, addresses)
+
+	// offsets and keys cannot be combined
+	req.Pagination = &query.PageRequest{Key: []byte{0x01}, Offset: 1}
+	_, err = s.queryClient.Inspect(ctx, req)
+	require.ErrorContains(err, "either offset or key is expected, got both")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountSummariesSorted() {
+	ctx, require := s.ctx, s.Require()
+
+	// a supplier without borrows, and two borrowers with different LTV
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+	addr1 := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr1, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr1, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr1, coin.New(umeeDenom, 20_000000))
+	addr2 := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr2, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr2, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(addr2, coin.New(umeeDenom, 10_000000))
+
+	summaryAddresses := func(resp *types.QueryAccountSummariesResponse) []string {
+		addrs := []string{}
+		for _, a := range resp.AccountSummaries {
+			addrs = append(addrs, a.Address)
+		}
+		return addrs
+	}
+
+	// sorted summaries only include borrowers
+	resp, err := s.queryClient.AccountSummaries(ctx, &types.QueryAccountSummaries{
+		SortBy: types.AccountSortKey_ACCOUNT_SORT_KEY_BORROWED_VALUE,
+	})
+	require.NoError(err)
+	require.Equal([]string{addr1.String(), addr2.String()}, summaryAddresses(resp))
+
+	resp, err = s.queryClient.AccountSummaries(ctx, &types.QueryAccountSummaries{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_LTV,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Equal([]string{addr2.String()}, summaryAddresses(resp))
+	resp, err = s.queryClient.AccountSummaries(ctx, &types.QueryAccountSummaries{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_LTV,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal([]string{addr1.String()}, summaryAddresses(resp))
+	require.Nil(resp.Pagination.NextKey)
+
+	// unsorted summaries include every account
+	resp, err = s.queryClient.AccountSummaries(ctx, &types.QueryAccountSummaries{})
+	require.NoError(err)
+	require.Subset(summaryAddresses(resp), []string{supplier.String(), addr1.String(), addr2.String()})
+}
+
+func (s *IntegrationTestSuite) TestQuerier_SortedAccountsLimit() {
+	ctx, require := s.ctx, s.Require()
+
+	for i := 0; i <= keeper.MaxSortedAccounts; i++ {
+		addr := sdk.AccAddress(fmt.Sprintf("borrower%012d", i))
+		require.NoError(s.tk.SetBorrow(ctx, addr, coin.New(umeeDenom, 1_000000)))
+	}
+
+	// sorting more than MaxSortedAccounts borrowers returns the top accounts
+	summaries, err := s.queryClient.AccountSummaries(ctx, &types.QueryAccountSummaries{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_BORROWED_VALUE,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(summaries.AccountSummaries, 1)
+	require.Equal(uint64(keeper.MaxSortedAccounts+1), summaries.Pagination.Total)
+	require.NotNil(summaries.Pagination.NextKey)
+
+	// the page is truncated at the last sorted account, and no further page is available
+	sortedReq := &types.QueryAccountSummaries{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_LTV,
+		Pagination: &query.PageRequest{Offset: keeper.MaxSortedAccounts - 2, Limit: 10},
+	}
+	summaries, err = s.queryClient.AccountSummaries(ctx, sortedReq)
+	require.NoError(err)
+	require.Len(summaries.AccountSummaries, 2)
+	require.Nil(summaries.Pagination.NextKey)
+	sortedReq.Pagination = &query.PageRequest{Offset: keeper.MaxSortedAccounts}
+	_, err = s.queryClient.AccountSummaries(ctx, sortedReq)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.queryClient.Inspect(ctx, &types.QueryInspect{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_DANGER,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+
+	// the default order streams pages of borrowers
+	resp, err := s.queryClient.Inspect(ctx, &types.QueryInspect{Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(err)
+	require.NotNil(resp.Pagination.NextKey)
+
+	// accounts can still be paged through in address order
+	resp, err = s.queryClient.Inspect(ctx, &types.QueryInspect{
+		SortBy:     types.AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS,
+		Pagination: &query.PageRequest{Limit: 10},
+	})
+	require.NoError(err)
+	require.NotNil(resp.Pagination.NextKey)
+}
+
+func inspectedAddresses(accounts []types.InspectAccount) []string {
+	addrs := []string{}
+	for _, a := range accounts {
+		addrs = append(addrs, a.Address)
+	}
+	return addrs
 }
 
 func (s *IntegrationTestSuite) TestQuerier_LiquidationTargets() {
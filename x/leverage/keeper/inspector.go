@@ -56,74 +56,71 @@ func (q Querier) Inspect(
 		}
 	}
 
-	// inspect every borrower only once
 	borrowers := []*types.InspectAccount{}
-	checkedAddrs := map[string]interface{}{}
-
-	prefix := types.KeyPrefixAdjustedBorrow
-	iterator := func(key, _ []byte) error {
-		// get borrower address from key
-		addr := types.AddressFromKey(key, prefix)
-
-		// if the address is already checked, do not check again
-		if _, ok := checkedAddrs[addr.String()]; ok {
-			return nil
+	if req.SortBy == types.AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED ||
+		req.SortBy == types.AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS {
+		// stream one page of borrowers in store order, filtering within the page
+		addrs, pageRes, err := k.paginateBorrowers(ctx, req.Pagination)
+		if err != nil {
+			return nil, err
 		}
-		checkedAddrs[addr.String()] = struct{}{}
-
-		borrowedValue, collateralValue, liquidationThreshold := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
-		position, err := k.GetAccountPosition(ctx, addr, true)
-		if err == nil {
-			borrowedValue = position.BorrowedValue()
-			collateralValue = position.CollateralValue()
-			liquidationThreshold = position.Limit()
-		} else {
-			failures = append(failures, addr.String())
-		}
-
-		borrowed := k.GetBorrowerBorrows(ctx, addr)
-		collateral := k.GetBorrowerCollateral(ctx, addr)
-
-		account := types.InspectAccount{
-			Address: addr.String(),
-			Analysis: &types.RiskInfo{
-				Borrowed:    neat(borrowedValue),
-				Liquidation: neat(liquidationThreshold),
-				Value:       neat(collateralValue),
-			},
-			Position: &types.DecBalances{
-				Collateral: symbolDecCoins(collateral, exchangeRates),
-				Borrowed:   symbolDecCoins(borrowed, exchangeRates),
-			},
-			Info: "",
+		for _, addr := range addrs {
+			account, err := k.inspectAccount(ctx, addr, exchangeRates)
+			if err != nil {
+				failures = append(failures, addr.String())
+			}
+			if inspectFilter(req, account) {
+				borrowers = append(borrowers, &account)
+			}
 		}
-		ok := account.Analysis.Borrowed > req.Borrowed
-		ok = ok && account.Analysis.Value > req.Collateral
-		ok = ok && account.Analysis.Borrowed/account.Analysis.Liquidation > req.Danger
-		ok = ok && account.Analysis.Borrowed/account.Analysis.Value > req.Ltv
-		if ok {
-			borrowers = append(borrowers, &account)
+		if req.SortBy == types.AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED {
+			sortInspectPage(borrowers, req.Symbol)
 		}
-		return nil
+		return &types.QueryInspectResponse{
+			Borrowers:  fromPointers(borrowers),
+			Failures:   failures,
+			Pagination: pageRes,
+		}, nil
 	}
 
-	// collect all accounts (filtered but unsorted)
-	_ = k.iterate(ctx, prefix, iterator)
+	// evaluate every borrower, keeping the filtered accounts up to the end of the page
+	value := func(a *types.InspectAccount) float64 {
+		return accountSortValue(req.SortBy, a.Analysis.Borrowed, a.Analysis.Value, a.Analysis.Liquidation)
+	}
+	less := accountLess(value, func(a *types.InspectAccount) string { return a.Address })
+	page, pageRes, err := paginateSorted(req.Pagination, less, func(add func(*types.InspectAccount)) error {
+		return k.iterateBorrowers(ctx, func(addr sdk.AccAddress) error {
+			account, err := k.inspectAccount(ctx, addr, exchangeRates)
+			if err != nil {
+				failures = append(failures, addr.String())
+			}
+			if inspectFilter(req, account) {
+				add(&account)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInspectResponse{Borrowers: fromPointers(page), Failures: failures, Pagination: pageRes}, nil
+}
 
-	// sorts the borrowers
+// sortInspectPage sorts a page of inspected borrowers in the default order: by borrowed amount (descending) of
+// the symbol denom if it's not empty, otherwise by borrowed value (descending).
+func sortInspectPage(borrowers []*types.InspectAccount, symbol string) {
 	sort.SliceStable(borrowers, func(i, j int) bool {
-		if req.Symbol != "" {
-			// for non-empty symbol denom, sorts by borrowed amount (descending) of that token
+		if symbol != "" {
 			var a, b sdkmath.Int
 			for _, c := range borrowers[i].Position.Borrowed {
-				if c.Denom == req.Symbol {
+				if c.Denom == symbol {
 					a = c.BaseAmount
 					break
 				}
 			}
 
 			for _, c := range borrowers[j].Position.Borrowed {
-				if c.Denom == req.Symbol {
+				if c.Denom == symbol {
 					b = c.BaseAmount
 					break
 				}
@@ -134,17 +131,56 @@ func (q Querier) Inspect(
 			}
 			return a.GTE(b)
 		}
-		// otherwise, sorts by borrowed value (descending)
 		return borrowers[i].Analysis.Borrowed > borrowers[j].Analysis.Borrowed
-	},
-	)
+	})
+}
+
+// inspectAccount computes the inspector's risk and balance info for a single borrower. If its position cannot be
+// calculated, an account with zero USD values is returned along with the error.
+func (k Keeper) inspectAccount(ctx sdk.Context, addr sdk.AccAddress, exchangeRates map[string]tokenExchangeRate,
+) (types.InspectAccount, error) {
+	borrowedValue, collateralValue, liquidationThreshold := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	position, err := k.GetAccountPosition(ctx, addr, true)
+	if err == nil {
+		borrowedValue = position.BorrowedValue()
+		collateralValue = position.CollateralValue()
+		liquidationThreshold = position.Limit()
+	}
+
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+	collateral := k.GetBorrowerCollateral(ctx, addr)
+
+	return types.InspectAccount{
+		Address: addr.String(),
+		Analysis: &types.RiskInfo{
+			Borrowed:    neat(borrowedValue),
+			Liquidation: neat(liquidationThreshold),
+			Value:       neat(collateralValue),
+		},
+		Position: &types.DecBalances{
+			Collateral: symbolDecCoins(collateral, exchangeRates),
+			Borrowed:   symbolDecCoins(borrowed, exchangeRates),
+		},
+		Info: "",
+	}, err
+}
+
+// inspectFilter returns true if an account meets all of the inspector query's minimum values.
+func inspectFilter(req *types.QueryInspect, account types.InspectAccount) bool {
+	ok := account.Analysis.Borrowed > req.Borrowed
+	ok = ok && account.Analysis.Value > req.Collateral
+	ok = ok && account.Analysis.Borrowed/account.Analysis.Liquidation > req.Danger
+	ok = ok && account.Analysis.Borrowed/account.Analysis.Value > req.Ltv
+	return ok
+}
 
-	// convert from pointers
-	sortedBorrowers := []types.InspectAccount{}
-	for _, b := range borrowers {
-		sortedBorrowers = append(sortedBorrowers, *b)
+// fromPointers converts a list of inspected account pointers to values.
+func fromPointers(accounts []*types.InspectAccount) []types.InspectAccount {
+	values := []types.InspectAccount{}
+	for _, a := range accounts {
+		values = append(values, *a)
 	}
-	return &types.QueryInspectResponse{Borrowers: sortedBorrowers, Failures: failures}, nil
+	return values
 }
 
 // Separated from grpc_query.go
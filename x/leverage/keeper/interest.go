@@ -69,8 +69,8 @@ func (k Keeper) DeriveSupplyAPY(ctx sdk.Context, denom string) sdk.Dec {
 }
 
 // AccrueAllInterest is called by EndBlock to update borrow positions.
-// It accrues interest on all open borrows, increase reserves, funds
-// oracle rewards, and sets LastInterestTime to BlockTime.
+// It accrues interest on all open borrows, increase reserves, increases
+// referral indexes, funds oracle rewards, and sets LastInterestTime to BlockTime.
 func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 	currentTime := ctx.BlockTime().Unix()
 	prevInterestTime := k.getLastInterestTime(ctx)
@@ -131,6 +131,12 @@ func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 			return err
 		}
 
+		// interest accrued per unit of adjusted borrow, a share of which goes to referrers
+		interestFactor := scalar.Mul(exponential.Sub(sdk.OneDec()))
+		if err := k.accrueReferralIndex(ctx, token, interestFactor, params.ReferralRewardFactor); err != nil {
+			return err
+		}
+
 		// apply (pre-accural) interest scalar to borrows to get total borrowed before interest accrued
 		prevTotalBorrowed := k.getAdjustedTotalBorrowed(ctx, token.BaseDenom).Mul(scalar)
 
@@ -88,7 +88,7 @@ func (k Keeper) ModuleBalance(ctx sdk.Context, denom string) sdk.Coin {
 // insufficient, we return an error. Returns the amount of uTokens minted.
 // Note: For supplying from a module account instead of a user, use SupplyFromModule.
 func (k Keeper) Supply(ctx sdk.Context, supplierAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
-	if err := k.validateSupply(ctx, coin); err != nil {
+	if err := k.validateSupply(ctx, supplierAddr, coin); err != nil {
 		return sdk.Coin{}, err
 	}
 
@@ -126,7 +126,7 @@ func (k Keeper) Supply(ctx sdk.Context, supplierAddr sdk.AccAddress, coin sdk.Co
 // return, also returns a boolean which indicates whether the error was recoverable.
 // A recoverable = true error means SupplyFromModule was aborted without harming state.
 func (k Keeper) SupplyFromModule(ctx sdk.Context, fromModule string, coin sdk.Coin) (sdk.Coin, bool, error) {
-	if err := k.validateSupply(ctx, coin); err != nil {
+	if err := k.validateSupply(ctx, authtypes.NewModuleAddress(fromModule), coin); err != nil {
 		return sdk.Coin{}, true, err
 	}
 
@@ -300,7 +300,7 @@ func (k Keeper) WithdrawToModule(ctx sdk.Context, toModule string, uToken sdk.Co
 // This function does NOT check that a borrower remains under their borrow limit or that
 // collateral liquidity remains healthy - those assertions have been moved to MsgServer.
 func (k Keeper) Borrow(ctx sdk.Context, borrowerAddr sdk.AccAddress, borrow sdk.Coin) error {
-	if err := k.validateBorrow(ctx, borrow); err != nil {
+	if err := k.validateBorrow(ctx, borrowerAddr, borrow); err != nil {
 		return err
 	}
 
@@ -355,7 +355,7 @@ func (k Keeper) Repay(ctx sdk.Context, borrowerAddr sdk.AccAddress, payment sdk.
 // This function does NOT check that collateral share and collateral liquidity remain healthy.
 // Those assertions have been moved to MsgServer.
 func (k Keeper) Collateralize(ctx sdk.Context, borrowerAddr sdk.AccAddress, uToken sdk.Coin) error {
-	if err := k.validateCollateralize(ctx, uToken); err != nil {
+	if err := k.validateCollateralize(ctx, borrowerAddr, uToken); err != nil {
 		return err
 	}
 
@@ -43,6 +43,10 @@ func (s msgServer) Supply(
 		return nil, err
 	}
 
+	if err = s.keeper.recordReferrer(ctx, supplierAddr, msg.Referrer); err != nil {
+		return nil, err
+	}
+
 	s.keeper.Logger(ctx).Debug(
 		"assets supplied",
 		"supplier", msg.Supplier,
@@ -247,6 +251,10 @@ func (s msgServer) SupplyCollateral(
 		return nil, err
 	}
 
+	if err = s.keeper.recordReferrer(ctx, supplierAddr, msg.Referrer); err != nil {
+		return nil, err
+	}
+
 	s.keeper.Logger(ctx).Debug(
 		"assets supplied",
 		"supplier", msg.Supplier,
@@ -336,6 +344,10 @@ func (s msgServer) Borrow(
 		return nil, err
 	}
 
+	if err = s.keeper.recordReferrer(ctx, borrowerAddr, msg.Referrer); err != nil {
+		return nil, err
+	}
+
 	s.keeper.Logger(ctx).Debug(
 		"assets borrowed",
 		"borrower", msg.Borrower,
@@ -534,6 +546,114 @@ func (s msgServer) LeveragedLiquidate(
 	}, nil
 }
 
+// ClaimReferralRewards sends all unclaimed referral rewards to the referrer.
+func (s msgServer) ClaimReferralRewards(
+	goCtx context.Context,
+	msg *types.MsgClaimReferralRewards,
+) (*types.MsgClaimReferralRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	referrer, err := sdk.AccAddressFromBech32(msg.Referrer)
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := s.keeper.ClaimReferralRewards(ctx, referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"referral rewards claimed",
+		"referrer", msg.Referrer,
+		"rewards", claimed.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventClaimReferralRewards{
+		Referrer: msg.Referrer,
+		Rewards:  claimed,
+	})
+	return &types.MsgClaimReferralRewardsResponse{
+		Claimed: claimed,
+	}, nil
+}
+
+// UpdateAllowList adds or removes accounts from the allow list of a permissioned Token.
+func (s msgServer) UpdateAllowList(
+	goCtx context.Context,
+	msg *types.MsgUpdateAllowList,
+) (*types.MsgUpdateAllowListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.UpdateAllowList(ctx, admin, msg.Denom, msg.Add, msg.Remove); err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"allow list updated",
+		"admin", msg.Admin,
+		"denom", msg.Denom,
+		"added", msg.Add,
+		"removed", msg.Remove,
+	)
+	sdkutil.Emit(&ctx, &types.EventUpdateAllowList{
+		Denom:   msg.Denom,
+		Added:   msg.Add,
+		Removed: msg.Remove,
+	})
+	return &types.MsgUpdateAllowListResponse{}, nil
+}
+
+// CreateDeleverageOrder creates or replaces a borrower's deleverage order for a token.
+func (s msgServer) CreateDeleverageOrder(
+	goCtx context.Context,
+	msg *types.MsgCreateDeleverageOrder,
+) (*types.MsgCreateDeleverageOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.keeper.validateAcceptedDenom(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+	order := types.NewDeleverageOrder(msg.Borrower, msg.Denom, msg.TriggerRatio, msg.TargetRatio)
+	if err := s.keeper.SetDeleverageOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"deleverage order created",
+		"borrower", msg.Borrower,
+		"denom", msg.Denom,
+		"trigger_ratio", msg.TriggerRatio.String(),
+		"target_ratio", msg.TargetRatio.String(),
+	)
+	return &types.MsgCreateDeleverageOrderResponse{}, nil
+}
+
+// CancelDeleverageOrder removes a borrower's deleverage order for a token.
+func (s msgServer) CancelDeleverageOrder(
+	goCtx context.Context,
+	msg *types.MsgCancelDeleverageOrder,
+) (*types.MsgCancelDeleverageOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.DeleteDeleverageOrder(ctx, borrower, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"deleverage order cancelled",
+		"borrower", msg.Borrower,
+		"denom", msg.Denom,
+	)
+	return &types.MsgCancelDeleverageOrderResponse{}, nil
+}
+
 // GovUpdateRegistry updates existing tokens with new settings
 // or adds the new tokens to registry.
 func (s msgServer) GovUpdateRegistry(
@@ -39,7 +39,9 @@ func (k Keeper) TokenPrice(ctx sdk.Context, baseDenom string, mode types.PriceMo
 	if mode != types.PriceModeHistoric {
 		// spot price is required for modes other than historic
 		spotPrice, err = k.oracleKeeper.GetExchangeRate(ctx, t.SymbolDenom)
-		if err != nil {
+		// stale and low confidence prices are treated like expired prices: only queries can use them
+		if err != nil && !(mode.AllowsExpired() &&
+			(oracletypes.ErrStalePrice.Is(err) || oracletypes.ErrLowConfidence.Is(err))) {
 			return sdk.ZeroDec(), t.Exponent, errors.Wrap(err, "oracle")
 		}
 		if !mode.AllowsExpired() {
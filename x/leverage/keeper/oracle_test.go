@@ -17,6 +17,8 @@ type mockOracleKeeper struct {
 	baseExchangeRates     map[string]sdk.Dec
 	symbolExchangeRates   map[string]sdk.Dec
 	historicExchangeRates map[string]sdk.Dec
+	staleExchangeRates    map[string]bool
+	lowConfidenceRates    map[string]bool
 }
 
 func newMockOracleKeeper() *mockOracleKeeper {
@@ -24,6 +26,8 @@ func newMockOracleKeeper() *mockOracleKeeper {
 		baseExchangeRates:     make(map[string]sdk.Dec),
 		symbolExchangeRates:   make(map[string]sdk.Dec),
 		historicExchangeRates: make(map[string]sdk.Dec),
+		staleExchangeRates:    make(map[string]bool),
+		lowConfidenceRates:    make(map[string]bool),
 	}
 	m.Reset()
 
@@ -58,9 +62,27 @@ func (m *mockOracleKeeper) GetExchangeRate(ctx sdk.Context, denom string) (oracl
 		// except for one denom, whose most recent price is twice as old as leverage logic allows
 		t = t.Add(-2 * time.Second * keeper.MaxSpotPriceAge)
 	}
+	if m.staleExchangeRates[denom] {
+		// This error matches oracle behavior on prices older than their max price age
+		return oracletypes.ExchangeRate{Rate: p, Timestamp: t}, oracletypes.ErrStalePrice.Wrap(denom)
+	}
+	if m.lowConfidenceRates[denom] {
+		// This error matches oracle behavior on prices which don't meet their confidence requirements
+		return oracletypes.ExchangeRate{Rate: p, Timestamp: t}, oracletypes.ErrLowConfidence.Wrap(denom)
+	}
 	return oracletypes.ExchangeRate{Rate: p, Timestamp: t}, nil
 }
 
+// MarkStale makes the oracle return a denom's price along with a stale price error.
+func (m *mockOracleKeeper) MarkStale(denom string) {
+	m.staleExchangeRates[denom] = true
+}
+
+// MarkLowConfidence makes the oracle return a denom's price along with a low confidence error.
+func (m *mockOracleKeeper) MarkLowConfidence(denom string) {
+	m.lowConfidenceRates[denom] = true
+}
+
 // Clear clears a denom from the mock oracle, simulating an outage.
 func (m *mockOracleKeeper) Clear(denom string) {
 	delete(m.symbolExchangeRates, denom)
@@ -69,6 +91,8 @@ func (m *mockOracleKeeper) Clear(denom string) {
 
 // Reset restores the mock oracle's prices to its default values.
 func (m *mockOracleKeeper) Reset() {
+	m.staleExchangeRates = map[string]bool{}
+	m.lowConfidenceRates = map[string]bool{}
 	m.symbolExchangeRates = map[string]sdk.Dec{
 		"UMEE":   sdk.MustNewDecFromStr("4.21"),
 		"ATOM":   sdk.MustNewDecFromStr("39.38"),
@@ -173,6 +197,35 @@ func (s *IntegrationTestSuite) TestOracle_TokenPrice() {
 	require.NoError(err)
 	require.Equal(sdk.MustNewDecFromStr("0.50"), p)
 	require.Equal(uint32(6), e)
+
+	// Stale prices can only be used by queries
+	s.mockOracle.MarkStale("ATOM")
+	defer s.mockOracle.Reset()
+	for _, mode := range []types.PriceMode{types.PriceModeSpot, types.PriceModeHigh, types.PriceModeLow} {
+		_, _, err = app.LeverageKeeper.TokenPrice(ctx, atomDenom, mode)
+		require.ErrorIs(err, oracletypes.ErrStalePrice)
+	}
+	for _, mode := range []types.PriceMode{types.PriceModeQuery, types.PriceModeQueryHigh, types.PriceModeQueryLow} {
+		p, _, err = app.LeverageKeeper.TokenPrice(ctx, atomDenom, mode)
+		require.NoError(err)
+		require.Equal(sdk.MustNewDecFromStr("39.38"), p)
+	}
+	p, _, err = app.LeverageKeeper.TokenPrice(ctx, atomDenom, types.PriceModeHistoric)
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("39.38"), p)
+
+	// Low confidence prices are treated like stale prices
+	s.mockOracle.Reset()
+	s.mockOracle.MarkLowConfidence("ATOM")
+	for _, mode := range []types.PriceMode{types.PriceModeSpot, types.PriceModeHigh, types.PriceModeLow} {
+		_, _, err = app.LeverageKeeper.TokenPrice(ctx, atomDenom, mode)
+		require.ErrorIs(err, oracletypes.ErrLowConfidence)
+	}
+	for _, mode := range []types.PriceMode{types.PriceModeQuery, types.PriceModeQueryHigh, types.PriceModeQueryLow} {
+		p, _, err = app.LeverageKeeper.TokenPrice(ctx, atomDenom, mode)
+		require.NoError(err)
+		require.Equal(sdk.MustNewDecFromStr("39.38"), p)
+	}
 }
 
 func (s *IntegrationTestSuite) TestOracle_TokenValue() {
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+// MaxSortedAccounts is the number of top accounts which queries sorting accounts by anything other than
+// address can return. Sorted queries evaluate every borrower on each request, but only keep the accounts
+// up to the end of the requested page in memory, so pages beyond this number are not available and
+// accounts must be paged through in address order instead.
+const MaxSortedAccounts = 1000
+
+// iterateBorrowers calls fn with the address of every account with an open borrow, in store order.
+func (k Keeper) iterateBorrowers(ctx sdk.Context, fn func(addr sdk.AccAddress) error) error {
+	var last sdk.AccAddress
+
+	prefix := types.KeyPrefixAdjustedBorrow
+	iterator := func(key, _ []byte) error {
+		// keys of the same borrower are adjacent, so each borrower is visited once
+		addr := types.AddressFromKey(key, prefix)
+		if addr.Equals(last) {
+			return nil
+		}
+		last = addr
+		return fn(addr)
+	}
+
+	return k.iterate(ctx, prefix, iterator)
+}
+
+// paginateBorrowers returns one page of addresses of accounts with open borrows, in store order.
+// Page keys are length-prefixed borrower addresses, which allows pages to be read without iterating
+// over the borrowers of previous pages.
+func (k Keeper) paginateBorrowers(ctx sdk.Context, page *query.PageRequest,
+) ([]sdk.AccAddress, *query.PageResponse, error) {
+	offset, limit, countTotal, err := readPageRequest(page)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key []byte
+	if page != nil {
+		key = page.Key
+	}
+	// totals are only counted on the first page, as in the cosmos sdk
+	countTotal = countTotal && key == nil
+
+	kv := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdjustedBorrow)
+	iter := kv.Iterator(key, nil)
+	defer iter.Close()
+
+	borrowers := []sdk.AccAddress{}
+	resp := &query.PageResponse{}
+	var last sdk.AccAddress
+	for count := uint64(0); iter.Valid(); iter.Next() {
+		addr := types.AddressFromKey(iter.Key(), []byte{})
+		if addr.Equals(last) {
+			continue
+		}
+		last = addr
+		count++
+		if countTotal {
+			resp.Total = count
+		}
+		if count <= offset {
+			continue
+		}
+		if uint64(len(borrowers)) == limit {
+			if resp.NextKey == nil {
+				resp.NextKey = address.MustLengthPrefix(addr)
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+		borrowers = append(borrowers, addr)
+	}
+
+	return borrowers, resp, nil
+}
+
+// paginateSorted returns one page of a sorted stream of items, where less reports whether an item is sorted
+// before another. The stream adds its items to the page with add. Only the items up to the end of the page
+// are kept, in a heap, so pages must end within the first MaxSortedAccounts items. Page keys encode the
+// offset of the next page.
+func paginateSorted[T any](page *query.PageRequest, less func(a, b T) bool, stream func(add func(T)) error,
+) ([]T, *query.PageResponse, error) {
+	offset, limit, countTotal, err := readPageRequest(page)
+	if err != nil {
+		return nil, nil, err
+	}
+	if page != nil && len(page.Key) > 0 {
+		if len(page.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid page key for sorted accounts")
+		}
+		offset = binary.BigEndian.Uint64(page.Key)
+	}
+	if offset >= MaxSortedAccounts {
+		return nil, nil, status.Errorf(codes.InvalidArgument,
+			"sorted queries return the first %d accounts only, sort by address instead", MaxSortedAccounts)
+	}
+	end := uint64(MaxSortedAccounts)
+	if limit < end-offset {
+		end = offset + limit
+	}
+
+	top := &topItems[T]{less: less}
+	var total uint64
+	err = stream(func(item T) {
+		total++
+		if uint64(len(top.items)) < end {
+			heap.Push(top, item)
+		} else if less(item, top.items[0]) {
+			// replace the last item of the page
+			top.items[0] = item
+			heap.Fix(top, 0)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	items := top.items
+	sort.Slice(items, func(i, j int) bool { return less(items[i], items[j]) })
+
+	resp := &query.PageResponse{}
+	if countTotal {
+		resp.Total = total
+	}
+	if offset >= uint64(len(items)) {
+		return []T{}, resp, nil
+	}
+	if total > end && end < MaxSortedAccounts {
+		resp.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	return items[offset:], resp, nil
+}
+
+// topItems is a heap of the items sorted first, with the item sorted last at its root.
+type topItems[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h topItems[T]) Len() int           { return len(h.items) }
+func (h topItems[T]) Less(i, j int) bool { return h.less(h.items[j], h.items[i]) }
+func (h topItems[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *topItems[T]) Push(x any)        { h.items = append(h.items, x.(T)) }
+
+func (h *topItems[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// readPageRequest validates a page request and returns its offset, limit and count_total,
+// applying the default limit if none is set.
+func readPageRequest(page *query.PageRequest) (uint64, uint64, bool, error) {
+	if page == nil {
+		return 0, query.DefaultLimit, false, nil
+	}
+	if page.Reverse {
+		return 0, 0, false, status.Error(codes.InvalidArgument, "reverse pagination is not supported for accounts")
+	}
+	if len(page.Key) > 0 && page.Offset > 0 {
+		return 0, 0, false, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	return page.Offset, limit, page.CountTotal, nil
+}
+
+// accountSortValue returns the value by which an account is ordered for a sort key, given its borrowed value,
+// collateral value and liquidation threshold. Ratios with a zero denominator and positive borrowed value are
+// infinite, so such accounts are sorted first.
+func accountSortValue(key types.AccountSortKey, borrowed, collateral, liquidation float64) float64 {
+	v := borrowed
+	switch key {
+	case types.AccountSortKey_ACCOUNT_SORT_KEY_LTV:
+		v = borrowed / collateral
+	case types.AccountSortKey_ACCOUNT_SORT_KEY_DANGER:
+		v = borrowed / liquidation
+	}
+	if math.IsNaN(v) {
+		// zero borrowed over zero denominator
+		return 0
+	}
+	return v
+}
+
+// accountLess returns a function reporting whether an account is sorted before another: by descending
+// sort value, then by address.
+func accountLess[T any](value func(T) float64, addr func(T) string) func(a, b T) bool {
+	return func(a, b T) bool {
+		va, vb := value(a), value(b)
+		if va != vb {
+			return va > vb
+		}
+		return addr(a) < addr(b)
+	}
+}
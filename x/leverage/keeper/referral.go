@@ -0,0 +1,316 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+// GetReferrer returns the referrer of an account, or nil if the account has no referrer.
+func (k Keeper) GetReferrer(ctx sdk.Context, addr sdk.AccAddress) sdk.AccAddress {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyReferrer(addr))
+	if bz == nil {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// setReferrer sets the referrer of an account. Existing borrows of the account only start
+// generating referral rewards from this point on.
+func (k Keeper) setReferrer(ctx sdk.Context, addr, referrer sdk.AccAddress) error {
+	if addr.Empty() || referrer.Empty() {
+		return types.ErrEmptyAddress
+	}
+	if addr.Equals(referrer) {
+		return types.ErrSelfReferral
+	}
+	kvs := ctx.KVStore(k.storeKey)
+	kvs.Set(types.KeyReferrer(addr), referrer)
+	kvs.Set(types.KeyReferral(referrer, addr), []byte{0x01})
+
+	for _, b := range k.getAdjustedBorrows(ctx, addr) {
+		if err := k.setReferralSnapshot(ctx, addr, b.Denom, k.getReferralIndex(ctx, b.Denom)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordReferrer sets the referrer of an account from an optional bech32 address found in a
+// message. Does nothing if the referrer is empty or the account already has a referrer.
+func (k Keeper) recordReferrer(ctx sdk.Context, addr sdk.AccAddress, referrer string) error {
+	if referrer == "" || k.GetReferrer(ctx, addr) != nil {
+		return nil
+	}
+	referrerAddr, err := sdk.AccAddressFromBech32(referrer)
+	if err != nil {
+		return err
+	}
+	return k.setReferrer(ctx, addr, referrerAddr)
+}
+
+// GetReferralRewards returns all unclaimed referral rewards of a referrer.
+func (k Keeper) GetReferralRewards(ctx sdk.Context, referrer sdk.AccAddress) sdk.Coins {
+	prefix := types.KeyReferralRewardNoDenom(referrer)
+	rewards := sdk.NewCoins()
+
+	iterator := func(key, val []byte) error {
+		denom := types.DenomFromKeyWithAddress(key, types.KeyPrefixReferralReward)
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(val); err != nil {
+			// improperly marshaled amount should never happen
+			return err
+		}
+
+		rewards = rewards.Add(sdk.NewCoin(denom, amount))
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return rewards
+}
+
+// setReferralReward sets the unclaimed referral reward of a referrer in a given denom. Also updates
+// the total unclaimed referral rewards of the denom by the resulting change in amount.
+func (k Keeper) setReferralReward(ctx sdk.Context, referrer sdk.AccAddress, reward sdk.Coin) error {
+	if err := validateBaseToken(reward); err != nil {
+		return err
+	}
+	if referrer.Empty() {
+		return types.ErrEmptyAddress
+	}
+
+	key := types.KeyReferralReward(referrer, reward.Denom)
+	delta := reward.Amount.Sub(k.getStoredInt(ctx, key, "referral reward"))
+	total := k.GetTotalReferralRewards(ctx, reward.Denom).Amount.Add(delta)
+	if err := k.setStoredInt(ctx, types.KeyTotalReferralReward(reward.Denom), total, "total referral reward"); err != nil {
+		return err
+	}
+
+	return k.setStoredInt(ctx, key, reward.Amount, "referral reward")
+}
+
+// GetTotalReferralRewards returns the total unclaimed referral rewards of a given token.
+// Unclaimed referral rewards are held by the module, but are not available to borrowers.
+func (k Keeper) GetTotalReferralRewards(ctx sdk.Context, denom string) sdk.Coin {
+	amount := k.getStoredInt(ctx, types.KeyTotalReferralReward(denom), "total referral reward")
+	return sdk.NewCoin(denom, amount)
+}
+
+// getReferralIndex returns the cumulative referral reward accrued per unit of adjusted borrow of a
+// given token.
+func (k Keeper) getReferralIndex(ctx sdk.Context, denom string) sdk.Dec {
+	return k.getStoredDec(ctx, types.KeyReferralIndex(denom), sdk.ZeroDec(), "referral index")
+}
+
+// getReferralSnapshot returns the referral index of a given token at the last settlement of a
+// referred account's referral rewards.
+func (k Keeper) getReferralSnapshot(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Dec {
+	return k.getStoredDec(ctx, types.KeyReferralSnapshot(addr, denom), sdk.ZeroDec(), "referral snapshot")
+}
+
+func (k Keeper) setReferralSnapshot(ctx sdk.Context, addr sdk.AccAddress, denom string, index sdk.Dec) error {
+	return k.setStoredDec(ctx, types.KeyReferralSnapshot(addr, denom), index, sdk.ZeroDec(), "referral snapshot")
+}
+
+// accrueReferralIndex increases the referral index of a token by the share of its accrued interest
+// which is diverted from reserves to referrers, given the interest accrued per unit of adjusted
+// borrow. Referral rewards are only computed when they are settled, by settleReferralReward, so
+// accrual does not depend on the number of referrers.
+func (k Keeper) accrueReferralIndex(ctx sdk.Context, token types.Token, interestFactor, rewardFactor sdk.Dec) error {
+	share := interestFactor.Mul(token.ReserveFactor).Mul(rewardFactor)
+	if !share.IsPositive() {
+		return nil
+	}
+	index := k.getReferralIndex(ctx, token.BaseDenom).Add(share)
+	return k.setStoredDec(ctx, types.KeyReferralIndex(token.BaseDenom), index, sdk.ZeroDec(), "referral index")
+}
+
+// accruedReferralReward returns the referral reward generated by an account's borrow of a given
+// token since its last settlement. It is zero if the account has no referrer.
+func (k Keeper) accruedReferralReward(ctx sdk.Context, addr sdk.AccAddress, denom string) sdkmath.Int {
+	borrow := k.getAdjustedBorrow(ctx, addr, denom)
+	if borrow.IsZero() || k.GetReferrer(ctx, addr) == nil {
+		return sdk.ZeroInt()
+	}
+	delta := k.getReferralIndex(ctx, denom).Sub(k.getReferralSnapshot(ctx, addr, denom))
+	return borrow.Mul(delta).TruncateInt()
+}
+
+// settleReferralReward moves the referral reward generated by an account's borrow of a given token
+// since its last settlement from the reserves to the account's referrer. It must be called before
+// the account's adjusted borrow changes. Rewards are capped by the reserves available.
+func (k Keeper) settleReferralReward(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	referrer := k.GetReferrer(ctx, addr)
+	if referrer == nil {
+		return nil
+	}
+
+	reward := k.accruedReferralReward(ctx, addr, denom)
+	reserves := k.GetReserves(ctx, denom)
+	reward = sdk.MinInt(reward, reserves.Amount)
+	if reward.IsPositive() {
+		if err := k.setReserves(ctx, reserves.SubAmount(reward)); err != nil {
+			return err
+		}
+		prev := k.getStoredInt(ctx, types.KeyReferralReward(referrer, denom), "referral reward")
+		if err := k.setReferralReward(ctx, referrer, sdk.NewCoin(denom, prev.Add(reward))); err != nil {
+			return err
+		}
+	}
+
+	return k.setReferralSnapshot(ctx, addr, denom, k.getReferralIndex(ctx, denom))
+}
+
+// settleAllReferralRewards settles the referral rewards generated by every account referred by a
+// referrer. Its cost grows with the number of accounts referred by the referrer.
+func (k Keeper) settleAllReferralRewards(ctx sdk.Context, referrer sdk.AccAddress) error {
+	for _, addr := range k.getReferredAccounts(ctx, referrer) {
+		for _, b := range k.getAdjustedBorrows(ctx, addr) {
+			if err := k.settleReferralReward(ctx, addr, b.Denom); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getReferredAccounts returns all accounts referred by a referrer.
+func (k Keeper) getReferredAccounts(ctx sdk.Context, referrer sdk.AccAddress) []sdk.AccAddress {
+	prefix := types.KeyReferralNoAddress(referrer)
+	accounts := []sdk.AccAddress{}
+
+	iterator := func(key, _ []byte) error {
+		accounts = append(accounts, types.AddressFromKey(key, prefix))
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return accounts
+}
+
+// PendingReferralRewards returns the unclaimed referral rewards of a referrer, including the ones
+// generated by its referred accounts but not settled yet.
+func (k Keeper) PendingReferralRewards(ctx sdk.Context, referrer sdk.AccAddress) sdk.Coins {
+	rewards := k.GetReferralRewards(ctx, referrer)
+	for _, addr := range k.getReferredAccounts(ctx, referrer) {
+		for _, b := range k.getAdjustedBorrows(ctx, addr) {
+			rewards = rewards.Add(sdk.NewCoin(b.Denom, k.accruedReferralReward(ctx, addr, b.Denom)))
+		}
+	}
+	return rewards
+}
+
+// getAdjustedBorrows returns all adjusted borrows of an account.
+func (k Keeper) getAdjustedBorrows(ctx sdk.Context, addr sdk.AccAddress) sdk.DecCoins {
+	prefix := types.KeyAdjustedBorrowNoDenom(addr)
+	borrows := sdk.NewDecCoins()
+
+	iterator := func(key, val []byte) error {
+		denom := types.DenomFromKeyWithAddress(key, types.KeyPrefixAdjustedBorrow)
+		var amount sdk.Dec
+		if err := amount.Unmarshal(val); err != nil {
+			// improperly marshaled borrow amount should never happen
+			return err
+		}
+
+		borrows = borrows.Add(sdk.NewDecCoinFromDec(denom, amount))
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return borrows
+}
+
+// ClaimReferralRewards sends all unclaimed referral rewards of a referrer to its account.
+// Returns the amount claimed.
+func (k Keeper) ClaimReferralRewards(ctx sdk.Context, referrer sdk.AccAddress) (sdk.Coins, error) {
+	if err := k.settleAllReferralRewards(ctx, referrer); err != nil {
+		return nil, err
+	}
+
+	rewards := k.GetReferralRewards(ctx, referrer)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	for _, r := range rewards {
+		if err := k.setReferralReward(ctx, referrer, sdk.NewCoin(r.Denom, sdk.ZeroInt())); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, referrer, rewards); err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// getAllReferrals returns all account-referrer links. Uses the Referral struct found in GenesisState.
+func (k Keeper) getAllReferrals(ctx sdk.Context) []types.Referral {
+	referrals := []types.Referral{}
+
+	iterator := func(key, val []byte) error {
+		addr := types.AddressFromKey(key, types.KeyPrefixReferrer)
+		referrals = append(referrals, types.NewReferral(addr.String(), sdk.AccAddress(val).String()))
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, types.KeyPrefixReferrer, iterator))
+
+	return referrals
+}
+
+// getAllReferralRewards returns unclaimed referral rewards of all referrers, including the ones
+// generated by referred accounts but not settled yet. Uses the ReferralReward struct found in
+// GenesisState. Also returns the reserves which the unsettled rewards must be taken from.
+func (k Keeper) getAllReferralRewards(ctx sdk.Context) ([]types.ReferralReward, sdk.Coins) {
+	rewards := map[string]sdk.Coins{}
+	referrers := []string{}
+	add := func(referrer string, c sdk.Coin) {
+		if _, ok := rewards[referrer]; !ok {
+			referrers = append(referrers, referrer)
+		}
+		rewards[referrer] = rewards[referrer].Add(c)
+	}
+
+	iterator := func(key, val []byte) error {
+		referrer := types.AddressFromKey(key, types.KeyPrefixReferralReward).String()
+		denom := types.DenomFromKeyWithAddress(key, types.KeyPrefixReferralReward)
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(val); err != nil {
+			// improperly marshaled amount should never happen
+			return err
+		}
+
+		add(referrer, sdk.NewCoin(denom, amount))
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, types.KeyPrefixReferralReward, iterator))
+
+	// settle pending rewards the same way settleReferralReward would, capped by reserves
+	reserves := k.GetAllReserves(ctx)
+	settled := sdk.NewCoins()
+	for _, referral := range k.getAllReferrals(ctx) {
+		addr := sdk.MustAccAddressFromBech32(referral.Address)
+		for _, b := range k.getAdjustedBorrows(ctx, addr) {
+			available := reserves.AmountOf(b.Denom).Sub(settled.AmountOf(b.Denom))
+			reward := sdk.NewCoin(b.Denom, sdk.MinInt(k.accruedReferralReward(ctx, addr, b.Denom), available))
+			if reward.IsPositive() {
+				add(referral.Referrer, reward)
+				settled = settled.Add(reward)
+			}
+		}
+	}
+
+	referralRewards := make([]types.ReferralReward, 0, len(referrers))
+	for _, r := range referrers {
+		referralRewards = append(referralRewards, types.NewReferralReward(r, rewards[r]))
+	}
+	return referralRewards, settled
+}
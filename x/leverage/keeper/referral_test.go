@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/coin"
+	"github.com/umee-network/umee/v6/x/leverage/types"
+)
+
+func (s *IntegrationTestSuite) TestReferralRewards() {
+	app, srv, require := s.app, s.msgSrvr, s.Require()
+
+	params := app.LeverageKeeper.GetParams(s.ctx)
+	params.ReferralRewardFactor = sdk.MustNewDecFromStr("0.5")
+	require.NoError(app.LeverageKeeper.SetParams(s.ctx, params))
+
+	referrer := s.newAccount()
+	other := s.newAccount()
+	borrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+
+	// the first referrer is recorded, and later ones are ignored
+	_, err := srv.Supply(s.ctx, &types.MsgSupply{
+		Supplier: borrower.String(),
+		Asset:    coin.New(umeeDenom, 500_000000),
+		Referrer: referrer.String(),
+	})
+	require.NoError(err)
+	_, err = srv.Supply(s.ctx, &types.MsgSupply{
+		Supplier: borrower.String(),
+		Asset:    coin.New(umeeDenom, 500_000000),
+		Referrer: other.String(),
+	})
+	require.NoError(err)
+	require.Equal(referrer, app.LeverageKeeper.GetReferrer(s.ctx, borrower))
+
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 200_000000))
+
+	// start the interest clock, then accrue one week of interest
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1000, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(s.ctx))
+	reservesBefore := app.LeverageKeeper.GetReserves(s.ctx, umeeDenom)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1000+7*24*3600, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(s.ctx))
+	reservesGained := app.LeverageKeeper.GetReserves(s.ctx, umeeDenom).Sub(reservesBefore)
+
+	// rewards are not settled by interest accrual, but can be queried
+	require.True(app.LeverageKeeper.GetReferralRewards(s.ctx, referrer).IsZero())
+	require.True(app.LeverageKeeper.GetTotalReferralRewards(s.ctx, umeeDenom).IsZero())
+	rewards := app.LeverageKeeper.PendingReferralRewards(s.ctx, referrer)
+	require.True(rewards.AmountOf(umeeDenom).IsPositive(), "referral rewards")
+	// referrer receives half of the reserves generated by the borrow
+	require.True(reservesGained.Amount.Sub(rewards.AmountOf(umeeDenom).MulRaw(2)).Abs().LTE(sdk.NewInt(2)),
+		"reserves split")
+	require.True(app.LeverageKeeper.PendingReferralRewards(s.ctx, other).IsZero())
+
+	// query
+	resp, err := s.queryClient.ReferralRewards(s.ctx, &types.QueryReferralRewards{Address: referrer.String()})
+	require.NoError(err)
+	require.Equal(rewards, resp.Rewards)
+
+	// a change of the referred borrow settles its rewards, moving them out of the reserves
+	reserves := app.LeverageKeeper.GetReserves(s.ctx, umeeDenom)
+	s.borrow(borrower, coin.New(umeeDenom, 1_000000))
+	require.Equal(rewards, app.LeverageKeeper.GetReferralRewards(s.ctx, referrer))
+	require.Equal(rewards.AmountOf(umeeDenom), app.LeverageKeeper.GetTotalReferralRewards(s.ctx, umeeDenom).Amount)
+	require.Equal(reserves.Sub(rewards[0]), app.LeverageKeeper.GetReserves(s.ctx, umeeDenom))
+	require.Equal(rewards, app.LeverageKeeper.PendingReferralRewards(s.ctx, referrer))
+
+	// more interest accrues, and claiming settles it as well
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1000+14*24*3600, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(s.ctx))
+	rewards = app.LeverageKeeper.PendingReferralRewards(s.ctx, referrer)
+	claimResp, err := srv.ClaimReferralRewards(s.ctx, types.NewMsgClaimReferralRewards(referrer))
+	require.NoError(err)
+	require.Equal(rewards, claimResp.Claimed)
+	require.Equal(rewards.AmountOf(umeeDenom), app.BankKeeper.GetBalance(s.ctx, referrer, umeeDenom).Amount)
+	require.True(app.LeverageKeeper.PendingReferralRewards(s.ctx, referrer).IsZero())
+	require.True(app.LeverageKeeper.GetTotalReferralRewards(s.ctx, umeeDenom).IsZero())
+
+	// an account referred after borrowing doesn't generate rewards for the interest accrued before
+	late := s.newAccount(coin.New(umeeDenom, 1001_000000))
+	s.supply(late, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(late, coin.New("u/"+umeeDenom, 900_000000))
+	s.borrow(late, coin.New(umeeDenom, 200_000000))
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1000+21*24*3600, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(s.ctx))
+	_, err = srv.Supply(s.ctx, &types.MsgSupply{
+		Supplier: late.String(),
+		Asset:    coin.New(umeeDenom, 1_000000),
+		Referrer: other.String(),
+	})
+	require.NoError(err)
+	require.Equal(other, app.LeverageKeeper.GetReferrer(s.ctx, late))
+	require.True(app.LeverageKeeper.PendingReferralRewards(s.ctx, other).IsZero())
+
+	s.checkInvariants("referral rewards")
+}
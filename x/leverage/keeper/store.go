@@ -108,6 +108,11 @@ func (k Keeper) setAdjustedBorrow(ctx sdk.Context, addr sdk.AccAddress, adjusted
 		return types.ErrEmptyAddress
 	}
 
+	// Referral rewards generated by the previous amount must be settled before it changes
+	if err := k.settleReferralReward(ctx, addr, adjustedBorrow.Denom); err != nil {
+		return err
+	}
+
 	// Determine the increase or decrease in total borrowed. A decrease is negative.
 	delta := adjustedBorrow.Amount.Sub(k.getAdjustedBorrow(ctx, addr, adjustedBorrow.Denom))
 
@@ -119,6 +124,11 @@ func (k Keeper) setAdjustedBorrow(ctx sdk.Context, addr sdk.AccAddress, adjusted
 		return err
 	}
 
+	// A repaid borrow generates no referral rewards, so its referral snapshot can be cleared
+	if adjustedBorrow.Amount.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.KeyReferralSnapshot(addr, adjustedBorrow.Denom))
+	}
+
 	// Set new adjusted borrow
 	key = types.KeyAdjustedBorrow(addr, adjustedBorrow.Denom)
 	return k.setStoredDec(ctx, key, adjustedBorrow.Amount, sdk.ZeroDec(), "adjusted borrow")
@@ -29,8 +29,9 @@ func (k Keeper) validateAcceptedAsset(ctx sdk.Context, coin sdk.Coin) error {
 	return k.validateAcceptedDenom(ctx, coin.Denom)
 }
 
-// validateSupply validates an sdk.Coin and ensures its Denom is a Token with EnableMsgSupply
-func (k Keeper) validateSupply(ctx sdk.Context, coin sdk.Coin) error {
+// validateSupply validates an sdk.Coin and ensures its Denom is a Token with EnableMsgSupply.
+// For permissioned Tokens, the supplier must be in the Token's allow list.
+func (k Keeper) validateSupply(ctx sdk.Context, supplier sdk.AccAddress, coin sdk.Coin) error {
 	if err := validateBaseToken(coin); err != nil {
 		return err
 	}
@@ -38,11 +39,15 @@ func (k Keeper) validateSupply(ctx sdk.Context, coin sdk.Coin) error {
 	if err != nil {
 		return err
 	}
-	return token.AssertSupplyEnabled()
+	if err := token.AssertSupplyEnabled(); err != nil {
+		return err
+	}
+	return k.assertAllowListed(ctx, token, supplier)
 }
 
-// validateBorrow validates an sdk.Coin and ensures its Denom is a Token with EnableMsgBorrow
-func (k Keeper) validateBorrow(ctx sdk.Context, borrow sdk.Coin) error {
+// validateBorrow validates an sdk.Coin and ensures its Denom is a Token with EnableMsgBorrow.
+// For permissioned Tokens, the borrower must be in the Token's allow list.
+func (k Keeper) validateBorrow(ctx sdk.Context, borrower sdk.AccAddress, borrow sdk.Coin) error {
 	if err := validateBaseToken(borrow); err != nil {
 		return err
 	}
@@ -50,12 +55,16 @@ func (k Keeper) validateBorrow(ctx sdk.Context, borrow sdk.Coin) error {
 	if err != nil {
 		return err
 	}
-	return token.AssertBorrowEnabled()
+	if err := token.AssertBorrowEnabled(); err != nil {
+		return err
+	}
+	return k.assertAllowListed(ctx, token, borrower)
 }
 
 // validateCollateralize validates an sdk.Coin and ensures it is a uToken of an accepted
-// Token with EnableMsgSupply and CollateralWeight > 0
-func (k Keeper) validateCollateralize(ctx sdk.Context, collateral sdk.Coin) error {
+// Token with EnableMsgSupply and CollateralWeight > 0. For permissioned Tokens, the borrower
+// must be in the Token's allow list.
+func (k Keeper) validateCollateralize(ctx sdk.Context, borrower sdk.AccAddress, collateral sdk.Coin) error {
 	if err := validateUToken(collateral); err != nil {
 		return err
 	}
@@ -66,7 +75,10 @@ func (k Keeper) validateCollateralize(ctx sdk.Context, collateral sdk.Coin) erro
 	if token.CollateralWeight.IsZero() {
 		return types.ErrCollateralWeightZero
 	}
-	return token.AssertSupplyEnabled()
+	if err := token.AssertSupplyEnabled(); err != nil {
+		return err
+	}
+	return k.assertAllowListed(ctx, token, borrower)
 }
 
 // validateBaseToken validates an sdk.Coin and ensures its Denom is not a uToken.
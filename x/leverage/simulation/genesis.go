@@ -17,6 +17,9 @@ const (
 	oracleRewardFactorKey           = "oracle_reward_factor"
 	smallLiquidationSizeKey         = "small_liquidation_size"
 	directLiquidationFeeKey         = "direct_liquidation_fee"
+	referralRewardFactorKey         = "referral_reward_factor"
+	deleverageFeeKey                = "deleverage_fee"
+	maxDeleverageOrdersPerBlockKey  = "max_deleverage_orders_per_block"
 )
 
 // GenCompleteLiquidationThreshold produces a randomized CompleteLiquidationThreshold in the range of [0.050, 0.100]
@@ -44,6 +47,21 @@ func GenDirectLiquidationFee(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(int64(r.Intn(1000)))
 }
 
+// GenReferralRewardFactor produces a randomized ReferralRewardFactor in the range of [0, 0.500]
+func GenReferralRewardFactor(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(501)), 3)
+}
+
+// GenDeleverageFee produces a randomized DeleverageFee in the range of [0, 0.100]
+func GenDeleverageFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 3)
+}
+
+// GenMaxDeleverageOrdersPerBlock produces a randomized MaxDeleverageOrdersPerBlock in the range of [0, 100]
+func GenMaxDeleverageOrdersPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(101))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var completeLiquidationThreshold sdk.Dec
@@ -76,6 +94,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { smallLiquidationSize = GenDirectLiquidationFee(r) },
 	)
 
+	var referralRewardFactor sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, referralRewardFactorKey, &referralRewardFactor, simState.Rand,
+		func(r *rand.Rand) { referralRewardFactor = GenReferralRewardFactor(r) },
+	)
+
+	var deleverageFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, deleverageFeeKey, &deleverageFee, simState.Rand,
+		func(r *rand.Rand) { deleverageFee = GenDeleverageFee(r) },
+	)
+
+	var maxDeleverageOrdersPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxDeleverageOrdersPerBlockKey, &maxDeleverageOrdersPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxDeleverageOrdersPerBlock = GenMaxDeleverageOrdersPerBlock(r) },
+	)
+
 	leverageGenesis := types.NewGenesisState(
 		types.Params{
 			CompleteLiquidationThreshold: completeLiquidationThreshold,
@@ -83,6 +119,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 			OracleRewardFactor:           oracleRewardFactor,
 			SmallLiquidationSize:         smallLiquidationSize,
 			DirectLiquidationFee:         directLiquidationFee,
+			ReferralRewardFactor:         referralRewardFactor,
+			DeleverageFee:                deleverageFee,
+			MaxDeleverageOrdersPerBlock:  maxDeleverageOrdersPerBlock,
 		},
 		[]types.Token{},
 		[]types.AdjustedBorrow{},
@@ -93,6 +132,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.InterestScalar{},
 		sdk.Coins{},
 		[]types.SpecialAssetPair{},
+		[]types.Referral{},
+		[]types.ReferralReward{},
+		[]types.AllowList{},
+		[]types.DeleverageOrder{},
 	)
 
 	bz, err := json.MarshalIndent(&leverageGenesis.Params, "", " ")
@@ -37,6 +37,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMaxWithdraw{}, "umee/leverage/MsgMaxWithdraw", nil)
 	cdc.RegisterConcrete(&MsgMaxBorrow{}, "umee/leverage/MsgMaxBorrow", nil)
 	cdc.RegisterConcrete(&MsgLeveragedLiquidate{}, "umee/leverage/MsgLeveragedLiquidate", nil)
+	cdc.RegisterConcrete(&MsgClaimReferralRewards{}, "umee/leverage/MsgClaimReferralRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateAllowList{}, "umee/leverage/MsgUpdateAllowList", nil)
+	cdc.RegisterConcrete(&MsgCreateDeleverageOrder{}, "umee/leverage/MsgCreateDeleverageOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelDeleverageOrder{}, "umee/leverage/MsgCancelDeleverageOrder", nil)
 
 	cdc.RegisterConcrete(&MsgGovUpdateRegistry{}, "umee/leverage/MsgGovUpdateRegistry", nil)
 	cdc.RegisterConcrete(&MsgGovSetParams{}, "umee/leverage/MsgGovSetParams", nil)
@@ -57,6 +61,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgMaxWithdraw{},
 		&MsgMaxBorrow{},
 		&MsgLeveragedLiquidate{},
+		&MsgClaimReferralRewards{},
+		&MsgUpdateAllowList{},
+		&MsgCreateDeleverageOrder{},
+		&MsgCancelDeleverageOrder{},
 
 		&MsgGovUpdateRegistry{},
 		&MsgGovUpdateSpecialAssets{},
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDeleverageOrder creates a DeleverageOrder.
+func NewDeleverageOrder(borrower, denom string, triggerRatio, targetRatio sdk.Dec) DeleverageOrder {
+	return DeleverageOrder{
+		Borrower:     borrower,
+		Denom:        denom,
+		TriggerRatio: triggerRatio,
+		TargetRatio:  targetRatio,
+	}
+}
+
+// Validate performs validation on a DeleverageOrder.
+func (o DeleverageOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Borrower); err != nil {
+		return err
+	}
+	if err := ValidateBaseDenom(o.Denom); err != nil {
+		return err
+	}
+	return validateDeleverageRatios(o.TriggerRatio, o.TargetRatio)
+}
+
+func validateDeleverageRatios(trigger, target sdk.Dec) error {
+	if trigger.IsNil() || target.IsNil() {
+		return fmt.Errorf("deleverage ratios cannot be nil")
+	}
+	if !trigger.IsPositive() || trigger.GTE(sdk.OneDec()) {
+		return fmt.Errorf("trigger ratio must be between 0 and 1, exclusive: %s", trigger)
+	}
+	if !target.IsPositive() || target.GTE(trigger) {
+		return fmt.Errorf("target ratio must be positive and less than trigger ratio: %s", target)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ var (
 	ErrGetAmount        = errors.Register(ModuleName, 102, "retrieved invalid amount")
 	ErrSetAmount        = errors.Register(ModuleName, 103, "cannot set invalid amount")
 	ErrInvalidPriceMode = errors.Register(ModuleName, 104, "invalid price mode")
+	ErrSelfReferral     = errors.Register(ModuleName, 105, "account cannot be its own referrer")
 
 	// 2XX = Token Registry
 	ErrNotRegisteredToken   = errors.Register(ModuleName, 200, "not a registered Token")
@@ -27,12 +28,16 @@ var (
 	)
 	ErrDuplicateToken          = errors.Register(ModuleName, 207, "duplicate token")
 	ErrEmptyAddAndUpdateTokens = errors.Register(ModuleName, 208, "empty add and update tokens")
+	ErrNotAllowListed          = errors.Register(ModuleName, 209, "account is not in the allow list of Token")
+	ErrNotAllowListAdmin       = errors.Register(ModuleName, 210, "signer is not the allow list admin of Token")
 
 	// 3XX = User Positions
 	ErrInsufficientBalance    = errors.Register(ModuleName, 300, "insufficient balance")
 	ErrInsufficientCollateral = errors.Register(ModuleName, 301, "insufficient collateral")
 	ErrLiquidationRepayZero   = errors.Register(ModuleName, 303, "liquidation would repay zero tokens")
 	ErrBondedCollateral       = errors.Register(ModuleName, 304, "collateral is bonded to incentive module")
+	ErrNoDeleverageOrder      = errors.Register(ModuleName, 305, "deleverage order not found")
+	ErrUnreachableTarget      = errors.Register(ModuleName, 306, "deleverage target ratio is unreachable")
 
 	// 4XX = Price Sensitive
 	ErrBadValue              = errors.Register(ModuleName, 400, "bad USD value")
@@ -487,6 +487,133 @@ func (m *EventFundOracle) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventFundOracle proto.InternalMessageInfo
 
+// EventClaimReferralRewards is emitted on Msg/ClaimReferralRewards
+type EventClaimReferralRewards struct {
+	// Referrer bech32 address.
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// Referral rewards sent to the referrer.
+	Rewards []types.Coin `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *EventClaimReferralRewards) Reset()         { *m = EventClaimReferralRewards{} }
+func (m *EventClaimReferralRewards) String() string { return proto.CompactTextString(m) }
+func (*EventClaimReferralRewards) ProtoMessage()    {}
+func (*EventClaimReferralRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{11}
+}
+func (m *EventClaimReferralRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimReferralRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimReferralRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimReferralRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimReferralRewards.Merge(m, src)
+}
+func (m *EventClaimReferralRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimReferralRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimReferralRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimReferralRewards proto.InternalMessageInfo
+
+// EventUpdateAllowList is emitted when the allow list of a permissioned Token is updated.
+type EventUpdateAllowList struct {
+	// Token base denom
+	Denom   string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Added   []string `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`
+	Removed []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *EventUpdateAllowList) Reset()         { *m = EventUpdateAllowList{} }
+func (m *EventUpdateAllowList) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAllowList) ProtoMessage()    {}
+func (*EventUpdateAllowList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{12}
+}
+func (m *EventUpdateAllowList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAllowList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAllowList.Merge(m, src)
+}
+func (m *EventUpdateAllowList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAllowList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateAllowList proto.InternalMessageInfo
+
+// EventDeleverage is emitted when a DeleverageOrder is executed during EndBlock.
+type EventDeleverage struct {
+	// Borrower bech32 address.
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// Amount of the borrow repaid.
+	Repaid types.Coin `protobuf:"bytes,2,opt,name=repaid,proto3" json:"repaid"`
+	// uToken collateral burned to fund the repayment and the fee.
+	Collateral types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+	// Deleverage fee added to reserves.
+	Fee types.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *EventDeleverage) Reset()         { *m = EventDeleverage{} }
+func (m *EventDeleverage) String() string { return proto.CompactTextString(m) }
+func (*EventDeleverage) ProtoMessage()    {}
+func (*EventDeleverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{13}
+}
+func (m *EventDeleverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeleverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeleverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeleverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeleverage.Merge(m, src)
+}
+func (m *EventDeleverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeleverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeleverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeleverage proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventSupply)(nil), "umee.leverage.v1.EventSupply")
 	proto.RegisterType((*EventWithdraw)(nil), "umee.leverage.v1.EventWithdraw")
@@ -499,53 +626,64 @@ func init() {
 	proto.RegisterType((*EventRepayBadDebt)(nil), "umee.leverage.v1.EventRepayBadDebt")
 	proto.RegisterType((*EventReservesExhausted)(nil), "umee.leverage.v1.EventReservesExhausted")
 	proto.RegisterType((*EventFundOracle)(nil), "umee.leverage.v1.EventFundOracle")
+	proto.RegisterType((*EventClaimReferralRewards)(nil), "umee.leverage.v1.EventClaimReferralRewards")
+	proto.RegisterType((*EventUpdateAllowList)(nil), "umee.leverage.v1.EventUpdateAllowList")
+	proto.RegisterType((*EventDeleverage)(nil), "umee.leverage.v1.EventDeleverage")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/events.proto", fileDescriptor_aaf62b4902d7471c) }
 
 var fileDescriptor_aaf62b4902d7471c = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0xc7, 0xc7, 0x33, 0xf3, 0x55, 0xad, 0xfb, 0xf5, 0x42, 0x54, 0xa1, 0xb4, 0x82, 0x50, 0xb2,
-	0xea, 0xa6, 0x09, 0xe5, 0x2e, 0xb1, 0x40, 0x9d, 0x5e, 0x04, 0x15, 0x02, 0x69, 0xba, 0x40, 0x62,
-	0x33, 0x72, 0xe2, 0xa3, 0x8c, 0xd5, 0x24, 0x0e, 0xb6, 0x93, 0x5e, 0xd8, 0x80, 0x78, 0x01, 0xde,
-	0x80, 0x87, 0x00, 0x1e, 0x80, 0x5d, 0x97, 0x15, 0x2b, 0x16, 0x08, 0x41, 0xfb, 0x22, 0x28, 0x4e,
-	0x3a, 0x19, 0x56, 0xa4, 0x59, 0xc0, 0x2e, 0x3e, 0xfe, 0xff, 0xcf, 0xf9, 0x1d, 0xfb, 0x44, 0xc6,
-	0x57, 0xd3, 0x08, 0xc0, 0x0d, 0x21, 0x03, 0x41, 0x02, 0x70, 0xb3, 0x35, 0x17, 0x32, 0x88, 0x95,
-	0x74, 0x12, 0xc1, 0x15, 0x37, 0xe6, 0xf3, 0x6d, 0xe7, 0x7c, 0xdb, 0xc9, 0xd6, 0x96, 0x2c, 0x9f,
-	0xcb, 0x88, 0x4b, 0xd7, 0x23, 0x32, 0x97, 0x7b, 0xa0, 0xc8, 0x9a, 0xeb, 0x73, 0x16, 0x17, 0x8e,
-	0xa5, 0xc5, 0x62, 0x7f, 0xa0, 0x57, 0x6e, 0xb1, 0x28, 0xb7, 0x16, 0x02, 0x1e, 0xf0, 0x22, 0x9e,
-	0x7f, 0x15, 0x51, 0xfb, 0x03, 0xc2, 0xd3, 0x5b, 0x79, 0xcd, 0xdd, 0x34, 0x49, 0xc2, 0x43, 0xe3,
-	0x36, 0x9e, 0x94, 0xf9, 0x17, 0x03, 0x61, 0xa2, 0x65, 0xb4, 0x32, 0xd5, 0x33, 0xbf, 0x7c, 0x5c,
-	0x5d, 0x28, 0x33, 0xad, 0x53, 0x2a, 0x40, 0xca, 0x5d, 0x25, 0x58, 0x1c, 0xf4, 0x47, 0x4a, 0xe3,
-	0x0e, 0xfe, 0x8f, 0x48, 0x09, 0xca, 0x6c, 0x2f, 0xa3, 0x95, 0xe9, 0x9b, 0x8b, 0x4e, 0xa9, 0xcf,
-	0x31, 0x9d, 0x12, 0xd3, 0xd9, 0xe0, 0x2c, 0xee, 0x75, 0x8f, 0xbf, 0x5f, 0x6b, 0xf5, 0x0b, 0xb5,
-	0x71, 0x0f, 0x4f, 0xa4, 0x8a, 0xef, 0x41, 0x6c, 0x76, 0xea, 0xf9, 0x4a, 0xb9, 0xfd, 0x09, 0xe1,
-	0x19, 0x4d, 0xfd, 0x9c, 0xa9, 0x21, 0x15, 0x64, 0xbf, 0x21, 0x77, 0x05, 0xd0, 0xbe, 0x10, 0x40,
-	0xd5, 0x70, 0xe7, 0x22, 0x0d, 0xdb, 0x6f, 0x10, 0x9e, 0xd7, 0xdc, 0x1b, 0x3c, 0x0c, 0x89, 0x02,
-	0xc1, 0x8e, 0x20, 0x47, 0xf7, 0xb8, 0x10, 0x7c, 0xbf, 0x0e, 0xfa, 0xb9, 0xb2, 0x31, 0xba, 0xfd,
-	0x16, 0x61, 0x43, 0x33, 0x6c, 0x82, 0xff, 0xef, 0x28, 0x8e, 0xca, 0xb1, 0xeb, 0xe9, 0x4c, 0x0d,
-	0xab, 0x37, 0x1b, 0x3b, 0xfb, 0x15, 0xc6, 0xba, 0x76, 0x1f, 0x12, 0x72, 0xd8, 0xbc, 0x71, 0x01,
-	0x09, 0x61, 0xb4, 0x76, 0xe3, 0x85, 0xdc, 0xfe, 0x8c, 0xf0, 0xac, 0xae, 0xfe, 0x84, 0xbd, 0x4c,
-	0x19, 0x25, 0x0a, 0x8c, 0xfb, 0x18, 0x87, 0xe5, 0x82, 0xff, 0x99, 0x61, 0x4c, 0xfb, 0x1b, 0x7b,
-	0xbb, 0x36, 0xfb, 0xc3, 0xaa, 0x1e, 0xd0, 0xba, 0x13, 0x3c, 0x66, 0xb1, 0xbf, 0x21, 0xbc, 0xa0,
-	0x7b, 0x78, 0x1c, 0x2b, 0x10, 0x20, 0xd5, 0xba, 0xef, 0x8b, 0x94, 0x84, 0xc6, 0x75, 0xfc, 0xbf,
-	0x17, 0x72, 0x7f, 0x6f, 0x30, 0x04, 0x16, 0x0c, 0x95, 0xee, 0xa5, 0xdb, 0x9f, 0xd6, 0xb1, 0x47,
-	0x3a, 0x64, 0x5c, 0xc1, 0x53, 0x8a, 0x45, 0x20, 0x15, 0x89, 0x12, 0xcd, 0xdc, 0xed, 0x57, 0x01,
-	0x63, 0x1b, 0xcf, 0x2a, 0xae, 0x48, 0x38, 0x60, 0x65, 0x66, 0xb3, 0xb3, 0xdc, 0xa9, 0x83, 0x37,
-	0xa3, 0x6d, 0xe7, 0x3c, 0xc6, 0x03, 0x3c, 0x29, 0x40, 0x82, 0xc8, 0x80, 0x9a, 0xdd, 0x7a, 0x19,
-	0x46, 0x06, 0xfb, 0x35, 0xc2, 0x97, 0xaa, 0x01, 0xe9, 0x11, 0xba, 0x09, 0x9e, 0xfa, 0xbb, 0x23,
-	0xfa, 0xbe, 0x8d, 0x2f, 0x97, 0x08, 0x1a, 0x4a, 0x6e, 0x1d, 0x0c, 0x49, 0x2a, 0x15, 0xd0, 0x86,
-	0x1c, 0x3b, 0x78, 0x9e, 0xa7, 0x4a, 0x2a, 0x12, 0x53, 0x16, 0x07, 0x03, 0x0a, 0x5e, 0x6d, 0xa4,
-	0xb9, 0x31, 0xa3, 0x3e, 0x89, 0x6d, 0x3c, 0x1b, 0x71, 0x9a, 0x86, 0x30, 0xf0, 0x48, 0x48, 0x62,
-	0x1f, 0xea, 0xce, 0xd0, 0x4c, 0x61, 0xeb, 0x15, 0xae, 0xb1, 0x4b, 0x92, 0x66, 0xb7, 0x5e, 0x86,
-	0x91, 0xc1, 0xde, 0xc1, 0x73, 0xfa, 0x80, 0xb6, 0xd3, 0x98, 0x3e, 0x13, 0xc4, 0x0f, 0x21, 0xff,
-	0x27, 0xf5, 0xe9, 0x49, 0x13, 0xd5, 0xbb, 0xf2, 0x52, 0xde, 0x7b, 0x7a, 0xfc, 0xd3, 0x6a, 0x1d,
-	0x9f, 0x5a, 0xe8, 0xe4, 0xd4, 0x42, 0x3f, 0x4e, 0x2d, 0xf4, 0xee, 0xcc, 0x6a, 0x9d, 0x9c, 0x59,
-	0xad, 0xaf, 0x67, 0x56, 0xeb, 0xc5, 0x8d, 0x80, 0xa9, 0x61, 0xea, 0x39, 0x3e, 0x8f, 0xdc, 0xfc,
-	0x41, 0x5e, 0x8d, 0x41, 0xed, 0x73, 0xb1, 0xa7, 0x17, 0x6e, 0x76, 0xd7, 0x3d, 0xa8, 0x5e, 0x70,
-	0x75, 0x98, 0x80, 0xf4, 0x26, 0xf4, 0xdb, 0x7a, 0xeb, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2,
-	0x20, 0xab, 0xaf, 0xdf, 0x07, 0x00, 0x00,
+	// 773 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0xc7, 0x33, 0x49, 0x5a, 0xda, 0x29, 0xfd, 0xc0, 0x8a, 0x90, 0x5b, 0x81, 0x29, 0x5e, 0x75,
+	0xd3, 0x98, 0xf0, 0x8d, 0x58, 0xa0, 0xa6, 0x1f, 0x82, 0xaa, 0x02, 0xc9, 0x15, 0x42, 0x42, 0x48,
+	0xd1, 0xd8, 0x73, 0x9a, 0x8c, 0x3a, 0xf6, 0x98, 0x99, 0x71, 0xd2, 0x96, 0x0d, 0x88, 0x2d, 0x0b,
+	0xde, 0x80, 0x87, 0x00, 0x1e, 0x80, 0x5d, 0x97, 0x15, 0x2b, 0x16, 0x08, 0x71, 0xdb, 0x57, 0xb8,
+	0x0f, 0x70, 0x35, 0x63, 0xbb, 0xc9, 0x5d, 0x5d, 0x37, 0x8b, 0xde, 0x5d, 0xce, 0x99, 0xff, 0x99,
+	0xf3, 0x3b, 0xc7, 0x67, 0x26, 0x83, 0xdf, 0xcc, 0x13, 0x80, 0x80, 0xc3, 0x18, 0x24, 0x19, 0x42,
+	0x30, 0xee, 0x05, 0x30, 0x86, 0x54, 0xab, 0x6e, 0x26, 0x85, 0x16, 0xce, 0x86, 0x59, 0xee, 0x56,
+	0xcb, 0xdd, 0x71, 0x6f, 0xcb, 0x8b, 0x85, 0x4a, 0x84, 0x0a, 0x22, 0xa2, 0x8c, 0x3c, 0x02, 0x4d,
+	0x7a, 0x41, 0x2c, 0x58, 0x5a, 0x44, 0x6c, 0x6d, 0x16, 0xeb, 0x03, 0x6b, 0x05, 0x85, 0x51, 0x2e,
+	0x75, 0x86, 0x62, 0x28, 0x0a, 0xbf, 0xf9, 0x55, 0x78, 0xfd, 0xdf, 0x11, 0x5e, 0x39, 0x34, 0x39,
+	0x4f, 0xf3, 0x2c, 0xe3, 0x97, 0xce, 0xfb, 0x78, 0x49, 0x99, 0x5f, 0x0c, 0xa4, 0x8b, 0xb6, 0xd1,
+	0xce, 0x72, 0xdf, 0xfd, 0xfb, 0x8f, 0xdd, 0x4e, 0xb9, 0xd3, 0x1e, 0xa5, 0x12, 0x94, 0x3a, 0xd5,
+	0x92, 0xa5, 0xc3, 0xf0, 0x5e, 0xe9, 0x7c, 0x80, 0x17, 0x88, 0x52, 0xa0, 0xdd, 0xe6, 0x36, 0xda,
+	0x59, 0x79, 0x77, 0xb3, 0x5b, 0xea, 0x0d, 0x66, 0xb7, 0xc4, 0xec, 0xee, 0x0b, 0x96, 0xf6, 0xdb,
+	0xd7, 0xff, 0xbd, 0xd5, 0x08, 0x0b, 0xb5, 0xf3, 0x11, 0x5e, 0xcc, 0xb5, 0x38, 0x87, 0xd4, 0x6d,
+	0xd5, 0x8b, 0x2b, 0xe5, 0xfe, 0x9f, 0x08, 0xaf, 0x5a, 0xea, 0x6f, 0x98, 0x1e, 0x51, 0x49, 0x26,
+	0x73, 0x72, 0x4f, 0x01, 0x9a, 0x0f, 0x02, 0x98, 0x16, 0xdc, 0x7a, 0x48, 0xc1, 0xfe, 0x4f, 0x08,
+	0x6f, 0x58, 0xee, 0x7d, 0xc1, 0x39, 0xd1, 0x20, 0xd9, 0x15, 0x18, 0xf4, 0x48, 0x48, 0x29, 0x26,
+	0x75, 0xd0, 0x2b, 0xe5, 0xdc, 0xe8, 0xfe, 0xcf, 0x08, 0x3b, 0x96, 0xe1, 0x00, 0xe2, 0x97, 0x47,
+	0x71, 0x55, 0x8e, 0x5d, 0xdf, 0xee, 0x34, 0x67, 0xf6, 0xf9, 0xc6, 0xce, 0xff, 0x01, 0x63, 0x9b,
+	0x3b, 0x84, 0x8c, 0x5c, 0xce, 0x5f, 0xb8, 0x84, 0x8c, 0x30, 0x5a, 0xbb, 0xf0, 0x42, 0xee, 0xff,
+	0x85, 0xf0, 0x9a, 0xcd, 0x7e, 0xc2, 0xbe, 0xcf, 0x19, 0x25, 0x1a, 0x9c, 0x8f, 0x31, 0xe6, 0xa5,
+	0x21, 0x5e, 0xcc, 0x30, 0xa3, 0x7d, 0x8e, 0xbd, 0x59, 0x9b, 0xfd, 0xb3, 0x69, 0x3e, 0xa0, 0x75,
+	0x27, 0x78, 0x26, 0xc4, 0xff, 0x17, 0xe1, 0x8e, 0xad, 0xe1, 0x8b, 0x54, 0x83, 0x04, 0xa5, 0xf7,
+	0xe2, 0x58, 0xe6, 0x84, 0x3b, 0x6f, 0xe3, 0x57, 0x23, 0x2e, 0xe2, 0xf3, 0xc1, 0x08, 0xd8, 0x70,
+	0xa4, 0x6d, 0x2d, 0xed, 0x70, 0xc5, 0xfa, 0x3e, 0xb7, 0x2e, 0xe7, 0x0d, 0xbc, 0xac, 0x59, 0x02,
+	0x4a, 0x93, 0x24, 0xb3, 0xcc, 0xed, 0x70, 0xea, 0x70, 0x8e, 0xf0, 0x9a, 0x16, 0x9a, 0xf0, 0x01,
+	0x2b, 0x77, 0x76, 0x5b, 0xdb, 0xad, 0x3a, 0x78, 0xab, 0x36, 0xac, 0xe2, 0x71, 0x3e, 0xc5, 0x4b,
+	0x12, 0x14, 0xc8, 0x31, 0x50, 0xb7, 0x5d, 0x6f, 0x87, 0xfb, 0x00, 0xff, 0x47, 0x84, 0x5f, 0x9b,
+	0x0e, 0x48, 0x9f, 0xd0, 0x03, 0x88, 0xf4, 0xe3, 0x8e, 0xe8, 0x6f, 0x4d, 0xfc, 0x7a, 0x89, 0x60,
+	0xa1, 0xd4, 0xe1, 0xc5, 0x88, 0xe4, 0x4a, 0x03, 0x9d, 0x93, 0xe3, 0x18, 0x6f, 0x88, 0x5c, 0x2b,
+	0x4d, 0x52, 0xca, 0xd2, 0xe1, 0x80, 0x42, 0x54, 0x1b, 0x69, 0x7d, 0x26, 0xd0, 0x76, 0xe2, 0x08,
+	0xaf, 0x25, 0x82, 0xe6, 0x1c, 0x06, 0x11, 0xe1, 0x24, 0x8d, 0xa1, 0xee, 0x0c, 0xad, 0x16, 0x61,
+	0xfd, 0x22, 0x6a, 0xe6, 0x23, 0x29, 0xb7, 0x5d, 0x6f, 0x87, 0xfb, 0x00, 0xff, 0x18, 0xaf, 0xdb,
+	0x06, 0x1d, 0xe5, 0x29, 0xfd, 0x4a, 0x92, 0x98, 0x83, 0x39, 0x93, 0xb6, 0x7b, 0xca, 0x45, 0xf5,
+	0x3e, 0x79, 0x29, 0xf7, 0x7f, 0x41, 0x78, 0xb3, 0xb8, 0x96, 0x39, 0x61, 0x49, 0x08, 0x67, 0x20,
+	0x25, 0xe1, 0x21, 0x4c, 0x88, 0xa4, 0xca, 0x34, 0x5c, 0x5a, 0x57, 0x9d, 0x86, 0x57, 0x4a, 0xe7,
+	0x13, 0xfc, 0x8a, 0x2c, 0x36, 0x70, 0x9b, 0xf5, 0x68, 0x2a, 0xbd, 0xff, 0x5d, 0x79, 0xba, 0xbe,
+	0xce, 0xcc, 0x71, 0xdb, 0xe3, 0x5c, 0x4c, 0x4e, 0x98, 0xd2, 0x4e, 0x07, 0x2f, 0x50, 0x48, 0x45,
+	0x52, 0x50, 0x84, 0x85, 0x61, 0xbc, 0x84, 0x52, 0xa0, 0x36, 0xcd, 0x72, 0x58, 0x18, 0x8e, 0x6b,
+	0xd2, 0x27, 0x62, 0x6c, 0x0f, 0xb8, 0xf1, 0x57, 0xa6, 0xff, 0x14, 0x95, 0x9d, 0x3b, 0x80, 0xea,
+	0x65, 0xf1, 0xc8, 0x77, 0xa0, 0xb9, 0x80, 0xaa, 0xbf, 0x1e, 0xc2, 0x6b, 0x5f, 0x40, 0xd3, 0x10,
+	0xa7, 0x87, 0x5b, 0x67, 0x00, 0x75, 0x87, 0xc6, 0x68, 0xfb, 0x5f, 0x5e, 0x3f, 0xf1, 0x1a, 0xd7,
+	0xb7, 0x1e, 0xba, 0xb9, 0xf5, 0xd0, 0xff, 0xb7, 0x1e, 0xfa, 0xf5, 0xce, 0x6b, 0xdc, 0xdc, 0x79,
+	0x8d, 0x7f, 0xee, 0xbc, 0xc6, 0xb7, 0xef, 0x0c, 0x99, 0x1e, 0xe5, 0x51, 0x37, 0x16, 0x49, 0x60,
+	0x1e, 0x5d, 0xbb, 0x29, 0xe8, 0x89, 0x90, 0xe7, 0xd6, 0x08, 0xc6, 0x1f, 0x06, 0x17, 0xd3, 0x57,
+	0x9a, 0xbe, 0xcc, 0x40, 0x45, 0x8b, 0xf6, 0xfd, 0xf4, 0xde, 0xb3, 0x01, 0x00, 0x3e, 0x75, 0x19,
+	0x8a, 0xc3, 0x09, 0x00, 0x00,
 }
 
 func (m *EventSupply) Marshal() (dAtA []byte, err error) {
@@ -1053,6 +1191,158 @@ func (m *EventFundOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventClaimReferralRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimReferralRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimReferralRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeleverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeleverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeleverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Repaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1253,6 +1543,69 @@ func (m *EventFundOracle) Size() (n int) {
 	return n
 }
 
+func (m *EventClaimReferralRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDeleverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Repaid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2698,6 +3051,449 @@ func (m *EventFundOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventClaimReferralRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimReferralRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimReferralRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateAllowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateAllowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleverage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleverage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleverage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Repaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,10 @@ func NewGenesisState(
 	interestScalars []InterestScalar,
 	uTokenSupply sdk.Coins,
 	specialPairs []SpecialAssetPair,
+	referrals []Referral,
+	referralRewards []ReferralReward,
+	allowLists []AllowList,
+	deleverageOrders []DeleverageOrder,
 ) *GenesisState {
 	return &GenesisState{
 		Params:           params,
@@ -31,6 +35,10 @@ func NewGenesisState(
 		InterestScalars:  interestScalars,
 		UtokenSupply:     uTokenSupply,
 		SpecialPairs:     specialPairs,
+		Referrals:        referrals,
+		ReferralRewards:  referralRewards,
+		AllowLists:       allowLists,
+		DeleverageOrders: deleverageOrders,
 	}
 }
 
@@ -107,6 +115,44 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	for _, referral := range gs.Referrals {
+		if _, err := sdk.AccAddressFromBech32(referral.Address); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(referral.Referrer); err != nil {
+			return err
+		}
+		if referral.Address == referral.Referrer {
+			return ErrSelfReferral.Wrap(referral.Address)
+		}
+	}
+
+	for _, reward := range gs.ReferralRewards {
+		if _, err := sdk.AccAddressFromBech32(reward.Referrer); err != nil {
+			return err
+		}
+		if err := reward.Rewards.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, list := range gs.AllowLists {
+		if err := ValidateBaseDenom(list.Denom); err != nil {
+			return err
+		}
+		for _, addr := range list.Addresses {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, order := range gs.DeleverageOrders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.UtokenSupply.Validate()
 }
 
@@ -153,3 +199,27 @@ func NewInterestScalar(denom string, scalar sdk.Dec) InterestScalar {
 		Scalar: scalar,
 	}
 }
+
+// NewReferral creates the Referral struct used in GenesisState
+func NewReferral(addr, referrer string) Referral {
+	return Referral{
+		Address:  addr,
+		Referrer: referrer,
+	}
+}
+
+// NewReferralReward creates the ReferralReward struct used in GenesisState
+func NewReferralReward(referrer string, rewards sdk.Coins) ReferralReward {
+	return ReferralReward{
+		Referrer: referrer,
+		Rewards:  rewards,
+	}
+}
+
+// NewAllowList creates the AllowList struct used in GenesisState
+func NewAllowList(denom string, addresses []string) AllowList {
+	return AllowList{
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
@@ -37,6 +37,10 @@ type GenesisState struct {
 	InterestScalars  []InterestScalar                         `protobuf:"bytes,8,rep,name=interest_scalars,json=interestScalars,proto3" json:"interest_scalars"`
 	UtokenSupply     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=utoken_supply,json=utokenSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"utoken_supply"`
 	SpecialPairs     []SpecialAssetPair                       `protobuf:"bytes,10,rep,name=special_pairs,json=specialPairs,proto3" json:"special_pairs"`
+	Referrals        []Referral                               `protobuf:"bytes,11,rep,name=referrals,proto3" json:"referrals"`
+	ReferralRewards  []ReferralReward                         `protobuf:"bytes,12,rep,name=referral_rewards,json=referralRewards,proto3" json:"referral_rewards"`
+	AllowLists       []AllowList                              `protobuf:"bytes,13,rep,name=allow_lists,json=allowLists,proto3" json:"allow_lists"`
+	DeleverageOrders []DeleverageOrder                        `protobuf:"bytes,14,rep,name=deleverage_orders,json=deleverageOrders,proto3" json:"deleverage_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -231,58 +235,191 @@ func (m *InterestScalar) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterestScalar proto.InternalMessageInfo
 
+// Referral links an account with its referrer. It is used in the leverage module's
+// genesis state.
+type Referral struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *Referral) Reset()         { *m = Referral{} }
+func (m *Referral) String() string { return proto.CompactTextString(m) }
+func (*Referral) ProtoMessage()    {}
+func (*Referral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f71666aa8f549, []int{5}
+}
+func (m *Referral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Referral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Referral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Referral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Referral.Merge(m, src)
+}
+func (m *Referral) XXX_Size() int {
+	return m.Size()
+}
+func (m *Referral) XXX_DiscardUnknown() {
+	xxx_messageInfo_Referral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Referral proto.InternalMessageInfo
+
+// ReferralReward holds unclaimed referral rewards of a referrer. It is used in the
+// leverage module's genesis state.
+type ReferralReward struct {
+	Referrer string                                   `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Rewards  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ReferralReward) Reset()         { *m = ReferralReward{} }
+func (m *ReferralReward) String() string { return proto.CompactTextString(m) }
+func (*ReferralReward) ProtoMessage()    {}
+func (*ReferralReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f71666aa8f549, []int{6}
+}
+func (m *ReferralReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferralReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralReward.Merge(m, src)
+}
+func (m *ReferralReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralReward proto.InternalMessageInfo
+
+// AllowList holds the accounts allowed to use a permissioned Token. It is used in the
+// leverage module's genesis state.
+type AllowList struct {
+	Denom     string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *AllowList) Reset()         { *m = AllowList{} }
+func (m *AllowList) String() string { return proto.CompactTextString(m) }
+func (*AllowList) ProtoMessage()    {}
+func (*AllowList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f71666aa8f549, []int{7}
+}
+func (m *AllowList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowList.Merge(m, src)
+}
+func (m *AllowList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowList proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "umee.leverage.v1.GenesisState")
 	proto.RegisterType((*AdjustedBorrow)(nil), "umee.leverage.v1.AdjustedBorrow")
 	proto.RegisterType((*Collateral)(nil), "umee.leverage.v1.Collateral")
 	proto.RegisterType((*BadDebt)(nil), "umee.leverage.v1.BadDebt")
 	proto.RegisterType((*InterestScalar)(nil), "umee.leverage.v1.InterestScalar")
+	proto.RegisterType((*Referral)(nil), "umee.leverage.v1.Referral")
+	proto.RegisterType((*ReferralReward)(nil), "umee.leverage.v1.ReferralReward")
+	proto.RegisterType((*AllowList)(nil), "umee.leverage.v1.AllowList")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/genesis.proto", fileDescriptor_a51f71666aa8f549) }
 
 var fileDescriptor_a51f71666aa8f549 = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0x7b, 0x49, 0x9b, 0xe9, 0xe5, 0xaf, 0x46, 0x95, 0x7e, 0x53, 0x55, 0x4e, 0x94, 0x05,
-	0xca, 0x82, 0xda, 0x6d, 0x91, 0x8a, 0x8a, 0xd8, 0xd4, 0xad, 0x40, 0x2c, 0x40, 0x25, 0xe9, 0x8a,
-	0x8d, 0x35, 0xb6, 0x0f, 0xc6, 0xd4, 0xf6, 0x58, 0x73, 0x26, 0x29, 0x5d, 0xf2, 0x06, 0x3c, 0x07,
-	0x4f, 0xd2, 0x65, 0x97, 0x88, 0x45, 0x81, 0xf6, 0x45, 0x90, 0x67, 0x26, 0x69, 0xd3, 0x40, 0xc4,
-	0x82, 0x55, 0x32, 0x73, 0xbe, 0xcb, 0x9c, 0xf3, 0xcd, 0x98, 0x38, 0xfd, 0x1c, 0xc0, 0xcb, 0x60,
-	0x00, 0x82, 0x25, 0xe0, 0x0d, 0x76, 0xbc, 0x04, 0x0a, 0xc0, 0x14, 0xdd, 0x52, 0x70, 0xc9, 0xe9,
-	0x5a, 0x55, 0x77, 0x87, 0x75, 0x77, 0xb0, 0xb3, 0xe1, 0x44, 0x1c, 0x73, 0x8e, 0x5e, 0xc8, 0xb0,
-	0xc2, 0x87, 0x20, 0xd9, 0x8e, 0x17, 0xf1, 0xb4, 0xd0, 0x8c, 0x8d, 0xe6, 0x84, 0xe2, 0x88, 0xad,
-	0x01, 0xeb, 0x09, 0x4f, 0xb8, 0xfa, 0xeb, 0x55, 0xff, 0xf4, 0x6e, 0xfb, 0x53, 0x9d, 0x2c, 0xbf,
-	0xd0, 0xd6, 0x3d, 0xc9, 0x24, 0xd0, 0x3d, 0x52, 0x2f, 0x99, 0x60, 0x39, 0xda, 0x56, 0xcb, 0xea,
-	0x2c, 0xed, 0xda, 0xee, 0xfd, 0xa3, 0xb8, 0xc7, 0xaa, 0xee, 0xcf, 0x5d, 0x5c, 0x35, 0x6b, 0x5d,
-	0x83, 0xa6, 0xfb, 0x64, 0x51, 0x40, 0x92, 0xa2, 0x14, 0xe7, 0xf6, 0x4c, 0x6b, 0xb6, 0xb3, 0xb4,
-	0xfb, 0xff, 0x24, 0xf3, 0x84, 0x9f, 0x42, 0x61, 0x88, 0x23, 0x38, 0x7d, 0x43, 0xd6, 0x58, 0xfc,
-	0xa1, 0x8f, 0x12, 0xe2, 0x20, 0xe4, 0x42, 0xf0, 0x33, 0xb4, 0x67, 0x95, 0x44, 0x6b, 0x52, 0xe2,
-	0xc0, 0x20, 0x7d, 0x05, 0x34, 0x5a, 0xff, 0xb1, 0xb1, 0x5d, 0xa4, 0x3e, 0x21, 0x11, 0xcf, 0x32,
-	0x26, 0x41, 0xb0, 0xcc, 0x9e, 0x53, 0x62, 0x9b, 0x93, 0x62, 0x87, 0x23, 0x8c, 0x11, 0xba, 0xc3,
-	0xa2, 0x49, 0xd5, 0x11, 0x82, 0x18, 0x00, 0xda, 0xf3, 0x4a, 0xe1, 0x81, 0xab, 0x43, 0x70, 0xab,
-	0x10, 0x5c, 0x13, 0x82, 0x7b, 0xc8, 0xd3, 0xc2, 0xdf, 0xae, 0xe8, 0x5f, 0xbe, 0x37, 0x3b, 0x49,
-	0x2a, 0xdf, 0xf7, 0x43, 0x37, 0xe2, 0xb9, 0x67, 0x12, 0xd3, 0x3f, 0x5b, 0x18, 0x9f, 0x7a, 0xf2,
-	0xbc, 0x04, 0x54, 0x04, 0xec, 0x8e, 0xc4, 0xe9, 0x23, 0x42, 0x33, 0x86, 0x32, 0x48, 0x0b, 0x09,
-	0x02, 0x50, 0x06, 0x32, 0xcd, 0xc1, 0xae, 0xb7, 0xac, 0xce, 0x6c, 0x77, 0xad, 0xaa, 0xbc, 0x34,
-	0x85, 0x93, 0x34, 0x07, 0xfa, 0x8c, 0x34, 0x42, 0x16, 0x07, 0x31, 0x84, 0x12, 0xed, 0x05, 0x73,
-	0xae, 0x89, 0xce, 0x7c, 0x16, 0x1f, 0x41, 0x28, 0x87, 0xb3, 0x0e, 0xf5, 0x12, 0xab, 0x59, 0x8f,
-	0x6c, 0x30, 0x62, 0x19, 0x13, 0x68, 0x2f, 0xfe, 0x69, 0xd6, 0x43, 0xdf, 0x9e, 0x02, 0x0e, 0x67,
-	0x9d, 0x8e, 0xed, 0x22, 0x2d, 0xc9, 0x4a, 0x5f, 0x56, 0xc1, 0x06, 0xd8, 0x2f, 0xcb, 0xec, 0xdc,
-	0x6e, 0xfc, 0xfb, 0x61, 0x2d, 0x6b, 0x87, 0x9e, 0x32, 0xa0, 0xaf, 0xc8, 0x0a, 0x96, 0x10, 0xa5,
-	0x2c, 0x0b, 0x4a, 0x96, 0x0a, 0xb4, 0x89, 0x72, 0x6c, 0x4f, 0x76, 0xd0, 0xd3, 0xb0, 0x03, 0x44,
-	0x90, 0xc7, 0x2c, 0x1d, 0xf6, 0xb0, 0x6c, 0xe8, 0xd5, 0x16, 0xb6, 0xdf, 0x91, 0xd5, 0xf1, 0x5b,
-	0x45, 0x6d, 0xb2, 0xc0, 0xe2, 0x58, 0x00, 0xea, 0x57, 0xd0, 0xe8, 0x0e, 0x97, 0xf4, 0x29, 0xa9,
-	0xb3, 0x9c, 0xf7, 0x0b, 0x69, 0xcf, 0xa8, 0xe7, 0xb1, 0xf9, 0xdb, 0x2e, 0x8f, 0x20, 0x52, 0x8d,
-	0x9a, 0x27, 0xa2, 0x19, 0xed, 0x80, 0x90, 0xdb, 0x0b, 0x37, 0xc5, 0xe3, 0xc9, 0x3d, 0x8f, 0x29,
-	0x93, 0x1c, 0x37, 0xd8, 0x27, 0x0b, 0x26, 0xf7, 0x29, 0xea, 0xeb, 0x64, 0x3e, 0x86, 0x82, 0xe7,
-	0x4a, 0xbc, 0xd1, 0xd5, 0x8b, 0x76, 0x41, 0x56, 0xc7, 0xd3, 0xbe, 0xc5, 0x59, 0x77, 0x70, 0xf4,
-	0x39, 0xa9, 0xeb, 0x6b, 0xa3, 0xe9, 0xbe, 0x5b, 0x1d, 0xe0, 0xdb, 0x55, 0xf3, 0xe1, 0x5f, 0x44,
-	0x79, 0x04, 0x51, 0xd7, 0xb0, 0xfd, 0xd7, 0x17, 0x3f, 0x9d, 0xda, 0xc5, 0xb5, 0x63, 0x5d, 0x5e,
-	0x3b, 0xd6, 0x8f, 0x6b, 0xc7, 0xfa, 0x7c, 0xe3, 0xd4, 0x2e, 0x6f, 0x9c, 0xda, 0xd7, 0x1b, 0xa7,
-	0xf6, 0x76, 0xfb, 0x8e, 0x5a, 0x95, 0xe9, 0x56, 0x01, 0xf2, 0x8c, 0x8b, 0x53, 0xb5, 0xf0, 0x06,
-	0x7b, 0xde, 0xc7, 0xdb, 0x2f, 0x9d, 0xd2, 0x0e, 0xeb, 0xea, 0x73, 0xf6, 0xf8, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xba, 0x3b, 0x91, 0xf3, 0x59, 0x05, 0x00, 0x00,
+	// 800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0x27, 0xed, 0x26, 0x9e, 0xfc, 0x21, 0x8c, 0x2a, 0x31, 0x84, 0xc8, 0x09, 0x3e, 0xa0,
+	0x1c, 0xa8, 0xdd, 0x14, 0xa9, 0xa8, 0x08, 0x01, 0xdd, 0x46, 0x20, 0x24, 0xfe, 0x14, 0x27, 0x27,
+	0x2e, 0xd6, 0xd8, 0x7e, 0x5d, 0x4c, 0x6c, 0x8f, 0x35, 0x6f, 0x76, 0x43, 0xbe, 0x05, 0x12, 0xdf,
+	0x82, 0x03, 0x9f, 0x23, 0xc7, 0x1e, 0x11, 0x87, 0x02, 0xc9, 0x17, 0x41, 0x33, 0x1e, 0x7b, 0xe3,
+	0xba, 0x59, 0x71, 0xe8, 0x69, 0xf7, 0xbd, 0xf9, 0xfd, 0x7e, 0xef, 0xcd, 0xfb, 0x33, 0x26, 0xde,
+	0xac, 0x04, 0x08, 0x0b, 0x98, 0x83, 0xe4, 0x53, 0x08, 0xe7, 0x47, 0xe1, 0x14, 0x2a, 0xc0, 0x1c,
+	0x83, 0x5a, 0x0a, 0x25, 0xe8, 0x8e, 0x3e, 0x0f, 0xda, 0xf3, 0x60, 0x7e, 0xb4, 0xeb, 0xa5, 0x02,
+	0x4b, 0x81, 0x61, 0xc2, 0x51, 0xe3, 0x13, 0x50, 0xfc, 0x28, 0x4c, 0x45, 0x5e, 0x35, 0x8c, 0xdd,
+	0xfd, 0x81, 0x62, 0xc7, 0x6e, 0x00, 0xf7, 0xa6, 0x62, 0x2a, 0xcc, 0xdf, 0x50, 0xff, 0x6b, 0xbc,
+	0xfe, 0x1f, 0xeb, 0x64, 0xf3, 0xab, 0x26, 0xf4, 0x89, 0xe2, 0x0a, 0xe8, 0x23, 0x32, 0xae, 0xb9,
+	0xe4, 0x25, 0x32, 0xe7, 0xc0, 0x39, 0xdc, 0x78, 0xc8, 0x82, 0x57, 0x53, 0x09, 0x9e, 0x99, 0xf3,
+	0xc9, 0x9d, 0xcb, 0x97, 0xfb, 0xa3, 0xc8, 0xa2, 0xe9, 0x63, 0xb2, 0x2e, 0x61, 0x9a, 0xa3, 0x92,
+	0x17, 0x6c, 0xe5, 0x60, 0xf5, 0x70, 0xe3, 0xe1, 0x3b, 0x43, 0xe6, 0xa9, 0x38, 0x83, 0xca, 0x12,
+	0x3b, 0x38, 0xfd, 0x81, 0xec, 0xf0, 0xec, 0xe7, 0x19, 0x2a, 0xc8, 0xe2, 0x44, 0x48, 0x29, 0xce,
+	0x91, 0xad, 0x1a, 0x89, 0x83, 0xa1, 0xc4, 0x13, 0x8b, 0x9c, 0x18, 0xa0, 0xd5, 0x7a, 0x8b, 0xf7,
+	0xbc, 0x48, 0x27, 0x84, 0xa4, 0xa2, 0x28, 0xb8, 0x02, 0xc9, 0x0b, 0x76, 0xc7, 0x88, 0xed, 0x0d,
+	0xc5, 0x9e, 0x76, 0x18, 0x2b, 0x74, 0x83, 0x45, 0xa7, 0xfa, 0x46, 0x08, 0x72, 0x0e, 0xc8, 0xee,
+	0x1a, 0x85, 0x77, 0x83, 0xa6, 0x09, 0x81, 0x6e, 0x42, 0x60, 0x9b, 0x10, 0x3c, 0x15, 0x79, 0x35,
+	0x79, 0xa0, 0xe9, 0xbf, 0xff, 0xbd, 0x7f, 0x38, 0xcd, 0xd5, 0x4f, 0xb3, 0x24, 0x48, 0x45, 0x19,
+	0xda, 0x8e, 0x35, 0x3f, 0xf7, 0x31, 0x3b, 0x0b, 0xd5, 0x45, 0x0d, 0x68, 0x08, 0x18, 0x75, 0xe2,
+	0xf4, 0x43, 0x42, 0x0b, 0x8e, 0x2a, 0xce, 0x2b, 0x05, 0x12, 0x50, 0xc5, 0x2a, 0x2f, 0x81, 0x8d,
+	0x0f, 0x9c, 0xc3, 0xd5, 0x68, 0x47, 0x9f, 0x7c, 0x6d, 0x0f, 0x4e, 0xf3, 0x12, 0xe8, 0xa7, 0xc4,
+	0x4d, 0x78, 0x16, 0x67, 0x90, 0x28, 0x64, 0x6b, 0x36, 0xaf, 0xc1, 0xcd, 0x26, 0x3c, 0x3b, 0x86,
+	0x44, 0xb5, 0xb5, 0x4e, 0x1a, 0x13, 0x75, 0xad, 0xbb, 0x30, 0x98, 0xf2, 0x82, 0x4b, 0x64, 0xeb,
+	0xb7, 0xd5, 0xba, 0x8d, 0x7b, 0x62, 0x80, 0x6d, 0xad, 0xf3, 0x9e, 0x17, 0x69, 0x4d, 0xb6, 0x66,
+	0x4a, 0x37, 0x36, 0xc6, 0x59, 0x5d, 0x17, 0x17, 0xcc, 0x7d, 0xf3, 0xc5, 0xda, 0x6c, 0x22, 0x9c,
+	0x98, 0x00, 0xf4, 0x5b, 0xb2, 0x85, 0x35, 0xa4, 0x39, 0x2f, 0xe2, 0x9a, 0xe7, 0x12, 0x19, 0x31,
+	0x11, 0xfd, 0xe1, 0x0d, 0x4e, 0x1a, 0xd8, 0x13, 0x44, 0x50, 0xcf, 0x78, 0xde, 0xde, 0x61, 0xd3,
+	0xd2, 0xb5, 0x0b, 0xe9, 0x67, 0xc4, 0x95, 0xf0, 0x1c, 0xa4, 0xe4, 0x05, 0xb2, 0x0d, 0x23, 0xb5,
+	0x3b, 0x94, 0x8a, 0x2c, 0xc4, 0x4a, 0x2c, 0x28, 0xba, 0xa6, 0xad, 0x11, 0x4b, 0x38, 0xe7, 0x32,
+	0x43, 0xb6, 0x79, 0x5b, 0x4d, 0x5b, 0x99, 0xc8, 0x00, 0xdb, 0x9a, 0xca, 0x9e, 0x57, 0xcf, 0xef,
+	0x06, 0x2f, 0x0a, 0x71, 0x1e, 0x17, 0x39, 0x2a, 0x64, 0x5b, 0x46, 0xed, 0xbd, 0xd7, 0x6c, 0x83,
+	0x06, 0x7d, 0x93, 0x63, 0xdb, 0x68, 0xc2, 0x5b, 0x07, 0xd2, 0x53, 0xf2, 0x76, 0x06, 0x2d, 0x38,
+	0x16, 0x32, 0x03, 0x89, 0x6c, 0xdb, 0x28, 0xbd, 0x3f, 0x54, 0x3a, 0xee, 0xa0, 0xdf, 0x6b, 0xa4,
+	0xd5, 0xdb, 0xc9, 0xfa, 0x6e, 0xf4, 0x9f, 0x93, 0xed, 0xfe, 0x0a, 0x52, 0x46, 0xd6, 0x78, 0x96,
+	0x49, 0xc0, 0xe6, 0xc9, 0x70, 0xa3, 0xd6, 0xa4, 0x9f, 0x90, 0x31, 0x2f, 0xc5, 0xac, 0x52, 0x6c,
+	0xc5, 0xbc, 0x25, 0x7b, 0xaf, 0x1d, 0x89, 0x63, 0x48, 0xcd, 0x54, 0xd8, 0xf7, 0xa4, 0x61, 0xf8,
+	0x31, 0x21, 0x8b, 0xed, 0x5c, 0x12, 0xe3, 0xe3, 0x57, 0x62, 0x2c, 0x19, 0xbb, 0x7e, 0x80, 0xc7,
+	0x64, 0xcd, 0x2e, 0xc9, 0x12, 0xf5, 0x7b, 0xe4, 0x6e, 0x06, 0x95, 0x28, 0x8d, 0xb8, 0x1b, 0x35,
+	0x86, 0x5f, 0x91, 0xed, 0xfe, 0x6a, 0x2c, 0x70, 0xce, 0x0d, 0x1c, 0xfd, 0x92, 0x8c, 0x9b, 0x1d,
+	0x6b, 0xe8, 0x93, 0x40, 0x27, 0xf0, 0xd7, 0xcb, 0xfd, 0x0f, 0xfe, 0xc7, 0xdc, 0x1f, 0x43, 0x1a,
+	0x59, 0xb6, 0xff, 0x05, 0x59, 0x6f, 0xc7, 0x66, 0x49, 0xae, 0xbb, 0xfa, 0xbd, 0xd2, 0x28, 0xb0,
+	0xf1, 0xa2, 0xce, 0xf6, 0x7f, 0x73, 0xc8, 0x76, 0x7f, 0xf2, 0x7a, 0x70, 0xa7, 0x0f, 0xa7, 0x40,
+	0xd6, 0xda, 0x41, 0x5e, 0x79, 0xf3, 0xcb, 0xdc, 0x6a, 0xfb, 0x9f, 0x13, 0xb7, 0x1b, 0xe0, 0x5b,
+	0x4a, 0xb8, 0x47, 0x5c, 0x7b, 0x3f, 0x68, 0x72, 0x71, 0xa3, 0x85, 0x63, 0xf2, 0xdd, 0xe5, 0xbf,
+	0xde, 0xe8, 0xf2, 0xca, 0x73, 0x5e, 0x5c, 0x79, 0xce, 0x3f, 0x57, 0x9e, 0xf3, 0xeb, 0xb5, 0x37,
+	0x7a, 0x71, 0xed, 0x8d, 0xfe, 0xbc, 0xf6, 0x46, 0x3f, 0x3e, 0xb8, 0x91, 0x91, 0x9e, 0xf7, 0xfb,
+	0x15, 0xa8, 0x73, 0x21, 0xcf, 0x8c, 0x11, 0xce, 0x1f, 0x85, 0xbf, 0x2c, 0xbe, 0x97, 0x26, 0xbf,
+	0x64, 0x6c, 0x3e, 0x8a, 0x1f, 0xfd, 0x37, 0x00, 0xf3, 0xd0, 0xf2, 0xad, 0x9f, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -305,6 +442,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeleverageOrders) > 0 {
+		for iNdEx := len(m.DeleverageOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeleverageOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AllowLists) > 0 {
+		for iNdEx := len(m.AllowLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ReferralRewards) > 0 {
+		for iNdEx := len(m.ReferralRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.SpecialPairs) > 0 {
 		for iNdEx := len(m.SpecialPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -592,6 +785,126 @@ func (m *InterestScalar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Referral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Referral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Referral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -662,6 +975,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralRewards) > 0 {
+		for _, e := range m.ReferralRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowLists) > 0 {
+		for _, e := range m.AllowLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeleverageOrders) > 0 {
+		for _, e := range m.DeleverageOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -727,23 +1064,78 @@ func (m *InterestScalar) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Referral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ReferralReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
+
+func (m *AllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -885,21 +1277,428 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, Collateral{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, types.Coin{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterestTime", wireType)
+			}
+			m.LastInterestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastInterestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadDebts = append(m.BadDebts, BadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestScalars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestScalars = append(m.InterestScalars, InterestScalar{})
+			if err := m.InterestScalars[len(m.InterestScalars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtokenSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UtokenSupply = append(m.UtokenSupply, types.Coin{})
+			if err := m.UtokenSupply[len(m.UtokenSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialPairs = append(m.SpecialPairs, SpecialAssetPair{})
+			if err := m.SpecialPairs[len(m.SpecialPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, Referral{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralRewards = append(m.ReferralRewards, ReferralReward{})
+			if err := m.ReferralRewards[len(m.ReferralRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowLists = append(m.AllowLists, AllowList{})
+			if err := m.AllowLists[len(m.AllowLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleverageOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleverageOrders = append(m.DeleverageOrders, DeleverageOrder{})
+			if err := m.DeleverageOrders[len(m.DeleverageOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdjustedBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdjustedBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdjustedBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = append(m.Collateral, Collateral{})
-			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -926,35 +1725,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reserves = append(m.Reserves, types.Coin{})
-			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInterestTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastInterestTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastInterestTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Collateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Collateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Collateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -964,29 +1793,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadDebts = append(m.BadDebts, BadDebt{})
-			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestScalars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1013,16 +1840,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestScalars = append(m.InterestScalars, InterestScalar{})
-			if err := m.InterestScalars[len(m.InterestScalars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UtokenSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1032,31 +1908,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UtokenSupply = append(m.UtokenSupply, types.Coin{})
-			if err := m.UtokenSupply[len(m.UtokenSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecialPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1066,25 +1940,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecialPairs = append(m.SpecialPairs, SpecialAssetPair{})
-			if err := m.SpecialPairs[len(m.SpecialPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1107,7 +1979,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdjustedBorrow) Unmarshal(dAtA []byte) error {
+func (m *InterestScalar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1130,15 +2002,15 @@ func (m *AdjustedBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdjustedBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterestScalar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdjustedBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterestScalar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1166,13 +2038,13 @@ func (m *AdjustedBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scalar", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1182,22 +2054,23 @@ func (m *AdjustedBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scalar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1222,7 +2095,7 @@ func (m *AdjustedBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Collateral) Unmarshal(dAtA []byte) error {
+func (m *Referral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1245,10 +2118,10 @@ func (m *Collateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Collateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: Referral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Collateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Referral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1285,9 +2158,9 @@ func (m *Collateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1297,24 +2170,23 @@ func (m *Collateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1337,7 +2209,7 @@ func (m *Collateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BadDebt) Unmarshal(dAtA []byte) error {
+func (m *ReferralReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,15 +2232,15 @@ func (m *BadDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BadDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReferralReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReferralReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1396,13 +2268,13 @@ func (m *BadDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1412,23 +2284,25 @@ func (m *BadDebt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1451,7 +2325,7 @@ func (m *BadDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterestScalar) Unmarshal(dAtA []byte) error {
+func (m *AllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1474,10 +2348,10 @@ func (m *InterestScalar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterestScalar: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterestScalar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1514,7 +2388,7 @@ func (m *InterestScalar) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scalar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1542,9 +2416,7 @@ func (m *InterestScalar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scalar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
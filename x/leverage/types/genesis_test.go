@@ -24,7 +24,7 @@ func TestGenesisValidation(t *testing.T) {
 			*NewGenesisState(
 				Params{
 					CompleteLiquidationThreshold: sdk.MustNewDecFromStr("-0.4"),
-				}, nil, nil, nil, nil, 0, nil, nil, nil, nil,
+				}, nil, nil, nil, nil, 0, nil, nil, nil, nil, nil, nil, nil, nil,
 			),
 			true,
 			"complete liquidation threshold must be positive",
@@ -141,6 +141,61 @@ func TestGenesisValidation(t *testing.T) {
 			true,
 			"exchange rate less than one",
 		},
+		{
+			"invalid referral address",
+			GenesisState{
+				Params: DefaultParams(),
+				Referrals: []Referral{
+					NewReferral("", testAddr),
+				},
+			},
+			true,
+			"empty address string is not allowed",
+		},
+		{
+			"self referral",
+			GenesisState{
+				Params: DefaultParams(),
+				Referrals: []Referral{
+					NewReferral(testAddr, testAddr),
+				},
+			},
+			true,
+			"account cannot be its own referrer",
+		},
+		{
+			"invalid referral rewards",
+			GenesisState{
+				Params: DefaultParams(),
+				ReferralRewards: []ReferralReward{
+					NewReferralReward(testAddr, sdk.Coins{sdk.Coin{Denom: ""}}),
+				},
+			},
+			true,
+			"invalid denom",
+		},
+		{
+			"invalid allow list address",
+			GenesisState{
+				Params: DefaultParams(),
+				AllowLists: []AllowList{
+					NewAllowList("uumee", []string{"invalid"}),
+				},
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"invalid deleverage order",
+			GenesisState{
+				Params: DefaultParams(),
+				DeleverageOrders: []DeleverageOrder{
+					NewDeleverageOrder(testAddr, "uumee", sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.95")),
+				},
+			},
+			true,
+			"target ratio must be positive and less than trigger ratio",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/umee-network/umee/v6/util"
@@ -27,6 +29,15 @@ var (
 	KeyPrefixUtokenSupply        = []byte{0x0A}
 	KeyPrefixSpecialAssetPair    = []byte{0x0B}
 	KeyParams                    = []byte{0x0C}
+	KeyPrefixReferrer            = []byte{0x0D}
+	KeyPrefixReferralReward      = []byte{0x0E}
+	KeyPrefixTotalReferralReward = []byte{0x0F}
+	KeyPrefixAllowList           = []byte{0x10}
+	KeyPrefixDeleverageOrder     = []byte{0x11}
+	KeyDeleverageCursor          = []byte{0x12}
+	KeyPrefixReferralIndex       = []byte{0x13}
+	KeyPrefixReferralSnapshot    = []byte{0x14}
+	KeyPrefixReferral            = []byte{0x15}
 )
 
 // KeyRegisteredToken returns a KVStore key for getting and setting a Token.
@@ -108,6 +119,84 @@ func KeyUTokenSupply(uTokenDenom string) []byte {
 	return util.ConcatBytes(1, KeyPrefixUtokenSupply, []byte(uTokenDenom))
 }
 
+// KeyReferrer returns a KVStore key for getting and setting the referrer of an account.
+func KeyReferrer(addr sdk.AccAddress) []byte {
+	// referrerprefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixReferrer, address.MustLengthPrefix(addr))
+}
+
+// KeyReferralReward returns a KVStore key for getting and setting the unclaimed referral
+// rewards of a referrer in a given denom.
+func KeyReferralReward(referrer sdk.AccAddress, tokenDenom string) []byte {
+	// referralrewardprefix | lengthprefixed(referrer) | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyReferralRewardNoDenom(referrer), []byte(tokenDenom))
+}
+
+// KeyReferralRewardNoDenom returns the common prefix used by all referral rewards
+// associated with a given referrer.
+func KeyReferralRewardNoDenom(referrer sdk.AccAddress) []byte {
+	// referralrewardprefix | lengthprefixed(referrer)
+	return util.ConcatBytes(0, KeyPrefixReferralReward, address.MustLengthPrefix(referrer))
+}
+
+// KeyTotalReferralReward returns a KVStore key for getting and setting the total unclaimed
+// referral rewards of a given token.
+func KeyTotalReferralReward(tokenDenom string) []byte {
+	// totalreferralrewardprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixTotalReferralReward, []byte(tokenDenom))
+}
+
+// KeyReferralIndex returns a KVStore key for getting and setting the cumulative referral reward
+// accrued per unit of adjusted borrow of a given token.
+func KeyReferralIndex(tokenDenom string) []byte {
+	// referralindexprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixReferralIndex, []byte(tokenDenom))
+}
+
+// KeyReferralSnapshot returns a KVStore key for getting and setting the referral index of a given
+// token at the last settlement of a referred account's referral rewards.
+func KeyReferralSnapshot(addr sdk.AccAddress, tokenDenom string) []byte {
+	// referralsnapshotprefix | lengthprefixed(addr) | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixReferralSnapshot, address.MustLengthPrefix(addr), []byte(tokenDenom))
+}
+
+// KeyReferral returns a KVStore key for tracking an account referred by a referrer.
+func KeyReferral(referrer, addr sdk.AccAddress) []byte {
+	// referralprefix | lengthprefixed(referrer) | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyReferralNoAddress(referrer), address.MustLengthPrefix(addr))
+}
+
+// KeyReferralNoAddress returns the common prefix used by all accounts referred by a referrer.
+func KeyReferralNoAddress(referrer sdk.AccAddress) []byte {
+	// referralprefix | lengthprefixed(referrer)
+	return util.ConcatBytes(0, KeyPrefixReferral, address.MustLengthPrefix(referrer))
+}
+
+// KeyAllowListed returns a KVStore key for tracking an account allowed to use a permissioned Token.
+func KeyAllowListed(tokenDenom string, addr sdk.AccAddress) []byte {
+	// allowlistprefix | denom | 0x00 | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyAllowListNoAddress(tokenDenom), address.MustLengthPrefix(addr))
+}
+
+// KeyAllowListNoAddress returns the common prefix used by all allow-listed accounts of a given Token.
+func KeyAllowListNoAddress(tokenDenom string) []byte {
+	// allowlistprefix | denom | 0x00
+	return util.ConcatBytes(1, KeyPrefixAllowList, []byte(tokenDenom))
+}
+
+// KeyDeleverageOrder returns a KVStore key for getting and setting the deleverage order of a
+// borrower for a given token.
+func KeyDeleverageOrder(borrower sdk.AccAddress, tokenDenom string) []byte {
+	// deleverageprefix | lengthprefixed(borrower) | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyDeleverageOrderNoDenom(borrower), []byte(tokenDenom))
+}
+
+// KeyDeleverageOrderNoDenom returns the common prefix used by all deleverage orders of a borrower.
+func KeyDeleverageOrderNoDenom(borrower sdk.AccAddress) []byte {
+	// deleverageprefix | lengthprefixed(borrower)
+	return util.ConcatBytes(0, KeyPrefixDeleverageOrder, address.MustLengthPrefix(borrower))
+}
+
 // AddressFromKey extracts address from a key with the form
 // prefix | lengthPrefixed(addr) | ...
 func AddressFromKey(key, prefix []byte) sdk.AccAddress {
@@ -122,6 +211,14 @@ func DenomFromKeyWithAddress(key, prefix []byte) string {
 	return string(key[len(prefix)+addrLength+1 : len(key)-1])
 }
 
+// DenomAndAddressFromKey extracts denom and address from a key with the form
+// prefix | denom | 0x00 | lengthPrefixed(addr)
+func DenomAndAddressFromKey(key, prefix []byte) (string, sdk.AccAddress) {
+	denomLength := bytes.IndexByte(key[len(prefix):], 0x00)
+	denom := string(key[len(prefix) : len(prefix)+denomLength])
+	return denom, AddressFromKey(key, key[:len(prefix)+denomLength+1])
+}
+
 // DenomFromKey extracts denom from a key with the form
 // prefix | denom | 0x00
 func DenomFromKey(key, prefix []byte) string {
@@ -54,6 +54,16 @@ func TestDenomFromKey(t *testing.T) {
 	assert.Equal(t, uDenom, expectedDenom)
 }
 
+func TestDenomAndAddressFromKey(t *testing.T) {
+	address := sdk.AccAddress([]byte("addr________________"))
+	denom := "ibc/abcd"
+	key := types.KeyAllowListed(denom, address)
+	expectedDenom, expectedAddress := types.DenomAndAddressFromKey(key, types.KeyPrefixAllowList)
+
+	assert.Equal(t, denom, expectedDenom)
+	assert.DeepEqual(t, address, expectedAddress)
+}
+
 func TestGetKeys(t *testing.T) {
 	type testCase struct {
 		actual      []byte
@@ -184,6 +194,28 @@ func TestGetKeys(t *testing.T) {
 			},
 			"uToken supply key",
 		},
+		{
+			types.KeyAllowListed("ibc/abcd", addr),
+			[][]byte{
+				{0x10},       // prefix
+				ibcabcdbytes, // ibc/abcd
+				{0x00},       // null terminator
+				{0x14},       // address length prefix = 20
+				addrbytes,    // addr________________
+			},
+			"allow list key",
+		},
+		{
+			types.KeyDeleverageOrder(addr, "ibc/abcd"),
+			[][]byte{
+				{0x11},       // prefix
+				{0x14},       // address length prefix = 20
+				addrbytes,    // addr________________
+				ibcabcdbytes, // ibc/abcd
+				{0x00},       // null terminator
+			},
+			"deleverage order key",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
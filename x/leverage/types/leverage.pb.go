@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -41,15 +42,14 @@ type Params struct {
 	// reaching its maximum when borrowed value passes
 	// complete_liquidation_threshold. We can put it into the picture:
 	//
-	//	           borrowed          CV := collateral
-	//	           value                   value
-	//	--- | ------- | ----- | -------- | ------->
-	//	   LV                 CL
+	//             borrowed          CV := collateral
+	//             value                   value
+	//  --- | ------- | ----- | -------- | ------->
+	//     LV                 CL
 	//
 	// LV = liquidation value = liquidation_threshold * CV
 	// CL = LV + (CV-LV) * complete_liquidation_threshold
-	//
-	//	is the borrowed value above which close factor will be 1.
+	//    is the borrowed value above which close factor will be 1.
 	//
 	// Valid values: 0-1.
 	MinimumCloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=minimum_close_factor,json=minimumCloseFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_close_factor" yaml:"minimum_close_factor"`
@@ -71,6 +71,21 @@ type Params struct {
 	// So, 2% means, that there is additional 2% per year fee collected.
 	// Valid values: 0-1.
 	RewardsAuctionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=rewards_auction_fee,json=rewardsAuctionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewards_auction_fee" yaml:"oracle_reward_factor"`
+	// Referral Reward Factor determines the portion of reserves, generated by interest
+	// accrued on borrows of accounts with a referrer, which is credited to the referrer
+	// instead of being added to reserves. Referral rewards are collected using
+	// MsgClaimReferralRewards.
+	// Valid values: 0-1.
+	ReferralRewardFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=referral_reward_factor,json=referralRewardFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"referral_reward_factor" yaml:"referral_reward_factor"`
+	// Deleverage Fee is charged on the amount repaid when a DeleverageOrder is executed.
+	// It is paid from the borrower's collateral and added to reserves.
+	// Valid values: 0-0.1.
+	DeleverageFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=deleverage_fee,json=deleverageFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deleverage_fee" yaml:"deleverage_fee"`
+	// Max Deleverage Orders Per Block is the maximum number of DeleverageOrders evaluated
+	// during each EndBlock. Orders are evaluated in a round-robin fashion, so every order is
+	// eventually visited. Zero disables the execution of deleverage orders.
+	// Valid values: 0-500.
+	MaxDeleverageOrdersPerBlock uint32 `protobuf:"varint,10,opt,name=max_deleverage_orders_per_block,json=maxDeleverageOrdersPerBlock,proto3" json:"max_deleverage_orders_per_block,omitempty" yaml:"max_deleverage_orders_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -182,15 +197,11 @@ type Token struct {
 	// Max Supply Utilization specifies the maximum supply utilization a token is
 	// allowed to reach as a direct result of user borrowing. New borrows are not allowed when
 	// the supply utilization is above `max_supply_utilization`.
-	//
-	//	supply_utilization(token) = total_borrowed(token) / total_supply(token)
-	//
+	//    supply_utilization(token) = total_borrowed(token) / total_supply(token)
 	// Valid values: 0-1.
 	MaxSupplyUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_supply_utilization,json=maxSupplyUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_supply_utilization" yaml:"max_supply_utilization"`
 	// Min Collateral Liquidity specifies min limit for the following function:
-	//
-	//	collateral_liquidity(token) = available(token) / total_collateral(token)
-	//
+	//    collateral_liquidity(token) = available(token) / total_collateral(token)
 	// Borrowing, collateralizing, or withdrawing assets is not allowed when the
 	// result of such action invalidates min_collateral_liquidity.
 	// Liquidity can only drop below this value due to interest or liquidations.
@@ -211,9 +222,13 @@ type Token struct {
 	// zero for this field causes current price to be used in those calculations
 	// for the affected Token.
 	// The time span covered by the historic median will be:
-	//
-	//	oracle.Params.median_stamp_period * oracle.Params.historic_stamp_period * historic_medians.
+	//     oracle.Params.median_stamp_period * oracle.Params.historic_stamp_period * historic_medians.
 	HistoricMedians uint32 `protobuf:"varint,19,opt,name=historic_medians,json=historicMedians,proto3" json:"historic_medians,omitempty" yaml:"historic_medians"`
+	// Allow List Admin, when set, makes the Token a permissioned market: only accounts in the
+	// Token's allow list can supply, collateralize or borrow it. The admin (for example an x/group
+	// policy account) manages the allow list using MsgUpdateAllowList. Withdrawals, repayments and
+	// liquidations remain permissionless. Empty value means the market is open to everyone.
+	AllowListAdmin string `protobuf:"bytes,20,opt,name=allow_list_admin,json=allowListAdmin,proto3" json:"allow_list_admin,omitempty" yaml:"allow_list_admin"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -349,82 +364,147 @@ func (m *SpecialAssetSet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SpecialAssetSet proto.InternalMessageInfo
 
+// DeleverageOrder is a borrower's standing instruction to repay part of a borrow using its
+// collateral, before its position can be liquidated. When the ratio of the borrower's borrowed
+// value to its liquidation threshold reaches trigger_ratio, the order burns u/denom collateral
+// to repay the denom borrow until the ratio is back at target_ratio.
+type DeleverageOrder struct {
+	// Borrower is the account whose position is protected.
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// Denom is the base denom of the borrow to repay.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Trigger Ratio of borrowed value to liquidation threshold at which the order executes.
+	// Valid values: 0-1, exclusive.
+	TriggerRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=trigger_ratio,json=triggerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_ratio"`
+	// Target Ratio of borrowed value to liquidation threshold after the order executes.
+	// Valid values: 0 to trigger_ratio, exclusive.
+	TargetRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=target_ratio,json=targetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_ratio"`
+}
+
+func (m *DeleverageOrder) Reset()         { *m = DeleverageOrder{} }
+func (m *DeleverageOrder) String() string { return proto.CompactTextString(m) }
+func (*DeleverageOrder) ProtoMessage()    {}
+func (*DeleverageOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb1bf9ea641ecc6, []int{4}
+}
+func (m *DeleverageOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleverageOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleverageOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleverageOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleverageOrder.Merge(m, src)
+}
+func (m *DeleverageOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleverageOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleverageOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleverageOrder proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "umee.leverage.v1.Params")
 	proto.RegisterType((*Token)(nil), "umee.leverage.v1.Token")
 	proto.RegisterType((*SpecialAssetPair)(nil), "umee.leverage.v1.SpecialAssetPair")
 	proto.RegisterType((*SpecialAssetSet)(nil), "umee.leverage.v1.SpecialAssetSet")
+	proto.RegisterType((*DeleverageOrder)(nil), "umee.leverage.v1.DeleverageOrder")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/leverage.proto", fileDescriptor_8cb1bf9ea641ecc6) }
 
 var fileDescriptor_8cb1bf9ea641ecc6 = []byte{
-	// 1039 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x26, 0xad, 0xbf, 0xc9, 0xb4, 0x89, 0x9d, 0xcd, 0x8f, 0xae, 0xbe, 0x0d, 0x76, 0x34,
-	0x12, 0x28, 0x97, 0xc6, 0x54, 0x20, 0x0e, 0xb9, 0xd5, 0xa9, 0x42, 0x83, 0x9a, 0x52, 0xc6, 0x45,
-	0x95, 0xe0, 0xb0, 0x1a, 0xaf, 0x5f, 0xed, 0x91, 0x67, 0x77, 0xcc, 0xce, 0xf8, 0x57, 0x24, 0xc4,
-	0x01, 0x71, 0xe2, 0x82, 0xb8, 0x23, 0xf1, 0x9f, 0x70, 0xcd, 0xb1, 0x47, 0xc4, 0xc1, 0x82, 0xe4,
-	0xc2, 0x81, 0x0b, 0xf9, 0x0b, 0xd0, 0xce, 0xac, 0xbd, 0xeb, 0x74, 0x1b, 0x69, 0xe5, 0xf4, 0x94,
-	0xdd, 0xcf, 0x1b, 0x7f, 0x3e, 0x9f, 0x37, 0xf3, 0xde, 0xbc, 0x2c, 0xaa, 0xf4, 0x7c, 0x80, 0x2a,
-	0x87, 0x3e, 0x84, 0xb4, 0x05, 0xd5, 0xfe, 0xc3, 0xe9, 0xf3, 0x7e, 0x37, 0x14, 0x4a, 0xd8, 0xa5,
-	0x68, 0xc1, 0xfe, 0x14, 0xec, 0x3f, 0xfc, 0xff, 0x66, 0x4b, 0xb4, 0x84, 0x0e, 0x56, 0xa3, 0x27,
-	0xb3, 0x0e, 0xff, 0x56, 0x40, 0x85, 0xe7, 0x34, 0xa4, 0xbe, 0xb4, 0x7f, 0xb1, 0x50, 0xd9, 0x13,
-	0x7e, 0x97, 0x83, 0x02, 0x97, 0xb3, 0x6f, 0x7a, 0xac, 0x49, 0x15, 0x13, 0x81, 0xab, 0xda, 0x21,
-	0xc8, 0xb6, 0xe0, 0x4d, 0x67, 0x71, 0xd7, 0xda, 0x5b, 0xa9, 0xbd, 0x3c, 0x1b, 0x57, 0x16, 0xfe,
-	0x18, 0x57, 0x3e, 0x68, 0x31, 0xd5, 0xee, 0x35, 0xf6, 0x3d, 0xe1, 0x57, 0x3d, 0x21, 0x7d, 0x21,
-	0xe3, 0x3f, 0x0f, 0x64, 0xb3, 0x53, 0x55, 0xa3, 0x2e, 0xc8, 0xfd, 0xc7, 0xe0, 0x5d, 0x8e, 0x2b,
-	0xef, 0x8f, 0xa8, 0xcf, 0x0f, 0xf0, 0xf5, 0xec, 0x98, 0xec, 0x4c, 0x16, 0x3c, 0x4d, 0xe2, 0x2f,
-	0x26, 0x61, 0xfb, 0x3b, 0xb4, 0xe9, 0xb3, 0x80, 0xf9, 0x3d, 0xdf, 0xf5, 0xb8, 0x90, 0xe0, 0xbe,
-	0xa2, 0x9e, 0x12, 0xa1, 0xb3, 0xa4, 0x4d, 0x9d, 0xe4, 0x36, 0x75, 0xdf, 0x98, 0xca, 0xe2, 0xc4,
-	0xc4, 0x8e, 0xe1, 0xc3, 0x08, 0x3d, 0xd2, 0x60, 0x64, 0x40, 0x84, 0xd4, 0xe3, 0xe0, 0x86, 0x30,
-	0xa0, 0x61, 0x73, 0x62, 0xe0, 0xd6, 0x7c, 0x06, 0xb2, 0x38, 0x31, 0xb1, 0x0d, 0x4c, 0x34, 0x1a,
-	0x1b, 0xf8, 0xc1, 0x42, 0xdb, 0xd2, 0xa7, 0x9c, 0xcf, 0x6c, 0xa0, 0x64, 0xa7, 0xe0, 0xdc, 0xd6,
-	0x1e, 0x3e, 0xcf, 0xed, 0xe1, 0x3d, 0xe3, 0x21, 0x9b, 0x15, 0x93, 0x4d, 0x1d, 0x48, 0x1d, 0x47,
-	0x9d, 0x9d, 0x82, 0xf6, 0xd1, 0x64, 0x21, 0x78, 0x6a, 0xe6, 0x27, 0xaf, 0x00, 0x9c, 0xc2, 0x7c,
-	0x3e, 0xb2, 0x59, 0x31, 0xd9, 0x34, 0x81, 0x94, 0x91, 0x23, 0x00, 0xfb, 0x5b, 0xb4, 0x61, 0x76,
-	0x4d, 0xba, 0xb4, 0xe7, 0x4d, 0x3d, 0xfc, 0xef, 0x5d, 0x9c, 0xc7, 0x7a, 0xac, 0xf4, 0xc8, 0x08,
-	0x1d, 0x01, 0x1c, 0xdc, 0xfa, 0xfb, 0xd7, 0x8a, 0x85, 0xff, 0x59, 0x43, 0xb7, 0x5f, 0x88, 0x0e,
-	0x04, 0xf6, 0xc7, 0x08, 0x35, 0xa8, 0x04, 0xb7, 0x09, 0x81, 0xf0, 0x1d, 0x4b, 0xbb, 0xd8, 0xba,
-	0x1c, 0x57, 0xd6, 0x0d, 0x6f, 0x12, 0xc3, 0x64, 0x25, 0x7a, 0x79, 0x1c, 0x3d, 0xdb, 0x01, 0x5a,
-	0x0b, 0x41, 0x42, 0xd8, 0x9f, 0x16, 0xb4, 0xe9, 0xb2, 0x4f, 0x73, 0xfb, 0xdf, 0x32, 0x3a, 0xb3,
-	0x6c, 0x98, 0xac, 0xc6, 0x40, 0x5c, 0x44, 0x03, 0xb4, 0xee, 0x09, 0xce, 0xa9, 0x82, 0x90, 0x72,
-	0x77, 0x00, 0xac, 0xd5, 0x56, 0x71, 0x0f, 0x7d, 0x96, 0x5b, 0xd2, 0x99, 0x34, 0xf6, 0x15, 0x42,
-	0x4c, 0x4a, 0x09, 0xf6, 0x52, 0x43, 0xf6, 0xf7, 0x16, 0xda, 0xca, 0xbe, 0x56, 0x4c, 0x03, 0x3d,
-	0xcb, 0xad, 0xbe, 0x63, 0xd4, 0xdf, 0x72, 0x9b, 0x6c, 0xf2, 0xac, 0x5b, 0x44, 0xa2, 0x92, 0x3e,
-	0x88, 0x86, 0x08, 0x43, 0x31, 0x70, 0x43, 0xaa, 0x26, 0xcd, 0x73, 0x9c, 0x5b, 0xff, 0x5e, 0xea,
-	0x60, 0x53, 0x7c, 0x98, 0xac, 0x45, 0x50, 0x4d, 0x23, 0x84, 0x2a, 0x88, 0x44, 0x3b, 0x2c, 0xe8,
-	0xcc, 0x88, 0x16, 0xe6, 0x13, 0xbd, 0xca, 0x87, 0xc9, 0x5a, 0x04, 0xa5, 0x44, 0xbb, 0xa8, 0xe8,
-	0xd3, 0xe1, 0x8c, 0xa6, 0xe9, 0x8c, 0x27, 0xb9, 0x35, 0xb7, 0xe3, 0xab, 0x72, 0x96, 0x0e, 0x93,
-	0x55, 0x9f, 0x0e, 0x53, 0x8a, 0x2a, 0x4e, 0xb3, 0xa7, 0x18, 0x67, 0xa7, 0x7a, 0xe3, 0x9d, 0xe5,
-	0x1b, 0x48, 0x33, 0xc5, 0x87, 0x49, 0x31, 0x82, 0xbe, 0x4c, 0x90, 0x37, 0xea, 0x8a, 0x05, 0x1e,
-	0x04, 0x8a, 0xf5, 0xc1, 0x59, 0xb9, 0xb9, 0xba, 0x9a, 0x92, 0xce, 0xd6, 0xd5, 0xf1, 0x04, 0xb6,
-	0x0f, 0xd0, 0x5d, 0x39, 0xf2, 0x1b, 0x82, 0xc7, 0xed, 0x8f, 0xb4, 0xf6, 0xbd, 0xcb, 0x71, 0x65,
-	0x23, 0xbe, 0x62, 0x53, 0x51, 0x4c, 0xee, 0x98, 0x57, 0x73, 0x05, 0x54, 0xd1, 0x32, 0x0c, 0xbb,
-	0x22, 0x80, 0x40, 0x39, 0x77, 0x76, 0xad, 0xbd, 0xd5, 0xda, 0xc6, 0xe5, 0xb8, 0x52, 0x34, 0xbf,
-	0x9b, 0x44, 0x30, 0x99, 0x2e, 0xb2, 0x9f, 0xa0, 0x75, 0x08, 0x68, 0x83, 0x83, 0xeb, 0xcb, 0x96,
-	0x2b, 0x7b, 0xdd, 0x2e, 0x1f, 0x39, 0x77, 0x77, 0xad, 0xbd, 0xe5, 0xda, 0x4e, 0xd2, 0x95, 0x6f,
-	0x2c, 0xc1, 0xa4, 0x68, 0xb0, 0x13, 0xd9, 0xaa, 0x6b, 0xe4, 0x0a, 0x93, 0x39, 0x5c, 0x67, 0xf5,
-	0x1a, 0x26, 0xb3, 0x24, 0xcd, 0x64, 0x0a, 0xc0, 0xde, 0x41, 0x2b, 0x0d, 0x4e, 0xbd, 0x0e, 0x67,
-	0x52, 0x39, 0x6b, 0x11, 0x03, 0x49, 0x00, 0x3d, 0xbc, 0xe9, 0xd0, 0x4d, 0x5d, 0x14, 0xb2, 0x4d,
-	0x43, 0x70, 0x8a, 0x73, 0x0e, 0xef, 0x0c, 0xce, 0x68, 0x78, 0xd3, 0xe1, 0xe1, 0x14, 0xad, 0x47,
-	0xa0, 0x9e, 0x59, 0xd1, 0x6a, 0xb3, 0x13, 0x33, 0x25, 0x5a, 0x9a, 0x6f, 0x66, 0x65, 0xb3, 0x62,
-	0x12, 0x25, 0x6c, 0x76, 0x39, 0x5d, 0xad, 0x3f, 0x5a, 0xc8, 0xf1, 0x59, 0x90, 0x76, 0x6d, 0xea,
-	0x89, 0xa9, 0x91, 0xb3, 0xae, 0x9d, 0x7c, 0x91, 0xdb, 0x49, 0x65, 0xfa, 0xaf, 0x4c, 0x26, 0x2f,
-	0x26, 0xdb, 0x3e, 0x0b, 0x92, 0x1d, 0x79, 0x3a, 0x09, 0xd8, 0x0d, 0x84, 0x12, 0xfb, 0x8e, 0xad,
-	0xe5, 0x0f, 0x73, 0xc8, 0x1f, 0x07, 0x2a, 0x19, 0x70, 0x09, 0x13, 0x26, 0x2b, 0xd3, 0xe4, 0xed,
-	0x23, 0x54, 0x6a, 0x33, 0xa9, 0x44, 0xc8, 0x3c, 0xd7, 0x87, 0x26, 0xa3, 0x81, 0x74, 0x36, 0x74,
-	0x95, 0xdf, 0x4f, 0xfa, 0xfc, 0xea, 0x0a, 0x4c, 0x8a, 0x13, 0xe8, 0xc4, 0x20, 0xf1, 0xb8, 0xfd,
-	0x79, 0x11, 0x95, 0xea, 0x5d, 0xf0, 0x18, 0xe5, 0x8f, 0xa4, 0x04, 0xf5, 0x9c, 0xb2, 0xd0, 0x2e,
-	0x23, 0x94, 0xe4, 0x6d, 0x26, 0x2f, 0x49, 0x21, 0xf6, 0x36, 0x2a, 0xc4, 0xa5, 0xad, 0x67, 0x2b,
-	0x89, 0xdf, 0xec, 0xaf, 0xdf, 0x3e, 0x0b, 0xf7, 0xf3, 0x1d, 0x42, 0xc6, 0xbc, 0xf3, 0xae, 0x1f,
-	0x77, 0x79, 0x05, 0x32, 0xc7, 0x59, 0xbc, 0x29, 0xff, 0x5a, 0xa8, 0x98, 0xde, 0x94, 0x3a, 0xa8,
-	0x28, 0x67, 0x1a, 0x3d, 0x4b, 0xc7, 0xda, 0x5d, 0x8a, 0x72, 0x36, 0x6f, 0xd9, 0x39, 0x2f, 0xbe,
-	0xeb, 0x9c, 0x97, 0x6e, 0x3a, 0xe7, 0xda, 0xb3, 0xb3, 0xbf, 0xca, 0x0b, 0x67, 0xe7, 0x65, 0xeb,
-	0xf5, 0x79, 0xd9, 0xfa, 0xf3, 0xbc, 0x6c, 0xfd, 0x74, 0x51, 0x5e, 0x78, 0x7d, 0x51, 0x5e, 0xf8,
-	0xfd, 0xa2, 0xbc, 0xf0, 0xd5, 0x87, 0x29, 0x85, 0xe8, 0x53, 0xe8, 0x41, 0x00, 0x6a, 0x20, 0xc2,
-	0x8e, 0x7e, 0xa9, 0xf6, 0x3f, 0xa9, 0x0e, 0x93, 0xaf, 0x27, 0xad, 0xd7, 0x28, 0xe8, 0x0f, 0xa2,
-	0x8f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x07, 0xe0, 0xb9, 0xa9, 0x5b, 0x0d, 0x00, 0x00,
+	// 1263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0x06, 0x92, 0x26, 0x43, 0x12, 0x3b, 0x1b, 0x13, 0xb6, 0x90, 0xda, 0xd1, 0x48, 0x45,
+	0xa8, 0x12, 0x71, 0x11, 0xa8, 0x07, 0x6e, 0x09, 0x88, 0x02, 0xe2, 0xe7, 0x98, 0x0a, 0xa9, 0x55,
+	0xb5, 0x1a, 0xaf, 0x1f, 0xce, 0xc8, 0xbb, 0x3b, 0xee, 0xcc, 0x38, 0x76, 0x90, 0xaa, 0x1e, 0xaa,
+	0x9e, 0x7a, 0xa9, 0x7a, 0x47, 0xea, 0xb5, 0xf7, 0xfe, 0x11, 0x48, 0xbd, 0xa0, 0x9e, 0xaa, 0x1e,
+	0xac, 0x16, 0x2e, 0xbd, 0x36, 0x7f, 0x41, 0x35, 0x33, 0xbb, 0xde, 0xb5, 0x59, 0x22, 0x19, 0xc3,
+	0x29, 0x3b, 0xdf, 0xbc, 0xfd, 0xbe, 0xf7, 0xde, 0xbc, 0x7d, 0x6f, 0x62, 0x54, 0xeb, 0x45, 0x00,
+	0xf5, 0x10, 0x0e, 0x40, 0xd0, 0x36, 0xd4, 0x0f, 0x2e, 0x8d, 0x9e, 0x77, 0xba, 0x82, 0x2b, 0xee,
+	0x96, 0xb5, 0xc1, 0xce, 0x08, 0x3c, 0xb8, 0x74, 0xf6, 0xc3, 0x80, 0xcb, 0x88, 0x4b, 0xdf, 0xec,
+	0xd7, 0xed, 0xc2, 0x1a, 0x9f, 0xad, 0xb4, 0x79, 0x9b, 0x5b, 0x5c, 0x3f, 0x59, 0x14, 0xff, 0xba,
+	0x8c, 0x16, 0x1f, 0x50, 0x41, 0x23, 0xe9, 0x3e, 0x73, 0x50, 0x35, 0xe0, 0x51, 0x37, 0x04, 0x05,
+	0x7e, 0xc8, 0xbe, 0xe9, 0xb1, 0x16, 0x55, 0x8c, 0xc7, 0xbe, 0xda, 0x17, 0x20, 0xf7, 0x79, 0xd8,
+	0xf2, 0xe6, 0xb7, 0x9d, 0x0b, 0xcb, 0x7b, 0x8f, 0x9f, 0x0f, 0x6b, 0x73, 0x7f, 0x0d, 0x6b, 0xe7,
+	0xdb, 0x4c, 0xed, 0xf7, 0x9a, 0x3b, 0x01, 0x8f, 0x12, 0xa9, 0xe4, 0xcf, 0x45, 0xd9, 0xea, 0xd4,
+	0xd5, 0x61, 0x17, 0xe4, 0xce, 0x75, 0x08, 0x8e, 0x86, 0xb5, 0x8f, 0x0f, 0x69, 0x14, 0x5e, 0xc5,
+	0xc7, 0xb3, 0x63, 0xb2, 0x95, 0x1a, 0xdc, 0xc9, 0xf6, 0x1f, 0xa5, 0xdb, 0xee, 0x77, 0xa8, 0x12,
+	0xb1, 0x98, 0x45, 0xbd, 0xc8, 0x0f, 0x42, 0x2e, 0xc1, 0x7f, 0x42, 0x03, 0xc5, 0x85, 0x77, 0xc2,
+	0x38, 0x75, 0x77, 0x6a, 0xa7, 0xce, 0x59, 0xa7, 0x8a, 0x38, 0x31, 0x71, 0x13, 0xf8, 0x9a, 0x46,
+	0x6f, 0x18, 0x50, 0x3b, 0xc0, 0x05, 0x0d, 0x42, 0xf0, 0x05, 0xf4, 0xa9, 0x68, 0xa5, 0x0e, 0x9c,
+	0x9c, 0xcd, 0x81, 0x22, 0x4e, 0x4c, 0x5c, 0x0b, 0x13, 0x83, 0x26, 0x0e, 0xfc, 0xe0, 0xa0, 0x4d,
+	0x19, 0xd1, 0x30, 0x1c, 0x4b, 0xa0, 0x64, 0x4f, 0xc1, 0x5b, 0x30, 0x3e, 0xdc, 0x9f, 0xda, 0x87,
+	0x8f, 0xac, 0x0f, 0xc5, 0xac, 0x98, 0x54, 0xcc, 0x46, 0xee, 0x38, 0x1a, 0xec, 0x29, 0x18, 0x3f,
+	0x5a, 0x4c, 0x40, 0xa0, 0xc6, 0x5e, 0x79, 0x02, 0xe0, 0x2d, 0xce, 0xe6, 0x47, 0x31, 0x2b, 0x26,
+	0x15, 0xbb, 0x91, 0x73, 0xe4, 0x06, 0x80, 0xfb, 0x2d, 0xda, 0xb0, 0x59, 0x93, 0x3e, 0xed, 0x05,
+	0x23, 0x1f, 0x3e, 0x78, 0x1f, 0xe7, 0xb1, 0x9e, 0x28, 0xed, 0xf6, 0x82, 0x54, 0x5e, 0xa7, 0x41,
+	0xc0, 0x13, 0x10, 0x82, 0x86, 0x13, 0x25, 0xb1, 0x34, 0x5b, 0x1a, 0x8a, 0x59, 0x31, 0xa9, 0xa4,
+	0x1b, 0x63, 0x65, 0x11, 0xa3, 0xb5, 0x16, 0xa4, 0x5d, 0xc0, 0x64, 0x60, 0xd9, 0xc8, 0x7f, 0x3e,
+	0xb5, 0xfc, 0xe9, 0xe4, 0x14, 0xc6, 0xd8, 0x30, 0x59, 0xcd, 0x00, 0x1d, 0x77, 0x17, 0xd5, 0x22,
+	0x3a, 0xf0, 0x73, 0x56, 0x5c, 0xb4, 0x40, 0x48, 0xbf, 0x0b, 0xc2, 0x6f, 0x86, 0x3c, 0xe8, 0x78,
+	0x68, 0xdb, 0xb9, 0xb0, 0xba, 0xf7, 0xc9, 0xd1, 0xb0, 0x76, 0x3e, 0xf9, 0xca, 0x8e, 0x7f, 0x01,
+	0x93, 0x73, 0x11, 0x1d, 0x5c, 0x1f, 0x19, 0xdc, 0x37, 0xfb, 0x0f, 0x40, 0xec, 0xe9, 0xdd, 0xab,
+	0x27, 0xff, 0xfd, 0xa5, 0xe6, 0xe0, 0xdf, 0x4b, 0x68, 0xe1, 0x11, 0xef, 0x40, 0xec, 0x5e, 0x41,
+	0xa8, 0x49, 0x25, 0xf8, 0x2d, 0x88, 0x79, 0xe4, 0x39, 0x26, 0xda, 0xd3, 0x47, 0xc3, 0xda, 0xba,
+	0x15, 0xcb, 0xf6, 0x30, 0x59, 0xd6, 0x8b, 0xeb, 0xfa, 0x59, 0xe7, 0x49, 0x80, 0x04, 0x71, 0x30,
+	0x6a, 0x1d, 0xf3, 0xb3, 0xe5, 0x69, 0x9c, 0x0d, 0x93, 0xd5, 0x04, 0x48, 0xce, 0xa5, 0x8f, 0xd6,
+	0x03, 0x1e, 0x86, 0x54, 0x81, 0x3e, 0xca, 0x3e, 0xb0, 0xf6, 0xbe, 0x4a, 0xba, 0xd5, 0xed, 0xa9,
+	0x25, 0xbd, 0xb4, 0x85, 0x4e, 0x10, 0x62, 0x52, 0xce, 0xb0, 0xc7, 0x06, 0x72, 0xbf, 0x77, 0xd0,
+	0xe9, 0xe2, 0x06, 0x6e, 0x5b, 0xd5, 0xbd, 0xa9, 0xd5, 0xb7, 0xac, 0xfa, 0x1b, 0xfa, 0x76, 0x25,
+	0x2c, 0xea, 0xd7, 0x12, 0x95, 0xcd, 0x41, 0x34, 0xb9, 0x10, 0xbc, 0xef, 0x0b, 0xaa, 0xd2, 0x36,
+	0x75, 0x6b, 0x6a, 0xfd, 0x33, 0xb9, 0x83, 0xcd, 0xf1, 0x61, 0xb2, 0xa6, 0xa1, 0x3d, 0x83, 0x10,
+	0xaa, 0x40, 0x8b, 0x76, 0x58, 0xdc, 0x19, 0x13, 0x5d, 0x9c, 0x4d, 0x74, 0x92, 0x0f, 0x93, 0x35,
+	0x0d, 0xe5, 0x44, 0xbb, 0xa8, 0xa4, 0xeb, 0x3b, 0xaf, 0x69, 0x7b, 0xd0, 0xcd, 0xa9, 0x35, 0x37,
+	0xb3, 0xcf, 0x65, 0x4c, 0x72, 0x35, 0xa2, 0x83, 0x9c, 0xa2, 0x4a, 0xc2, 0xec, 0x29, 0x16, 0xb2,
+	0xa7, 0x26, 0xf1, 0xde, 0xd2, 0x3b, 0x08, 0x33, 0xc7, 0x87, 0x49, 0x49, 0x43, 0x5f, 0x64, 0xc8,
+	0x6b, 0x75, 0xc5, 0xe2, 0x00, 0x62, 0xc5, 0x0e, 0xd2, 0x86, 0xf3, 0x4e, 0xea, 0x6a, 0x44, 0x3a,
+	0x5e, 0x57, 0xb7, 0x52, 0xd8, 0xbd, 0x8a, 0x56, 0xe4, 0x61, 0xd4, 0xe4, 0x61, 0xf2, 0xf9, 0x23,
+	0xa3, 0x7d, 0xe6, 0x68, 0x58, 0xdb, 0xb0, 0x6c, 0xf9, 0x5d, 0x4c, 0x4e, 0xd9, 0xa5, 0x6d, 0x01,
+	0x75, 0xb4, 0x04, 0x83, 0x2e, 0x8f, 0x21, 0x56, 0xde, 0x29, 0xd3, 0xa3, 0x36, 0x8e, 0x86, 0xb5,
+	0x92, 0x7d, 0x2f, 0xdd, 0xc1, 0x64, 0x64, 0xe4, 0xde, 0x44, 0xeb, 0x10, 0xd3, 0x66, 0x08, 0x7e,
+	0x24, 0xdb, 0xbe, 0xec, 0x75, 0xbb, 0xe1, 0xa1, 0xb7, 0xb2, 0xed, 0x5c, 0x58, 0xda, 0xdb, 0xca,
+	0xbe, 0xca, 0xd7, 0x4c, 0x30, 0x29, 0x59, 0xec, 0xae, 0x6c, 0x37, 0x0c, 0x32, 0xc1, 0x64, 0x0f,
+	0xd7, 0x5b, 0x3d, 0x86, 0xc9, 0x9a, 0xe4, 0x99, 0x6c, 0x01, 0xb8, 0x5b, 0x68, 0xb9, 0x19, 0xd2,
+	0xa0, 0x13, 0x32, 0xa9, 0xbc, 0x35, 0xcd, 0x40, 0x32, 0xc0, 0x5c, 0x93, 0xe8, 0xc0, 0xcf, 0x35,
+	0x0a, 0xb9, 0x4f, 0x05, 0x78, 0xa5, 0x19, 0xaf, 0x49, 0x05, 0x9c, 0xfa, 0x9a, 0x44, 0x07, 0xd7,
+	0x46, 0x68, 0x43, 0x83, 0x66, 0x2c, 0x6a, 0x6b, 0x9b, 0x89, 0xb1, 0x12, 0x2d, 0xcf, 0x36, 0x16,
+	0x8b, 0x59, 0x31, 0xd1, 0x01, 0xdb, 0x2c, 0xe7, 0xab, 0xf5, 0x47, 0x07, 0x79, 0x11, 0x8b, 0xf3,
+	0x5e, 0xdb, 0x7a, 0x62, 0xea, 0xd0, 0x5b, 0x37, 0x9e, 0x3c, 0x9c, 0xda, 0x93, 0xda, 0xe8, 0xd2,
+	0x58, 0xc8, 0x8b, 0xc9, 0x66, 0xc4, 0xe2, 0x2c, 0x23, 0x77, 0xd2, 0x0d, 0xb7, 0x89, 0x50, 0xe6,
+	0xbe, 0xe7, 0x1a, 0xf9, 0x6b, 0x53, 0xc8, 0xdf, 0x8a, 0x55, 0x36, 0xe0, 0x32, 0x26, 0x4c, 0x96,
+	0x47, 0xc1, 0xbb, 0x37, 0x50, 0x79, 0x9f, 0x49, 0xc5, 0x05, 0x0b, 0xfc, 0x08, 0x5a, 0x8c, 0xc6,
+	0xd2, 0xdb, 0x30, 0x55, 0x7e, 0x2e, 0xfb, 0xce, 0x27, 0x2d, 0x30, 0x29, 0xa5, 0xd0, 0x5d, 0x8b,
+	0xb8, 0x5f, 0xa3, 0x32, 0x0d, 0x43, 0xde, 0xf7, 0x75, 0x41, 0xf9, 0xb4, 0x15, 0xb1, 0xd8, 0xab,
+	0x18, 0x8f, 0x2f, 0x67, 0x3c, 0x93, 0x16, 0xf8, 0x8f, 0xdf, 0x2e, 0x56, 0x92, 0xff, 0x38, 0x76,
+	0x5b, 0x2d, 0x01, 0x52, 0x36, 0x94, 0x60, 0x71, 0x9b, 0xac, 0x19, 0xd3, 0x3b, 0x4c, 0xaa, 0x5d,
+	0x6d, 0x98, 0x4c, 0xf3, 0x9f, 0xe7, 0x51, 0xb9, 0xd1, 0x85, 0x80, 0xd1, 0x70, 0x57, 0x4a, 0x50,
+	0x0f, 0x28, 0x13, 0x6e, 0x15, 0xa1, 0x2c, 0xad, 0x76, 0xb0, 0x93, 0x1c, 0xe2, 0x6e, 0xa2, 0xc5,
+	0xe4, 0xcb, 0x31, 0xa3, 0x9b, 0x24, 0x2b, 0xf7, 0xab, 0x37, 0x8f, 0xda, 0x9d, 0xe9, 0xce, 0xb8,
+	0x60, 0x9c, 0x06, 0xc7, 0x4f, 0xd3, 0x69, 0x05, 0x0a, 0xa7, 0x65, 0x92, 0x94, 0xff, 0x1c, 0x54,
+	0xca, 0x27, 0xa5, 0x01, 0x4a, 0xc7, 0x4c, 0xf5, 0xb3, 0xf4, 0x9c, 0xed, 0x13, 0x3a, 0x66, 0xbb,
+	0x2a, 0x8e, 0x79, 0xfe, 0x7d, 0xc7, 0x7c, 0xe2, 0x9d, 0xc7, 0xfc, 0x6c, 0x1e, 0x95, 0x26, 0x6e,
+	0x7e, 0xee, 0x15, 0xb4, 0x64, 0x4f, 0x16, 0x44, 0x72, 0xbd, 0xf3, 0xde, 0x58, 0x5e, 0x23, 0x4b,
+	0xb7, 0x82, 0x16, 0xec, 0x48, 0xb0, 0xc5, 0x61, 0x17, 0x6e, 0x03, 0xad, 0x2a, 0xc1, 0xda, 0x6d,
+	0x10, 0x7a, 0x96, 0x32, 0xfe, 0x96, 0x21, 0xac, 0x24, 0x24, 0x44, 0x73, 0xb8, 0x0f, 0xd1, 0x8a,
+	0xa2, 0xa2, 0x0d, 0x2a, 0xe1, 0x7c, 0xbb, 0x52, 0x38, 0x65, 0x39, 0x0c, 0xe5, 0xed, 0x93, 0x4b,
+	0x0b, 0xe5, 0xc5, 0xfc, 0x51, 0xd8, 0x09, 0xb6, 0x77, 0xef, 0xf9, 0x3f, 0xd5, 0xb9, 0xe7, 0x2f,
+	0xab, 0xce, 0x8b, 0x97, 0x55, 0xe7, 0xef, 0x97, 0x55, 0xe7, 0xa7, 0x57, 0xd5, 0xb9, 0x17, 0xaf,
+	0xaa, 0x73, 0x7f, 0xbe, 0xaa, 0xce, 0x7d, 0xf9, 0x69, 0x4e, 0x4a, 0xff, 0x1c, 0x70, 0x31, 0x06,
+	0xd5, 0xe7, 0xa2, 0x63, 0x16, 0xf5, 0x83, 0xcf, 0xea, 0x83, 0xec, 0x17, 0x04, 0x23, 0xdc, 0x5c,
+	0x34, 0xff, 0xf9, 0x5f, 0xfe, 0x7f, 0x00, 0x6f, 0x4a, 0xde, 0x03, 0x5f, 0x10, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -464,6 +544,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RewardsAuctionFee.Equal(that1.RewardsAuctionFee) {
 		return false
 	}
+	if !this.ReferralRewardFactor.Equal(that1.ReferralRewardFactor) {
+		return false
+	}
+	if !this.DeleverageFee.Equal(that1.DeleverageFee) {
+		return false
+	}
+	if this.MaxDeleverageOrdersPerBlock != that1.MaxDeleverageOrdersPerBlock {
+		return false
+	}
 	return true
 }
 func (this *Token) Equal(that interface{}) bool {
@@ -542,6 +631,9 @@ func (this *Token) Equal(that interface{}) bool {
 	if this.HistoricMedians != that1.HistoricMedians {
 		return false
 	}
+	if this.AllowListAdmin != that1.AllowListAdmin {
+		return false
+	}
 	return true
 }
 func (this *SpecialAssetPair) Equal(that interface{}) bool {
@@ -632,6 +724,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDeleverageOrdersPerBlock != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.MaxDeleverageOrdersPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DeleverageFee.Size()
+		i -= size
+		if _, err := m.DeleverageFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ReferralRewardFactor.Size()
+		i -= size
+		if _, err := m.ReferralRewardFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.RewardsAuctionFee.Size()
 		i -= size
@@ -715,6 +832,15 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowListAdmin) > 0 {
+		i -= len(m.AllowListAdmin)
+		copy(dAtA[i:], m.AllowListAdmin)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.AllowListAdmin)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.HistoricMedians != 0 {
 		i = encodeVarintLeverage(dAtA, i, uint64(m.HistoricMedians))
 		i--
@@ -1009,6 +1135,63 @@ func (m *SpecialAssetSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeleverageOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleverageOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleverageOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetRatio.Size()
+		i -= size
+		if _, err := m.TargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TriggerRatio.Size()
+		i -= size
+		if _, err := m.TriggerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLeverage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLeverage(v)
 	base := offset
@@ -1038,6 +1221,13 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovLeverage(uint64(l))
 	l = m.RewardsAuctionFee.Size()
 	n += 1 + l + sovLeverage(uint64(l))
+	l = m.ReferralRewardFactor.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.DeleverageFee.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	if m.MaxDeleverageOrdersPerBlock != 0 {
+		n += 1 + sovLeverage(uint64(m.MaxDeleverageOrdersPerBlock))
+	}
 	return n
 }
 
@@ -1094,6 +1284,10 @@ func (m *Token) Size() (n int) {
 	if m.HistoricMedians != 0 {
 		n += 2 + sovLeverage(uint64(m.HistoricMedians))
 	}
+	l = len(m.AllowListAdmin)
+	if l > 0 {
+		n += 2 + l + sovLeverage(uint64(l))
+	}
 	return n
 }
 
@@ -1137,6 +1331,27 @@ func (m *SpecialAssetSet) Size() (n int) {
 	return n
 }
 
+func (m *DeleverageOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
+	}
+	l = m.TriggerRatio.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.TargetRatio.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	return n
+}
+
 func sovLeverage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1376,6 +1591,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralRewardFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralRewardFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleverageFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeleverageFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeleverageOrdersPerBlock", wireType)
+			}
+			m.MaxDeleverageOrdersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeleverageOrdersPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLeverage(dAtA[iNdEx:])
@@ -1996,6 +2298,38 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowListAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLeverage(dAtA[iNdEx:])
@@ -2349,6 +2683,188 @@ func (m *SpecialAssetSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeleverageOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeverage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleverageOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleverageOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeverage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLeverage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
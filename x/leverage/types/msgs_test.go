@@ -204,6 +204,7 @@ updatetokens:
       min_collateral_liquidity: "0.000000000000000000"
       max_supply: "100000000000"
       historic_medians: 24
+      allow_list_admin: ""
 `
 	assert.Equal(t, expResult, msg.String())
 	tassert.NotNil(t, msg.GetSignBytes(), "sign byte shouldn't be nil")
@@ -6,6 +6,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// maxDeleverageFee is the maximum fee charged on deleverage order repayments.
+var maxDeleverageFee = sdk.MustNewDecFromStr("0.1")
+
+// maxDeleverageOrdersPerBlock is the maximum number of deleverage orders evaluated during each EndBlock.
+const maxDeleverageOrdersPerBlock = 500
+
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
@@ -15,6 +21,9 @@ func DefaultParams() Params {
 		RewardsAuctionFee:            sdk.MustNewDecFromStr("0.02"),
 		SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
 		DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+		ReferralRewardFactor:         sdk.ZeroDec(),
+		DeleverageFee:                sdk.MustNewDecFromStr("0.005"),
+		MaxDeleverageOrdersPerBlock:  50,
 	}
 }
 
@@ -32,7 +41,16 @@ func (p Params) Validate() error {
 	if err := validateSmallLiquidationSize(p.SmallLiquidationSize); err != nil {
 		return err
 	}
-	return validateDirectLiquidationFee(p.DirectLiquidationFee)
+	if err := validateDirectLiquidationFee(p.DirectLiquidationFee); err != nil {
+		return err
+	}
+	if err := validateReferralRewardFactor(p.ReferralRewardFactor); err != nil {
+		return err
+	}
+	if err := validateDeleverageFee(p.DeleverageFee); err != nil {
+		return err
+	}
+	return validateMaxDeleverageOrdersPerBlock(p.MaxDeleverageOrdersPerBlock)
 }
 
 func validateLiquidationThreshold(v sdk.Dec) error {
@@ -87,3 +105,39 @@ func validateDirectLiquidationFee(v sdk.Dec) error {
 
 	return nil
 }
+
+func validateReferralRewardFactor(v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("referral reward factor cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("referral reward factor cannot be negative: %d", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("referral reward factor cannot exceed 1: %d", v)
+	}
+
+	return nil
+}
+
+func validateDeleverageFee(v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("deleverage fee cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("deleverage fee cannot be negative: %d", v)
+	}
+	if v.GT(maxDeleverageFee) {
+		return fmt.Errorf("deleverage fee cannot exceed %s: %d", maxDeleverageFee, v)
+	}
+
+	return nil
+}
+
+func validateMaxDeleverageOrdersPerBlock(v uint32) error {
+	if v > maxDeleverageOrdersPerBlock {
+		return fmt.Errorf("max deleverage orders per block cannot exceed %d: %d", maxDeleverageOrdersPerBlock, v)
+	}
+
+	return nil
+}
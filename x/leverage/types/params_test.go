@@ -98,6 +98,57 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"direct liquidation fee must be less than 1",
 		},
+		{
+			"negative referral reward factor",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				ReferralRewardFactor:         negativeDec,
+			},
+			"referral reward factor cannot be negative",
+		},
+		{
+			"exceeded referral reward factor",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				ReferralRewardFactor:         exceededDec,
+			},
+			"referral reward factor cannot exceed 1",
+		},
+		{
+			"exceeded deleverage fee",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				ReferralRewardFactor:         sdk.MustNewDecFromStr("0.1"),
+				DeleverageFee:                sdk.MustNewDecFromStr("0.2"),
+			},
+			"deleverage fee cannot exceed 0.1",
+		},
+		{
+			"exceeded max deleverage orders per block",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				ReferralRewardFactor:         sdk.MustNewDecFromStr("0.1"),
+				DeleverageFee:                sdk.MustNewDecFromStr("0.005"),
+				MaxDeleverageOrdersPerBlock:  501,
+			},
+			"max deleverage orders per block cannot exceed 500",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -35,6 +35,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccountSortKey selects the order in which the AccountSummaries and Inspect queries return accounts.
+// Sorted results (by borrowed value, LTV or danger) require evaluating every borrower before a page is
+// returned, and support offset pagination only. Their page keys encode the offset of the next page, and
+// only the first 1000 accounts can be returned.
+type AccountSortKey int32
+
+const (
+	// UNSPECIFIED uses the query's default order: address for AccountSummaries. Inspect returns a page of
+	// borrowers in address order, sorting the page by borrowed value (or borrowed amount of the requested
+	// symbol).
+	AccountSortKey_ACCOUNT_SORT_KEY_UNSPECIFIED AccountSortKey = 0
+	// ADDRESS returns accounts in store order, allowing pages to be streamed using key pagination.
+	AccountSortKey_ACCOUNT_SORT_KEY_ADDRESS AccountSortKey = 1
+	// BORROWED_VALUE sorts accounts by borrowed value, highest first.
+	AccountSortKey_ACCOUNT_SORT_KEY_BORROWED_VALUE AccountSortKey = 2
+	// LTV sorts accounts by the ratio (borrowed value / collateral value), highest first.
+	AccountSortKey_ACCOUNT_SORT_KEY_LTV AccountSortKey = 3
+	// DANGER sorts accounts by the ratio (borrowed value / liquidation threshold), highest first.
+	AccountSortKey_ACCOUNT_SORT_KEY_DANGER AccountSortKey = 4
+)
+
+var AccountSortKey_name = map[int32]string{
+	0: "ACCOUNT_SORT_KEY_UNSPECIFIED",
+	1: "ACCOUNT_SORT_KEY_ADDRESS",
+	2: "ACCOUNT_SORT_KEY_BORROWED_VALUE",
+	3: "ACCOUNT_SORT_KEY_LTV",
+	4: "ACCOUNT_SORT_KEY_DANGER",
+}
+
+var AccountSortKey_value = map[string]int32{
+	"ACCOUNT_SORT_KEY_UNSPECIFIED":    0,
+	"ACCOUNT_SORT_KEY_ADDRESS":        1,
+	"ACCOUNT_SORT_KEY_BORROWED_VALUE": 2,
+	"ACCOUNT_SORT_KEY_LTV":            3,
+	"ACCOUNT_SORT_KEY_DANGER":         4,
+}
+
+func (x AccountSortKey) String() string {
+	return proto.EnumName(AccountSortKey_name, int32(x))
+}
+
+func (AccountSortKey) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{0}
+}
+
 // QueryParams defines the request structure for the Params gRPC service
 // handler.
 type QueryParams struct {
@@ -695,6 +740,9 @@ var xxx_messageInfo_QueryAccountSummaryResponse proto.InternalMessageInfo
 type QueryAccountSummaries struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// sort_by selects the order of accounts. Unspecified or address order returns all accounts, while other
+	// sort keys only return accounts with open borrows, in descending order.
+	SortBy AccountSortKey `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=umee.leverage.v1.AccountSortKey" json:"sort_by,omitempty"`
 }
 
 func (m *QueryAccountSummaries) Reset()         { *m = QueryAccountSummaries{} }
@@ -1137,6 +1185,12 @@ type QueryInspect struct {
 	Danger float64 `protobuf:"fixed64,4,opt,name=danger,proto3" json:"danger,omitempty"`
 	// LTV is the minimum ratio (borrowed value / collateral value) an account must have to show. Use 0 to show all.
 	Ltv float64 `protobuf:"fixed64,5,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	// sort_by selects the order of accounts. When sorting by address or unspecified, filters are applied to
+	// each page separately, so pages can contain fewer accounts than the requested limit.
+	SortBy AccountSortKey `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=umee.leverage.v1.AccountSortKey" json:"sort_by,omitempty"`
+	// pagination defines an optional pagination for the request. Without it, the default page limit (100)
+	// applies, so the response no longer contains every borrower: follow next_key for the remaining pages.
+	Pagination *query.PageRequest `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryInspect) Reset()         { *m = QueryInspect{} }
@@ -1215,6 +1269,8 @@ type QueryInspectResponse struct {
 	Borrowers []InspectAccount `protobuf:"bytes,1,rep,name=borrowers,proto3" json:"borrowers"`
 	// Failures is a list of addresses for which the position calculation failed.
 	Failures []string `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryInspectResponse) Reset()         { *m = QueryInspectResponse{} }
@@ -1464,7 +1520,240 @@ func (m *PositionBalance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PositionBalance proto.InternalMessageInfo
 
+// QueryReferralRewards defines the request structure for the ReferralRewards gRPC service handler.
+type QueryReferralRewards struct {
+	// address is the referrer bech32 address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryReferralRewards) Reset()         { *m = QueryReferralRewards{} }
+func (m *QueryReferralRewards) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralRewards) ProtoMessage()    {}
+func (*QueryReferralRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{34}
+}
+func (m *QueryReferralRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralRewards.Merge(m, src)
+}
+func (m *QueryReferralRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReferralRewards proto.InternalMessageInfo
+
+// QueryReferralRewardsResponse defines the response structure for the ReferralRewards gRPC service handler.
+type QueryReferralRewardsResponse struct {
+	// rewards are the referral rewards which can be collected using MsgClaimReferralRewards.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryReferralRewardsResponse) Reset()         { *m = QueryReferralRewardsResponse{} }
+func (m *QueryReferralRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralRewardsResponse) ProtoMessage()    {}
+func (*QueryReferralRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{35}
+}
+func (m *QueryReferralRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReferralRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralRewardsResponse.Merge(m, src)
+}
+func (m *QueryReferralRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReferralRewardsResponse proto.InternalMessageInfo
+
+// QueryAllowList defines the request structure for the AllowList gRPC service handler.
+type QueryAllowList struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAllowList) Reset()         { *m = QueryAllowList{} }
+func (m *QueryAllowList) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowList) ProtoMessage()    {}
+func (*QueryAllowList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{36}
+}
+func (m *QueryAllowList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowList.Merge(m, src)
+}
+func (m *QueryAllowList) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowList proto.InternalMessageInfo
+
+// QueryAllowListResponse defines the response structure for the AllowList gRPC service handler.
+type QueryAllowListResponse struct {
+	// Admin is the Token's allow list admin. Empty if the Token is not permissioned.
+	Admin     string   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryAllowListResponse) Reset()         { *m = QueryAllowListResponse{} }
+func (m *QueryAllowListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowListResponse) ProtoMessage()    {}
+func (*QueryAllowListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{37}
+}
+func (m *QueryAllowListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowListResponse.Merge(m, src)
+}
+func (m *QueryAllowListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowListResponse proto.InternalMessageInfo
+
+// QueryDeleverageOrders defines the request structure for the DeleverageOrders gRPC service handler.
+type QueryDeleverageOrders struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDeleverageOrders) Reset()         { *m = QueryDeleverageOrders{} }
+func (m *QueryDeleverageOrders) String() string { return proto.CompactTextString(m) }
+func (*QueryDeleverageOrders) ProtoMessage()    {}
+func (*QueryDeleverageOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{38}
+}
+func (m *QueryDeleverageOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeleverageOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeleverageOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeleverageOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeleverageOrders.Merge(m, src)
+}
+func (m *QueryDeleverageOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeleverageOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeleverageOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeleverageOrders proto.InternalMessageInfo
+
+// QueryDeleverageOrdersResponse defines the response structure for the DeleverageOrders gRPC service handler.
+type QueryDeleverageOrdersResponse struct {
+	Orders []DeleverageOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+}
+
+func (m *QueryDeleverageOrdersResponse) Reset()         { *m = QueryDeleverageOrdersResponse{} }
+func (m *QueryDeleverageOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeleverageOrdersResponse) ProtoMessage()    {}
+func (*QueryDeleverageOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e8137dcabb0ccc7, []int{39}
+}
+func (m *QueryDeleverageOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeleverageOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeleverageOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeleverageOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeleverageOrdersResponse.Merge(m, src)
+}
+func (m *QueryDeleverageOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeleverageOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeleverageOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeleverageOrdersResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("umee.leverage.v1.AccountSortKey", AccountSortKey_name, AccountSortKey_value)
 	proto.RegisterType((*QueryParams)(nil), "umee.leverage.v1.QueryParams")
 	proto.RegisterType((*QueryParamsResponse)(nil), "umee.leverage.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryRegisteredTokens)(nil), "umee.leverage.v1.QueryRegisteredTokens")
@@ -1499,150 +1788,176 @@ func init() {
 	proto.RegisterType((*RiskInfo)(nil), "umee.leverage.v1.RiskInfo")
 	proto.RegisterType((*DecBalances)(nil), "umee.leverage.v1.DecBalances")
 	proto.RegisterType((*PositionBalance)(nil), "umee.leverage.v1.PositionBalance")
+	proto.RegisterType((*QueryReferralRewards)(nil), "umee.leverage.v1.QueryReferralRewards")
+	proto.RegisterType((*QueryReferralRewardsResponse)(nil), "umee.leverage.v1.QueryReferralRewardsResponse")
+	proto.RegisterType((*QueryAllowList)(nil), "umee.leverage.v1.QueryAllowList")
+	proto.RegisterType((*QueryAllowListResponse)(nil), "umee.leverage.v1.QueryAllowListResponse")
+	proto.RegisterType((*QueryDeleverageOrders)(nil), "umee.leverage.v1.QueryDeleverageOrders")
+	proto.RegisterType((*QueryDeleverageOrdersResponse)(nil), "umee.leverage.v1.QueryDeleverageOrdersResponse")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/query.proto", fileDescriptor_1e8137dcabb0ccc7) }
 
 var fileDescriptor_1e8137dcabb0ccc7 = []byte{
-	// 2207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x4a, 0x16, 0x25, 0x3d, 0xea, 0x73, 0x2c, 0xd9, 0x6b, 0xca, 0xa2, 0xe4, 0xb5, 0xf5,
-	0x11, 0x27, 0x22, 0x2d, 0x05, 0x30, 0x9a, 0x7e, 0x8b, 0x52, 0xd3, 0x2a, 0x90, 0x03, 0x79, 0x1d,
-	0x3b, 0x70, 0xd2, 0x86, 0x18, 0x92, 0x63, 0x6a, 0xa1, 0xe5, 0x2e, 0xbd, 0xb3, 0x94, 0xc5, 0x02,
-	0xb9, 0x18, 0xe8, 0xad, 0x2d, 0x1a, 0x14, 0x05, 0x5a, 0xf4, 0xd4, 0x6b, 0x6f, 0x05, 0x0a, 0xf4,
-	0x4f, 0xa8, 0x8f, 0x41, 0x7b, 0x29, 0x0a, 0x54, 0x69, 0xed, 0xa2, 0x87, 0xfc, 0x01, 0x3d, 0xf5,
-	0x50, 0xcc, 0x27, 0x77, 0xb9, 0xa4, 0x44, 0x12, 0xf1, 0x49, 0x9c, 0x9d, 0xf7, 0x7e, 0xef, 0xf7,
-	0xde, 0xcc, 0x9b, 0x79, 0x6f, 0x04, 0xd7, 0x1b, 0x35, 0x42, 0xf2, 0x2e, 0x39, 0x21, 0x01, 0xae,
-	0x92, 0xfc, 0xc9, 0x56, 0xfe, 0x69, 0x83, 0x04, 0xcd, 0x5c, 0x3d, 0xf0, 0x43, 0x1f, 0xcd, 0xb2,
-	0xd9, 0x9c, 0x9a, 0xcd, 0x9d, 0x6c, 0x65, 0xae, 0x57, 0x7d, 0xbf, 0xea, 0x92, 0x3c, 0xae, 0x3b,
-	0x79, 0xec, 0x79, 0x7e, 0x88, 0x43, 0xc7, 0xf7, 0xa8, 0x90, 0xcf, 0x64, 0x13, 0x68, 0x55, 0xe2,
-	0x11, 0xea, 0xa8, 0xf9, 0xe5, 0xc4, 0xbc, 0xc6, 0x16, 0x02, 0xf3, 0x55, 0xbf, 0xea, 0xf3, 0x9f,
-	0x79, 0xf6, 0x4b, 0xc1, 0x96, 0x7d, 0x5a, 0xf3, 0x69, 0xbe, 0x84, 0x29, 0x53, 0x2a, 0x91, 0x10,
-	0x6f, 0xe5, 0xcb, 0xbe, 0xe3, 0xc9, 0xf9, 0xdb, 0xd1, 0x79, 0xce, 0x5f, 0x4b, 0xd5, 0x71, 0xd5,
-	0xf1, 0x38, 0x47, 0x29, 0x7b, 0x4d, 0xc8, 0x16, 0x85, 0x11, 0x31, 0x10, 0x53, 0xd6, 0x14, 0xa4,
-	0xef, 0x33, 0xe5, 0x43, 0x1c, 0xe0, 0x1a, 0xb5, 0xee, 0xc1, 0xe5, 0xc8, 0xd0, 0x26, 0xb4, 0xee,
-	0x7b, 0x94, 0xa0, 0xbb, 0x90, 0xaa, 0xf3, 0x2f, 0xa6, 0xb1, 0x62, 0x6c, 0xa4, 0xb7, 0xcd, 0x5c,
-	0x7b, 0x90, 0x72, 0x42, 0xa3, 0x70, 0xe9, 0xc5, 0xd9, 0xf2, 0x90, 0x2d, 0xa5, 0xad, 0xbb, 0xb0,
-	0xc0, 0xe1, 0x6c, 0x52, 0x75, 0x68, 0x48, 0x02, 0x52, 0xf9, 0xc0, 0x3f, 0x26, 0x1e, 0x45, 0x4b,
-	0x00, 0x8c, 0x78, 0xb1, 0x42, 0x3c, 0xbf, 0xc6, 0x41, 0x27, 0xec, 0x09, 0xf6, 0x65, 0x8f, 0x7d,
-	0xb0, 0x3e, 0x82, 0xa5, 0x8e, 0x7a, 0x9a, 0xd0, 0x3b, 0x30, 0x1e, 0xf0, 0xb9, 0xa0, 0x69, 0x1a,
-	0x2b, 0x23, 0x1b, 0xe9, 0xed, 0xab, 0x49, 0x4a, 0x5c, 0x47, 0x32, 0xd2, 0xe2, 0x96, 0x05, 0x2b,
-	0x1d, 0xb1, 0x3f, 0x74, 0xc2, 0xa3, 0x7b, 0x38, 0x38, 0x26, 0x21, 0xb5, 0x1c, 0xd8, 0xb8, 0x48,
-	0x46, 0x53, 0xf9, 0x16, 0x8c, 0xd5, 0xc4, 0x27, 0xc9, 0x64, 0xa9, 0x0b, 0x13, 0xa1, 0x28, 0xf9,
-	0x28, 0x1d, 0xeb, 0xe7, 0x06, 0xa4, 0x23, 0xd3, 0xe8, 0x6d, 0x18, 0x0d, 0xd9, 0x50, 0x46, 0xfa,
-	0x02, 0xb7, 0x84, 0x2c, 0x7a, 0x0f, 0x52, 0x02, 0xcf, 0x1c, 0xe6, 0x5a, 0x6f, 0x25, 0xb5, 0xb8,
-	0x3f, 0xc2, 0xc6, 0x83, 0x46, 0xad, 0x86, 0x99, 0x73, 0xc2, 0x03, 0xb5, 0x66, 0x02, 0xc1, 0xba,
-	0x0d, 0x88, 0xcb, 0x3e, 0xa8, 0x93, 0xb2, 0x83, 0xdd, 0x1d, 0x4a, 0x49, 0x48, 0xd1, 0x3c, 0x8c,
-	0x46, 0xd7, 0x4a, 0x0c, 0xac, 0x1f, 0x42, 0x26, 0x29, 0xab, 0x23, 0xf3, 0x6d, 0x18, 0xad, 0x63,
-	0x27, 0x50, 0x71, 0xb1, 0x92, 0xa4, 0xa2, 0x7a, 0x87, 0xd8, 0x09, 0x94, 0x57, 0x5c, 0x4d, 0x33,
-	0x89, 0xb1, 0xee, 0xc2, 0xe4, 0x7f, 0x93, 0x92, 0x4a, 0x47, 0x17, 0xd1, 0x0d, 0x98, 0xa4, 0xcd,
-	0x5a, 0xc9, 0x77, 0x63, 0x3b, 0x2e, 0x2d, 0xbe, 0xf1, 0x3d, 0x87, 0x32, 0x30, 0x4e, 0x4e, 0xeb,
-	0xbe, 0x47, 0x3c, 0x11, 0xc5, 0x29, 0x5b, 0x8f, 0xd1, 0x7d, 0x98, 0xf4, 0x03, 0x5c, 0x76, 0x49,
-	0xb1, 0x1e, 0x38, 0x65, 0x62, 0x8e, 0x30, 0xf5, 0x42, 0xee, 0xc5, 0xd9, 0xb2, 0xf1, 0xf7, 0xb3,
-	0xe5, 0xb5, 0xaa, 0x13, 0x1e, 0x35, 0x4a, 0xb9, 0xb2, 0x5f, 0x93, 0xc9, 0x25, 0xff, 0x6c, 0xd2,
-	0xca, 0x71, 0x3e, 0x6c, 0xd6, 0x09, 0xcd, 0xed, 0x91, 0xb2, 0x9d, 0x16, 0x18, 0x87, 0x0c, 0x02,
-	0x9d, 0xc2, 0x7c, 0x83, 0xaf, 0x64, 0x91, 0x9c, 0x96, 0x8f, 0xb0, 0x57, 0x25, 0xc5, 0x00, 0x87,
-	0xc4, 0xbc, 0xc4, 0xa1, 0xdf, 0x65, 0x71, 0xe8, 0x1d, 0xfa, 0xcb, 0xb3, 0xe5, 0xf9, 0x46, 0x98,
-	0x44, 0xb3, 0x91, 0xb0, 0xf1, 0x3d, 0xf9, 0xd1, 0xc6, 0x21, 0x41, 0x1f, 0x03, 0xd0, 0x46, 0xbd,
-	0xee, 0x36, 0x8b, 0x3b, 0x87, 0x8f, 0xcd, 0x51, 0x6e, 0xef, 0x9b, 0x7d, 0xdb, 0x53, 0x18, 0xb8,
-	0xde, 0xb4, 0x27, 0xc4, 0xef, 0x9d, 0xc3, 0xc7, 0x0c, 0xbc, 0xe4, 0x07, 0x81, 0xff, 0x8c, 0x83,
-	0xa7, 0x06, 0x05, 0x97, 0x18, 0x1c, 0x5c, 0xfc, 0x66, 0xe0, 0xef, 0xc1, 0x38, 0xb7, 0xe4, 0x90,
-	0x8a, 0x39, 0xa6, 0x97, 0xa0, 0x57, 0xe8, 0x7d, 0x2f, 0xb4, 0xb5, 0x3e, 0xc3, 0x0a, 0x08, 0x25,
-	0xc1, 0x09, 0xa9, 0x98, 0xe3, 0x83, 0x61, 0x29, 0x7d, 0xf4, 0x3e, 0x40, 0xd9, 0x77, 0x5d, 0x1c,
-	0x92, 0x00, 0xbb, 0xe6, 0xc4, 0x40, 0x68, 0x11, 0x04, 0xc6, 0x4d, 0x38, 0x4d, 0x2a, 0x26, 0x0c,
-	0xc6, 0x4d, 0xe9, 0xa3, 0x03, 0x98, 0x70, 0x9d, 0xa7, 0x0d, 0xa7, 0xe2, 0x84, 0x4d, 0x33, 0x3d,
-	0x10, 0x58, 0x0b, 0x00, 0x3d, 0x84, 0xe9, 0x1a, 0x3e, 0x75, 0x6a, 0x8d, 0x5a, 0x51, 0x58, 0x30,
-	0x27, 0x07, 0x82, 0x9c, 0x92, 0x28, 0x05, 0x0e, 0x82, 0x7e, 0x04, 0x48, 0xc1, 0x46, 0x02, 0x39,
-	0x35, 0x10, 0xf4, 0x9c, 0x44, 0xda, 0x6d, 0xc5, 0xf3, 0x63, 0x98, 0xab, 0x39, 0x1e, 0x87, 0x6f,
-	0xc5, 0x62, 0x7a, 0x20, 0xf4, 0x59, 0x09, 0x74, 0xa0, 0x43, 0x52, 0x81, 0x29, 0x99, 0xc8, 0x22,
-	0x0b, 0xcc, 0x19, 0x0e, 0xfc, 0x9d, 0xfe, 0x80, 0xbf, 0x3c, 0x5b, 0x9e, 0x92, 0x19, 0x2c, 0x60,
-	0xec, 0x49, 0x81, 0xfa, 0x80, 0x8f, 0xd0, 0x63, 0x98, 0xc5, 0x27, 0xd8, 0x71, 0x71, 0xc9, 0x25,
-	0x2a, 0xf4, 0xb3, 0x03, 0x79, 0x30, 0xa3, 0x71, 0x5a, 0xc1, 0x6f, 0x41, 0x3f, 0x73, 0xc2, 0xa3,
-	0x4a, 0x80, 0x9f, 0x99, 0x73, 0x83, 0x05, 0x5f, 0x23, 0x7d, 0x28, 0x81, 0x50, 0x15, 0xae, 0xb6,
-	0xe0, 0x5b, 0xab, 0xeb, 0xfc, 0x98, 0x98, 0x68, 0x20, 0x1b, 0x57, 0x34, 0xdc, 0x6e, 0x14, 0x0d,
-	0x95, 0x60, 0x41, 0x1e, 0xd2, 0x47, 0x0e, 0x0d, 0xfd, 0xc0, 0x29, 0xcb, 0xd3, 0xfa, 0xf2, 0x40,
-	0xa7, 0xf5, 0x65, 0x01, 0xf6, 0x03, 0x89, 0x25, 0x4e, 0xed, 0x2b, 0x90, 0x22, 0x41, 0xe0, 0x07,
-	0xd4, 0x9c, 0xe7, 0x37, 0x88, 0x1c, 0x59, 0x77, 0x60, 0x9e, 0xdf, 0x3e, 0x3b, 0xe5, 0xb2, 0xdf,
-	0xf0, 0xc2, 0x02, 0x76, 0xb1, 0x57, 0x26, 0x14, 0x99, 0x30, 0x86, 0x2b, 0x95, 0x80, 0x50, 0x2a,
-	0xaf, 0x1c, 0x35, 0xb4, 0xfe, 0x31, 0x0c, 0xd7, 0x3b, 0xa9, 0xe8, 0x2b, 0xab, 0x1a, 0x39, 0xec,
-	0xc4, 0x05, 0x7a, 0x2d, 0x27, 0x4b, 0x37, 0x56, 0x28, 0xe5, 0x64, 0xb5, 0x97, 0xdb, 0xf5, 0x1d,
-	0xaf, 0x70, 0x87, 0xc5, 0xf0, 0xf7, 0x5f, 0x2c, 0x6f, 0xf4, 0xe0, 0x1c, 0x53, 0xa0, 0x91, 0x93,
-	0xf0, 0x38, 0x76, 0x7a, 0x0d, 0x7f, 0xf5, 0xa6, 0xa2, 0x47, 0x5b, 0x35, 0x72, 0xb4, 0x8d, 0xbc,
-	0x06, 0xaf, 0x14, 0xb8, 0x95, 0x97, 0x95, 0xac, 0x0c, 0xaf, 0xaa, 0x1e, 0xba, 0x2f, 0xc8, 0xf3,
-	0x14, 0x2c, 0x76, 0xd0, 0xd0, 0xeb, 0xf1, 0x10, 0xa6, 0x55, 0xc8, 0x8a, 0x27, 0xd8, 0x6d, 0x10,
-	0x01, 0xd0, 0xd7, 0xf6, 0x65, 0xfb, 0x6a, 0x4a, 0xa1, 0x3c, 0x62, 0x20, 0x2c, 0xb1, 0x5b, 0xe1,
-	0x91, 0xc0, 0xc3, 0x03, 0x01, 0xcf, 0xb4, 0x70, 0x04, 0xf4, 0x43, 0x98, 0x56, 0xe1, 0x90, 0xc0,
-	0x23, 0x83, 0x31, 0x56, 0x28, 0x02, 0xf6, 0x3e, 0x4c, 0xca, 0xeb, 0xd9, 0x75, 0x6a, 0x4e, 0x28,
-	0x2b, 0x96, 0xbe, 0x8b, 0x21, 0x81, 0x71, 0xc0, 0x20, 0x50, 0x19, 0x16, 0xc4, 0xc1, 0xcc, 0xbb,
-	0x96, 0x62, 0x78, 0x14, 0x10, 0x7a, 0xe4, 0xbb, 0x15, 0x59, 0x9d, 0xf4, 0x8b, 0x3d, 0x1f, 0x01,
-	0xfb, 0x40, 0x61, 0xa1, 0x4f, 0xe0, 0x32, 0xad, 0xfb, 0x61, 0xb1, 0x6d, 0x15, 0x53, 0x03, 0xc5,
-	0x64, 0x8e, 0x41, 0x3d, 0x88, 0xad, 0x64, 0x09, 0x16, 0x38, 0x7e, 0x62, 0x39, 0xc7, 0x06, 0xb2,
-	0xc0, 0xc9, 0xee, 0xb6, 0x2d, 0xa9, 0xf2, 0xa1, 0x6d, 0x5d, 0xc7, 0x07, 0xf7, 0xa1, 0x10, 0x5d,
-	0x5b, 0xab, 0x28, 0x1b, 0xb6, 0x58, 0x0e, 0x38, 0x84, 0xa2, 0x77, 0x01, 0x5a, 0x6d, 0xa5, 0xec,
-	0x4d, 0xd6, 0x62, 0x99, 0x2b, 0x7a, 0x68, 0x95, 0xbf, 0x87, 0xb8, 0x4a, 0x6c, 0xf2, 0xb4, 0x41,
-	0x68, 0x68, 0x47, 0x34, 0xad, 0xe7, 0x06, 0x4c, 0xf7, 0x9a, 0x92, 0xe8, 0x11, 0xcc, 0x60, 0x21,
-	0x5b, 0xa4, 0x42, 0x58, 0xf6, 0x37, 0x9b, 0x5d, 0xfa, 0x9b, 0xce, 0xa9, 0x6b, 0x4f, 0xe3, 0xd8,
-	0x77, 0xeb, 0x4f, 0x86, 0xec, 0x2f, 0xdb, 0xdd, 0xd4, 0xc9, 0x7e, 0x0f, 0xe6, 0xe2, 0x96, 0x1d,
-	0xa2, 0xda, 0x98, 0x95, 0xa4, 0xed, 0x36, 0xb3, 0xb3, 0xb8, 0x3d, 0x7a, 0xdf, 0x8f, 0x45, 0x4f,
-	0xf8, 0xb0, 0x7e, 0x61, 0xf4, 0x24, 0xfb, 0x68, 0xf8, 0xae, 0xc1, 0x55, 0x4e, 0xfc, 0x20, 0xb2,
-	0xc1, 0x71, 0x50, 0x65, 0x8d, 0xe4, 0x37, 0x60, 0xb9, 0xcb, 0x94, 0xf6, 0xca, 0x84, 0xb1, 0x50,
-	0x7c, 0xe2, 0xbe, 0x4c, 0xd8, 0x6a, 0x68, 0xcd, 0xc0, 0x14, 0x57, 0x2e, 0xe0, 0xca, 0x1e, 0x29,
-	0x85, 0xd4, 0xb2, 0xe5, 0x46, 0x50, 0x1f, 0x22, 0x9d, 0x77, 0x0c, 0x83, 0x9d, 0xdf, 0x89, 0x78,
-	0x48, 0x25, 0xd5, 0xea, 0x2a, 0x23, 0x05, 0x98, 0x95, 0x2d, 0xda, 0xa9, 0xae, 0x0e, 0xba, 0x2f,
-	0xbe, 0xee, 0xf3, 0x86, 0xa3, 0x7d, 0xde, 0x7f, 0x0c, 0x30, 0xdb, 0x41, 0x34, 0x37, 0x02, 0x63,
-	0xa2, 0x68, 0xa2, 0xaf, 0xe3, 0xc6, 0x54, 0xd8, 0xa8, 0x0c, 0xa9, 0x50, 0x58, 0x79, 0x0d, 0x97,
-	0xa5, 0x84, 0xb6, 0xbe, 0x0b, 0xd3, 0xca, 0x4f, 0x59, 0xa7, 0xf5, 0x1b, 0xaa, 0x4f, 0xe1, 0x4a,
-	0x1c, 0x41, 0xc7, 0xa9, 0xe5, 0x80, 0xf1, 0xfa, 0x1c, 0xf8, 0xa9, 0x01, 0x93, 0xdc, 0xfe, 0xbe,
-	0x47, 0xeb, 0xa4, 0x1c, 0xb2, 0xda, 0x49, 0xf4, 0xdb, 0x92, 0xbe, 0x1c, 0xb1, 0xc6, 0x5b, 0x97,
-	0x04, 0xcc, 0x01, 0x23, 0xd2, 0xbd, 0x64, 0x63, 0xb5, 0xc9, 0x08, 0x9f, 0x8d, 0x96, 0x13, 0x57,
-	0x20, 0x55, 0x61, 0x8d, 0x6d, 0xc0, 0x6f, 0x21, 0xc3, 0x96, 0x23, 0x34, 0x0b, 0x23, 0x6e, 0x78,
-	0xc2, 0xaf, 0x0f, 0xc3, 0x66, 0x3f, 0x75, 0x3d, 0x20, 0xd9, 0xc8, 0x94, 0x3d, 0xa7, 0x1e, 0x38,
-	0x95, 0x25, 0x9d, 0x54, 0xd0, 0xc1, 0xdb, 0x03, 0xd9, 0x91, 0x92, 0xe0, 0x9c, 0x23, 0x21, 0x6e,
-	0x46, 0x66, 0x42, 0x4b, 0x91, 0x39, 0xfd, 0x04, 0x3b, 0x6e, 0x23, 0x20, 0x62, 0x17, 0x4d, 0xd8,
-	0x7a, 0x6c, 0x61, 0x59, 0x88, 0xc4, 0x31, 0x34, 0x81, 0x82, 0x8e, 0x57, 0x20, 0x0f, 0xe2, 0x5e,
-	0xed, 0x6b, 0x3d, 0xeb, 0x0f, 0x06, 0x4c, 0xf7, 0x1a, 0x09, 0x74, 0x17, 0xc6, 0xb1, 0x87, 0xdd,
-	0x26, 0x75, 0xa8, 0x3c, 0xbb, 0x32, 0x49, 0x83, 0xb6, 0x43, 0x8f, 0xf7, 0xbd, 0x27, 0xbe, 0xad,
-	0x65, 0xd1, 0x3b, 0x30, 0x5e, 0xf7, 0xa9, 0xc3, 0xcf, 0xbc, 0x11, 0xae, 0xd7, 0xe1, 0x69, 0x6c,
-	0x8f, 0x94, 0x75, 0xe9, 0xab, 0xc5, 0x11, 0x82, 0x4b, 0x8e, 0xf7, 0xc4, 0x17, 0xb5, 0x85, 0xcd,
-	0x7f, 0x5b, 0x9f, 0xc0, 0xb8, 0x32, 0xc2, 0xc2, 0xa7, 0x2e, 0x2e, 0xce, 0xd6, 0xb0, 0xf5, 0x18,
-	0xad, 0x40, 0x3a, 0x72, 0x06, 0xca, 0x2d, 0x15, 0xfd, 0xc4, 0xf2, 0xe5, 0x91, 0xae, 0x87, 0x0c,
-	0x5b, 0x0c, 0xac, 0xdf, 0x1a, 0x90, 0x8e, 0xb0, 0x61, 0x87, 0x76, 0x64, 0xef, 0x89, 0x95, 0xbe,
-	0xd1, 0xe1, 0xe1, 0x53, 0x72, 0x96, 0x7a, 0x32, 0xd4, 0xd1, 0x4d, 0xba, 0x1b, 0xdb, 0xe0, 0x7d,
-	0xc1, 0xb4, 0xea, 0xd9, 0x2f, 0x0c, 0x98, 0x69, 0x93, 0xe9, 0xfc, 0x14, 0xd6, 0xf6, 0xb6, 0x3a,
-	0xdc, 0xf6, 0xb6, 0x8a, 0xf6, 0x21, 0x85, 0x6b, 0x6c, 0xc5, 0x65, 0x35, 0xb8, 0x25, 0xab, 0x86,
-	0x45, 0x91, 0xcf, 0xb4, 0x72, 0x9c, 0x73, 0xfc, 0x7c, 0x0d, 0x87, 0x47, 0xb9, 0x03, 0x52, 0xc5,
-	0xe5, 0xe6, 0x1e, 0x29, 0xff, 0xe5, 0x8f, 0x9b, 0x20, 0x8f, 0x08, 0x56, 0x38, 0x48, 0x00, 0x74,
-	0x00, 0x69, 0x6e, 0x49, 0xe2, 0x89, 0x42, 0xf0, 0x4d, 0x89, 0xb7, 0x90, 0xc4, 0xdb, 0xf7, 0xc2,
-	0x08, 0x12, 0x7f, 0xf5, 0x60, 0xfa, 0x3b, 0x5c, 0x7d, 0xfb, 0xbf, 0x33, 0x30, 0xca, 0xf7, 0x3d,
-	0xaa, 0x43, 0x4a, 0x3c, 0x27, 0xa3, 0xa5, 0x2e, 0x17, 0xbd, 0x98, 0xce, 0xac, 0x9e, 0x3b, 0xad,
-	0x32, 0xc6, 0x5a, 0x79, 0xfe, 0xd7, 0x7f, 0xff, 0x72, 0x38, 0x83, 0xcc, 0x7c, 0xe2, 0x2d, 0x5e,
-	0x3c, 0x54, 0xa3, 0xdf, 0x18, 0x30, 0x9b, 0x78, 0xa4, 0x5e, 0xef, 0x82, 0xde, 0x2e, 0x98, 0xc9,
-	0xf7, 0x28, 0xa8, 0x09, 0xbd, 0xc9, 0x09, 0xad, 0xa2, 0x9b, 0x49, 0x42, 0x81, 0xd6, 0x29, 0x8a,
-	0x83, 0x14, 0xfd, 0xd9, 0x80, 0xc5, 0x73, 0x1e, 0xa2, 0xd1, 0x76, 0x8f, 0xd6, 0x23, 0x3a, 0x99,
-	0xaf, 0xf7, 0xaf, 0xa3, 0xc9, 0x7f, 0x8d, 0x93, 0xdf, 0x46, 0x77, 0x7a, 0x20, 0xcf, 0xdf, 0x13,
-	0x8a, 0xf2, 0xad, 0x1b, 0xfd, 0xcc, 0x80, 0xa9, 0xf8, 0xb3, 0xf2, 0xad, 0x2e, 0x3c, 0x62, 0x52,
-	0x99, 0xb7, 0x7a, 0x91, 0xd2, 0xfc, 0x36, 0x38, 0x3f, 0x0b, 0xad, 0x24, 0xf9, 0x51, 0xa1, 0x50,
-	0xc4, 0xc2, 0x3a, 0xe3, 0x13, 0x7f, 0x5c, 0xbe, 0xd5, 0xcb, 0xc3, 0x79, 0xa6, 0xaf, 0xe7, 0xf5,
-	0xf3, 0xf8, 0x88, 0xc0, 0xa8, 0xe2, 0x16, 0xfd, 0xca, 0x80, 0x99, 0xf6, 0x17, 0x84, 0xb5, 0xf3,
-	0x4b, 0x5d, 0x25, 0x97, 0xc9, 0xf5, 0x26, 0xa7, 0x59, 0xdd, 0xe6, 0xac, 0x6e, 0x21, 0x2b, 0xc9,
-	0x4a, 0x55, 0xbe, 0x25, 0xc5, 0xe1, 0xb3, 0x64, 0xd1, 0xbe, 0xda, 0x53, 0x05, 0x9e, 0xe9, 0xaf,
-	0x50, 0xb7, 0xde, 0xe0, 0xa4, 0x6e, 0xa2, 0x1b, 0xdd, 0x49, 0xa9, 0x58, 0xfd, 0xda, 0x80, 0xd9,
-	0x44, 0x97, 0xb2, 0xde, 0x8b, 0x39, 0x87, 0x74, 0xcf, 0xd8, 0x6e, 0x0d, 0x41, 0x0f, 0xe1, 0xa2,
-	0x9a, 0xda, 0xef, 0x0c, 0x40, 0xc9, 0x2a, 0x1c, 0xbd, 0xd1, 0xc5, 0x66, 0x52, 0x34, 0xb3, 0xd5,
-	0xb3, 0xa8, 0x26, 0xb8, 0xc9, 0x09, 0xae, 0xa3, 0xd5, 0x24, 0xc1, 0x58, 0x6b, 0x2d, 0xc9, 0x34,
-	0x61, 0x5c, 0x95, 0xf6, 0x68, 0xb9, 0x8b, 0x35, 0x25, 0x90, 0x59, 0xbf, 0x40, 0x40, 0x93, 0xb8,
-	0xc9, 0x49, 0x2c, 0xa1, 0xc5, 0x24, 0x89, 0x12, 0xae, 0x14, 0x2b, 0xdc, 0xdc, 0x4f, 0x0c, 0x48,
-	0x47, 0x5b, 0x00, 0xab, 0x6b, 0x36, 0x69, 0x99, 0xcc, 0xed, 0x8b, 0x65, 0x34, 0x89, 0x35, 0x4e,
-	0x62, 0x05, 0x65, 0x3b, 0xe5, 0xdb, 0xa9, 0x7e, 0xe1, 0x44, 0x9f, 0xc2, 0x44, 0xab, 0xb8, 0x5e,
-	0xe9, 0x6e, 0x40, 0x48, 0x64, 0x36, 0x2e, 0x92, 0xd0, 0x04, 0x6e, 0x71, 0x02, 0x59, 0x74, 0xbd,
-	0x33, 0x01, 0x71, 0xa5, 0xa3, 0x10, 0xc6, 0x54, 0x65, 0x9c, 0xed, 0x02, 0x2d, 0xe7, 0x33, 0x6b,
-	0xe7, 0xcf, 0x6b, 0xc3, 0x37, 0xb8, 0xe1, 0x45, 0x74, 0x2d, 0x69, 0xd8, 0x91, 0xa6, 0x3e, 0x4b,
-	0x16, 0x7e, 0xab, 0xe7, 0xa3, 0x4b, 0xb1, 0xae, 0xa9, 0xdc, 0xb9, 0x4a, 0x3d, 0x2f, 0x95, 0x25,
-	0x97, 0x4d, 0x99, 0x38, 0x85, 0xf7, 0x5f, 0xfc, 0x2b, 0x3b, 0xf4, 0xe2, 0x65, 0xd6, 0xf8, 0xfc,
-	0x65, 0xd6, 0xf8, 0xe7, 0xcb, 0xac, 0xf1, 0x8b, 0x57, 0xd9, 0xa1, 0xcf, 0x5f, 0x65, 0x87, 0xfe,
-	0xf6, 0x2a, 0x3b, 0xf4, 0xd1, 0x9d, 0x48, 0xe7, 0xc1, 0xa0, 0x36, 0x3d, 0x12, 0x3e, 0xf3, 0x83,
-	0x63, 0x81, 0x7b, 0x72, 0x37, 0x7f, 0xda, 0x02, 0xe7, 0x7d, 0x48, 0x29, 0xc5, 0xff, 0xb5, 0xfd,
-	0xf6, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xde, 0xb2, 0xe8, 0x2c, 0xe8, 0x1f, 0x00, 0x00,
+	// 2526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0x1b, 0xc9,
+	0xd1, 0xd7, 0x48, 0x16, 0x25, 0x95, 0x5e, 0x74, 0x4b, 0xb2, 0xc7, 0x23, 0x89, 0x92, 0xc7, 0xb6,
+	0xac, 0xf5, 0xae, 0x48, 0x4b, 0x0b, 0x18, 0xdf, 0x7e, 0x79, 0x6c, 0x44, 0x51, 0xbb, 0xd1, 0xae,
+	0x6c, 0xcb, 0x23, 0xcb, 0x86, 0x77, 0x93, 0x9d, 0x34, 0xc9, 0x36, 0x35, 0xd0, 0x70, 0x86, 0x9e,
+	0x19, 0xca, 0x62, 0x80, 0xbd, 0x18, 0xd8, 0x63, 0x1e, 0x8b, 0x20, 0x40, 0x1e, 0xa7, 0x1c, 0x13,
+	0xe4, 0x12, 0x20, 0x40, 0xce, 0x39, 0xc5, 0xc7, 0x45, 0x72, 0x09, 0x02, 0xc4, 0x9b, 0xd8, 0x41,
+	0x0e, 0xfb, 0x37, 0xe4, 0x10, 0x4c, 0xbf, 0x38, 0xe4, 0x90, 0xd4, 0x88, 0x88, 0x4f, 0x62, 0x77,
+	0x57, 0xfd, 0xea, 0xd7, 0xd5, 0xdd, 0xd5, 0x55, 0x3d, 0x82, 0x85, 0x7a, 0x95, 0x90, 0x9c, 0x4d,
+	0x8e, 0x89, 0x87, 0x2b, 0x24, 0x77, 0xbc, 0x9e, 0x7b, 0x52, 0x27, 0x5e, 0x23, 0x5b, 0xf3, 0xdc,
+	0xc0, 0x45, 0xe9, 0x70, 0x34, 0x2b, 0x46, 0xb3, 0xc7, 0xeb, 0xda, 0x42, 0xc5, 0x75, 0x2b, 0x36,
+	0xc9, 0xe1, 0x9a, 0x95, 0xc3, 0x8e, 0xe3, 0x06, 0x38, 0xb0, 0x5c, 0xc7, 0x67, 0xf2, 0x5a, 0x26,
+	0x86, 0x56, 0x21, 0x0e, 0xf1, 0x2d, 0x31, 0xbe, 0x14, 0x1b, 0x97, 0xd8, 0x4c, 0x60, 0xb6, 0xe2,
+	0x56, 0x5c, 0xfa, 0x33, 0x17, 0xfe, 0x12, 0xb0, 0x25, 0xd7, 0xaf, 0xba, 0x7e, 0xae, 0x88, 0xfd,
+	0x50, 0xa9, 0x48, 0x02, 0xbc, 0x9e, 0x2b, 0xb9, 0x96, 0xc3, 0xc7, 0x6f, 0x44, 0xc7, 0x29, 0x7f,
+	0x29, 0x55, 0xc3, 0x15, 0xcb, 0xa1, 0x1c, 0xb9, 0xec, 0x25, 0x26, 0x6b, 0x32, 0x23, 0xac, 0xc1,
+	0x86, 0xf4, 0x49, 0x18, 0xbf, 0x17, 0x2a, 0xef, 0x61, 0x0f, 0x57, 0x7d, 0xfd, 0x36, 0xcc, 0x44,
+	0x9a, 0x06, 0xf1, 0x6b, 0xae, 0xe3, 0x13, 0x74, 0x0b, 0x52, 0x35, 0xda, 0xa3, 0x2a, 0xcb, 0xca,
+	0xea, 0xf8, 0x86, 0x9a, 0x6d, 0x77, 0x52, 0x96, 0x69, 0xe4, 0xcf, 0x3d, 0x7f, 0xb1, 0x34, 0x60,
+	0x70, 0x69, 0xfd, 0x16, 0xcc, 0x51, 0x38, 0x83, 0x54, 0x2c, 0x3f, 0x20, 0x1e, 0x29, 0xdf, 0x77,
+	0x8f, 0x88, 0xe3, 0xa3, 0x45, 0x80, 0x90, 0xb8, 0x59, 0x26, 0x8e, 0x5b, 0xa5, 0xa0, 0x63, 0xc6,
+	0x58, 0xd8, 0x53, 0x08, 0x3b, 0xf4, 0x8f, 0x60, 0xb1, 0xa3, 0x9e, 0x24, 0xf4, 0x0e, 0x8c, 0x7a,
+	0x74, 0xcc, 0x6b, 0xa8, 0xca, 0xf2, 0xd0, 0xea, 0xf8, 0xc6, 0xc5, 0x38, 0x25, 0xaa, 0xc3, 0x19,
+	0x49, 0x71, 0x5d, 0x87, 0xe5, 0x8e, 0xd8, 0x0f, 0xad, 0xe0, 0xf0, 0x36, 0xf6, 0x8e, 0x48, 0xe0,
+	0xeb, 0x16, 0xac, 0x9e, 0x26, 0x23, 0xa9, 0x7c, 0x03, 0x46, 0xaa, 0xac, 0x8b, 0x33, 0x59, 0xec,
+	0xc2, 0x84, 0x29, 0x72, 0x3e, 0x42, 0x47, 0xff, 0xa1, 0x02, 0xe3, 0x91, 0x61, 0xf4, 0x36, 0x0c,
+	0x07, 0x61, 0x93, 0x7b, 0xfa, 0x94, 0x69, 0x31, 0x59, 0xf4, 0x01, 0xa4, 0x18, 0x9e, 0x3a, 0x48,
+	0xb5, 0xde, 0x8a, 0x6b, 0xd1, 0xf9, 0x30, 0x1b, 0xfb, 0xf5, 0x6a, 0x15, 0x7b, 0x0d, 0x31, 0x03,
+	0xb1, 0x66, 0x0c, 0x41, 0xbf, 0x01, 0x88, 0xca, 0xee, 0xd7, 0x48, 0xc9, 0xc2, 0xf6, 0xa6, 0xef,
+	0x93, 0xc0, 0x47, 0xb3, 0x30, 0x1c, 0x5d, 0x2b, 0xd6, 0xd0, 0xbf, 0x03, 0x5a, 0x5c, 0x56, 0x7a,
+	0xe6, 0x9b, 0x30, 0x5c, 0xc3, 0x96, 0x27, 0xfc, 0xa2, 0xc7, 0x49, 0x45, 0xf5, 0xf6, 0xb0, 0xe5,
+	0x89, 0x59, 0x51, 0x35, 0xc9, 0xa4, 0x85, 0x75, 0x17, 0x26, 0xff, 0x99, 0x00, 0x2d, 0x2e, 0x2c,
+	0xa9, 0x5c, 0x86, 0x09, 0xbf, 0x51, 0x2d, 0xba, 0x76, 0xcb, 0x8e, 0x1b, 0x67, 0x7d, 0x74, 0xcf,
+	0x21, 0x0d, 0x46, 0xc9, 0x49, 0xcd, 0x75, 0x88, 0xc3, 0xbc, 0x38, 0x69, 0xc8, 0x36, 0xba, 0x07,
+	0x13, 0xae, 0x87, 0x4b, 0x36, 0x31, 0x6b, 0x9e, 0x55, 0x22, 0xea, 0x50, 0xa8, 0x9e, 0xcf, 0x3e,
+	0x7f, 0xb1, 0xa4, 0xfc, 0xed, 0xc5, 0xd2, 0x4a, 0xc5, 0x0a, 0x0e, 0xeb, 0xc5, 0x6c, 0xc9, 0xad,
+	0xf2, 0xc3, 0xc5, 0xff, 0xac, 0xf9, 0xe5, 0xa3, 0x5c, 0xd0, 0xa8, 0x11, 0x3f, 0x5b, 0x20, 0x25,
+	0x63, 0x9c, 0x61, 0xec, 0x85, 0x10, 0xe8, 0x04, 0x66, 0xeb, 0x74, 0x25, 0x4d, 0x72, 0x52, 0x3a,
+	0xc4, 0x4e, 0x85, 0x98, 0x1e, 0x0e, 0x88, 0x7a, 0x8e, 0x42, 0xbf, 0x17, 0xfa, 0x21, 0x39, 0xf4,
+	0x57, 0x2f, 0x96, 0x66, 0xeb, 0x41, 0x1c, 0xcd, 0x40, 0xcc, 0xc6, 0x36, 0xef, 0x34, 0x70, 0x40,
+	0xd0, 0xc7, 0x00, 0x7e, 0xbd, 0x56, 0xb3, 0x1b, 0xe6, 0xe6, 0xde, 0x23, 0x75, 0x98, 0xda, 0xfb,
+	0xfa, 0x99, 0xed, 0x09, 0x0c, 0x5c, 0x6b, 0x18, 0x63, 0xec, 0xf7, 0xe6, 0xde, 0xa3, 0x10, 0xbc,
+	0xe8, 0x7a, 0x9e, 0xfb, 0x94, 0x82, 0xa7, 0xfa, 0x05, 0xe7, 0x18, 0x14, 0x9c, 0xfd, 0x0e, 0xc1,
+	0x3f, 0x80, 0x51, 0x6a, 0xc9, 0x22, 0x65, 0x75, 0x44, 0x2e, 0x41, 0x52, 0xe8, 0x1d, 0x27, 0x30,
+	0xa4, 0x7e, 0x88, 0xe5, 0x11, 0x9f, 0x78, 0xc7, 0xa4, 0xac, 0x8e, 0xf6, 0x87, 0x25, 0xf4, 0xd1,
+	0x1d, 0x80, 0x92, 0x6b, 0xdb, 0x38, 0x20, 0x1e, 0xb6, 0xd5, 0xb1, 0xbe, 0xd0, 0x22, 0x08, 0x21,
+	0x37, 0x36, 0x69, 0x52, 0x56, 0xa1, 0x3f, 0x6e, 0x42, 0x1f, 0xed, 0xc2, 0x98, 0x6d, 0x3d, 0xa9,
+	0x5b, 0x65, 0x2b, 0x68, 0xa8, 0xe3, 0x7d, 0x81, 0x35, 0x01, 0xd0, 0x01, 0x4c, 0x55, 0xf1, 0x89,
+	0x55, 0xad, 0x57, 0x4d, 0x66, 0x41, 0x9d, 0xe8, 0x0b, 0x72, 0x92, 0xa3, 0xe4, 0x29, 0x08, 0xfa,
+	0x2e, 0x20, 0x01, 0x1b, 0x71, 0xe4, 0x64, 0x5f, 0xd0, 0xe7, 0x39, 0xd2, 0x56, 0xd3, 0x9f, 0x1f,
+	0xc3, 0xf9, 0xaa, 0xe5, 0x50, 0xf8, 0xa6, 0x2f, 0xa6, 0xfa, 0x42, 0x4f, 0x73, 0xa0, 0x5d, 0xe9,
+	0x92, 0x32, 0x4c, 0xf2, 0x83, 0xcc, 0x4e, 0x81, 0x3a, 0x4d, 0x81, 0xdf, 0x3d, 0x1b, 0xf0, 0x57,
+	0x2f, 0x96, 0x26, 0xeb, 0x41, 0x04, 0xc6, 0x98, 0x60, 0xa8, 0xfb, 0xb4, 0x85, 0x1e, 0x41, 0x1a,
+	0x1f, 0x63, 0xcb, 0xc6, 0x45, 0x9b, 0x08, 0xd7, 0xa7, 0xfb, 0x9a, 0xc1, 0xb4, 0xc4, 0x69, 0x3a,
+	0xbf, 0x09, 0xfd, 0xd4, 0x0a, 0x0e, 0xcb, 0x1e, 0x7e, 0xaa, 0x9e, 0xef, 0xcf, 0xf9, 0x12, 0xe9,
+	0x21, 0x07, 0x42, 0x15, 0xb8, 0xd8, 0x84, 0x6f, 0xae, 0xae, 0xf5, 0x7d, 0xa2, 0xa2, 0xbe, 0x6c,
+	0x5c, 0x90, 0x70, 0x5b, 0x51, 0x34, 0x54, 0x84, 0x39, 0x1e, 0xa4, 0x0f, 0x2d, 0x3f, 0x70, 0x3d,
+	0xab, 0xc4, 0xa3, 0xf5, 0x4c, 0x5f, 0xd1, 0x7a, 0x86, 0x81, 0x7d, 0x9b, 0x63, 0xb1, 0xa8, 0x7d,
+	0x01, 0x52, 0xc4, 0xf3, 0x5c, 0xcf, 0x57, 0x67, 0xe9, 0x0d, 0xc2, 0x5b, 0xfa, 0x4d, 0x98, 0xa5,
+	0xb7, 0xcf, 0x66, 0xa9, 0xe4, 0xd6, 0x9d, 0x20, 0x8f, 0x6d, 0xec, 0x94, 0x88, 0x8f, 0x54, 0x18,
+	0xc1, 0xe5, 0xb2, 0x47, 0x7c, 0x9f, 0x5f, 0x39, 0xa2, 0xa9, 0xff, 0x7d, 0x10, 0x16, 0x3a, 0xa9,
+	0xc8, 0x2b, 0xab, 0x12, 0x09, 0x76, 0xec, 0x02, 0xbd, 0x94, 0xe5, 0xa9, 0x5b, 0x98, 0x28, 0x65,
+	0x79, 0xb6, 0x97, 0xdd, 0x72, 0x2d, 0x27, 0x7f, 0x33, 0xf4, 0xe1, 0x6f, 0xbe, 0x5c, 0x5a, 0x4d,
+	0x30, 0xb9, 0x50, 0xc1, 0x8f, 0x44, 0xc2, 0xa3, 0x96, 0xe8, 0x35, 0xf8, 0xbf, 0x37, 0x15, 0x0d,
+	0x6d, 0x95, 0x48, 0x68, 0x1b, 0x7a, 0x0d, 0xb3, 0x12, 0xe0, 0x7a, 0x0e, 0x66, 0xa2, 0xee, 0x15,
+	0xd9, 0x43, 0xf7, 0x05, 0x79, 0x96, 0x82, 0xf9, 0x0e, 0x1a, 0x72, 0x3d, 0x0e, 0x60, 0x4a, 0xb8,
+	0xcc, 0x3c, 0xc6, 0x76, 0x9d, 0xa8, 0x8a, 0xdc, 0x57, 0x67, 0xb8, 0xdd, 0x8c, 0x49, 0x81, 0xf2,
+	0x20, 0x04, 0x09, 0x0f, 0x76, 0xd3, 0x3d, 0x1c, 0x78, 0xb0, 0x2f, 0xe0, 0xe9, 0x26, 0x0e, 0x83,
+	0x3e, 0x80, 0x29, 0xe1, 0x0e, 0x0e, 0x3c, 0xd4, 0x1f, 0x63, 0x81, 0xc2, 0x60, 0xef, 0xc1, 0x04,
+	0xbf, 0x9e, 0x6d, 0xab, 0x6a, 0x05, 0xea, 0x39, 0x09, 0x7a, 0xa6, 0x64, 0x88, 0x61, 0xec, 0x86,
+	0x10, 0xa8, 0x04, 0x73, 0x2c, 0x30, 0xd3, 0xaa, 0xc5, 0x0c, 0x0e, 0x3d, 0xe2, 0x1f, 0xba, 0x76,
+	0x59, 0x1d, 0xee, 0x0b, 0x7b, 0x36, 0x02, 0x76, 0x5f, 0x60, 0xa1, 0x4f, 0x60, 0xc6, 0xaf, 0xb9,
+	0x81, 0xd9, 0xb6, 0x8a, 0xa9, 0xbe, 0x7c, 0x72, 0x3e, 0x84, 0xda, 0x6f, 0x59, 0xc9, 0x22, 0xcc,
+	0x51, 0xfc, 0xd8, 0x72, 0x8e, 0xf4, 0x65, 0x81, 0x92, 0xdd, 0x6a, 0x5b, 0x52, 0x31, 0x87, 0xb6,
+	0x75, 0x1d, 0xed, 0x7f, 0x0e, 0xf9, 0xe8, 0xda, 0xea, 0xbf, 0x50, 0x60, 0x2e, 0x7e, 0x08, 0x2c,
+	0xe2, 0xa3, 0xf7, 0x00, 0x9a, 0x75, 0x25, 0x2f, 0x4e, 0x56, 0x5a, 0x8e, 0x2e, 0x2b, 0xa2, 0xc5,
+	0x01, 0xde, 0xc3, 0x15, 0x62, 0x90, 0x27, 0x75, 0xe2, 0x07, 0x46, 0x44, 0x13, 0xbd, 0x03, 0x23,
+	0xbe, 0xeb, 0x05, 0x66, 0xb1, 0x41, 0xb7, 0xf9, 0xd4, 0xc6, 0x72, 0xbc, 0x2c, 0x10, 0xc6, 0x5d,
+	0x2f, 0xf8, 0x90, 0x34, 0x8c, 0x54, 0xa8, 0x90, 0x6f, 0xe8, 0xcf, 0x14, 0x98, 0x4a, 0x7a, 0x9c,
+	0xd1, 0x03, 0x98, 0xc6, 0x4c, 0xd6, 0xf4, 0x99, 0x30, 0xaf, 0x8d, 0xd6, 0xba, 0xd4, 0x46, 0x9d,
+	0x8f, 0xbd, 0x31, 0x85, 0x5b, 0xfa, 0xf5, 0x3f, 0x28, 0xb0, 0x18, 0x97, 0xb7, 0x22, 0x81, 0xfb,
+	0x36, 0x9c, 0x6f, 0xb5, 0x6c, 0x11, 0x51, 0x02, 0xf5, 0x98, 0x2b, 0x37, 0x9b, 0xc6, 0xed, 0x8e,
+	0x7f, 0xbf, 0xc5, 0xf1, 0x6c, 0x0e, 0xd7, 0x4f, 0x75, 0x3c, 0x67, 0x1f, 0x51, 0xd5, 0x2f, 0xc1,
+	0x45, 0x4a, 0x7c, 0x37, 0x72, 0x38, 0xb0, 0x57, 0x09, 0x8b, 0xd0, 0xaf, 0xc1, 0x52, 0x97, 0x21,
+	0x39, 0x2b, 0x15, 0x46, 0x02, 0xd6, 0x45, 0xe7, 0x32, 0x66, 0x88, 0xa6, 0x3e, 0x0d, 0x93, 0x54,
+	0x39, 0x8f, 0xcb, 0x05, 0x52, 0x0c, 0x7c, 0xdd, 0x80, 0xb9, 0x96, 0x8e, 0x48, 0xd5, 0xde, 0x82,
+	0x11, 0xc6, 0xfe, 0x98, 0x3f, 0xb8, 0x92, 0x28, 0x93, 0x85, 0x91, 0x3c, 0xa4, 0x79, 0x79, 0x77,
+	0x22, 0x33, 0x8b, 0xee, 0x8b, 0x2f, 0x6b, 0xc4, 0xc1, 0x68, 0x8d, 0xf8, 0x6f, 0x05, 0xd4, 0x76,
+	0x10, 0xc9, 0x8d, 0xc0, 0x08, 0x4b, 0xb8, 0xfc, 0xd7, 0x71, 0xdb, 0x0a, 0x6c, 0x54, 0x82, 0x54,
+	0xc0, 0xac, 0xbc, 0x86, 0x8b, 0x96, 0x43, 0xeb, 0xdf, 0x82, 0x29, 0x31, 0x4f, 0x9e, 0xe3, 0x9d,
+	0xd5, 0x55, 0x9f, 0xc2, 0x85, 0x56, 0x04, 0xe9, 0xa7, 0xe6, 0x04, 0x94, 0xd7, 0x37, 0x81, 0x1f,
+	0x0d, 0xc2, 0x04, 0xb5, 0xbf, 0xe3, 0xf8, 0x35, 0x52, 0x0a, 0xc2, 0xbc, 0x8b, 0xd5, 0xea, 0x9c,
+	0x3e, 0x6f, 0x85, 0x45, 0xbb, 0x4c, 0x27, 0xc2, 0x09, 0x28, 0x91, 0xca, 0x27, 0xd3, 0x92, 0xd7,
+	0x0c, 0xd1, 0xd1, 0x48, 0x4f, 0x88, 0x59, 0x0e, 0x8b, 0x62, 0x8f, 0xde, 0x60, 0x8a, 0xc1, 0x5b,
+	0x28, 0x0d, 0x43, 0x76, 0x70, 0x4c, 0xaf, 0x1e, 0xc5, 0x08, 0x7f, 0x46, 0x63, 0x56, 0xea, 0x6c,
+	0x31, 0xab, 0x2d, 0x6c, 0x8e, 0xf4, 0x1b, 0x36, 0x65, 0x3a, 0xc3, 0x1d, 0xc2, 0xad, 0xf5, 0x48,
+	0x67, 0xfe, 0xa8, 0xc0, 0x6c, 0x54, 0x43, 0x2e, 0x60, 0x01, 0x78, 0x45, 0x4d, 0xbc, 0x1e, 0x61,
+	0xa9, 0xd5, 0x0e, 0x3f, 0x8d, 0x4d, 0xc5, 0xd0, 0xf1, 0x8f, 0xb1, 0x65, 0xd7, 0x3d, 0xc2, 0x76,
+	0xf2, 0x98, 0x21, 0xdb, 0x6d, 0x11, 0x6b, 0xa8, 0xff, 0x88, 0x85, 0x79, 0x46, 0xd6, 0x4a, 0x46,
+	0xce, 0x24, 0x2f, 0x17, 0xdf, 0xe3, 0x17, 0x52, 0xd2, 0x89, 0x48, 0x3d, 0xfd, 0x77, 0x0a, 0x4c,
+	0x25, 0xf5, 0x29, 0xba, 0x05, 0xa3, 0xd8, 0xc1, 0x76, 0xc3, 0xb7, 0x7c, 0x1e, 0x88, 0xb5, 0xb8,
+	0x41, 0xc3, 0xf2, 0x8f, 0x76, 0x9c, 0xc7, 0xae, 0x21, 0x65, 0xc3, 0xd7, 0xca, 0x9a, 0xeb, 0x5b,
+	0x11, 0x77, 0x74, 0x78, 0x23, 0x2c, 0x90, 0x92, 0xac, 0x01, 0xa4, 0x38, 0x42, 0x70, 0xce, 0x72,
+	0x1e, 0xbb, 0x2c, 0xc9, 0x32, 0xe8, 0x6f, 0xfd, 0x13, 0x18, 0x15, 0x46, 0xc2, 0x75, 0x10, 0x37,
+	0x38, 0x65, 0xab, 0x18, 0xb2, 0x8d, 0x96, 0x61, 0x3c, 0x12, 0xd0, 0xf9, 0xf9, 0x88, 0x76, 0x85,
+	0x87, 0xff, 0x81, 0x4c, 0x0c, 0x15, 0x83, 0x35, 0xf4, 0x5f, 0x2a, 0x30, 0x1e, 0x61, 0x13, 0xae,
+	0x67, 0xe4, 0x20, 0xb1, 0x2d, 0x73, 0xb9, 0xc3, 0x0b, 0x30, 0xe7, 0xcc, 0xf5, 0xb8, 0xab, 0xa3,
+	0x27, 0x6e, 0xab, 0xe5, 0xb4, 0x9e, 0x09, 0xa6, 0x99, 0xd8, 0x7f, 0xa9, 0xc0, 0x74, 0x9b, 0x4c,
+	0xe7, 0x37, 0xc1, 0xb6, 0x47, 0xe6, 0xc1, 0xb6, 0x47, 0x66, 0xb4, 0x03, 0x29, 0x5c, 0x0d, 0x57,
+	0x9c, 0xa7, 0xc5, 0xeb, 0x3c, 0x7d, 0x9a, 0x67, 0x3b, 0xd5, 0x2f, 0x1f, 0x65, 0x2d, 0x37, 0x57,
+	0xc5, 0xc1, 0x61, 0x76, 0x97, 0x54, 0x70, 0xa9, 0x51, 0x20, 0xa5, 0x3f, 0xff, 0x7e, 0x0d, 0xd8,
+	0x30, 0xcd, 0xa0, 0x38, 0x00, 0xda, 0x85, 0x71, 0x6a, 0x89, 0xe3, 0xb1, 0x8c, 0xf8, 0x4d, 0x8e,
+	0x37, 0x17, 0xc7, 0xdb, 0x71, 0x82, 0x08, 0x12, 0x7d, 0xfe, 0x09, 0xf5, 0x37, 0xa9, 0xba, 0x2c,
+	0x26, 0x0d, 0xf2, 0x98, 0x78, 0x1e, 0xb6, 0x0d, 0xf2, 0x14, 0x7b, 0xe5, 0x5e, 0xc5, 0xe4, 0x67,
+	0x0a, 0x2c, 0x74, 0x52, 0x89, 0xde, 0x6e, 0x1e, 0xeb, 0x7a, 0x2d, 0xb7, 0x1b, 0xc7, 0xd6, 0x57,
+	0xf8, 0xc5, 0xb3, 0x69, 0xdb, 0x61, 0x6a, 0xef, 0x07, 0x5d, 0x5e, 0x6b, 0x77, 0xe1, 0x42, 0xab,
+	0x9c, 0x24, 0x3a, 0x0b, 0xc3, 0xb8, 0x5c, 0xb5, 0x1c, 0x21, 0x4f, 0x1b, 0x68, 0x01, 0xc6, 0xf8,
+	0x54, 0x65, 0xb8, 0x69, 0x76, 0xe8, 0xeb, 0x3c, 0xdf, 0x28, 0x10, 0xb1, 0x8f, 0xee, 0x7a, 0x65,
+	0xe2, 0xf5, 0x72, 0xd8, 0xf7, 0x60, 0xb1, 0xa3, 0x8a, 0xe4, 0xf1, 0x2e, 0xa4, 0x5c, 0xda, 0xd3,
+	0x7d, 0xbf, 0xb7, 0xe9, 0x8a, 0x67, 0x74, 0xa6, 0x76, 0xe3, 0xb7, 0x91, 0x64, 0x95, 0xdd, 0x09,
+	0x68, 0x19, 0x16, 0x36, 0xb7, 0xb6, 0xee, 0x1e, 0xdc, 0xb9, 0x6f, 0xee, 0xdf, 0x35, 0xee, 0x9b,
+	0x1f, 0x6e, 0x3f, 0x32, 0x0f, 0xee, 0xec, 0xef, 0x6d, 0x6f, 0xed, 0xbc, 0xb7, 0xb3, 0x5d, 0x48,
+	0x0f, 0xa0, 0x05, 0x50, 0x63, 0x12, 0x9b, 0x85, 0x82, 0xb1, 0xbd, 0xbf, 0x9f, 0x56, 0xd0, 0x15,
+	0x58, 0x8a, 0x8d, 0xe6, 0xef, 0x1a, 0xc6, 0xdd, 0x87, 0xdb, 0x05, 0xf3, 0xc1, 0xe6, 0xee, 0xc1,
+	0x76, 0x7a, 0x10, 0xa9, 0x30, 0x1b, 0x13, 0xda, 0xbd, 0xff, 0x20, 0x3d, 0x84, 0xe6, 0xe1, 0x62,
+	0x6c, 0xa4, 0xb0, 0x79, 0xe7, 0xfd, 0x6d, 0x23, 0x7d, 0x6e, 0xe3, 0xd7, 0x33, 0x30, 0x4c, 0x3d,
+	0x82, 0x6a, 0x90, 0x62, 0xdf, 0x72, 0xd0, 0x62, 0x97, 0x4c, 0x99, 0x0d, 0x6b, 0xd7, 0x7a, 0x0e,
+	0x0b, 0x4f, 0xea, 0xcb, 0xcf, 0xfe, 0xf2, 0xaf, 0x9f, 0x0c, 0x6a, 0x48, 0xcd, 0xc5, 0x3e, 0x84,
+	0xb1, 0xaf, 0x44, 0xe8, 0xe7, 0x0a, 0xa4, 0x63, 0x5f, 0x88, 0xae, 0x77, 0x41, 0x6f, 0x17, 0xd4,
+	0x72, 0x09, 0x05, 0x25, 0xa1, 0x37, 0x29, 0xa1, 0x6b, 0xe8, 0x4a, 0x9c, 0x90, 0x27, 0x75, 0x4c,
+	0x96, 0x89, 0xa0, 0x3f, 0x29, 0x30, 0xdf, 0xe3, 0x2b, 0x10, 0xda, 0x48, 0x68, 0x3d, 0xa2, 0xa3,
+	0xfd, 0xff, 0xd9, 0x75, 0x24, 0xf9, 0xff, 0xa3, 0xe4, 0x37, 0xd0, 0xcd, 0x04, 0xe4, 0xe9, 0x63,
+	0x9e, 0xc9, 0x3f, 0x34, 0xa1, 0x1f, 0x28, 0x30, 0xd9, 0xfa, 0x4d, 0xe7, 0x6a, 0x17, 0x1e, 0x2d,
+	0x52, 0xda, 0x5b, 0x49, 0xa4, 0x24, 0xbf, 0x55, 0xca, 0x4f, 0x47, 0xcb, 0x71, 0x7e, 0x3e, 0x53,
+	0x30, 0xb1, 0xef, 0x0b, 0x3e, 0xad, 0x5f, 0x76, 0xae, 0x26, 0xf9, 0x6a, 0xa5, 0x9d, 0xe9, 0xdb,
+	0x56, 0x2f, 0x3e, 0xcc, 0x31, 0xa2, 0x3a, 0x44, 0x3f, 0x55, 0x60, 0xba, 0xfd, 0xf9, 0x6e, 0xa5,
+	0x77, 0xad, 0x28, 0xe4, 0xb4, 0x6c, 0x32, 0x39, 0xc9, 0xea, 0x06, 0x65, 0x75, 0x15, 0xe9, 0x71,
+	0x56, 0xa2, 0x74, 0x2c, 0x0a, 0x0e, 0x9f, 0xc7, 0xab, 0xde, 0x6b, 0x89, 0x4a, 0x58, 0xed, 0x6c,
+	0x95, 0xae, 0xfe, 0x06, 0x25, 0x75, 0x05, 0x5d, 0xee, 0x4e, 0x4a, 0xf8, 0xea, 0x67, 0x0a, 0xa4,
+	0x63, 0x2f, 0x04, 0xd7, 0x93, 0x98, 0xb3, 0x48, 0xf7, 0x13, 0xdb, 0xad, 0xa2, 0x4e, 0xe0, 0x2e,
+	0x5f, 0x52, 0xfb, 0x95, 0x02, 0x28, 0x5e, 0xc6, 0xa2, 0x37, 0xba, 0xd8, 0x8c, 0x8b, 0x6a, 0xeb,
+	0x89, 0x45, 0x25, 0xc1, 0x35, 0x4a, 0xf0, 0x3a, 0xba, 0x16, 0x27, 0xd8, 0xf2, 0xae, 0xc5, 0xc9,
+	0x34, 0x60, 0x54, 0xd4, 0xc6, 0x68, 0xa9, 0x8b, 0x35, 0x21, 0xa0, 0x5d, 0x3f, 0x45, 0x40, 0x92,
+	0xb8, 0x42, 0x49, 0x2c, 0xa2, 0xf9, 0x38, 0x89, 0x22, 0x2e, 0x9b, 0x65, 0x6a, 0xee, 0x33, 0x05,
+	0xc6, 0xa3, 0x35, 0xb4, 0xde, 0xf5, 0x34, 0x49, 0x19, 0xed, 0xc6, 0xe9, 0x32, 0x92, 0xc4, 0x0a,
+	0x25, 0xb1, 0x8c, 0x32, 0x9d, 0xce, 0xdb, 0x89, 0xfc, 0xbc, 0x80, 0x3e, 0x85, 0xb1, 0x66, 0x75,
+	0xba, 0xdc, 0xdd, 0x00, 0x93, 0xd0, 0x56, 0x4f, 0x93, 0x90, 0x04, 0xae, 0x52, 0x02, 0x19, 0xb4,
+	0xd0, 0x99, 0x00, 0x4b, 0x23, 0x51, 0x00, 0x23, 0xa2, 0xb4, 0xcc, 0x74, 0x81, 0xe6, 0xe3, 0xda,
+	0x4a, 0xef, 0x71, 0x69, 0xf8, 0x32, 0x35, 0x3c, 0x8f, 0x2e, 0xc5, 0x0d, 0x5b, 0xdc, 0xd4, 0xe7,
+	0xf1, 0x62, 0xe3, 0x5a, 0x6f, 0x74, 0x2e, 0xa6, 0xad, 0x25, 0x12, 0x4b, 0x72, 0x94, 0x39, 0x97,
+	0x35, 0x7e, 0x70, 0x68, 0xd8, 0x6b, 0x4f, 0x34, 0x57, 0xba, 0x5e, 0x50, 0x2d, 0x72, 0x5a, 0x36,
+	0x99, 0x5c, 0x92, 0x73, 0xec, 0x71, 0x15, 0x93, 0xa7, 0x92, 0xe1, 0x06, 0x69, 0x66, 0x91, 0xdd,
+	0x36, 0x88, 0x94, 0xd0, 0x56, 0x4f, 0x93, 0x48, 0xb2, 0x41, 0x70, 0x28, 0x6c, 0xda, 0xa1, 0xc5,
+	0x30, 0x27, 0x89, 0xe5, 0x93, 0xdd, 0x8e, 0x62, 0xbb, 0xa0, 0x96, 0x4b, 0x28, 0x98, 0x24, 0x27,
+	0x29, 0x4b, 0x1d, 0x93, 0xa5, 0x96, 0xf9, 0x3b, 0xcf, 0xff, 0x99, 0x19, 0x78, 0xfe, 0x32, 0xa3,
+	0x7c, 0xf1, 0x32, 0xa3, 0xfc, 0xe3, 0x65, 0x46, 0xf9, 0xf1, 0xab, 0xcc, 0xc0, 0x17, 0xaf, 0x32,
+	0x03, 0x7f, 0x7d, 0x95, 0x19, 0xf8, 0xe8, 0x66, 0x24, 0x6d, 0x0f, 0xc1, 0xd6, 0x1c, 0x12, 0x3c,
+	0x75, 0xbd, 0x23, 0x86, 0x7c, 0x7c, 0x2b, 0x77, 0xd2, 0x84, 0xa7, 0x49, 0x7c, 0x31, 0x45, 0xff,
+	0x15, 0xe8, 0xed, 0xff, 0x0e, 0x00, 0x48, 0x0e, 0x9f, 0x24, 0x18, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1672,6 +1987,7 @@ type QueryClient interface {
 	// AccountSummary queries USD values representing an account's total positions and borrowing limits. It requires oracle prices to return successfully.
 	AccountSummary(ctx context.Context, in *QueryAccountSummary, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error)
 	// AccountSummaries queries USD values representing an account's total positions and borrowing limits. It requires oracle prices to return successfully.
+	// Accounts are returned in address order, or when sorted, only accounts with open borrows are returned.
 	AccountSummaries(ctx context.Context, in *QueryAccountSummaries, opts ...grpc.CallOption) (*QueryAccountSummariesResponse, error)
 	// LiquidationTargets queries a list of all borrower account addresses eligible for liquidation.
 	LiquidationTargets(ctx context.Context, in *QueryLiquidationTargets, opts ...grpc.CallOption) (*QueryLiquidationTargetsResponse, error)
@@ -1687,9 +2003,16 @@ type QueryClient interface {
 	// progress toward liquidation threshold, and minimum LTV. Each account is displayed
 	// with its address and borrowed/liquidation/collateral USD values, as well as its
 	// actual token positions in human-readable symbol denoms instead of uTokens or ibc denoms.
+	// Results are paginated, and can be sorted by address to stream pages without evaluating every borrower.
 	Inspect(ctx context.Context, in *QueryInspect, opts ...grpc.CallOption) (*QueryInspectResponse, error)
 	// InspectAccount runs the inspect query on a single address
 	InspectAccount(ctx context.Context, in *QueryInspectAccount, opts ...grpc.CallOption) (*QueryInspectAccountResponse, error)
+	// ReferralRewards queries the unclaimed referral rewards of a referrer.
+	ReferralRewards(ctx context.Context, in *QueryReferralRewards, opts ...grpc.CallOption) (*QueryReferralRewardsResponse, error)
+	// AllowList queries the accounts allowed to use a permissioned Token.
+	AllowList(ctx context.Context, in *QueryAllowList, opts ...grpc.CallOption) (*QueryAllowListResponse, error)
+	// DeleverageOrders queries the deleverage orders of a borrower.
+	DeleverageOrders(ctx context.Context, in *QueryDeleverageOrders, opts ...grpc.CallOption) (*QueryDeleverageOrdersResponse, error)
 }
 
 type queryClient struct {
@@ -1826,6 +2149,33 @@ func (c *queryClient) InspectAccount(ctx context.Context, in *QueryInspectAccoun
 	return out, nil
 }
 
+func (c *queryClient) ReferralRewards(ctx context.Context, in *QueryReferralRewards, opts ...grpc.CallOption) (*QueryReferralRewardsResponse, error) {
+	out := new(QueryReferralRewardsResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Query/ReferralRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowList(ctx context.Context, in *QueryAllowList, opts ...grpc.CallOption) (*QueryAllowListResponse, error) {
+	out := new(QueryAllowListResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Query/AllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeleverageOrders(ctx context.Context, in *QueryDeleverageOrders, opts ...grpc.CallOption) (*QueryDeleverageOrdersResponse, error) {
+	out := new(QueryDeleverageOrdersResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Query/DeleverageOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the x/leverage module.
@@ -1843,6 +2193,7 @@ type QueryServer interface {
 	// AccountSummary queries USD values representing an account's total positions and borrowing limits. It requires oracle prices to return successfully.
 	AccountSummary(context.Context, *QueryAccountSummary) (*QueryAccountSummaryResponse, error)
 	// AccountSummaries queries USD values representing an account's total positions and borrowing limits. It requires oracle prices to return successfully.
+	// Accounts are returned in address order, or when sorted, only accounts with open borrows are returned.
 	AccountSummaries(context.Context, *QueryAccountSummaries) (*QueryAccountSummariesResponse, error)
 	// LiquidationTargets queries a list of all borrower account addresses eligible for liquidation.
 	LiquidationTargets(context.Context, *QueryLiquidationTargets) (*QueryLiquidationTargetsResponse, error)
@@ -1858,9 +2209,16 @@ type QueryServer interface {
 	// progress toward liquidation threshold, and minimum LTV. Each account is displayed
 	// with its address and borrowed/liquidation/collateral USD values, as well as its
 	// actual token positions in human-readable symbol denoms instead of uTokens or ibc denoms.
+	// Results are paginated, and can be sorted by address to stream pages without evaluating every borrower.
 	Inspect(context.Context, *QueryInspect) (*QueryInspectResponse, error)
 	// InspectAccount runs the inspect query on a single address
 	InspectAccount(context.Context, *QueryInspectAccount) (*QueryInspectAccountResponse, error)
+	// ReferralRewards queries the unclaimed referral rewards of a referrer.
+	ReferralRewards(context.Context, *QueryReferralRewards) (*QueryReferralRewardsResponse, error)
+	// AllowList queries the accounts allowed to use a permissioned Token.
+	AllowList(context.Context, *QueryAllowList) (*QueryAllowListResponse, error)
+	// DeleverageOrders queries the deleverage orders of a borrower.
+	DeleverageOrders(context.Context, *QueryDeleverageOrders) (*QueryDeleverageOrdersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1909,6 +2267,15 @@ func (*UnimplementedQueryServer) Inspect(ctx context.Context, req *QueryInspect)
 func (*UnimplementedQueryServer) InspectAccount(ctx context.Context, req *QueryInspectAccount) (*QueryInspectAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectAccount not implemented")
 }
+func (*UnimplementedQueryServer) ReferralRewards(ctx context.Context, req *QueryReferralRewards) (*QueryReferralRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferralRewards not implemented")
+}
+func (*UnimplementedQueryServer) AllowList(ctx context.Context, req *QueryAllowList) (*QueryAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowList not implemented")
+}
+func (*UnimplementedQueryServer) DeleverageOrders(ctx context.Context, req *QueryDeleverageOrders) (*QueryDeleverageOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleverageOrders not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2166,6 +2533,60 @@ func _Query_InspectAccount_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReferralRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReferralRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Query/ReferralRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferralRewards(ctx, req.(*QueryReferralRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Query/AllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowList(ctx, req.(*QueryAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeleverageOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeleverageOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeleverageOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Query/DeleverageOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeleverageOrders(ctx, req.(*QueryDeleverageOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "umee.leverage.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2226,6 +2647,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InspectAccount",
 			Handler:    _Query_InspectAccount_Handler,
 		},
+		{
+			MethodName: "ReferralRewards",
+			Handler:    _Query_ReferralRewards_Handler,
+		},
+		{
+			MethodName: "AllowList",
+			Handler:    _Query_AllowList_Handler,
+		},
+		{
+			MethodName: "DeleverageOrders",
+			Handler:    _Query_DeleverageOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "umee/leverage/v1/query.proto",
@@ -3032,6 +3465,11 @@ func (m *QueryAccountSummaries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SortBy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SortBy))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -3435,6 +3873,23 @@ func (m *QueryInspect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SortBy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SortBy))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Ltv != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Ltv))))
@@ -3519,6 +3974,18 @@ func (m *QueryInspectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Failures) > 0 {
 		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Failures[iNdEx])
@@ -3788,23 +4255,226 @@ func (m *PositionBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryReferralRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *QueryReferralRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeleverageOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeleverageOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeleverageOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeleverageOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeleverageOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeleverageOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -4075,6 +4745,9 @@ func (m *QueryAccountSummaries) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.SortBy != 0 {
+		n += 1 + sovQuery(uint64(m.SortBy))
+	}
 	return n
 }
 
@@ -4254,6 +4927,13 @@ func (m *QueryInspect) Size() (n int) {
 	if m.Ltv != 0 {
 		n += 9
 	}
+	if m.SortBy != 0 {
+		n += 1 + sovQuery(uint64(m.SortBy))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4288,6 +4968,10 @@ func (m *QueryInspectResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4387,54 +5071,142 @@ func (m *PositionBalance) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryReferralRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
+
+func (m *QueryReferralRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeleverageOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeleverageOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -6612,6 +7384,25 @@ func (m *QueryAccountSummaries) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			m.SortBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortBy |= AccountSortKey(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7672,6 +8463,61 @@ func (m *QueryInspect) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Ltv = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			m.SortBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortBy |= AccountSortKey(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7870,6 +8716,42 @@ func (m *QueryInspectResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Failures = append(m.Failures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8543,6 +9425,534 @@ func (m *PositionBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReferralRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReferralRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReferralRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReferralRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeleverageOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeleverageOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeleverageOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeleverageOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeleverageOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeleverageOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, DeleverageOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
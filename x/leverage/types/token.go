@@ -123,6 +123,12 @@ func (t Token) Validate() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("Token.MaxSupply must not be negative")
 	}
 
+	if t.AllowListAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(t.AllowListAdmin); err != nil {
+			return fmt.Errorf("allow_list_admin: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -150,6 +156,11 @@ func (t Token) AssertNotBlacklisted() error {
 	return nil
 }
 
+// IsPermissioned returns true if a Token can only be used by the accounts in its allow list.
+func (t Token) IsPermissioned() bool {
+	return t.AllowListAdmin != ""
+}
+
 // BorrowFactor returns the minimum of 2.0 or 1 / collateralWeight.
 func (t Token) BorrowFactor() sdk.Dec {
 	if t.CollateralWeight.LTE(halfDec) {
@@ -63,6 +63,7 @@ addtokens:
       min_collateral_liquidity: "1.000000000000000000"
       max_supply: "1000"
       historic_medians: 24
+      allow_list_admin: ""
 updatetokens: []
 `
 	assert.Equal(t, expected, p.String())
@@ -130,6 +131,12 @@ func TestTokenValidate(t *testing.T) {
 	validMaxSupply2 := validToken()
 	validMaxSupply2.MaxSupply = sdk.NewInt(0)
 
+	invalidAllowListAdmin := validToken()
+	invalidAllowListAdmin.AllowListAdmin = "admin"
+
+	validAllowListAdmin := validToken()
+	validAllowListAdmin.AllowListAdmin = "umee1s84d29zk3k20xk9f0hvczkax90l9t94g72n6wm"
+
 	testCases := map[string]struct {
 		input     types.Token
 		expectErr bool
@@ -197,6 +204,13 @@ func TestTokenValidate(t *testing.T) {
 			input:     invalidMaxSupplyUtilization,
 			expectErr: true,
 		},
+		"invalid allow list admin": {
+			input:     invalidAllowListAdmin,
+			expectErr: true,
+		},
+		"valid allow list admin": {
+			input: validAllowListAdmin,
+		},
 		"invalid min collateral liquidity": {
 			input:     invalidMinCollateralLiquidity,
 			expectErr: true,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/umee-network/umee/v6/util/checkers"
 )
@@ -16,7 +17,10 @@ func NewMsgSupply(supplier sdk.AccAddress, asset sdk.Coin) *MsgSupply {
 }
 
 func (msg *MsgSupply) ValidateBasic() error {
-	return validateSenderAndAsset(msg.Supplier, &msg.Asset)
+	if err := validateSenderAndAsset(msg.Supplier, &msg.Asset); err != nil {
+		return err
+	}
+	return validateReferrer(msg.Supplier, msg.Referrer)
 }
 
 func (msg *MsgSupply) GetSigners() []sdk.AccAddress {
@@ -108,7 +112,10 @@ func NewMsgSupplyCollateral(supplier sdk.AccAddress, asset sdk.Coin) *MsgSupplyC
 }
 
 func (msg *MsgSupplyCollateral) ValidateBasic() error {
-	return validateSenderAndAsset(msg.Supplier, &msg.Asset)
+	if err := validateSenderAndAsset(msg.Supplier, &msg.Asset); err != nil {
+		return err
+	}
+	return validateReferrer(msg.Supplier, msg.Referrer)
 }
 
 func (msg *MsgSupplyCollateral) GetSigners() []sdk.AccAddress {
@@ -153,7 +160,10 @@ func NewMsgBorrow(borrower sdk.AccAddress, asset sdk.Coin) *MsgBorrow {
 }
 
 func (msg *MsgBorrow) ValidateBasic() error {
-	return validateSenderAndAsset(msg.Borrower, &msg.Asset)
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Asset); err != nil {
+		return err
+	}
+	return validateReferrer(msg.Borrower, msg.Referrer)
 }
 
 func (msg *MsgBorrow) GetSigners() []sdk.AccAddress {
@@ -289,6 +299,129 @@ func (msg MsgLeveragedLiquidate) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
+func NewMsgClaimReferralRewards(referrer sdk.AccAddress) *MsgClaimReferralRewards {
+	return &MsgClaimReferralRewards{
+		Referrer: referrer.String(),
+	}
+}
+
+func (msg *MsgClaimReferralRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Referrer)
+	return err
+}
+
+func (msg *MsgClaimReferralRewards) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Referrer)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgClaimReferralRewards) Route() string { return "" }
+func (msg MsgClaimReferralRewards) Type() string  { return sdk.MsgTypeURL(&msg) }
+func (msg MsgClaimReferralRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func NewMsgUpdateAllowList(admin sdk.AccAddress, denom string, add, remove []sdk.AccAddress,
+) *MsgUpdateAllowList {
+	toStrings := func(addrs []sdk.AccAddress) []string {
+		s := make([]string, len(addrs))
+		for i, a := range addrs {
+			s[i] = a.String()
+		}
+		return s
+	}
+	return &MsgUpdateAllowList{
+		Admin:  admin.String(),
+		Denom:  denom,
+		Add:    toStrings(add),
+		Remove: toStrings(remove),
+	}
+}
+
+func (msg *MsgUpdateAllowList) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return err
+	}
+	if err := ValidateBaseDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty add and remove lists")
+	}
+	seen := map[string]bool{}
+	for _, addr := range append(append([]string{}, msg.Add...), msg.Remove...) {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if seen[addr] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+func (msg *MsgUpdateAllowList) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Admin)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgUpdateAllowList) Route() string { return "" }
+func (msg MsgUpdateAllowList) Type() string  { return sdk.MsgTypeURL(&msg) }
+func (msg MsgUpdateAllowList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func NewMsgCreateDeleverageOrder(borrower sdk.AccAddress, denom string, triggerRatio, targetRatio sdk.Dec,
+) *MsgCreateDeleverageOrder {
+	return &MsgCreateDeleverageOrder{
+		Borrower:     borrower.String(),
+		Denom:        denom,
+		TriggerRatio: triggerRatio,
+		TargetRatio:  targetRatio,
+	}
+}
+
+func (msg *MsgCreateDeleverageOrder) ValidateBasic() error {
+	return NewDeleverageOrder(msg.Borrower, msg.Denom, msg.TriggerRatio, msg.TargetRatio).Validate()
+}
+
+func (msg *MsgCreateDeleverageOrder) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Borrower)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgCreateDeleverageOrder) Route() string { return "" }
+func (msg MsgCreateDeleverageOrder) Type() string  { return sdk.MsgTypeURL(&msg) }
+func (msg MsgCreateDeleverageOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func NewMsgCancelDeleverageOrder(borrower sdk.AccAddress, denom string) *MsgCancelDeleverageOrder {
+	return &MsgCancelDeleverageOrder{
+		Borrower: borrower.String(),
+		Denom:    denom,
+	}
+}
+
+func (msg *MsgCancelDeleverageOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return err
+	}
+	return ValidateBaseDenom(msg.Denom)
+}
+
+func (msg *MsgCancelDeleverageOrder) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Borrower)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgCancelDeleverageOrder) Route() string { return "" }
+func (msg MsgCancelDeleverageOrder) Type() string  { return sdk.MsgTypeURL(&msg) }
+func (msg MsgCancelDeleverageOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
 // -- helper methods -- //
 
 func validateSenderAndAsset(sender string, asset *sdk.Coin) error {
@@ -309,3 +442,17 @@ func validateSenderAndDenom(sender string, denom string) error {
 	}
 	return sdk.ValidateDenom(denom)
 }
+
+// validateReferrer validates an optional referrer, which can't be the sender itself.
+func validateReferrer(sender, referrer string) error {
+	if referrer == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(referrer); err != nil {
+		return err
+	}
+	if sender == referrer {
+		return ErrSelfReferral
+	}
+	return nil
+}
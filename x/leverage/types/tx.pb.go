@@ -37,6 +37,9 @@ type MsgSupply struct {
 	// Supplier is the account address supplying assets and the signer of the message.
 	Supplier string     `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
 	Asset    types.Coin `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
+	// Referrer is an optional address of the frontend which routed the supplier to the module.
+	// It is recorded only if the account does not have a referrer yet, and can't be changed later.
+	Referrer string `protobuf:"bytes,3,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgSupply) Reset()         { *m = MsgSupply{} }
@@ -263,6 +266,9 @@ type MsgBorrow struct {
 	// of the message.
 	Borrower string     `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
 	Asset    types.Coin `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
+	// Referrer is an optional address of the frontend which routed the borrower to the module.
+	// It is recorded only if the account does not have a referrer yet, and can't be changed later.
+	Referrer string `protobuf:"bytes,3,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgBorrow) Reset()         { *m = MsgBorrow{} }
@@ -509,6 +515,9 @@ type MsgSupplyCollateral struct {
 	// Supplier is the account address supplying assets and the signer of the message.
 	Supplier string     `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
 	Asset    types.Coin `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
+	// Referrer is an optional address of the frontend which routed the supplier to the module.
+	// It is recorded only if the account does not have a referrer yet, and can't be changed later.
+	Referrer string `protobuf:"bytes,3,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgSupplyCollateral) Reset()         { *m = MsgSupplyCollateral{} }
@@ -548,6 +557,192 @@ func (*MsgSupplyCollateral) XXX_MessageName() string {
 	return "umee.leverage.v1.MsgSupplyCollateral"
 }
 
+// MsgClaimReferralRewards represents a referrer's request to claim accumulated referral rewards.
+type MsgClaimReferralRewards struct {
+	// Referrer is the account address claiming rewards and the signer of the message.
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *MsgClaimReferralRewards) Reset()         { *m = MsgClaimReferralRewards{} }
+func (m *MsgClaimReferralRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReferralRewards) ProtoMessage()    {}
+func (*MsgClaimReferralRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{11}
+}
+func (m *MsgClaimReferralRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReferralRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReferralRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReferralRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReferralRewards.Merge(m, src)
+}
+func (m *MsgClaimReferralRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReferralRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReferralRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimReferralRewards proto.InternalMessageInfo
+
+func (*MsgClaimReferralRewards) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgClaimReferralRewards"
+}
+
+// MsgUpdateAllowList represents a permissioned Token's allow list admin adding or removing
+// accounts from the allow list.
+type MsgUpdateAllowList struct {
+	// Admin must match the Token's allow_list_admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Denom is the base denom of the permissioned Token.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Add is the list of accounts to add to the allow list.
+	Add []string `protobuf:"bytes,3,rep,name=add,proto3" json:"add,omitempty"`
+	// Remove is the list of accounts to remove from the allow list.
+	Remove []string `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateAllowList) Reset()         { *m = MsgUpdateAllowList{} }
+func (m *MsgUpdateAllowList) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowList) ProtoMessage()    {}
+func (*MsgUpdateAllowList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{12}
+}
+func (m *MsgUpdateAllowList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowList.Merge(m, src)
+}
+func (m *MsgUpdateAllowList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowList proto.InternalMessageInfo
+
+func (*MsgUpdateAllowList) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgUpdateAllowList"
+}
+
+// MsgCreateDeleverageOrder represents a borrower's request to create or replace its
+// deleverage order for a token.
+type MsgCreateDeleverageOrder struct {
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// Denom is the base denom of the borrow to repay, using the borrower's u/denom collateral.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Trigger Ratio of borrowed value to liquidation threshold at which the order executes.
+	TriggerRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=trigger_ratio,json=triggerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_ratio"`
+	// Target Ratio of borrowed value to liquidation threshold after the order executes.
+	TargetRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=target_ratio,json=targetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_ratio"`
+}
+
+func (m *MsgCreateDeleverageOrder) Reset()         { *m = MsgCreateDeleverageOrder{} }
+func (m *MsgCreateDeleverageOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDeleverageOrder) ProtoMessage()    {}
+func (*MsgCreateDeleverageOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{13}
+}
+func (m *MsgCreateDeleverageOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDeleverageOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDeleverageOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDeleverageOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDeleverageOrder.Merge(m, src)
+}
+func (m *MsgCreateDeleverageOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDeleverageOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDeleverageOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDeleverageOrder proto.InternalMessageInfo
+
+func (*MsgCreateDeleverageOrder) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgCreateDeleverageOrder"
+}
+
+// MsgCancelDeleverageOrder represents a borrower's request to remove its deleverage order
+// for a token.
+type MsgCancelDeleverageOrder struct {
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgCancelDeleverageOrder) Reset()         { *m = MsgCancelDeleverageOrder{} }
+func (m *MsgCancelDeleverageOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDeleverageOrder) ProtoMessage()    {}
+func (*MsgCancelDeleverageOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{14}
+}
+func (m *MsgCancelDeleverageOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDeleverageOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDeleverageOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDeleverageOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDeleverageOrder.Merge(m, src)
+}
+func (m *MsgCancelDeleverageOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDeleverageOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDeleverageOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDeleverageOrder proto.InternalMessageInfo
+
+func (*MsgCancelDeleverageOrder) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgCancelDeleverageOrder"
+}
+
 // MsgSupplyResponse defines the Msg/Supply response type.
 type MsgSupplyResponse struct {
 	// Received is the amount of uTokens received.
@@ -558,7 +753,7 @@ func (m *MsgSupplyResponse) Reset()         { *m = MsgSupplyResponse{} }
 func (m *MsgSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSupplyResponse) ProtoMessage()    {}
 func (*MsgSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{11}
+	return fileDescriptor_72683128ee6e8843, []int{15}
 }
 func (m *MsgSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +796,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{12}
+	return fileDescriptor_72683128ee6e8843, []int{16}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,7 +841,7 @@ func (m *MsgMaxWithdrawResponse) Reset()         { *m = MsgMaxWithdrawResponse{}
 func (m *MsgMaxWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMaxWithdrawResponse) ProtoMessage()    {}
 func (*MsgMaxWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{13}
+	return fileDescriptor_72683128ee6e8843, []int{17}
 }
 func (m *MsgMaxWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +882,7 @@ func (m *MsgCollateralizeResponse) Reset()         { *m = MsgCollateralizeRespon
 func (m *MsgCollateralizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCollateralizeResponse) ProtoMessage()    {}
 func (*MsgCollateralizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{14}
+	return fileDescriptor_72683128ee6e8843, []int{18}
 }
 func (m *MsgCollateralizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +923,7 @@ func (m *MsgDecollateralizeResponse) Reset()         { *m = MsgDecollateralizeRe
 func (m *MsgDecollateralizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDecollateralizeResponse) ProtoMessage()    {}
 func (*MsgDecollateralizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{15}
+	return fileDescriptor_72683128ee6e8843, []int{19}
 }
 func (m *MsgDecollateralizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +964,7 @@ func (m *MsgBorrowResponse) Reset()         { *m = MsgBorrowResponse{} }
 func (m *MsgBorrowResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBorrowResponse) ProtoMessage()    {}
 func (*MsgBorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{16}
+	return fileDescriptor_72683128ee6e8843, []int{20}
 }
 func (m *MsgBorrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +1007,7 @@ func (m *MsgMaxBorrowResponse) Reset()         { *m = MsgMaxBorrowResponse{} }
 func (m *MsgMaxBorrowResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMaxBorrowResponse) ProtoMessage()    {}
 func (*MsgMaxBorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{17}
+	return fileDescriptor_72683128ee6e8843, []int{21}
 }
 func (m *MsgMaxBorrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1050,7 @@ func (m *MsgRepayResponse) Reset()         { *m = MsgRepayResponse{} }
 func (m *MsgRepayResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRepayResponse) ProtoMessage()    {}
 func (*MsgRepayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{18}
+	return fileDescriptor_72683128ee6e8843, []int{22}
 }
 func (m *MsgRepayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +1100,7 @@ func (m *MsgLiquidateResponse) Reset()         { *m = MsgLiquidateResponse{} }
 func (m *MsgLiquidateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidateResponse) ProtoMessage()    {}
 func (*MsgLiquidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{19}
+	return fileDescriptor_72683128ee6e8843, []int{23}
 }
 func (m *MsgLiquidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +1147,7 @@ func (m *MsgLeveragedLiquidateResponse) Reset()         { *m = MsgLeveragedLiqui
 func (m *MsgLeveragedLiquidateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeveragedLiquidateResponse) ProtoMessage()    {}
 func (*MsgLeveragedLiquidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{20}
+	return fileDescriptor_72683128ee6e8843, []int{24}
 }
 func (m *MsgLeveragedLiquidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,7 +1190,7 @@ func (m *MsgSupplyCollateralResponse) Reset()         { *m = MsgSupplyCollateral
 func (m *MsgSupplyCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSupplyCollateralResponse) ProtoMessage()    {}
 func (*MsgSupplyCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{21}
+	return fileDescriptor_72683128ee6e8843, []int{25}
 }
 func (m *MsgSupplyCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,6 +1223,172 @@ func (*MsgSupplyCollateralResponse) XXX_MessageName() string {
 	return "umee.leverage.v1.MsgSupplyCollateralResponse"
 }
 
+// MsgClaimReferralRewardsResponse defines the Msg/ClaimReferralRewards response type.
+type MsgClaimReferralRewardsResponse struct {
+	// Claimed is the amount of base tokens sent to the referrer.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *MsgClaimReferralRewardsResponse) Reset()         { *m = MsgClaimReferralRewardsResponse{} }
+func (m *MsgClaimReferralRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReferralRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimReferralRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{26}
+}
+func (m *MsgClaimReferralRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReferralRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReferralRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReferralRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReferralRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimReferralRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReferralRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReferralRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimReferralRewardsResponse proto.InternalMessageInfo
+
+func (*MsgClaimReferralRewardsResponse) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgClaimReferralRewardsResponse"
+}
+
+// MsgUpdateAllowListResponse defines the Msg/UpdateAllowList response type.
+type MsgUpdateAllowListResponse struct {
+}
+
+func (m *MsgUpdateAllowListResponse) Reset()         { *m = MsgUpdateAllowListResponse{} }
+func (m *MsgUpdateAllowListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowListResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{27}
+}
+func (m *MsgUpdateAllowListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowListResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowListResponse proto.InternalMessageInfo
+
+func (*MsgUpdateAllowListResponse) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgUpdateAllowListResponse"
+}
+
+// MsgCreateDeleverageOrderResponse defines the Msg/CreateDeleverageOrder response type.
+type MsgCreateDeleverageOrderResponse struct {
+}
+
+func (m *MsgCreateDeleverageOrderResponse) Reset()         { *m = MsgCreateDeleverageOrderResponse{} }
+func (m *MsgCreateDeleverageOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDeleverageOrderResponse) ProtoMessage()    {}
+func (*MsgCreateDeleverageOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{28}
+}
+func (m *MsgCreateDeleverageOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDeleverageOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDeleverageOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDeleverageOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDeleverageOrderResponse.Merge(m, src)
+}
+func (m *MsgCreateDeleverageOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDeleverageOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDeleverageOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDeleverageOrderResponse proto.InternalMessageInfo
+
+func (*MsgCreateDeleverageOrderResponse) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgCreateDeleverageOrderResponse"
+}
+
+// MsgCancelDeleverageOrderResponse defines the Msg/CancelDeleverageOrder response type.
+type MsgCancelDeleverageOrderResponse struct {
+}
+
+func (m *MsgCancelDeleverageOrderResponse) Reset()         { *m = MsgCancelDeleverageOrderResponse{} }
+func (m *MsgCancelDeleverageOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDeleverageOrderResponse) ProtoMessage()    {}
+func (*MsgCancelDeleverageOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72683128ee6e8843, []int{29}
+}
+func (m *MsgCancelDeleverageOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDeleverageOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDeleverageOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDeleverageOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDeleverageOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelDeleverageOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDeleverageOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDeleverageOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDeleverageOrderResponse proto.InternalMessageInfo
+
+func (*MsgCancelDeleverageOrderResponse) XXX_MessageName() string {
+	return "umee.leverage.v1.MsgCancelDeleverageOrderResponse"
+}
+
 // MsgGovUpdateRegistry defines the Msg/GovUpdateRegistry request type.
 type MsgGovUpdateRegistry struct {
 	// authority is the address of the governance account or the Emergency Group.
@@ -1044,7 +1405,7 @@ type MsgGovUpdateRegistry struct {
 func (m *MsgGovUpdateRegistry) Reset()      { *m = MsgGovUpdateRegistry{} }
 func (*MsgGovUpdateRegistry) ProtoMessage() {}
 func (*MsgGovUpdateRegistry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{22}
+	return fileDescriptor_72683128ee6e8843, []int{30}
 }
 func (m *MsgGovUpdateRegistry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1446,7 @@ func (m *MsgGovUpdateRegistryResponse) Reset()         { *m = MsgGovUpdateRegist
 func (m *MsgGovUpdateRegistryResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateRegistryResponse) ProtoMessage()    {}
 func (*MsgGovUpdateRegistryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{23}
+	return fileDescriptor_72683128ee6e8843, []int{31}
 }
 func (m *MsgGovUpdateRegistryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1503,7 @@ type MsgGovUpdateSpecialAssets struct {
 func (m *MsgGovUpdateSpecialAssets) Reset()      { *m = MsgGovUpdateSpecialAssets{} }
 func (*MsgGovUpdateSpecialAssets) ProtoMessage() {}
 func (*MsgGovUpdateSpecialAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{24}
+	return fileDescriptor_72683128ee6e8843, []int{32}
 }
 func (m *MsgGovUpdateSpecialAssets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1544,7 @@ func (m *MsgGovUpdateSpecialAssetsResponse) Reset()         { *m = MsgGovUpdateS
 func (m *MsgGovUpdateSpecialAssetsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateSpecialAssetsResponse) ProtoMessage()    {}
 func (*MsgGovUpdateSpecialAssetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{25}
+	return fileDescriptor_72683128ee6e8843, []int{33}
 }
 func (m *MsgGovUpdateSpecialAssetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1587,7 @@ type MsgGovSetParams struct {
 func (m *MsgGovSetParams) Reset()      { *m = MsgGovSetParams{} }
 func (*MsgGovSetParams) ProtoMessage() {}
 func (*MsgGovSetParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{26}
+	return fileDescriptor_72683128ee6e8843, []int{34}
 }
 func (m *MsgGovSetParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1628,7 @@ func (m *MsgGovSetParamsResponse) Reset()         { *m = MsgGovSetParamsResponse
 func (m *MsgGovSetParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovSetParamsResponse) ProtoMessage()    {}
 func (*MsgGovSetParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72683128ee6e8843, []int{27}
+	return fileDescriptor_72683128ee6e8843, []int{35}
 }
 func (m *MsgGovSetParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,6 +1672,10 @@ func init() {
 	proto.RegisterType((*MsgLiquidate)(nil), "umee.leverage.v1.MsgLiquidate")
 	proto.RegisterType((*MsgLeveragedLiquidate)(nil), "umee.leverage.v1.MsgLeveragedLiquidate")
 	proto.RegisterType((*MsgSupplyCollateral)(nil), "umee.leverage.v1.MsgSupplyCollateral")
+	proto.RegisterType((*MsgClaimReferralRewards)(nil), "umee.leverage.v1.MsgClaimReferralRewards")
+	proto.RegisterType((*MsgUpdateAllowList)(nil), "umee.leverage.v1.MsgUpdateAllowList")
+	proto.RegisterType((*MsgCreateDeleverageOrder)(nil), "umee.leverage.v1.MsgCreateDeleverageOrder")
+	proto.RegisterType((*MsgCancelDeleverageOrder)(nil), "umee.leverage.v1.MsgCancelDeleverageOrder")
 	proto.RegisterType((*MsgSupplyResponse)(nil), "umee.leverage.v1.MsgSupplyResponse")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "umee.leverage.v1.MsgWithdrawResponse")
 	proto.RegisterType((*MsgMaxWithdrawResponse)(nil), "umee.leverage.v1.MsgMaxWithdrawResponse")
@@ -1322,6 +1687,10 @@ func init() {
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "umee.leverage.v1.MsgLiquidateResponse")
 	proto.RegisterType((*MsgLeveragedLiquidateResponse)(nil), "umee.leverage.v1.MsgLeveragedLiquidateResponse")
 	proto.RegisterType((*MsgSupplyCollateralResponse)(nil), "umee.leverage.v1.MsgSupplyCollateralResponse")
+	proto.RegisterType((*MsgClaimReferralRewardsResponse)(nil), "umee.leverage.v1.MsgClaimReferralRewardsResponse")
+	proto.RegisterType((*MsgUpdateAllowListResponse)(nil), "umee.leverage.v1.MsgUpdateAllowListResponse")
+	proto.RegisterType((*MsgCreateDeleverageOrderResponse)(nil), "umee.leverage.v1.MsgCreateDeleverageOrderResponse")
+	proto.RegisterType((*MsgCancelDeleverageOrderResponse)(nil), "umee.leverage.v1.MsgCancelDeleverageOrderResponse")
 	proto.RegisterType((*MsgGovUpdateRegistry)(nil), "umee.leverage.v1.MsgGovUpdateRegistry")
 	proto.RegisterType((*MsgGovUpdateRegistryResponse)(nil), "umee.leverage.v1.MsgGovUpdateRegistryResponse")
 	proto.RegisterType((*MsgGovUpdateSpecialAssets)(nil), "umee.leverage.v1.MsgGovUpdateSpecialAssets")
@@ -1333,84 +1702,104 @@ func init() {
 func init() { proto.RegisterFile("umee/leverage/v1/tx.proto", fileDescriptor_72683128ee6e8843) }
 
 var fileDescriptor_72683128ee6e8843 = []byte{
-	// 1228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x73, 0xdb, 0x44,
-	0x14, 0xb6, 0xec, 0x38, 0x63, 0x3f, 0xa7, 0x6d, 0xaa, 0x86, 0xc6, 0x51, 0x5b, 0x39, 0x51, 0x69,
-	0x09, 0x85, 0xc8, 0x24, 0x85, 0xc0, 0x50, 0x0a, 0xd4, 0xcd, 0x4c, 0x67, 0x52, 0x3c, 0x93, 0xb1,
-	0x61, 0x18, 0x18, 0x20, 0x28, 0xd6, 0xa2, 0x68, 0x62, 0x4b, 0x42, 0x2b, 0x3b, 0x49, 0x8f, 0x9c,
-	0x38, 0x31, 0x30, 0xd3, 0x43, 0x2f, 0xcc, 0xe4, 0xc4, 0x89, 0x03, 0x07, 0x7e, 0x44, 0xb8, 0x75,
-	0x38, 0x31, 0x1c, 0x3a, 0x90, 0x1c, 0xe0, 0x67, 0x74, 0xb4, 0xbb, 0x5a, 0xc9, 0x96, 0xec, 0x28,
-	0x6d, 0x7d, 0x4a, 0x76, 0xdf, 0xf7, 0xbe, 0xf7, 0xbd, 0x27, 0xbd, 0xa7, 0x5d, 0xc3, 0x5c, 0xb7,
-	0x83, 0x50, 0xb5, 0x8d, 0x7a, 0xc8, 0xd5, 0x0c, 0x54, 0xed, 0x2d, 0x57, 0xbd, 0x3d, 0xd5, 0x71,
-	0x6d, 0xcf, 0x16, 0xa7, 0x7d, 0x93, 0x1a, 0x98, 0xd4, 0xde, 0xb2, 0x24, 0xb7, 0x6c, 0xdc, 0xb1,
-	0x71, 0x75, 0x4b, 0xc3, 0x3e, 0x74, 0x0b, 0x79, 0xda, 0x72, 0xb5, 0x65, 0x9b, 0x16, 0xf5, 0x90,
-	0x66, 0x99, 0xbd, 0x83, 0x0d, 0x9f, 0xa9, 0x83, 0x0d, 0x66, 0x98, 0xa3, 0x86, 0x4d, 0xb2, 0xaa,
-	0xd2, 0x05, 0x33, 0xcd, 0x18, 0xb6, 0x61, 0xd3, 0x7d, 0xff, 0x3f, 0xb6, 0x5b, 0x89, 0xc9, 0xe2,
-	0x3a, 0x08, 0x40, 0xf9, 0x0a, 0x8a, 0x75, 0x6c, 0x34, 0xbb, 0x8e, 0xd3, 0xde, 0x17, 0x25, 0x28,
-	0x60, 0xff, 0x3f, 0x13, 0xb9, 0x65, 0x61, 0x5e, 0x58, 0x2c, 0x36, 0xf8, 0x5a, 0x7c, 0x0b, 0xf2,
-	0x1a, 0xc6, 0xc8, 0x2b, 0x67, 0xe7, 0x85, 0xc5, 0xd2, 0xca, 0x9c, 0xca, 0xa2, 0xfb, 0x39, 0xa8,
-	0x2c, 0x07, 0xf5, 0xae, 0x6d, 0x5a, 0xb5, 0x89, 0xc3, 0x27, 0x95, 0x4c, 0x83, 0xa2, 0x95, 0xaf,
-	0xa1, 0x54, 0xc7, 0xc6, 0xa7, 0xa6, 0xb7, 0xad, 0xbb, 0xda, 0xee, 0x38, 0x22, 0xd4, 0xe0, 0x6c,
-	0x1d, 0x1b, 0x75, 0x6d, 0x2f, 0x55, 0x90, 0x19, 0xc8, 0xeb, 0xc8, 0xb2, 0x3b, 0x24, 0x48, 0xb1,
-	0x41, 0x17, 0x0a, 0x82, 0xe9, 0x3a, 0x36, 0xee, 0xda, 0xed, 0xb6, 0xe6, 0x21, 0x57, 0x6b, 0x9b,
-	0x0f, 0x90, 0xcf, 0xb2, 0x65, 0xbb, 0xae, 0xbd, 0x1b, 0xb2, 0x04, 0xeb, 0x67, 0x95, 0x6a, 0x80,
-	0x58, 0xc7, 0xc6, 0x1a, 0x6a, 0x8d, 0x3b, 0x10, 0x7d, 0xaa, 0x35, 0xc2, 0x32, 0x0e, 0xfe, 0x0f,
-	0x61, 0x8a, 0xd6, 0x3c, 0x45, 0x88, 0xe4, 0x8a, 0x7f, 0x09, 0x85, 0x3a, 0x36, 0x1a, 0xc8, 0xd1,
-	0xf6, 0xc7, 0x21, 0xf0, 0x57, 0x81, 0x28, 0xfc, 0xc8, 0xfc, 0xb6, 0x6b, 0xea, 0x9a, 0x87, 0x44,
-	0x19, 0xa0, 0xcd, 0x16, 0x76, 0x10, 0x25, 0xb2, 0xd3, 0xa7, 0x21, 0x3b, 0xa0, 0xe1, 0x36, 0x14,
-	0x5d, 0x5f, 0x68, 0x07, 0x59, 0x5e, 0x39, 0x97, 0x4e, 0x47, 0xe8, 0x21, 0x2e, 0xc0, 0x94, 0x8b,
-	0x76, 0x35, 0x57, 0xdf, 0xa4, 0x75, 0x98, 0x20, 0xf4, 0x25, 0xba, 0xb7, 0x46, 0xaa, 0xf1, 0x28,
-	0x0b, 0x2f, 0xf9, 0x72, 0x59, 0x6f, 0xea, 0xa1, 0xee, 0x77, 0xe2, 0xba, 0x6b, 0xe5, 0x3f, 0x7f,
-	0x5f, 0x9a, 0x61, 0xf1, 0xef, 0xe8, 0xba, 0x8b, 0x30, 0x6e, 0x7a, 0xae, 0x69, 0x19, 0x7d, 0x19,
-	0xbd, 0x39, 0x98, 0xd1, 0x08, 0xbf, 0x30, 0xd7, 0x0a, 0x94, 0x88, 0x72, 0xa6, 0x35, 0x47, 0x0b,
-	0x45, 0xb6, 0x88, 0xd4, 0x14, 0xd9, 0x88, 0xf7, 0xa1, 0xd8, 0xd1, 0xf6, 0x36, 0x89, 0x53, 0x39,
-	0x4f, 0x42, 0xab, 0x7e, 0x51, 0xfe, 0x7e, 0x52, 0xb9, 0x6e, 0x98, 0xde, 0x76, 0x77, 0x4b, 0x6d,
-	0xd9, 0x1d, 0x36, 0xbe, 0xd8, 0x9f, 0x25, 0xac, 0xef, 0x54, 0xbd, 0x7d, 0x07, 0x61, 0x75, 0x0d,
-	0xb5, 0x1a, 0x85, 0x8e, 0xb6, 0x47, 0x5e, 0x0e, 0x65, 0x1b, 0x2e, 0xf0, 0x01, 0x15, 0x36, 0xe8,
-	0x38, 0x06, 0xc9, 0x06, 0x9c, 0xe7, 0x91, 0x1a, 0x08, 0x3b, 0xb6, 0x85, 0x91, 0x78, 0x0b, 0x0a,
-	0x2e, 0x6a, 0x21, 0xb3, 0x87, 0x74, 0x12, 0x27, 0x05, 0x1d, 0x77, 0x50, 0x1a, 0x44, 0x7b, 0x30,
-	0x97, 0x5e, 0x0c, 0xe7, 0x43, 0x01, 0x2e, 0xf6, 0xcf, 0x3b, 0xce, 0x7b, 0x1b, 0x8a, 0xbb, 0x6c,
-	0xcf, 0x4a, 0x4b, 0x1c, 0x7a, 0xf4, 0xc9, 0xca, 0x9e, 0x56, 0x96, 0x04, 0xe5, 0xc1, 0x09, 0x1a,
-	0xe8, 0x52, 0x2e, 0x83, 0x14, 0x1f, 0x7b, 0xdc, 0x7a, 0x81, 0x94, 0x9d, 0x0e, 0x12, 0xbe, 0xd9,
-	0x84, 0x99, 0xe8, 0x80, 0x89, 0x96, 0x8e, 0xbd, 0xaa, 0xe9, 0x4b, 0x17, 0x38, 0x28, 0xf7, 0xc9,
-	0x94, 0x27, 0xaf, 0x15, 0x27, 0x7c, 0x1b, 0x26, 0xfd, 0xf7, 0xd4, 0x4c, 0x4d, 0xc7, 0xe0, 0xca,
-	0x1f, 0x02, 0x91, 0xc8, 0x3b, 0xf5, 0xb9, 0x19, 0xc5, 0x0f, 0x00, 0xc2, 0x0a, 0xa5, 0x7d, 0x02,
-	0x11, 0x17, 0x1a, 0xd9, 0x6f, 0xc3, 0xb4, 0x43, 0x8a, 0xc1, 0x95, 0x9f, 0x04, 0xb8, 0x92, 0x38,
-	0x7e, 0x9e, 0x3f, 0xa9, 0x50, 0x53, 0xf6, 0x74, 0x9a, 0xbe, 0x81, 0x4b, 0x09, 0x7d, 0xcf, 0x05,
-	0xdd, 0x83, 0xb3, 0x7d, 0xaf, 0x53, 0x6a, 0x61, 0x03, 0x6e, 0xca, 0xc3, 0x2c, 0x79, 0x8e, 0xf7,
-	0xec, 0xde, 0x27, 0x0e, 0x4d, 0xd9, 0x30, 0xb1, 0xe7, 0xee, 0x8b, 0xab, 0x50, 0xd4, 0xba, 0xde,
-	0xb6, 0xed, 0x9a, 0xde, 0xfe, 0x89, 0x83, 0x37, 0x84, 0x8a, 0xf3, 0x50, 0xd2, 0x11, 0x6e, 0xb9,
-	0xa6, 0xe3, 0x99, 0xb6, 0xc5, 0x26, 0x68, 0x74, 0x4b, 0x7c, 0x0f, 0x40, 0xd3, 0xf5, 0x4d, 0xcf,
-	0xde, 0x41, 0x16, 0x2e, 0x4f, 0xcc, 0xe7, 0x16, 0x4b, 0x2b, 0xb3, 0xea, 0xe0, 0x29, 0x51, 0xfd,
-	0xd8, 0xb7, 0x07, 0x6d, 0xaa, 0xe9, 0x3a, 0x59, 0x63, 0xb1, 0x06, 0x67, 0xba, 0x44, 0x69, 0x40,
-	0x90, 0x4f, 0x43, 0x30, 0x45, 0x7d, 0x28, 0xc7, 0xbb, 0xd2, 0xf7, 0x07, 0x95, 0xcc, 0xa3, 0x83,
-	0x4a, 0xe6, 0xff, 0x83, 0x8a, 0xf0, 0xdd, 0x7f, 0xbf, 0xdd, 0x08, 0xf5, 0xaf, 0x4f, 0x14, 0xb2,
-	0xd3, 0x39, 0x45, 0x86, 0xcb, 0x49, 0x55, 0xe1, 0x0d, 0xfa, 0x43, 0x16, 0xe6, 0xa2, 0x80, 0xa6,
-	0x83, 0x5a, 0xa6, 0xd6, 0xbe, 0xe3, 0x4f, 0x52, 0xfc, 0xa2, 0x6a, 0x97, 0x8d, 0xd7, 0xee, 0x16,
-	0x4c, 0xf8, 0x11, 0xca, 0x39, 0x92, 0xf4, 0x42, 0x3c, 0xe9, 0xa8, 0x90, 0x26, 0xf2, 0x58, 0xfa,
-	0xc4, 0x49, 0x7c, 0x1f, 0xf2, 0x8e, 0x66, 0xba, 0x41, 0xcd, 0x95, 0xd1, 0xde, 0x1b, 0x9a, 0xe9,
-	0x06, 0x5f, 0x08, 0xe2, 0x36, 0xaa, 0x6c, 0xca, 0x55, 0x58, 0x18, 0x5a, 0x0f, 0x5e, 0xb5, 0x9f,
-	0x05, 0x38, 0x47, 0x51, 0x4d, 0x9f, 0xdf, 0xd5, 0x3a, 0xcf, 0x5e, 0xab, 0x55, 0x98, 0x74, 0x08,
-	0x03, 0xeb, 0xac, 0x72, 0x3c, 0x1b, 0x1a, 0x21, 0x68, 0x2c, 0x8a, 0x1e, 0x99, 0xc4, 0x1c, 0xcc,
-	0x0e, 0xc8, 0x0b, 0xa4, 0xaf, 0xfc, 0x02, 0x90, 0xab, 0x63, 0x43, 0x5c, 0x87, 0x49, 0x76, 0x5b,
-	0xb8, 0x14, 0x0f, 0xc8, 0x3b, 0x56, 0xba, 0x3a, 0xc2, 0xc8, 0x9b, 0x78, 0x03, 0x0a, 0xfc, 0xd0,
-	0x7e, 0x25, 0xd1, 0x21, 0x30, 0x4b, 0xd7, 0x46, 0x9a, 0x39, 0xe3, 0x67, 0x50, 0x8a, 0xde, 0x04,
-	0xe6, 0x13, 0xbd, 0x22, 0x08, 0x69, 0xf1, 0x24, 0x04, 0xa7, 0xde, 0x84, 0x33, 0xfd, 0x17, 0x04,
-	0x25, 0xd1, 0xb5, 0x0f, 0x23, 0xdd, 0x38, 0x19, 0xc3, 0x03, 0x20, 0x38, 0x37, 0x78, 0x35, 0x78,
-	0x39, 0xd1, 0x7d, 0x00, 0x25, 0xbd, 0x9e, 0x06, 0xc5, 0xc3, 0xac, 0xc3, 0x24, 0x3b, 0xb5, 0x27,
-	0x3f, 0x40, 0x6a, 0x1c, 0xf2, 0x00, 0x07, 0x3e, 0xc7, 0x4d, 0x28, 0x86, 0x97, 0x00, 0x79, 0x58,
-	0x29, 0x19, 0xe3, 0xf5, 0xd1, 0xf6, 0xc8, 0x68, 0xcf, 0xb3, 0x7b, 0x41, 0xa2, 0x03, 0xb1, 0x49,
-	0xca, 0x70, 0x5b, 0x54, 0x5d, 0xe4, 0x02, 0x90, 0xe8, 0xc0, 0xed, 0x43, 0xd4, 0xc5, 0xbf, 0x84,
-	0x16, 0x88, 0x09, 0xc7, 0xf4, 0x57, 0x92, 0xbd, 0x63, 0x40, 0xa9, 0x9a, 0x12, 0xc8, 0xe3, 0x6d,
-	0xc3, 0x74, 0xec, 0xf0, 0x7b, 0x6d, 0x44, 0x73, 0x85, 0x30, 0x69, 0x29, 0x15, 0x8c, 0x47, 0xda,
-	0x81, 0xf3, 0xf1, 0xaf, 0x60, 0x72, 0x59, 0x62, 0x38, 0x49, 0x4d, 0x87, 0xe3, 0xc1, 0x1e, 0xc0,
-	0xc5, 0x21, 0xdf, 0x8e, 0xd7, 0x46, 0x33, 0xf5, 0x81, 0xa5, 0x9b, 0xa7, 0x00, 0xf3, 0xd8, 0x5f,
-	0xc0, 0x54, 0xdf, 0x04, 0x5e, 0x18, 0x46, 0xc2, 0x21, 0xd2, 0xab, 0x27, 0x42, 0x02, 0xf6, 0x5a,
-	0xe3, 0xf0, 0x5f, 0x39, 0x73, 0x78, 0x24, 0x0b, 0x8f, 0x8f, 0x64, 0xe1, 0x9f, 0x23, 0x59, 0xf8,
-	0xf1, 0x58, 0xce, 0x1c, 0x1e, 0xcb, 0xc2, 0xe3, 0x63, 0x39, 0xf3, 0xd7, 0xb1, 0x9c, 0xf9, 0xfc,
-	0x8d, 0xc8, 0x25, 0xc8, 0xa7, 0x5d, 0xb2, 0x90, 0xb7, 0x6b, 0xbb, 0x3b, 0x64, 0x51, 0xed, 0xad,
-	0x56, 0xf7, 0xc2, 0x5f, 0x6c, 0xc8, 0x95, 0x68, 0x6b, 0x92, 0xfc, 0x58, 0x73, 0xf3, 0x69, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x84, 0xae, 0x8c, 0x87, 0x66, 0x12, 0x00, 0x00,
+	// 1550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xc1, 0x6f, 0x13, 0xc7,
+	0x1a, 0xcf, 0xda, 0x71, 0x9e, 0xfd, 0x39, 0x81, 0xb0, 0x04, 0xe2, 0x2c, 0x60, 0x27, 0xcb, 0x83,
+	0x17, 0x78, 0xc4, 0x26, 0x81, 0xc7, 0xab, 0xa0, 0xb4, 0x25, 0x44, 0x42, 0x0a, 0x58, 0xd0, 0x75,
+	0xab, 0xaa, 0x55, 0xab, 0x74, 0xe2, 0x1d, 0x36, 0xab, 0xd8, 0x5e, 0x77, 0x66, 0x6d, 0x27, 0x1c,
+	0x7b, 0xe2, 0x54, 0xb5, 0x12, 0x07, 0x2e, 0xad, 0x38, 0x57, 0x3d, 0xf4, 0xd0, 0xfe, 0x07, 0x3d,
+	0xa4, 0x37, 0xd4, 0x53, 0xd5, 0x03, 0x6d, 0xc9, 0xa1, 0xfd, 0x07, 0x7a, 0xaf, 0x66, 0x76, 0x76,
+	0xbc, 0xb6, 0xd7, 0x9b, 0x25, 0x10, 0xa9, 0x27, 0x7b, 0xe6, 0xfb, 0x7d, 0xbf, 0xef, 0x37, 0xdf,
+	0xcc, 0x7c, 0x33, 0xb3, 0x30, 0xd3, 0xaa, 0x63, 0x5c, 0xaa, 0xe1, 0x36, 0x26, 0xc8, 0xc2, 0xa5,
+	0xf6, 0x62, 0xc9, 0xdd, 0x2a, 0x36, 0x89, 0xe3, 0x3a, 0xea, 0x24, 0x33, 0x15, 0x7d, 0x53, 0xb1,
+	0xbd, 0xa8, 0xe5, 0xab, 0x0e, 0xad, 0x3b, 0xb4, 0xb4, 0x8e, 0x28, 0x83, 0xae, 0x63, 0x17, 0x2d,
+	0x96, 0xaa, 0x8e, 0xdd, 0xf0, 0x3c, 0xb4, 0x69, 0x61, 0xaf, 0x53, 0x8b, 0x31, 0xd5, 0xa9, 0x25,
+	0x0c, 0x33, 0x9e, 0x61, 0x8d, 0xb7, 0x4a, 0x5e, 0x43, 0x98, 0xa6, 0x2c, 0xc7, 0x72, 0xbc, 0x7e,
+	0xf6, 0x4f, 0xf4, 0x16, 0x06, 0x64, 0x49, 0x1d, 0x1c, 0xa0, 0x3f, 0x52, 0x20, 0x53, 0xa6, 0x56,
+	0xa5, 0xd5, 0x6c, 0xd6, 0xb6, 0x55, 0x0d, 0xd2, 0x94, 0xfd, 0xb3, 0x31, 0xc9, 0x29, 0xb3, 0xca,
+	0x7c, 0xc6, 0x90, 0x6d, 0xf5, 0x7f, 0x90, 0x42, 0x94, 0x62, 0x37, 0x97, 0x98, 0x55, 0xe6, 0xb3,
+	0x4b, 0x33, 0x45, 0x11, 0x9e, 0x0d, 0xa2, 0x28, 0x06, 0x51, 0xbc, 0xe9, 0xd8, 0x8d, 0xe5, 0xd1,
+	0x9d, 0x67, 0x85, 0x11, 0xc3, 0x43, 0xab, 0x97, 0x21, 0x4d, 0xf0, 0x7d, 0x4c, 0x08, 0x26, 0xb9,
+	0x24, 0xa3, 0x5c, 0xce, 0xfd, 0xf4, 0xdd, 0xc2, 0x94, 0x70, 0xbe, 0x61, 0x9a, 0x04, 0x53, 0x5a,
+	0x71, 0x89, 0xdd, 0xb0, 0x0c, 0x89, 0xd4, 0x3f, 0x86, 0x6c, 0x99, 0x5a, 0xef, 0xd9, 0xee, 0x86,
+	0x49, 0x50, 0xe7, 0x00, 0x74, 0xe9, 0xcb, 0x70, 0xa8, 0x4c, 0xad, 0x32, 0xda, 0x8a, 0x15, 0x64,
+	0x0a, 0x52, 0x26, 0x6e, 0x38, 0x75, 0x1e, 0x24, 0x63, 0x78, 0x0d, 0x1d, 0xc3, 0x64, 0x99, 0x5a,
+	0x37, 0x9d, 0x5a, 0x0d, 0xb9, 0x98, 0xa0, 0x9a, 0xfd, 0x00, 0x33, 0x96, 0x75, 0x87, 0x10, 0xa7,
+	0xd3, 0x65, 0xf1, 0xdb, 0xfb, 0x95, 0x6a, 0x81, 0x5a, 0xa6, 0xd6, 0x0a, 0xae, 0x1e, 0x74, 0x20,
+	0xb1, 0x18, 0x96, 0x39, 0xcd, 0x01, 0x04, 0xd8, 0xe7, 0x62, 0x78, 0x0b, 0xc6, 0xbd, 0xa9, 0x8a,
+	0x21, 0x2c, 0x7c, 0xa2, 0x3e, 0x82, 0x74, 0x99, 0x5a, 0x06, 0x6e, 0xa2, 0xed, 0x83, 0xc8, 0xdb,
+	0x37, 0x0a, 0x57, 0x78, 0xc7, 0xfe, 0xa4, 0x65, 0x9b, 0xc8, 0xc5, 0x6a, 0x1e, 0xa0, 0x26, 0x1a,
+	0x8e, 0x1f, 0x25, 0xd0, 0xd3, 0xa3, 0x21, 0xd1, 0xa7, 0xe1, 0x3a, 0x64, 0x08, 0x13, 0x5a, 0xc7,
+	0x0d, 0x37, 0x97, 0x8c, 0xa7, 0xa3, 0xeb, 0xa1, 0xce, 0xc1, 0x38, 0xc1, 0x1d, 0x44, 0xcc, 0x35,
+	0x2f, 0x0f, 0xa3, 0x9c, 0x3e, 0xeb, 0xf5, 0xad, 0xf0, 0x6c, 0x3c, 0x4e, 0xc0, 0x31, 0x26, 0x57,
+	0x54, 0x02, 0xb3, 0xab, 0xfb, 0xb5, 0x41, 0xdd, 0x11, 0x33, 0x14, 0x1c, 0xd1, 0xe5, 0xfe, 0x11,
+	0x45, 0xcd, 0xac, 0x1c, 0x6b, 0x01, 0xb2, 0x5c, 0xb9, 0xd0, 0x9a, 0xf4, 0x12, 0xc5, 0xbb, 0xb8,
+	0xd4, 0x18, 0xa3, 0x51, 0x6f, 0x43, 0xa6, 0x8e, 0xb6, 0xd6, 0xb8, 0x53, 0x2e, 0xc5, 0x43, 0x17,
+	0x59, 0x52, 0x7e, 0x79, 0x56, 0x38, 0x6b, 0xd9, 0xee, 0x46, 0x6b, 0xbd, 0x58, 0x75, 0xea, 0xa2,
+	0x58, 0x8a, 0x9f, 0x05, 0x6a, 0x6e, 0x96, 0xdc, 0xed, 0x26, 0xa6, 0xc5, 0x15, 0x5c, 0x35, 0xd2,
+	0x75, 0xb4, 0xc5, 0x17, 0x87, 0xfe, 0x95, 0x02, 0x47, 0x65, 0x39, 0xec, 0x6e, 0xec, 0x7f, 0x4e,
+	0x61, 0xbc, 0x0b, 0xd3, 0xac, 0xe4, 0xd4, 0x90, 0x5d, 0x37, 0x78, 0x1f, 0xaa, 0x19, 0x3c, 0x19,
+	0xb4, 0x87, 0x50, 0x89, 0x4d, 0xf8, 0x83, 0xc2, 0xab, 0xcb, 0xbb, 0x4d, 0xb6, 0x00, 0x6e, 0xd4,
+	0x6a, 0x4e, 0xe7, 0x8e, 0x4d, 0x5d, 0xb5, 0x08, 0x29, 0x64, 0xd6, 0xed, 0xc6, 0x9e, 0x4c, 0x1e,
+	0x2c, 0x7c, 0xdf, 0xa9, 0xe7, 0x21, 0x89, 0x4c, 0x33, 0x97, 0x9c, 0x4d, 0x46, 0x72, 0x30, 0x90,
+	0x7a, 0x11, 0xc6, 0x08, 0xae, 0x3b, 0x6d, 0x9c, 0x1b, 0xdd, 0x03, 0x2e, 0x70, 0x57, 0xe1, 0xd3,
+	0x3f, 0xbe, 0x3d, 0xef, 0xc5, 0xd7, 0xbf, 0x4f, 0x40, 0x8e, 0x25, 0x86, 0x60, 0xe4, 0xe2, 0x15,
+	0xec, 0x9f, 0x72, 0x77, 0x89, 0x89, 0x7b, 0x17, 0xa7, 0x12, 0x7b, 0x71, 0x86, 0x0f, 0xa9, 0x02,
+	0x13, 0x2e, 0xb1, 0x2d, 0x0b, 0x93, 0x35, 0x82, 0x5c, 0xdb, 0xc9, 0x25, 0xf7, 0xb5, 0xe4, 0xc6,
+	0x05, 0x89, 0xc1, 0x38, 0xd4, 0xb7, 0x61, 0xdc, 0x45, 0xc4, 0xc2, 0xae, 0xe0, 0x1c, 0xdd, 0x17,
+	0x67, 0xd6, 0xe3, 0xe0, 0x94, 0x57, 0x27, 0x58, 0x72, 0xe4, 0x60, 0x56, 0x47, 0xd3, 0xa9, 0xc9,
+	0x31, 0x63, 0xb2, 0x7b, 0x84, 0x78, 0x1b, 0x4a, 0x6f, 0x79, 0x69, 0x43, 0x8d, 0x2a, 0xae, 0x1d,
+	0x60, 0xda, 0xfa, 0xe4, 0xe8, 0xf7, 0xe0, 0x88, 0xdc, 0x66, 0x06, 0xa6, 0x4d, 0xa7, 0x41, 0xb1,
+	0x7a, 0x8d, 0x2d, 0xe0, 0x2a, 0xb6, 0xdb, 0xd8, 0xcc, 0x29, 0xf1, 0xf6, 0x92, 0x74, 0xd0, 0x0d,
+	0xbe, 0x71, 0xfd, 0xc3, 0xfc, 0xd5, 0x70, 0x3e, 0x52, 0xe0, 0x78, 0xef, 0x25, 0x41, 0xf2, 0x5e,
+	0x87, 0x4c, 0x47, 0xf4, 0x35, 0xe2, 0x12, 0x77, 0x3d, 0x7a, 0x64, 0x25, 0x5e, 0x54, 0x96, 0xe6,
+	0xcd, 0x59, 0xf0, 0x36, 0xe0, 0xeb, 0xd2, 0x4f, 0x82, 0x36, 0x78, 0x57, 0x90, 0xd6, 0xa3, 0x3c,
+	0xed, 0xde, 0x31, 0x2a, 0x3b, 0x2b, 0x30, 0x15, 0x3c, 0x5e, 0x83, 0xa9, 0x13, 0xf3, 0x15, 0x3f,
+	0x75, 0xbe, 0x83, 0x7e, 0x9b, 0x5f, 0x8d, 0x78, 0x51, 0x95, 0x84, 0xff, 0x67, 0x3b, 0xbc, 0x89,
+	0xec, 0xd8, 0x74, 0x02, 0xae, 0xff, 0xa8, 0x70, 0x89, 0xf2, 0x9c, 0x7a, 0x69, 0x46, 0xf5, 0x4d,
+	0x80, 0x6e, 0x86, 0xe2, 0xce, 0x40, 0xc0, 0xc5, 0x8b, 0xcc, 0xea, 0x6e, 0xdc, 0x23, 0x5a, 0xc0,
+	0xf5, 0x2f, 0x14, 0x38, 0x15, 0x7a, 0xf8, 0xbe, 0xfc, 0xa0, 0xba, 0x9a, 0x12, 0x2f, 0xa6, 0xe9,
+	0x3e, 0x9c, 0x08, 0x39, 0xf4, 0xa4, 0xa0, 0x5b, 0x70, 0xa8, 0x67, 0x39, 0xc5, 0x16, 0xd6, 0xe7,
+	0xa6, 0x3f, 0x54, 0xa0, 0x30, 0xe4, 0xf4, 0x92, 0xc1, 0x30, 0xfc, 0xab, 0xca, 0xec, 0x3c, 0x4a,
+	0x32, 0x3a, 0xca, 0x45, 0x16, 0xe5, 0xeb, 0x5f, 0x0b, 0xf3, 0x31, 0x0a, 0x24, 0x73, 0xa0, 0x86,
+	0xcf, 0x2d, 0xf6, 0x49, 0xdf, 0xa9, 0x27, 0xb7, 0x84, 0x0e, 0xb3, 0xc3, 0x0e, 0x93, 0x7e, 0x4c,
+	0x58, 0xe5, 0x94, 0x98, 0x47, 0x09, 0xbe, 0x70, 0x6f, 0x39, 0x6d, 0x2f, 0x92, 0x81, 0x2d, 0x9b,
+	0xba, 0x64, 0x5b, 0xbd, 0x02, 0x19, 0xd4, 0x72, 0x37, 0x1c, 0x62, 0xbb, 0xdb, 0x7b, 0xd6, 0xd6,
+	0x2e, 0x54, 0x9d, 0x85, 0xac, 0x89, 0x69, 0x95, 0xd8, 0x4d, 0xd7, 0x76, 0x1a, 0xe2, 0xc2, 0x14,
+	0xec, 0x52, 0x5f, 0x07, 0x40, 0xa6, 0xb9, 0xe6, 0x3a, 0x9b, 0xb8, 0x41, 0xf9, 0x51, 0x9a, 0x5d,
+	0x9a, 0x2e, 0xf6, 0x3f, 0x41, 0x8b, 0xef, 0x30, 0xbb, 0x5f, 0x97, 0x90, 0x69, 0xf2, 0x36, 0x55,
+	0x97, 0x61, 0xa2, 0xc5, 0x95, 0xfa, 0x04, 0xa9, 0x38, 0x04, 0xe3, 0x9e, 0x8f, 0xc7, 0x71, 0x55,
+	0x7b, 0xf8, 0xa4, 0x30, 0xf2, 0xf8, 0x49, 0x61, 0xe4, 0xcf, 0x27, 0x05, 0x85, 0x95, 0xfd, 0xae,
+	0xfe, 0xd5, 0xd1, 0x74, 0x62, 0x32, 0xa9, 0xe7, 0xe1, 0x64, 0x58, 0x56, 0x64, 0xda, 0x3e, 0x4b,
+	0xc0, 0x4c, 0x10, 0x50, 0x69, 0xe2, 0xaa, 0x8d, 0x6a, 0x37, 0x28, 0xc5, 0x2e, 0x7d, 0x55, 0xb9,
+	0x4b, 0x0c, 0xe6, 0xee, 0x1a, 0x8c, 0xb2, 0x08, 0xfc, 0xbe, 0x92, 0x5d, 0x9a, 0x1b, 0x1c, 0x74,
+	0x50, 0x48, 0x05, 0xbb, 0x62, 0xf8, 0xdc, 0x49, 0x7d, 0x03, 0x52, 0x4d, 0x64, 0x13, 0x3f, 0xe7,
+	0x7a, 0xb4, 0xf7, 0x3d, 0x64, 0x13, 0xff, 0x3e, 0xc8, 0xdd, 0xa2, 0xd2, 0xa6, 0x9f, 0x86, 0xb9,
+	0xa1, 0xf9, 0x90, 0x59, 0xfb, 0x52, 0x81, 0xc3, 0x1e, 0xaa, 0xc2, 0xf8, 0x09, 0xaa, 0xef, 0x3f,
+	0x57, 0x57, 0x60, 0xac, 0xc9, 0x19, 0x44, 0x29, 0xc9, 0x0d, 0x8e, 0xc6, 0x8b, 0xe0, 0x57, 0x12,
+	0x0f, 0x1d, 0x39, 0x88, 0x19, 0x98, 0xee, 0x93, 0xe7, 0x4b, 0x5f, 0xfa, 0x6b, 0x02, 0x92, 0x65,
+	0x6a, 0xa9, 0xab, 0x30, 0x26, 0xbe, 0x44, 0x9c, 0x18, 0x0c, 0x28, 0x4b, 0x94, 0x76, 0x3a, 0xc2,
+	0x28, 0x0b, 0xc9, 0x3d, 0x48, 0xcb, 0xa7, 0xfd, 0xa9, 0x50, 0x07, 0xdf, 0xac, 0x9d, 0x89, 0x34,
+	0x4b, 0xc6, 0xf7, 0x21, 0x1b, 0xfc, 0x5e, 0x30, 0x1b, 0xea, 0x15, 0x40, 0x68, 0xf3, 0x7b, 0x21,
+	0x24, 0xf5, 0x1a, 0x4c, 0xf4, 0x7e, 0x46, 0xd0, 0x43, 0x5d, 0x7b, 0x30, 0xda, 0xf9, 0xbd, 0x31,
+	0x81, 0xb2, 0x7a, 0xb8, 0xff, 0x03, 0xc2, 0xbf, 0x43, 0xdd, 0xfb, 0x50, 0xda, 0x85, 0x38, 0x28,
+	0x19, 0x66, 0x15, 0xc6, 0xc4, 0x23, 0x3d, 0x7c, 0x02, 0x3d, 0xa3, 0x76, 0x3a, 0xc2, 0x28, 0xb9,
+	0x2a, 0x90, 0xe9, 0xbe, 0xf9, 0xf3, 0xc3, 0x52, 0x29, 0x18, 0xcf, 0x46, 0xdb, 0x03, 0x67, 0x59,
+	0x4a, 0x7c, 0x06, 0x08, 0x75, 0xe0, 0x36, 0x4d, 0x1f, 0x6e, 0x0b, 0xaa, 0x0b, 0xbc, 0xf7, 0x43,
+	0x1d, 0xa4, 0x5d, 0x3b, 0x1b, 0x6d, 0x97, 0xa4, 0x0d, 0x50, 0x43, 0x5e, 0xe5, 0xff, 0x09, 0xf7,
+	0x1e, 0x00, 0x6a, 0xa5, 0x98, 0x40, 0x19, 0x6f, 0x03, 0x26, 0x07, 0x9e, 0xba, 0x67, 0x22, 0x36,
+	0x57, 0x17, 0xa6, 0x2d, 0xc4, 0x82, 0xc9, 0x48, 0x2e, 0x4c, 0x85, 0x3e, 0x5a, 0xcf, 0x85, 0xaf,
+	0xe1, 0x10, 0xa8, 0xb6, 0x18, 0x1b, 0x1a, 0x5c, 0xf5, 0xfd, 0x0f, 0xdb, 0xf0, 0x55, 0xdf, 0x87,
+	0xd2, 0x2e, 0xc4, 0x41, 0xc9, 0x30, 0x1d, 0x38, 0x16, 0xfe, 0xf0, 0x1c, 0xb2, 0x43, 0xc3, 0xb0,
+	0xda, 0x52, 0x7c, 0x6c, 0x4f, 0xe0, 0xd0, 0xa7, 0xdb, 0x90, 0xc0, 0x61, 0x58, 0x6d, 0x29, 0x3e,
+	0x56, 0x06, 0xde, 0x84, 0x23, 0x83, 0x97, 0x9a, 0xf0, 0x55, 0x3e, 0x80, 0xd3, 0x8a, 0xf1, 0x70,
+	0x32, 0xd8, 0x03, 0x38, 0x3e, 0xe4, 0x2a, 0xf0, 0xdf, 0x68, 0xa6, 0x1e, 0xb0, 0x76, 0xe9, 0x05,
+	0xc0, 0x32, 0xf6, 0x87, 0x30, 0xde, 0x73, 0xa0, 0xce, 0x0d, 0x23, 0x91, 0x10, 0xed, 0xdc, 0x9e,
+	0x10, 0x9f, 0x7d, 0xd9, 0xd8, 0xf9, 0x3d, 0x3f, 0xb2, 0xf3, 0x3c, 0xaf, 0x3c, 0x7d, 0x9e, 0x57,
+	0x7e, 0x7b, 0x9e, 0x57, 0x3e, 0xdf, 0xcd, 0x8f, 0xec, 0xec, 0xe6, 0x95, 0xa7, 0xbb, 0xf9, 0x91,
+	0x9f, 0x77, 0xf3, 0x23, 0x1f, 0x5c, 0x0c, 0x5c, 0x6d, 0x19, 0xed, 0x42, 0x03, 0xbb, 0x1d, 0x87,
+	0x6c, 0xf2, 0x46, 0xa9, 0x7d, 0xa5, 0xb4, 0xd5, 0xfd, 0xba, 0xcf, 0x2f, 0xba, 0xeb, 0x63, 0xfc,
+	0xc3, 0xfe, 0xa5, 0xbf, 0x07, 0x00, 0x23, 0xa7, 0xc2, 0x5a, 0x92, 0x18, 0x00, 0x00,
 }
 
 func (this *MsgGovUpdateRegistry) Equal(that interface{}) bool {
@@ -1579,6 +1968,15 @@ type MsgClient interface {
 	LeveragedLiquidate(ctx context.Context, in *MsgLeveragedLiquidate, opts ...grpc.CallOption) (*MsgLeveragedLiquidateResponse, error)
 	// SupplyCollateral combines the Supply and Collateralize actions.
 	SupplyCollateral(ctx context.Context, in *MsgSupplyCollateral, opts ...grpc.CallOption) (*MsgSupplyCollateralResponse, error)
+	// ClaimReferralRewards sends all referral rewards accumulated by the referrer to its account.
+	ClaimReferralRewards(ctx context.Context, in *MsgClaimReferralRewards, opts ...grpc.CallOption) (*MsgClaimReferralRewardsResponse, error)
+	// UpdateAllowList adds or removes accounts from the allow list of a permissioned Token.
+	// Must be signed by the Token's allow list admin.
+	UpdateAllowList(ctx context.Context, in *MsgUpdateAllowList, opts ...grpc.CallOption) (*MsgUpdateAllowListResponse, error)
+	// CreateDeleverageOrder creates or replaces the borrower's deleverage order for a token.
+	CreateDeleverageOrder(ctx context.Context, in *MsgCreateDeleverageOrder, opts ...grpc.CallOption) (*MsgCreateDeleverageOrderResponse, error)
+	// CancelDeleverageOrder removes the borrower's deleverage order for a token.
+	CancelDeleverageOrder(ctx context.Context, in *MsgCancelDeleverageOrder, opts ...grpc.CallOption) (*MsgCancelDeleverageOrderResponse, error)
 	// GovUpdateRegistry adds new tokens to the token registry or
 	// updates existing tokens with new settings.
 	GovUpdateRegistry(ctx context.Context, in *MsgGovUpdateRegistry, opts ...grpc.CallOption) (*MsgGovUpdateRegistryResponse, error)
@@ -1697,6 +2095,42 @@ func (c *msgClient) SupplyCollateral(ctx context.Context, in *MsgSupplyCollatera
 	return out, nil
 }
 
+func (c *msgClient) ClaimReferralRewards(ctx context.Context, in *MsgClaimReferralRewards, opts ...grpc.CallOption) (*MsgClaimReferralRewardsResponse, error) {
+	out := new(MsgClaimReferralRewardsResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Msg/ClaimReferralRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAllowList(ctx context.Context, in *MsgUpdateAllowList, opts ...grpc.CallOption) (*MsgUpdateAllowListResponse, error) {
+	out := new(MsgUpdateAllowListResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Msg/UpdateAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDeleverageOrder(ctx context.Context, in *MsgCreateDeleverageOrder, opts ...grpc.CallOption) (*MsgCreateDeleverageOrderResponse, error) {
+	out := new(MsgCreateDeleverageOrderResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Msg/CreateDeleverageOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDeleverageOrder(ctx context.Context, in *MsgCancelDeleverageOrder, opts ...grpc.CallOption) (*MsgCancelDeleverageOrderResponse, error) {
+	out := new(MsgCancelDeleverageOrderResponse)
+	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Msg/CancelDeleverageOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) GovUpdateRegistry(ctx context.Context, in *MsgGovUpdateRegistry, opts ...grpc.CallOption) (*MsgGovUpdateRegistryResponse, error) {
 	out := new(MsgGovUpdateRegistryResponse)
 	err := c.cc.Invoke(ctx, "/umee.leverage.v1.Msg/GovUpdateRegistry", in, out, opts...)
@@ -1766,6 +2200,15 @@ type MsgServer interface {
 	LeveragedLiquidate(context.Context, *MsgLeveragedLiquidate) (*MsgLeveragedLiquidateResponse, error)
 	// SupplyCollateral combines the Supply and Collateralize actions.
 	SupplyCollateral(context.Context, *MsgSupplyCollateral) (*MsgSupplyCollateralResponse, error)
+	// ClaimReferralRewards sends all referral rewards accumulated by the referrer to its account.
+	ClaimReferralRewards(context.Context, *MsgClaimReferralRewards) (*MsgClaimReferralRewardsResponse, error)
+	// UpdateAllowList adds or removes accounts from the allow list of a permissioned Token.
+	// Must be signed by the Token's allow list admin.
+	UpdateAllowList(context.Context, *MsgUpdateAllowList) (*MsgUpdateAllowListResponse, error)
+	// CreateDeleverageOrder creates or replaces the borrower's deleverage order for a token.
+	CreateDeleverageOrder(context.Context, *MsgCreateDeleverageOrder) (*MsgCreateDeleverageOrderResponse, error)
+	// CancelDeleverageOrder removes the borrower's deleverage order for a token.
+	CancelDeleverageOrder(context.Context, *MsgCancelDeleverageOrder) (*MsgCancelDeleverageOrderResponse, error)
 	// GovUpdateRegistry adds new tokens to the token registry or
 	// updates existing tokens with new settings.
 	GovUpdateRegistry(context.Context, *MsgGovUpdateRegistry) (*MsgGovUpdateRegistryResponse, error)
@@ -1814,6 +2257,18 @@ func (*UnimplementedMsgServer) LeveragedLiquidate(ctx context.Context, req *MsgL
 func (*UnimplementedMsgServer) SupplyCollateral(ctx context.Context, req *MsgSupplyCollateral) (*MsgSupplyCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyCollateral not implemented")
 }
+func (*UnimplementedMsgServer) ClaimReferralRewards(ctx context.Context, req *MsgClaimReferralRewards) (*MsgClaimReferralRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReferralRewards not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAllowList(ctx context.Context, req *MsgUpdateAllowList) (*MsgUpdateAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowList not implemented")
+}
+func (*UnimplementedMsgServer) CreateDeleverageOrder(ctx context.Context, req *MsgCreateDeleverageOrder) (*MsgCreateDeleverageOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeleverageOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelDeleverageOrder(ctx context.Context, req *MsgCancelDeleverageOrder) (*MsgCancelDeleverageOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeleverageOrder not implemented")
+}
 func (*UnimplementedMsgServer) GovUpdateRegistry(ctx context.Context, req *MsgGovUpdateRegistry) (*MsgGovUpdateRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateRegistry not implemented")
 }
@@ -2026,20 +2481,92 @@ func _Msg_SupplyCollateral_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_GovUpdateRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovUpdateRegistry)
+func _Msg_ClaimReferralRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReferralRewards)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).GovUpdateRegistry(ctx, in)
+		return srv.(MsgServer).ClaimReferralRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/umee.leverage.v1.Msg/GovUpdateRegistry",
+		FullMethod: "/umee.leverage.v1.Msg/ClaimReferralRewards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovUpdateRegistry(ctx, req.(*MsgGovUpdateRegistry))
+		return srv.(MsgServer).ClaimReferralRewards(ctx, req.(*MsgClaimReferralRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Msg/UpdateAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowList(ctx, req.(*MsgUpdateAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDeleverageOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDeleverageOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDeleverageOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Msg/CreateDeleverageOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDeleverageOrder(ctx, req.(*MsgCreateDeleverageOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDeleverageOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDeleverageOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDeleverageOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Msg/CancelDeleverageOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDeleverageOrder(ctx, req.(*MsgCancelDeleverageOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovUpdateRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateRegistry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.leverage.v1.Msg/GovUpdateRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateRegistry(ctx, req.(*MsgGovUpdateRegistry))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2128,6 +2655,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyCollateral",
 			Handler:    _Msg_SupplyCollateral_Handler,
 		},
+		{
+			MethodName: "ClaimReferralRewards",
+			Handler:    _Msg_ClaimReferralRewards_Handler,
+		},
+		{
+			MethodName: "UpdateAllowList",
+			Handler:    _Msg_UpdateAllowList_Handler,
+		},
+		{
+			MethodName: "CreateDeleverageOrder",
+			Handler:    _Msg_CreateDeleverageOrder_Handler,
+		},
+		{
+			MethodName: "CancelDeleverageOrder",
+			Handler:    _Msg_CancelDeleverageOrder_Handler,
+		},
 		{
 			MethodName: "GovUpdateRegistry",
 			Handler:    _Msg_GovUpdateRegistry_Handler,
@@ -2165,6 +2708,13 @@ func (m *MsgSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2362,6 +2912,13 @@ func (m *MsgBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2594,6 +3151,13 @@ func (m *MsgSupplyCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2614,6 +3178,185 @@ func (m *MsgSupplyCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimReferralRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReferralRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReferralRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDeleverageOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDeleverageOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDeleverageOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetRatio.Size()
+		i -= size
+		if _, err := m.TargetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TriggerRatio.Size()
+		i -= size
+		if _, err := m.TriggerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDeleverageOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDeleverageOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDeleverageOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSupplyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2987,7 +3730,7 @@ func (m *MsgSupplyCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovUpdateRegistry) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimReferralRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2997,34 +3740,20 @@ func (m *MsgGovUpdateRegistry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovUpdateRegistry) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimReferralRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovUpdateRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimReferralRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdateTokens) > 0 {
-		for iNdEx := len(m.UpdateTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UpdateTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.AddTokens) > 0 {
-		for iNdEx := len(m.AddTokens) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AddTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3032,27 +3761,13 @@ func (m *MsgGovUpdateRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovUpdateRegistryResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateAllowListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3062,12 +3777,146 @@ func (m *MsgGovUpdateRegistryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovUpdateRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovUpdateRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDeleverageOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDeleverageOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDeleverageOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDeleverageOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDeleverageOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDeleverageOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateTokens) > 0 {
+		for iNdEx := len(m.UpdateTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddTokens) > 0 {
+		for iNdEx := len(m.AddTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3249,6 +4098,10 @@ func (m *MsgSupply) Size() (n int) {
 	}
 	l = m.Asset.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3326,6 +4179,10 @@ func (m *MsgBorrow) Size() (n int) {
 	}
 	l = m.Asset.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3423,6 +4280,90 @@ func (m *MsgSupplyCollateral) Size() (n int) {
 	}
 	l = m.Asset.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimReferralRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateDeleverageOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TriggerRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TargetRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelDeleverageOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3549,6 +4490,48 @@ func (m *MsgSupplyCollateralResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimReferralRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDeleverageOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDeleverageOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgGovUpdateRegistry) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3749,22 +4732,54 @@ func (m *MsgSupply) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -4323,6 +5338,38 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4847,7 +5894,594 @@ func (m *MsgLeveragedLiquidate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRepay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRepay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimReferralRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReferralRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReferralRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDeleverageOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDeleverageOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDeleverageOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4875,11 +6509,11 @@ func (m *MsgLeveragedLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepayDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4907,11 +6541,13 @@ func (m *MsgLeveragedLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TriggerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRepay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4939,7 +6575,7 @@ func (m *MsgLeveragedLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRepay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4964,7 +6600,7 @@ func (m *MsgLeveragedLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDeleverageOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4987,15 +6623,15 @@ func (m *MsgSupplyCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDeleverageOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDeleverageOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5023,13 +6659,13 @@ func (m *MsgSupplyCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplier = string(dAtA[iNdEx:postIndex])
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5039,24 +6675,23 @@ func (m *MsgSupplyCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6025,6 +7660,240 @@ func (m *MsgSupplyCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimReferralRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReferralRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReferralRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDeleverageOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDeleverageOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDeleverageOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDeleverageOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDeleverageOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDeleverageOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgGovUpdateRegistry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
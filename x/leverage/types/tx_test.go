@@ -37,6 +37,10 @@ func TestTxs(t *testing.T) {
 		types.NewMsgLiquidate(testAddr, testAddr, token, uDenom),
 		types.NewMsgLeveragedLiquidate(testAddr, testAddr, token.Denom, uDenom, sdk.OneDec()),
 		types.NewMsgLeveragedLiquidate(testAddr, testAddr, "", "", sdk.ZeroDec()), // empty optional fields
+		types.NewMsgClaimReferralRewards(testAddr),
+		types.NewMsgUpdateAllowList(testAddr, denom, []sdk.AccAddress{testAddr}, nil),
+		types.NewMsgCreateDeleverageOrder(testAddr, denom, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.75")),
+		types.NewMsgCancelDeleverageOrder(testAddr, denom),
 	}
 
 	for _, tx := range txs {
@@ -61,6 +65,10 @@ func TestLegacyMsg(t *testing.T) {
 		types.NewMsgRepay(testAddr, token),
 		types.NewMsgLiquidate(testAddr, testAddr, token, uDenom),
 		types.NewMsgLeveragedLiquidate(testAddr, testAddr, token.Denom, uDenom, sdk.OneDec()),
+		types.NewMsgClaimReferralRewards(testAddr),
+		types.NewMsgUpdateAllowList(testAddr, denom, []sdk.AccAddress{testAddr}, nil),
+		types.NewMsgCreateDeleverageOrder(testAddr, denom, sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.75")),
+		types.NewMsgCancelDeleverageOrder(testAddr, denom),
 	}
 
 	for _, tx := range txs {
@@ -72,6 +80,54 @@ func TestLegacyMsg(t *testing.T) {
 	}
 }
 
+func TestReferrer(t *testing.T) {
+	referrer := sdk.AccAddress([]byte("referrer____________")).String()
+
+	supply := types.NewMsgSupply(testAddr, token)
+	supply.Referrer = referrer
+	assert.NilError(t, supply.ValidateBasic())
+
+	borrow := types.NewMsgBorrow(testAddr, token)
+	borrow.Referrer = "invalid"
+	assert.ErrorContains(t, borrow.ValidateBasic(), "decoding bech32 failed")
+
+	supplyCollateral := types.NewMsgSupplyCollateral(testAddr, token)
+	supplyCollateral.Referrer = testAddr.String()
+	assert.ErrorIs(t, supplyCollateral.ValidateBasic(), types.ErrSelfReferral)
+}
+
+func TestMsgUpdateAllowList(t *testing.T) {
+	other := sdk.AccAddress([]byte("other_______________"))
+
+	msg := types.NewMsgUpdateAllowList(testAddr, denom, nil, nil)
+	assert.ErrorContains(t, msg.ValidateBasic(), "empty add and remove lists")
+
+	msg = types.NewMsgUpdateAllowList(testAddr, uDenom, []sdk.AccAddress{other}, nil)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrUToken)
+
+	msg = types.NewMsgUpdateAllowList(testAddr, denom, []sdk.AccAddress{other}, []sdk.AccAddress{other})
+	assert.ErrorContains(t, msg.ValidateBasic(), "duplicate address")
+
+	msg = types.NewMsgUpdateAllowList(testAddr, denom, []sdk.AccAddress{other}, []sdk.AccAddress{testAddr})
+	assert.NilError(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateDeleverageOrder(t *testing.T) {
+	trigger, target := sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.75")
+
+	msg := types.NewMsgCreateDeleverageOrder(testAddr, denom, sdk.OneDec(), target)
+	assert.ErrorContains(t, msg.ValidateBasic(), "trigger ratio must be between 0 and 1")
+
+	msg = types.NewMsgCreateDeleverageOrder(testAddr, denom, trigger, trigger)
+	assert.ErrorContains(t, msg.ValidateBasic(), "target ratio must be positive and less than trigger ratio")
+
+	msg = types.NewMsgCreateDeleverageOrder(testAddr, uDenom, trigger, target)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrUToken)
+
+	msg = types.NewMsgCreateDeleverageOrder(testAddr, "", trigger, target)
+	assert.ErrorContains(t, msg.ValidateBasic(), "invalid denom")
+}
+
 func addV1ToType(s string) string {
 	return strings.Replace(s, "*types", "leverage.v1", 1)
 }
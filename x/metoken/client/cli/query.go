@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,7 +28,10 @@ func GetQueryCmd() *cobra.Command {
 		IndexBalances(),
 		SwapFee(),
 		RedeemFee(),
+		SwapIndexFee(),
 		IndexPrice(),
+		InterestPosition(),
+		IndexHistory(),
 	)
 
 	return cmd
@@ -141,6 +145,34 @@ func RedeemFee() *cobra.Command {
 	return cmd
 }
 
+// SwapIndexFee creates a Cobra command to query for the SwapIndexFee
+func SwapIndexFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-index-fee [metoken] [asset_denom] [to_metoken_denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Get the fee amount to be charged for a swap between indexes. All args are required.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := metoken.NewQueryClient(clientCtx)
+			queryReq := metoken.QuerySwapIndexFee{}
+
+			queryReq.Metoken = args[0]
+			queryReq.AssetDenom = args[1]
+			queryReq.ToMetokenDenom = args[2]
+
+			resp, err := queryClient.SwapIndexFee(cmd.Context(), &queryReq)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // IndexBalances creates a Cobra command to query for the x/metoken module Indexes assets balances
 // metoken_denom is optional, if it isn't provided then all the balances will be returned.
 func IndexBalances() *cobra.Command {
@@ -194,3 +226,90 @@ func IndexPrice() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// InterestPosition creates a Cobra command to query for the meTokens an account locked for interest in an Index.
+func InterestPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-position [address] [metoken_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Get the meTokens an account locked for interest and its claimable interest",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := metoken.NewQueryClient(clientCtx)
+			resp, err := queryClient.InterestPosition(cmd.Context(), &metoken.QueryInterestPosition{
+				Address:      args[0],
+				MetokenDenom: args[1],
+			})
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagSince = "since"
+	flagUntil = "until"
+)
+
+// IndexHistory creates a Cobra command to query for the snapshots of an Index and the annualized yield of its
+// meToken price.
+func IndexHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-history [metoken_denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the snapshots of an Index and the annualized yield of its meToken price over a window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := metoken.QueryIndexHistory{MetokenDenom: args[0], Pagination: pageReq}
+			if req.From, err = parseTimeFlag(cmd, flagSince); err != nil {
+				return err
+			}
+			if req.To, err = parseTimeFlag(cmd, flagUntil); err != nil {
+				return err
+			}
+
+			queryClient := metoken.NewQueryClient(clientCtx)
+			resp, err := queryClient.IndexHistory(cmd.Context(), &req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(flagSince, "",
+		"start of the window: RFC3339 timestamp or duration before now (e.g. 720h)")
+	cmd.Flags().String(flagUntil, "",
+		"end of the window: RFC3339 timestamp or duration before now (e.g. 24h)")
+	flags.AddPaginationFlagsToCmd(cmd, "index-history")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTimeFlag reads an optional time flag, given as RFC3339 timestamp or duration before now.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	s, _ := cmd.Flags().GetString(flag)
+	if s == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		t := time.Now().Add(-d).UTC()
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	return &t, nil
+}
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -25,6 +28,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		Swap(),
 		Redeem(),
+		SwapMulti(),
+		RedeemProportional(),
+		SwapIndex(),
+		LockForInterest(),
+		UnlockFromInterest(),
+		ClaimInterest(),
 	)
 
 	return cmd
@@ -49,10 +58,14 @@ func Swap() *cobra.Command {
 			}
 
 			msg := metoken.NewMsgSwap(clientCtx.GetFromAddress(), asset, args[1])
+			if msg.MinAmountOut, msg.Deadline, err = parseSlippageFlags(cmd); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addSlippageFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -76,10 +89,232 @@ func Redeem() *cobra.Command {
 			}
 
 			msg := metoken.NewMsgRedeem(clientCtx.GetFromAddress(), meToken, args[1])
+			if msg.MinAmountOut, msg.Deadline, err = parseSlippageFlags(cmd); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSlippageFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SwapMulti creates a Cobra command to generate or broadcast a transaction with a MsgSwapMulti message.
+// Both arguments are required.
+func SwapMulti() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-multi [coins] [metoken_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "swap a basket of accepted assets for the selected meToken",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			assets, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := metoken.NewMsgSwapMulti(clientCtx.GetFromAddress(), assets, args[1])
+			if msg.MinAmountOut, msg.Deadline, err = parseSlippageFlags(cmd); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addSlippageFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// RedeemProportional creates a Cobra command to generate or broadcast a transaction with a MsgRedeemProportional
+// message. The argument is required.
+func RedeemProportional() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-proportional [metoken]",
+		Args:  cobra.ExactArgs(1),
+		Short: "redeem a specified amount of meToken for all the assets of the index, pro-rata to the index balances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			meToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := metoken.NewMsgRedeemProportional(clientCtx.GetFromAddress(), meToken)
+			if s, _ := cmd.Flags().GetString(flagMinAmountsOut); s != "" {
+				if msg.MinAmountsOut, err = sdk.ParseCoinsNormalized(s); err != nil {
+					return err
+				}
+			}
+			if _, msg.Deadline, err = parseSlippageFlags(cmd); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMinAmountsOut, "",
+		"minimum amounts of the assets to receive, otherwise the transaction fails (e.g. 100uusdt,100uusdc)")
+	addDeadlineFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SwapIndex creates a Cobra command to generate or broadcast a transaction with a MsgSwapIndex message.
+// All the arguments are required.
+func SwapIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-index [metoken] [asset_denom] [to_metoken_denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "swap a specified amount of meToken for another meToken through an asset accepted by both indexes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			meToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := metoken.NewMsgSwapIndex(clientCtx.GetFromAddress(), meToken, args[1], args[2])
+			if msg.MinAmountOut, msg.Deadline, err = parseSlippageFlags(cmd); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSlippageFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// LockForInterest creates a Cobra command to generate or broadcast a transaction with a MsgLockForInterest message.
+// The argument is required.
+func LockForInterest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-for-interest [metoken]",
+		Args:  cobra.ExactArgs(1),
+		Short: "lock a specified amount of meToken to receive the index interest distributions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			meToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := metoken.NewMsgLockForInterest(clientCtx.GetFromAddress(), meToken)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UnlockFromInterest creates a Cobra command to generate or broadcast a transaction with a MsgUnlockFromInterest
+// message. The argument is required.
+func UnlockFromInterest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-from-interest [metoken]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unlock a specified amount of meToken locked for interest",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			meToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := metoken.NewMsgUnlockFromInterest(clientCtx.GetFromAddress(), meToken)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ClaimInterest creates a Cobra command to generate or broadcast a transaction with a MsgClaimInterest message.
+// The argument is required.
+func ClaimInterest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-interest [metoken_denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "claim the interest distributed to the meTokens locked for interest",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := metoken.NewMsgClaimInterest(clientCtx.GetFromAddress(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagMinAmountsOut = "min-amounts-out"
+	flagMinAmountOut  = "min-amount-out"
+	flagDeadline      = "deadline"
+)
+
+func addSlippageFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMinAmountOut, "",
+		"minimum amount of the output denom to receive, otherwise the transaction fails")
+	addDeadlineFlag(cmd)
+}
+
+func addDeadlineFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagDeadline, "",
+		"block time after which the transaction fails: RFC3339 timestamp or duration from now (e.g. 2m)")
+}
+
+// parseSlippageFlags reads the optional --min-amount-out and --deadline flags.
+func parseSlippageFlags(cmd *cobra.Command) (sdkmath.Int, *time.Time, error) {
+	minAmountOut := sdkmath.ZeroInt()
+	if s, _ := cmd.Flags().GetString(flagMinAmountOut); s != "" {
+		var ok bool
+		if minAmountOut, ok = sdkmath.NewIntFromString(s); !ok {
+			return minAmountOut, nil, fmt.Errorf("invalid %s: %s", flagMinAmountOut, s)
+		}
+	}
+
+	s, _ := cmd.Flags().GetString(flagDeadline)
+	if s == "" {
+		return minAmountOut, nil, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		deadline := time.Now().Add(d).UTC()
+		return minAmountOut, &deadline, nil
+	}
+	deadline, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return minAmountOut, nil, fmt.Errorf("invalid %s: %w", flagDeadline, err)
+	}
+	return minAmountOut, &deadline, nil
+}
@@ -127,6 +127,12 @@ func (s *IntegrationTests) TestValidQueries() {
 					"uumee",
 					sdkmath.NewInt(18_760000),
 				),
+				// exchange_rate = 1
+				// returned = (1876_000000 - 18760000) * exchange_rate = 1857_240000
+				Returned: sdk.NewCoin(
+					mfixtures.MeBondDenom,
+					sdkmath.NewInt(1857_240000),
+				),
 			},
 			ErrMsg: "",
 		},
@@ -155,6 +161,11 @@ func (s *IntegrationTests) TestValidQueries() {
 					"uumee",
 					sdkmath.NewInt(40_000000),
 				),
+				// returned = asset_to_redeem - total_fee = 60_000000
+				Returned: sdk.NewCoin(
+					"uumee",
+					sdkmath.NewInt(60_000000),
+				),
 			},
 			ErrMsg: "",
 		},
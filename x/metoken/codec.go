@@ -31,6 +31,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgGovUpdateRegistry{}, "umee/metoken/MsgGovUpdateRegistry", nil)
 	cdc.RegisterConcrete(&MsgSwap{}, "umee/metoken/MsgSwap", nil)
 	cdc.RegisterConcrete(&MsgRedeem{}, "umee/metoken/MsgRedeem", nil)
+	cdc.RegisterConcrete(&MsgSwapMulti{}, "umee/metoken/MsgSwapMulti", nil)
+	cdc.RegisterConcrete(&MsgRedeemProportional{}, "umee/metoken/MsgRedeemProportional", nil)
+	cdc.RegisterConcrete(&MsgSwapIndex{}, "umee/metoken/MsgSwapIndex", nil)
+	cdc.RegisterConcrete(&MsgLockForInterest{}, "umee/metoken/MsgLockForInterest", nil)
+	cdc.RegisterConcrete(&MsgUnlockFromInterest{}, "umee/metoken/MsgUnlockFromInterest", nil)
+	cdc.RegisterConcrete(&MsgClaimInterest{}, "umee/metoken/MsgClaimInterest", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -40,6 +46,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgGovUpdateRegistry{},
 		&MsgSwap{},
 		&MsgRedeem{},
+		&MsgSwapMulti{},
+		&MsgRedeemProportional{},
+		&MsgSwapIndex{},
+		&MsgLockForInterest{},
+		&MsgUnlockFromInterest{},
+		&MsgClaimInterest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,11 @@
+package metoken
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/metoken module errors
+var (
+	ErrMinAmountOut     = errors.Register(ModuleName, 2, "amount out is below the minimum amount out")
+	ErrDeadlineExceeded = errors.Register(ModuleName, 3, "deadline exceeded")
+)
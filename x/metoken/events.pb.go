@@ -6,6 +6,7 @@ package metoken
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -115,6 +116,96 @@ func (m *EventRedeem) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventRedeem proto.InternalMessageInfo
 
+// EventSwapMulti is emitted on Msg/SwapMulti
+type EventSwapMulti struct {
+	// meToken recipient bech32 address.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Assets provided for the swap.
+	Assets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=assets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"assets"`
+	// meToken received by the recipient in exchange for the provided assets.
+	Metoken types.Coin `protobuf:"bytes,3,opt,name=metoken,proto3" json:"metoken"`
+	// Fee provided for the swap.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *EventSwapMulti) Reset()         { *m = EventSwapMulti{} }
+func (m *EventSwapMulti) String() string { return proto.CompactTextString(m) }
+func (*EventSwapMulti) ProtoMessage()    {}
+func (*EventSwapMulti) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503099fd3bb02aa5, []int{2}
+}
+func (m *EventSwapMulti) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwapMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwapMulti.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwapMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwapMulti.Merge(m, src)
+}
+func (m *EventSwapMulti) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwapMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwapMulti.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwapMulti proto.InternalMessageInfo
+
+// EventRedeemProportional is emitted on Msg/RedeemProportional
+type EventRedeemProportional struct {
+	// Assets recipient bech32 address.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// meToken provided for the redemption.
+	Metoken types.Coin `protobuf:"bytes,2,opt,name=metoken,proto3" json:"metoken"`
+	// Assets received by the recipient in exchange for the provided meToken.
+	Assets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=assets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"assets"`
+	// Fee provided for the redemption.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *EventRedeemProportional) Reset()         { *m = EventRedeemProportional{} }
+func (m *EventRedeemProportional) String() string { return proto.CompactTextString(m) }
+func (*EventRedeemProportional) ProtoMessage()    {}
+func (*EventRedeemProportional) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503099fd3bb02aa5, []int{3}
+}
+func (m *EventRedeemProportional) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRedeemProportional) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRedeemProportional.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRedeemProportional) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRedeemProportional.Merge(m, src)
+}
+func (m *EventRedeemProportional) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRedeemProportional) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRedeemProportional.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRedeemProportional proto.InternalMessageInfo
+
 // EventRebalancing is emitted when a reserve re-balancing occurs.
 type EventRebalancing struct {
 	// RebalancingResults of every asset in every Index.
@@ -125,7 +216,7 @@ func (m *EventRebalancing) Reset()         { *m = EventRebalancing{} }
 func (m *EventRebalancing) String() string { return proto.CompactTextString(m) }
 func (*EventRebalancing) ProtoMessage()    {}
 func (*EventRebalancing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_503099fd3bb02aa5, []int{2}
+	return fileDescriptor_503099fd3bb02aa5, []int{4}
 }
 func (m *EventRebalancing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +258,7 @@ func (m *RebalancingResult) Reset()         { *m = RebalancingResult{} }
 func (m *RebalancingResult) String() string { return proto.CompactTextString(m) }
 func (*RebalancingResult) ProtoMessage()    {}
 func (*RebalancingResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_503099fd3bb02aa5, []int{3}
+	return fileDescriptor_503099fd3bb02aa5, []int{5}
 }
 func (m *RebalancingResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +297,7 @@ func (m *EventInterestClaim) Reset()         { *m = EventInterestClaim{} }
 func (m *EventInterestClaim) String() string { return proto.CompactTextString(m) }
 func (*EventInterestClaim) ProtoMessage()    {}
 func (*EventInterestClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_503099fd3bb02aa5, []int{4}
+	return fileDescriptor_503099fd3bb02aa5, []int{6}
 }
 func (m *EventInterestClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,48 +326,104 @@ func (m *EventInterestClaim) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventInterestClaim proto.InternalMessageInfo
 
+// EventSwapIndex is emitted on Msg/SwapIndex
+type EventSwapIndex struct {
+	// meToken recipient bech32 address.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// meToken provided for the swap.
+	FromMetoken types.Coin `protobuf:"bytes,2,opt,name=from_metoken,json=fromMetoken,proto3" json:"from_metoken"`
+	// meToken received by the recipient in exchange for the provided meToken.
+	ToMetoken types.Coin `protobuf:"bytes,3,opt,name=to_metoken,json=toMetoken,proto3" json:"to_metoken"`
+	// Fee provided for the swap.
+	Fee types.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *EventSwapIndex) Reset()         { *m = EventSwapIndex{} }
+func (m *EventSwapIndex) String() string { return proto.CompactTextString(m) }
+func (*EventSwapIndex) ProtoMessage()    {}
+func (*EventSwapIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503099fd3bb02aa5, []int{7}
+}
+func (m *EventSwapIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwapIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwapIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwapIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwapIndex.Merge(m, src)
+}
+func (m *EventSwapIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwapIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwapIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwapIndex proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventSwap)(nil), "umee.metoken.v1.EventSwap")
 	proto.RegisterType((*EventRedeem)(nil), "umee.metoken.v1.EventRedeem")
+	proto.RegisterType((*EventSwapMulti)(nil), "umee.metoken.v1.EventSwapMulti")
+	proto.RegisterType((*EventRedeemProportional)(nil), "umee.metoken.v1.EventRedeemProportional")
 	proto.RegisterType((*EventRebalancing)(nil), "umee.metoken.v1.EventRebalancing")
 	proto.RegisterType((*RebalancingResult)(nil), "umee.metoken.v1.RebalancingResult")
 	proto.RegisterType((*EventInterestClaim)(nil), "umee.metoken.v1.EventInterestClaim")
+	proto.RegisterType((*EventSwapIndex)(nil), "umee.metoken.v1.EventSwapIndex")
 }
 
 func init() { proto.RegisterFile("umee/metoken/v1/events.proto", fileDescriptor_503099fd3bb02aa5) }
 
 var fileDescriptor_503099fd3bb02aa5 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x8f, 0x9b, 0xc1, 0x54, 0x77, 0xdd, 0xc0, 0xda, 0x21, 0x9b, 0x50, 0xa8, 0xc2, 0xa5, 0x1c,
-	0x16, 0x2b, 0x43, 0x4c, 0xe2, 0xb8, 0x6c, 0xfc, 0xbb, 0x66, 0x12, 0x87, 0x5d, 0xaa, 0xfc, 0x79,
-	0x04, 0x6b, 0x8d, 0x5d, 0xc5, 0x6e, 0xc6, 0xc7, 0xe0, 0xc3, 0xf0, 0x21, 0x2a, 0x4e, 0x13, 0x27,
-	0x4e, 0x08, 0xda, 0x0b, 0xe2, 0x53, 0x20, 0xc7, 0xee, 0x98, 0xe0, 0xb0, 0xae, 0x37, 0x3f, 0xff,
-	0xfe, 0xbc, 0xf7, 0x7b, 0x6e, 0x83, 0x1f, 0x4d, 0x2b, 0x00, 0x5a, 0x81, 0x12, 0x17, 0xc0, 0x69,
-	0x13, 0x51, 0x68, 0x80, 0x2b, 0x19, 0x4e, 0x6a, 0xa1, 0x04, 0xd9, 0xd1, 0x68, 0x68, 0xd1, 0xb0,
-	0x89, 0xf6, 0x77, 0x4b, 0x51, 0x8a, 0x16, 0xa3, 0xfa, 0x64, 0x68, 0xfb, 0x7b, 0xb9, 0x90, 0x95,
-	0x90, 0x23, 0x03, 0x98, 0xc2, 0x42, 0xbe, 0xa9, 0x68, 0x96, 0x4a, 0xa0, 0x4d, 0x94, 0x81, 0x4a,
-	0x23, 0x9a, 0x0b, 0xc6, 0x0d, 0x1e, 0xfc, 0x42, 0xb8, 0xfb, 0x52, 0xb7, 0x3c, 0xbb, 0x4c, 0x27,
-	0xe4, 0x08, 0x77, 0x6b, 0xc8, 0xd9, 0x84, 0x01, 0x57, 0x1e, 0x1a, 0xa0, 0x61, 0x37, 0xf6, 0xbe,
-	0x7e, 0x3e, 0xd8, 0xb5, 0x96, 0xc7, 0x45, 0x51, 0x83, 0x94, 0x67, 0xaa, 0x66, 0xbc, 0x4c, 0xfe,
-	0x52, 0xc9, 0x73, 0x7c, 0x2f, 0x95, 0x12, 0x94, 0xd7, 0x19, 0xa0, 0x61, 0xef, 0x70, 0x2f, 0xb4,
-	0x02, 0xdd, 0x35, 0xb4, 0x5d, 0xc3, 0x13, 0xc1, 0x78, 0xbc, 0x31, 0xfb, 0xfe, 0xd8, 0x49, 0x0c,
-	0x9b, 0xbc, 0xc0, 0x9b, 0x36, 0x9b, 0xe7, 0xae, 0x26, 0x5c, 0xf2, 0x49, 0x84, 0xdd, 0xf7, 0x00,
-	0xde, 0xc6, 0x6a, 0x32, 0xcd, 0x0d, 0x7e, 0x23, 0xdc, 0x6b, 0xa3, 0x26, 0x50, 0x00, 0x54, 0x6b,
-	0x87, 0xbd, 0x31, 0x75, 0xe7, 0x8e, 0x53, 0x5f, 0xef, 0xc9, 0xbd, 0xd3, 0x9e, 0xd6, 0x08, 0xfb,
-	0x0e, 0x3f, 0xb0, 0x59, 0xb3, 0x74, 0x9c, 0xf2, 0x9c, 0xf1, 0x92, 0xc4, 0x78, 0xb3, 0x06, 0x39,
-	0x1d, 0x2b, 0xe9, 0xa1, 0x81, 0x3b, 0xec, 0x1d, 0x06, 0xe1, 0x3f, 0xbf, 0xaf, 0xf0, 0x06, 0x3d,
-	0x69, 0xa9, 0xcb, 0x04, 0x56, 0x18, 0x7c, 0x41, 0xf8, 0xe1, 0x7f, 0x24, 0xf2, 0x04, 0xf7, 0xad,
-	0xc9, 0xa8, 0x00, 0x2e, 0x2a, 0xb3, 0xce, 0x64, 0xcb, 0x5e, 0x9e, 0xea, 0x3b, 0xf2, 0x06, 0xef,
-	0x30, 0xce, 0x14, 0x4b, 0xc7, 0x23, 0xa3, 0x07, 0xaf, 0xd3, 0x8e, 0x71, 0x6b, 0xa2, 0x6d, 0xab,
-	0x8b, 0x8d, 0x8c, 0xbc, 0xc2, 0xdb, 0x66, 0x9e, 0x6b, 0x23, 0x77, 0x35, 0xa3, 0xbe, 0x91, 0x59,
-	0x9f, 0xe0, 0x1c, 0x93, 0x76, 0x49, 0x6f, 0xb9, 0x82, 0x1a, 0xa4, 0x3a, 0x19, 0xa7, 0xac, 0x22,
-	0xa7, 0xb8, 0x9f, 0xeb, 0x03, 0x14, 0x23, 0xf3, 0x58, 0x68, 0x35, 0xf3, 0x2d, 0xab, 0x3a, 0xd6,
-	0xa2, 0xf8, 0xf5, 0xec, 0xa7, 0xef, 0xcc, 0xe6, 0x3e, 0xba, 0x9a, 0xfb, 0xe8, 0xc7, 0xdc, 0x47,
-	0x9f, 0x16, 0xbe, 0x73, 0xb5, 0xf0, 0x9d, 0x6f, 0x0b, 0xdf, 0x39, 0x7f, 0x5a, 0x32, 0xf5, 0x61,
-	0x9a, 0x85, 0xb9, 0xa8, 0xa8, 0x7e, 0x83, 0x03, 0x0e, 0xea, 0x52, 0xd4, 0x17, 0x6d, 0x41, 0x9b,
-	0x23, 0xfa, 0x71, 0xf9, 0x4d, 0xc8, 0xee, 0xb7, 0x7f, 0xd4, 0x67, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x6e, 0x73, 0xaa, 0xc4, 0x2a, 0x04, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xae, 0x9b, 0xb1, 0xa9, 0xde, 0xda, 0x41, 0x34, 0x89, 0x6c, 0x42, 0x59, 0x15, 0x2e, 0xe5,
+	0xd0, 0x84, 0x82, 0x98, 0xc4, 0x05, 0x69, 0xd9, 0xf8, 0xd8, 0xa1, 0x12, 0xca, 0x24, 0x0e, 0x93,
+	0x50, 0x94, 0x26, 0xef, 0x8a, 0xd5, 0xc4, 0xae, 0x62, 0xb7, 0x1b, 0xff, 0x82, 0xdf, 0xc1, 0x99,
+	0xff, 0x40, 0xc5, 0xa9, 0xe2, 0xc4, 0x89, 0x8f, 0xf6, 0x82, 0xf8, 0x13, 0xa0, 0xc4, 0x6e, 0x57,
+	0x81, 0x04, 0x6d, 0x61, 0xe2, 0xd4, 0xd8, 0xef, 0xf3, 0xbc, 0x1f, 0x8f, 0x9f, 0xda, 0xf8, 0x46,
+	0x2f, 0x01, 0x70, 0x12, 0x10, 0xac, 0x03, 0xd4, 0xe9, 0x37, 0x1c, 0xe8, 0x03, 0x15, 0xdc, 0xee,
+	0xa6, 0x4c, 0x30, 0x7d, 0x33, 0x8b, 0xda, 0x2a, 0x6a, 0xf7, 0x1b, 0x3b, 0x5b, 0x6d, 0xd6, 0x66,
+	0x79, 0xcc, 0xc9, 0xbe, 0x24, 0x6c, 0x67, 0x3b, 0x64, 0x3c, 0x61, 0xdc, 0x97, 0x01, 0xb9, 0x50,
+	0x21, 0x53, 0xae, 0x9c, 0x56, 0xc0, 0xc1, 0xe9, 0x37, 0x5a, 0x20, 0x82, 0x86, 0x13, 0x32, 0x42,
+	0x65, 0xdc, 0xfa, 0x8a, 0x70, 0xe9, 0x61, 0x56, 0xf2, 0xf8, 0x2c, 0xe8, 0xea, 0x7b, 0xb8, 0x94,
+	0x42, 0x48, 0xba, 0x04, 0xa8, 0x30, 0x50, 0x15, 0xd5, 0x4a, 0xae, 0xf1, 0xfe, 0x4d, 0x7d, 0x4b,
+	0xa5, 0xdc, 0x8f, 0xa2, 0x14, 0x38, 0x3f, 0x16, 0x29, 0xa1, 0x6d, 0xef, 0x02, 0xaa, 0xdf, 0xc3,
+	0x57, 0x02, 0xce, 0x41, 0x18, 0xc5, 0x2a, 0xaa, 0xad, 0xdf, 0xd9, 0xb6, 0x15, 0x21, 0xab, 0x6a,
+	0xab, 0xaa, 0xf6, 0x01, 0x23, 0xd4, 0x5d, 0x19, 0x7c, 0xdc, 0x2d, 0x78, 0x12, 0xad, 0xdf, 0xc7,
+	0x6b, 0x6a, 0x36, 0x43, 0x9b, 0x8f, 0x38, 0xc1, 0xeb, 0x0d, 0xac, 0x9d, 0x02, 0x18, 0x2b, 0xf3,
+	0xd1, 0x32, 0xac, 0xf5, 0x0d, 0xe1, 0xf5, 0x7c, 0x54, 0x0f, 0x22, 0x80, 0x64, 0xe9, 0x61, 0x67,
+	0xba, 0x2e, 0x2e, 0xd8, 0xf5, 0x54, 0x27, 0x6d, 0x21, 0x9d, 0x96, 0x18, 0xf6, 0x6d, 0x11, 0x57,
+	0xa6, 0xe7, 0xda, 0xec, 0xc5, 0x82, 0x2c, 0x3d, 0x6f, 0x88, 0x57, 0xf3, 0x36, 0xb8, 0x51, 0xac,
+	0x6a, 0xbf, 0x6f, 0xe0, 0x76, 0xd6, 0xc0, 0xeb, 0x4f, 0xbb, 0xb5, 0x36, 0x11, 0x2f, 0x7a, 0x2d,
+	0x3b, 0x64, 0x89, 0xb2, 0xa3, 0xfa, 0xa9, 0xf3, 0xa8, 0xe3, 0x88, 0x97, 0x5d, 0xe0, 0x39, 0x81,
+	0x7b, 0x2a, 0xf5, 0xdf, 0x58, 0xe1, 0xf9, 0x44, 0x9d, 0x7f, 0xde, 0x5c, 0xae, 0xe4, 0xb0, 0x88,
+	0xaf, 0xcf, 0xd8, 0xe6, 0x69, 0xca, 0xba, 0x2c, 0x15, 0x84, 0xd1, 0x20, 0xfe, 0x1f, 0x16, 0xba,
+	0x38, 0x0d, 0xed, 0xf2, 0x4e, 0xe3, 0x92, 0x25, 0x7d, 0x86, 0xaf, 0x2a, 0x45, 0x5b, 0x41, 0x1c,
+	0xd0, 0x90, 0xd0, 0xb6, 0xee, 0xe2, 0xb5, 0x14, 0x78, 0x2f, 0x16, 0xdc, 0x40, 0x79, 0x59, 0xcb,
+	0xfe, 0xe9, 0xf2, 0xb3, 0x67, 0xe0, 0x5e, 0x0e, 0x9d, 0x68, 0xa3, 0x88, 0xd6, 0x3b, 0x84, 0xaf,
+	0xfd, 0x02, 0xd2, 0x6f, 0xe2, 0xb2, 0x4a, 0xe2, 0x47, 0x40, 0x59, 0x22, 0x0f, 0xca, 0xdb, 0x50,
+	0x9b, 0x87, 0xd9, 0x9e, 0xfe, 0x04, 0x6f, 0x12, 0x4a, 0x04, 0x09, 0x62, 0x5f, 0xf2, 0xe1, 0xcf,
+	0x6e, 0x97, 0xd5, 0x2b, 0x8a, 0xe7, 0x4a, 0x9a, 0xfe, 0x08, 0x57, 0x64, 0x3f, 0xd3, 0x44, 0xda,
+	0x7c, 0x89, 0xca, 0x92, 0xa6, 0xf2, 0x58, 0x27, 0x58, 0xcf, 0x45, 0x3a, 0xa2, 0x02, 0x52, 0xe0,
+	0xe2, 0x20, 0x0e, 0x48, 0xa2, 0x1f, 0xe2, 0x72, 0x98, 0x7d, 0x40, 0xe4, 0xcb, 0x9b, 0x04, 0xcd,
+	0x97, 0x7c, 0x43, 0xb1, 0xf6, 0x33, 0x92, 0xf5, 0x1d, 0xcd, 0xdc, 0x0e, 0x47, 0x34, 0x82, 0xf3,
+	0xa5, 0xad, 0xec, 0xe2, 0x8d, 0xd3, 0x94, 0x25, 0xfe, 0x82, 0x7e, 0x5e, 0xcf, 0x48, 0x4d, 0xe5,
+	0xe9, 0x07, 0x18, 0x0b, 0xe6, 0x2f, 0xf8, 0xff, 0x2f, 0x09, 0xd6, 0x5c, 0xfa, 0x31, 0x70, 0x1f,
+	0x0f, 0xbe, 0x98, 0x85, 0xc1, 0xc8, 0x44, 0xc3, 0x91, 0x89, 0x3e, 0x8f, 0x4c, 0xf4, 0x6a, 0x6c,
+	0x16, 0x86, 0x63, 0xb3, 0xf0, 0x61, 0x6c, 0x16, 0x4e, 0x6e, 0xcd, 0xf8, 0x39, 0x73, 0x61, 0x9d,
+	0x82, 0x38, 0x63, 0x69, 0x27, 0x5f, 0x38, 0xfd, 0x3d, 0xe7, 0x7c, 0xf2, 0x64, 0xb7, 0x56, 0xf3,
+	0x77, 0xf4, 0xee, 0x8f, 0x01, 0x00, 0xb1, 0x27, 0xdc, 0xce, 0xc9, 0x07, 0x00, 0x00,
 }
 
 func (m *EventSwap) Marshal() (dAtA []byte, err error) {
@@ -399,6 +546,142 @@ func (m *EventRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSwapMulti) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapMulti) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRedeemProportional) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRedeemProportional) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRedeemProportional) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventRebalancing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -531,6 +814,66 @@ func (m *EventInterestClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSwapIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ToMetoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.FromMetoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -580,15 +923,69 @@ func (m *EventRedeem) Size() (n int) {
 	return n
 }
 
-func (m *EventRebalancing) Size() (n int) {
+func (m *EventSwapMulti) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRedeemProportional) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRebalancing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
@@ -617,31 +1014,412 @@ func (m *RebalancingResult) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventInterestClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimedAsset) > 0 {
+		for _, e := range m.ClaimedAsset {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSwapIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FromMetoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ToMetoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventInterestClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimedAsset) > 0 {
-		for _, e := range m.ClaimedAsset {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventSwap) Unmarshal(dAtA []byte) error {
+func (m *EventSwapMulti) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -664,10 +1442,10 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSwapMulti: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSwapMulti: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -704,7 +1482,7 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -731,7 +1509,8 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, types.Coin{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -797,7 +1576,8 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -822,7 +1602,7 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRedeem) Unmarshal(dAtA []byte) error {
+func (m *EventRedeemProportional) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -845,10 +1625,10 @@ func (m *EventRedeem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRedeem: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRedeemProportional: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRedeemProportional: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -918,7 +1698,7 @@ func (m *EventRedeem) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -945,7 +1725,8 @@ func (m *EventRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, types.Coin{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -978,7 +1759,8 @@ func (m *EventRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1321,6 +2103,187 @@ func (m *EventInterestClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSwapIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwapIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwapIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMetoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromMetoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToMetoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToMetoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
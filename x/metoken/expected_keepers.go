@@ -37,5 +37,6 @@ type LeverageKeeper interface {
 // OracleKeeper interface for price feed.
 type OracleKeeper interface {
 	AllMedianPrices(ctx sdk.Context) otypes.Prices
+	GetExchangeRate(ctx sdk.Context, symbol string) (otypes.ExchangeRate, error)
 	SetExchangeRate(ctx sdk.Context, denom string, rate sdk.Dec)
 }
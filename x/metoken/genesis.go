@@ -51,6 +51,47 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	locked := make(map[string]sdkmath.Int)
+	for _, d := range gs.InterestDistributions {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if _, ok := locked[d.MetokenDenom]; ok {
+			return fmt.Errorf("duplicated interest distribution %s", d.MetokenDenom)
+		}
+		locked[d.MetokenDenom] = d.Locked
+	}
+
+	for _, p := range gs.InterestPositions {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		total, ok := locked[p.Locked.Denom]
+		if !ok {
+			return fmt.Errorf("interest position of %s without interest distribution", p.Locked.Denom)
+		}
+		locked[p.Locked.Denom] = total.Sub(p.Locked.Amount)
+	}
+	for denom, diff := range locked {
+		if !diff.IsZero() {
+			return fmt.Errorf("locked %s in interest distribution doesn't match the interest positions", denom)
+		}
+	}
+
+	snapshots := make(map[string]map[int64]struct{})
+	for _, s := range gs.IndexHistory {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if snapshots[s.MetokenDenom] == nil {
+			snapshots[s.MetokenDenom] = make(map[int64]struct{})
+		}
+		if _, ok := snapshots[s.MetokenDenom][s.BlockHeight]; ok {
+			return fmt.Errorf("duplicated snapshot of %s at height %d", s.MetokenDenom, s.BlockHeight)
+		}
+		snapshots[s.MetokenDenom][s.BlockHeight] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -6,6 +6,7 @@ package metoken
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,11 +32,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the x/metoken module's genesis state.
 type GenesisState struct {
-	Params                Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Registry              []Index         `protobuf:"bytes,2,rep,name=registry,proto3" json:"registry"`
-	Balances              []IndexBalances `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances"`
-	NextRebalancingTime   time.Time       `protobuf:"bytes,4,opt,name=next_rebalancing_time,json=nextRebalancingTime,proto3,stdtime" json:"next_rebalancing_time"`
-	NextInterestClaimTime time.Time       `protobuf:"bytes,5,opt,name=next_interest_claim_time,json=nextInterestClaimTime,proto3,stdtime" json:"next_interest_claim_time"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Registry              []Index                `protobuf:"bytes,2,rep,name=registry,proto3" json:"registry"`
+	Balances              []IndexBalances        `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances"`
+	NextRebalancingTime   time.Time              `protobuf:"bytes,4,opt,name=next_rebalancing_time,json=nextRebalancingTime,proto3,stdtime" json:"next_rebalancing_time"`
+	NextInterestClaimTime time.Time              `protobuf:"bytes,5,opt,name=next_interest_claim_time,json=nextInterestClaimTime,proto3,stdtime" json:"next_interest_claim_time"`
+	InterestDistributions []InterestDistribution `protobuf:"bytes,6,rep,name=interest_distributions,json=interestDistributions,proto3" json:"interest_distributions"`
+	InterestPositions     []InterestPosition     `protobuf:"bytes,7,rep,name=interest_positions,json=interestPositions,proto3" json:"interest_positions"`
+	IndexHistory          []IndexSnapshot        `protobuf:"bytes,8,rep,name=index_history,json=indexHistory,proto3" json:"index_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -152,51 +156,211 @@ func (m *AssetBalance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AssetBalance proto.InternalMessageInfo
 
+// InterestDistribution tracks the interest distributed to the meTokens locked for interest in an Index.
+type InterestDistribution struct {
+	MetokenDenom string `protobuf:"bytes,1,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+	// Locked is the amount of meTokens locked for interest.
+	Locked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked,proto3,customtype=cosmossdk.io/math.Int" json:"locked"`
+	// Interest Per Metoken is the cumulative interest distributed per locked meToken unit.
+	InterestPerMetoken github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=interest_per_metoken,json=interestPerMetoken,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"interest_per_metoken"`
+	// Unclaimed is the distributed interest not claimed yet. It is part of the Index interest balances.
+	Unclaimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=unclaimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unclaimed"`
+}
+
+func (m *InterestDistribution) Reset()         { *m = InterestDistribution{} }
+func (m *InterestDistribution) String() string { return proto.CompactTextString(m) }
+func (*InterestDistribution) ProtoMessage()    {}
+func (*InterestDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5df2a396d6481bf7, []int{3}
+}
+func (m *InterestDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestDistribution.Merge(m, src)
+}
+func (m *InterestDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestDistribution proto.InternalMessageInfo
+
+// InterestPosition is the amount of meTokens an account locked for interest.
+type InterestPosition struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Locked  types.Coin `protobuf:"bytes,2,opt,name=locked,proto3" json:"locked"`
+	// Checkpoint is the Index InterestDistribution.interest_per_metoken when the position interest was last claimed.
+	Checkpoint github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=checkpoint,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"checkpoint"`
+	// Unlock Time is the earliest time the locked meTokens can be unlocked while the Index has INTEREST_POLICY_CLAIM.
+	// It's moved forward by claiming_frequency on every lock.
+	UnlockTime time.Time `protobuf:"bytes,4,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *InterestPosition) Reset()         { *m = InterestPosition{} }
+func (m *InterestPosition) String() string { return proto.CompactTextString(m) }
+func (*InterestPosition) ProtoMessage()    {}
+func (*InterestPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5df2a396d6481bf7, []int{4}
+}
+func (m *InterestPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestPosition.Merge(m, src)
+}
+func (m *InterestPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestPosition proto.InternalMessageInfo
+
+// IndexSnapshot is the state of an Index recorded after the reserves re-balancing or the interest claiming.
+type IndexSnapshot struct {
+	MetokenDenom string    `protobuf:"bytes,1,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+	BlockHeight  int64     `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Time         time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Price in USD of one unit of meToken, expressed in decimals.
+	Price         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	MetokenSupply types.Coin                             `protobuf:"bytes,5,opt,name=metoken_supply,json=metokenSupply,proto3" json:"metoken_supply"`
+	AssetBalances []AssetBalance                         `protobuf:"bytes,6,rep,name=asset_balances,json=assetBalances,proto3" json:"asset_balances"`
+	// Allocation Drift is the sum of the absolute differences between the current and the target allocation of every
+	// accepted asset.
+	AllocationDrift github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=allocation_drift,json=allocationDrift,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"allocation_drift"`
+}
+
+func (m *IndexSnapshot) Reset()         { *m = IndexSnapshot{} }
+func (m *IndexSnapshot) String() string { return proto.CompactTextString(m) }
+func (*IndexSnapshot) ProtoMessage()    {}
+func (*IndexSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5df2a396d6481bf7, []int{5}
+}
+func (m *IndexSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexSnapshot.Merge(m, src)
+}
+func (m *IndexSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexSnapshot proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "umee.metoken.v1.GenesisState")
 	proto.RegisterType((*IndexBalances)(nil), "umee.metoken.v1.IndexBalances")
 	proto.RegisterType((*AssetBalance)(nil), "umee.metoken.v1.AssetBalance")
+	proto.RegisterType((*InterestDistribution)(nil), "umee.metoken.v1.InterestDistribution")
+	proto.RegisterType((*InterestPosition)(nil), "umee.metoken.v1.InterestPosition")
+	proto.RegisterType((*IndexSnapshot)(nil), "umee.metoken.v1.IndexSnapshot")
 }
 
 func init() { proto.RegisterFile("umee/metoken/v1/genesis.proto", fileDescriptor_5df2a396d6481bf7) }
 
 var fileDescriptor_5df2a396d6481bf7 = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0xcd, 0x24, 0x69, 0x95, 0xba, 0x3f, 0x9f, 0xe4, 0xaf, 0x85, 0x21, 0x52, 0x26, 0x55, 0x56,
-	0x65, 0x81, 0xad, 0x14, 0x81, 0x40, 0x6c, 0x20, 0x95, 0xa8, 0xc2, 0x0a, 0xa5, 0x2c, 0x10, 0x12,
-	0x8a, 0x3c, 0xc9, 0xed, 0x74, 0x94, 0xd8, 0x1e, 0x8d, 0x9d, 0x21, 0x7d, 0x05, 0x56, 0x7d, 0x07,
-	0x5e, 0x26, 0xcb, 0x2e, 0x11, 0x8b, 0x02, 0xc9, 0x5b, 0xb0, 0x42, 0xf6, 0x78, 0xd2, 0x42, 0x84,
-	0x0a, 0xbb, 0xb1, 0xef, 0x39, 0xe7, 0x1e, 0x9f, 0x7b, 0x07, 0x35, 0x26, 0x1c, 0x80, 0x72, 0xd0,
-	0x72, 0x04, 0x82, 0x66, 0x6d, 0x1a, 0x81, 0x00, 0x15, 0x2b, 0x92, 0xa4, 0x52, 0x4b, 0xfc, 0x9f,
-	0x29, 0x13, 0x57, 0x26, 0x59, 0xbb, 0x1e, 0x0c, 0xa4, 0xe2, 0x52, 0xd1, 0x90, 0x29, 0xa0, 0x59,
-	0x3b, 0x04, 0xcd, 0xda, 0x74, 0x20, 0x63, 0x91, 0x13, 0xea, 0xbb, 0x91, 0x8c, 0xa4, 0xfd, 0xa4,
-	0xe6, 0xcb, 0xdd, 0x36, 0x23, 0x29, 0xa3, 0x31, 0x50, 0x7b, 0x0a, 0x27, 0xa7, 0x54, 0xc7, 0x1c,
-	0x94, 0x66, 0x3c, 0x71, 0x80, 0x15, 0x1b, 0x45, 0x4b, 0x5b, 0x6e, 0xfd, 0x28, 0xa3, 0xad, 0xe3,
-	0xdc, 0xd8, 0x89, 0x66, 0x1a, 0xf0, 0x23, 0xb4, 0x9e, 0xb0, 0x94, 0x71, 0xe5, 0x7b, 0xfb, 0xde,
-	0xc1, 0xe6, 0xe1, 0x5d, 0xf2, 0x9b, 0x51, 0xf2, 0xda, 0x96, 0x3b, 0xd5, 0xd9, 0x55, 0xb3, 0xd4,
-	0x73, 0x60, 0xfc, 0x04, 0xd5, 0x52, 0x88, 0x62, 0xa5, 0xd3, 0x73, 0xbf, 0xbc, 0x5f, 0x39, 0xd8,
-	0x3c, 0xbc, 0xb3, 0x42, 0xec, 0x8a, 0x21, 0x4c, 0x1d, 0x6f, 0x89, 0xc6, 0xcf, 0x51, 0x2d, 0x64,
-	0x63, 0x26, 0x06, 0xa0, 0xfc, 0x8a, 0x65, 0x06, 0x7f, 0x60, 0x3a, 0x54, 0xa1, 0x50, 0xb0, 0xf0,
-	0x5b, 0xb4, 0x27, 0x60, 0xaa, 0xfb, 0x29, 0xe4, 0x57, 0xb1, 0x88, 0xfa, 0x26, 0x06, 0xbf, 0x6a,
-	0x5f, 0x50, 0x27, 0x79, 0x46, 0xa4, 0xc8, 0x88, 0xbc, 0x29, 0x32, 0xea, 0xd4, 0x8c, 0xd4, 0xc5,
-	0xd7, 0xa6, 0xd7, 0xfb, 0xdf, 0x48, 0xf4, 0xae, 0x15, 0x0c, 0x06, 0xbf, 0x47, 0xbe, 0x55, 0x8e,
-	0x85, 0x86, 0x14, 0x94, 0xee, 0x0f, 0xc6, 0x2c, 0xe6, 0xb9, 0xf8, 0xda, 0x3f, 0x88, 0x5b, 0x7f,
-	0x5d, 0x27, 0x72, 0x64, 0x34, 0x0c, 0xaa, 0xf5, 0xc9, 0x43, 0xdb, 0xbf, 0x3c, 0x0d, 0xbf, 0x44,
-	0x3b, 0xee, 0xd9, 0x7d, 0x35, 0x49, 0x92, 0xf1, 0xb9, 0x9b, 0xc2, 0x3d, 0x92, 0x6f, 0x07, 0x31,
-	0xdb, 0x41, 0xdc, 0x76, 0x90, 0x23, 0x19, 0x0b, 0x97, 0xc6, 0xb6, 0xa3, 0x9d, 0x58, 0x16, 0x7e,
-	0x85, 0x76, 0x98, 0x52, 0xa0, 0xfb, 0xcb, 0x68, 0xf3, 0xa1, 0x34, 0x56, 0xa2, 0x7d, 0x61, 0x60,
-	0xae, 0x7f, 0xa1, 0xc5, 0x6e, 0xdc, 0xa9, 0xd6, 0xc7, 0x32, 0xda, 0xba, 0x89, 0xc2, 0xbb, 0x68,
-	0x6d, 0x08, 0x42, 0x72, 0xeb, 0x6d, 0xa3, 0x97, 0x1f, 0xf0, 0x33, 0xb4, 0x31, 0x86, 0x0c, 0x52,
-	0x16, 0xc1, 0xd0, 0x2f, 0x9b, 0x4a, 0xa7, 0x61, 0xe4, 0xbe, 0x5c, 0x35, 0xf7, 0x72, 0xf3, 0x6a,
-	0x38, 0x22, 0xb1, 0xa4, 0x9c, 0xe9, 0x33, 0xd2, 0x15, 0xba, 0x77, 0x8d, 0xc7, 0x4f, 0xcd, 0xfa,
-	0x28, 0x48, 0x33, 0x18, 0xfa, 0x95, 0xbf, 0xe1, 0x2e, 0xe1, 0xb8, 0x8d, 0xaa, 0xa7, 0x00, 0xca,
-	0x0e, 0xfb, 0x56, 0x9a, 0x85, 0x9a, 0x6e, 0xc5, 0x44, 0xed, 0x18, 0x6f, 0xef, 0x56, 0xc0, 0x3b,
-	0xc7, 0xb3, 0xef, 0x41, 0x69, 0x36, 0x0f, 0xbc, 0xcb, 0x79, 0xe0, 0x7d, 0x9b, 0x07, 0xde, 0xc5,
-	0x22, 0x28, 0x5d, 0x2e, 0x82, 0xd2, 0xe7, 0x45, 0x50, 0x7a, 0x77, 0x3f, 0x8a, 0xf5, 0xd9, 0x24,
-	0x24, 0x03, 0xc9, 0xa9, 0x09, 0xfa, 0x81, 0x00, 0xfd, 0x41, 0xa6, 0x23, 0x7b, 0xa0, 0xd9, 0x63,
-	0x3a, 0x2d, 0x7e, 0xbf, 0x70, 0xdd, 0x2e, 0xcc, 0xc3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1,
-	0x77, 0x6b, 0x0a, 0x27, 0x04, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x55,
+	0x14, 0xf5, 0x77, 0x92, 0x1b, 0xa7, 0x2d, 0x8f, 0xa4, 0x0c, 0x11, 0x19, 0xa7, 0x46, 0xa0, 0x20,
+	0xd4, 0x19, 0xdc, 0xaa, 0x50, 0xc4, 0x06, 0x52, 0x43, 0x1b, 0x24, 0xa4, 0xc8, 0x41, 0x08, 0x90,
+	0xd0, 0x68, 0x3e, 0x6e, 0xc6, 0x4f, 0xf6, 0xcc, 0x1b, 0xe6, 0x3d, 0x9b, 0x64, 0x89, 0xd8, 0xb1,
+	0xea, 0x3f, 0x60, 0xc1, 0x8e, 0x1f, 0x82, 0xb2, 0xec, 0x12, 0xb1, 0x68, 0x21, 0xf9, 0x23, 0xe8,
+	0x7d, 0x8c, 0xed, 0x24, 0x6e, 0xe5, 0x54, 0x74, 0x15, 0xbf, 0x77, 0xcf, 0x39, 0xf7, 0xce, 0x79,
+	0xf7, 0x5e, 0x05, 0xb6, 0x46, 0x09, 0xa2, 0x9b, 0xa0, 0x60, 0x03, 0x4c, 0xdd, 0x71, 0xc7, 0x8d,
+	0x31, 0x45, 0x4e, 0xb9, 0x93, 0xe5, 0x4c, 0x30, 0x72, 0x5d, 0x86, 0x1d, 0x13, 0x76, 0xc6, 0x9d,
+	0x4d, 0x3b, 0x64, 0x3c, 0x61, 0xdc, 0x0d, 0x7c, 0x8e, 0xee, 0xb8, 0x13, 0xa0, 0xf0, 0x3b, 0x6e,
+	0xc8, 0x68, 0xaa, 0x09, 0x9b, 0xeb, 0x31, 0x8b, 0x99, 0xfa, 0xe9, 0xca, 0x5f, 0xe6, 0xb6, 0x15,
+	0x33, 0x16, 0x0f, 0xd1, 0x55, 0xa7, 0x60, 0x74, 0xe8, 0x0a, 0x9a, 0x20, 0x17, 0x7e, 0x92, 0x19,
+	0xc0, 0xa5, 0x32, 0x8a, 0x94, 0x2a, 0xdc, 0xfe, 0xb9, 0x0e, 0xcd, 0x87, 0xba, 0xb0, 0x03, 0xe1,
+	0x0b, 0x24, 0xf7, 0xa0, 0x91, 0xf9, 0xb9, 0x9f, 0x70, 0xab, 0xbc, 0x5d, 0xde, 0x59, 0xbd, 0xf3,
+	0x86, 0x73, 0xa1, 0x50, 0x67, 0x5f, 0x85, 0x77, 0x6b, 0x27, 0x4f, 0x5b, 0xa5, 0x9e, 0x01, 0x93,
+	0xfb, 0xb0, 0x9c, 0x63, 0x4c, 0xb9, 0xc8, 0x8f, 0xad, 0xca, 0x76, 0x75, 0x67, 0xf5, 0xce, 0xcd,
+	0x4b, 0xc4, 0xbd, 0x34, 0xc2, 0x23, 0xc3, 0x9b, 0xa0, 0xc9, 0xa7, 0xb0, 0x1c, 0xf8, 0x43, 0x3f,
+	0x0d, 0x91, 0x5b, 0x55, 0xc5, 0xb4, 0x9f, 0xc3, 0x34, 0xa8, 0x42, 0xa1, 0x60, 0x91, 0x6f, 0x61,
+	0x23, 0xc5, 0x23, 0xe1, 0xe5, 0xa8, 0xaf, 0x68, 0x1a, 0x7b, 0xd2, 0x06, 0xab, 0xa6, 0xbe, 0x60,
+	0xd3, 0xd1, 0x1e, 0x39, 0x85, 0x47, 0xce, 0xd7, 0x85, 0x47, 0xbb, 0xcb, 0x52, 0xea, 0xf1, 0xb3,
+	0x56, 0xb9, 0xf7, 0xba, 0x94, 0xe8, 0x4d, 0x15, 0x24, 0x86, 0xfc, 0x00, 0x96, 0x52, 0xa6, 0xa9,
+	0xc0, 0x1c, 0xb9, 0xf0, 0xc2, 0xa1, 0x4f, 0x13, 0x2d, 0x5e, 0xbf, 0x82, 0xb8, 0xaa, 0x6f, 0xcf,
+	0x88, 0x3c, 0x90, 0x1a, 0x4a, 0x3e, 0x80, 0x9b, 0x13, 0xe5, 0x48, 0xba, 0x41, 0x83, 0x91, 0xa0,
+	0x2c, 0xe5, 0x56, 0x43, 0x19, 0xf1, 0xce, 0x1c, 0x23, 0x34, 0xbc, 0x3b, 0x83, 0x36, 0x7e, 0x6c,
+	0xd0, 0x39, 0x31, 0x4e, 0xbe, 0x01, 0x32, 0xc9, 0x91, 0x31, 0x4e, 0xb5, 0xfe, 0x92, 0xd2, 0xbf,
+	0xf5, 0x5c, 0xfd, 0x7d, 0x83, 0x34, 0xda, 0xaf, 0xd1, 0x0b, 0xf7, 0x9c, 0xec, 0xc1, 0x1a, 0x95,
+	0xaf, 0xe2, 0xf5, 0x29, 0x17, 0x2c, 0x3f, 0xb6, 0x96, 0x5f, 0xf4, 0x76, 0x07, 0xa9, 0x9f, 0xf1,
+	0x3e, 0x13, 0x46, 0xaf, 0xa9, 0xa8, 0x8f, 0x34, 0xb3, 0xfd, 0x7b, 0x19, 0xd6, 0xce, 0xbd, 0x30,
+	0xf9, 0x02, 0xae, 0x19, 0x05, 0x8f, 0x8f, 0xb2, 0x6c, 0x78, 0x6c, 0x9a, 0xf1, 0x4d, 0x47, 0x0f,
+	0x89, 0x23, 0x87, 0xc4, 0x31, 0x43, 0xe2, 0x3c, 0x60, 0xb4, 0x28, 0x74, 0xcd, 0xd0, 0x0e, 0x14,
+	0x8b, 0x7c, 0x09, 0xd7, 0x7c, 0xce, 0x51, 0x78, 0x93, 0x0e, 0xd3, 0xbd, 0xb9, 0x75, 0xa9, 0xca,
+	0xcf, 0x24, 0xcc, 0xe4, 0x2f, 0xb4, 0xfc, 0x99, 0x3b, 0xde, 0xfe, 0xb5, 0x02, 0xcd, 0x59, 0x14,
+	0x59, 0x87, 0x7a, 0x84, 0x29, 0x4b, 0x54, 0x6d, 0x2b, 0x3d, 0x7d, 0x20, 0x9f, 0xc0, 0xca, 0x10,
+	0xc7, 0x98, 0xfb, 0x31, 0x46, 0x56, 0x45, 0x46, 0x76, 0xb7, 0xa4, 0xdc, 0xdf, 0x4f, 0x5b, 0x1b,
+	0xba, 0x78, 0x1e, 0x0d, 0x1c, 0xca, 0xdc, 0xc4, 0x17, 0x7d, 0xe9, 0x77, 0x6f, 0x8a, 0x27, 0x1f,
+	0xcb, 0x29, 0xe2, 0x98, 0x8f, 0x31, 0xb2, 0xaa, 0x8b, 0x70, 0x27, 0x70, 0xd2, 0x81, 0xda, 0x21,
+	0x22, 0xb7, 0x6a, 0x8b, 0xd0, 0x14, 0x54, 0x66, 0x2b, 0xde, 0xd5, 0xaa, 0x2f, 0x42, 0x9b, 0xc0,
+	0xdb, 0x67, 0x15, 0x58, 0x9f, 0xd7, 0x8b, 0xe4, 0x6d, 0x28, 0x9e, 0xc0, 0x9b, 0x35, 0xa7, 0x69,
+	0x2e, 0xbb, 0xca, 0xa3, 0x7b, 0xd0, 0x18, 0xb2, 0x70, 0xb0, 0xa8, 0x41, 0x06, 0x4c, 0x7e, 0x29,
+	0xc3, 0xfa, 0xb4, 0x97, 0x31, 0xf7, 0x8c, 0xa8, 0x59, 0x1b, 0x6f, 0xcd, 0x6d, 0x8e, 0x2e, 0x86,
+	0xaa, 0x3f, 0xee, 0xca, 0x1c, 0x7f, 0x3c, 0x6b, 0xbd, 0x1f, 0x53, 0xd1, 0x1f, 0x05, 0x4e, 0xc8,
+	0x12, 0xd7, 0x6c, 0x5c, 0xfd, 0xe7, 0x36, 0x8f, 0x06, 0xae, 0x38, 0xce, 0x90, 0x17, 0x1c, 0xde,
+	0x9b, 0x8c, 0xce, 0x3e, 0xe6, 0x5f, 0xe9, 0x64, 0x84, 0xc2, 0xca, 0x28, 0x55, 0x7b, 0x00, 0x23,
+	0xab, 0xb6, 0x5d, 0x7d, 0x71, 0x5b, 0x7e, 0x60, 0xd2, 0xee, 0x2c, 0x90, 0x56, 0xe7, 0x9c, 0xaa,
+	0xb7, 0x7f, 0xab, 0xc0, 0x8d, 0x8b, 0x13, 0x49, 0x2c, 0x58, 0xf2, 0xa3, 0x28, 0x47, 0xce, 0x8d,
+	0xb7, 0xc5, 0x91, 0x7c, 0x74, 0xce, 0xd6, 0x05, 0xa6, 0xa5, 0x30, 0xf6, 0x47, 0x80, 0xb0, 0x8f,
+	0xe1, 0x20, 0x63, 0x34, 0x15, 0xaf, 0xce, 0xcd, 0x99, 0x24, 0xe4, 0x73, 0x58, 0x1d, 0xa5, 0x32,
+	0xfd, 0xd5, 0x37, 0x35, 0x68, 0xa2, 0x0c, 0xb5, 0xff, 0xac, 0xc2, 0xda, 0xb9, 0x05, 0xb3, 0x58,
+	0x03, 0xde, 0x82, 0x66, 0xa0, 0x92, 0xf7, 0x91, 0xc6, 0x7d, 0xa1, 0xfc, 0xaa, 0xf6, 0x56, 0xd5,
+	0xdd, 0x23, 0x75, 0x45, 0xee, 0x43, 0x4d, 0x55, 0x56, 0xbd, 0x42, 0x65, 0x8a, 0x41, 0xba, 0x50,
+	0xcf, 0x72, 0x1a, 0xa2, 0x19, 0x45, 0xc7, 0x34, 0xf7, 0xbb, 0x8b, 0x59, 0xd5, 0xd3, 0xe4, 0x39,
+	0x2b, 0xb0, 0xfe, 0x3f, 0xad, 0xc0, 0xc6, 0xcb, 0xae, 0x40, 0xf2, 0x1d, 0xdc, 0xf0, 0x87, 0x43,
+	0x16, 0xfa, 0xb2, 0x11, 0xbd, 0x28, 0xa7, 0x87, 0xc2, 0x5a, 0x7a, 0xa9, 0x8f, 0xbc, 0x3e, 0xd5,
+	0xe9, 0x4a, 0x99, 0xdd, 0x87, 0x27, 0xff, 0xda, 0xa5, 0x93, 0x53, 0xbb, 0xfc, 0xe4, 0xd4, 0x2e,
+	0xff, 0x73, 0x6a, 0x97, 0x1f, 0x9f, 0xd9, 0xa5, 0x27, 0x67, 0x76, 0xe9, 0xaf, 0x33, 0xbb, 0xf4,
+	0xfd, 0x7b, 0x33, 0xb2, 0xb2, 0xec, 0xdb, 0x29, 0x8a, 0x9f, 0x58, 0x3e, 0x50, 0x07, 0x77, 0xfc,
+	0xa1, 0x7b, 0x54, 0xfc, 0x5b, 0x13, 0x34, 0xd4, 0x0b, 0xdd, 0xfd, 0x6f, 0x00, 0xbe, 0x24, 0xae,
+	0x7c, 0x7f, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -219,6 +383,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IndexHistory) > 0 {
+		for iNdEx := len(m.IndexHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.InterestPositions) > 0 {
+		for iNdEx := len(m.InterestPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.InterestDistributions) > 0 {
+		for iNdEx := len(m.InterestDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextInterestClaimTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextInterestClaimTime):])
 	if err1 != nil {
 		return 0, err1
@@ -393,89 +599,1058 @@ func (m *AssetBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InterestDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *InterestDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unclaimed) > 0 {
+		for iNdEx := len(m.Unclaimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unclaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InterestPerMetoken) > 0 {
+		for iNdEx := len(m.InterestPerMetoken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestPerMetoken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterestPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Registry) > 0 {
-		for _, e := range m.Registry {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Checkpoint) > 0 {
+		for iNdEx := len(m.Checkpoint) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoint[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextRebalancingTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextInterestClaimTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IndexBalances) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IndexSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MetokenSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.AllocationDrift.Size()
+		i -= size
+		if _, err := m.AllocationDrift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.AssetBalances) > 0 {
-		for _, e := range m.AssetBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		for iNdEx := len(m.AssetBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.MetokenSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Registry) > 0 {
+		for _, e := range m.Registry {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextRebalancingTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextInterestClaimTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InterestDistributions) > 0 {
+		for _, e := range m.InterestDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InterestPositions) > 0 {
+		for _, e := range m.InterestPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IndexHistory) > 0 {
+		for _, e := range m.IndexHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetokenSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AssetBalances) > 0 {
+		for _, e := range m.AssetBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Leveraged.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Reserved.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Interest.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *InterestDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InterestPerMetoken) > 0 {
+		for _, e := range m.InterestPerMetoken {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unclaimed) > 0 {
+		for _, e := range m.Unclaimed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterestPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Checkpoint) > 0 {
+		for _, e := range m.Checkpoint {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IndexSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MetokenSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AssetBalances) > 0 {
+		for _, e := range m.AssetBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AllocationDrift.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registry = append(m.Registry, Index{})
+			if err := m.Registry[len(m.Registry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, IndexBalances{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRebalancingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextRebalancingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextInterestClaimTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextInterestClaimTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestDistributions = append(m.InterestDistributions, InterestDistribution{})
+			if err := m.InterestDistributions[len(m.InterestDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestPositions = append(m.InterestPositions, InterestPosition{})
+			if err := m.InterestPositions[len(m.InterestPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexHistory = append(m.IndexHistory, IndexSnapshot{})
+			if err := m.IndexHistory[len(m.IndexHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetBalances = append(m.AssetBalances, AssetBalance{})
+			if err := m.AssetBalances[len(m.AssetBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leveraged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leveraged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *AssetBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Leveraged.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Reserved.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Fees.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Interest.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *InterestDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -498,17 +1673,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterestDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterestDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -518,30 +1693,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -551,29 +1725,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Registry = append(m.Registry, Index{})
-			if err := m.Registry[len(m.Registry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPerMetoken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -600,47 +1774,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, IndexBalances{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterestPerMetoken = append(m.InterestPerMetoken, types.DecCoin{})
+			if err := m.InterestPerMetoken[len(m.InterestPerMetoken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRebalancingTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextRebalancingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextInterestClaimTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unclaimed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -667,7 +1808,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextInterestClaimTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Unclaimed = append(m.Unclaimed, types.Coin{})
+			if err := m.Unclaimed[len(m.Unclaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -692,7 +1834,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexBalances) Unmarshal(dAtA []byte) error {
+func (m *InterestPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -715,15 +1857,47 @@ func (m *IndexBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterestPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterestPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetokenSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -750,13 +1924,13 @@ func (m *IndexBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -783,8 +1957,41 @@ func (m *IndexBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetBalances = append(m.AssetBalances, AssetBalance{})
-			if err := m.AssetBalances[len(m.AssetBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoint = append(m.Checkpoint, types.DecCoin{})
+			if err := m.Checkpoint[len(m.Checkpoint)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -809,7 +2016,7 @@ func (m *IndexBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetBalance) Unmarshal(dAtA []byte) error {
+func (m *IndexSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,15 +2039,15 @@ func (m *AssetBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -868,13 +2075,32 @@ func (m *AssetBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leveraged", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -884,29 +2110,28 @@ func (m *AssetBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leveraged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -934,15 +2159,15 @@ func (m *AssetBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenSupply", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -952,29 +2177,62 @@ func (m *AssetBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MetokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetBalances = append(m.AssetBalances, AssetBalance{})
+			if err := m.AssetBalances[len(m.AssetBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationDrift", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1002,7 +2260,7 @@ func (m *AssetBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Interest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllocationDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
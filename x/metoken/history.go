@@ -0,0 +1,58 @@
+package metoken
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// secondsPerYear is the number of seconds in a 365 days year, used to annualize yields.
+const secondsPerYear = 365 * 24 * 60 * 60
+
+// Validate perform basic validation of the IndexSnapshot
+func (s IndexSnapshot) Validate() error {
+	if !IsMeToken(s.MetokenDenom) {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"meToken denom %s should have the following format: me/<TokenName>",
+			s.MetokenDenom,
+		)
+	}
+	if s.BlockHeight < 0 {
+		return fmt.Errorf("invalid snapshot block height %d", s.BlockHeight)
+	}
+	if s.Price.IsNil() || s.Price.IsNegative() {
+		return fmt.Errorf("invalid snapshot price %s of %s", s.Price, s.MetokenDenom)
+	}
+	if s.MetokenSupply.Denom != s.MetokenDenom {
+		return fmt.Errorf("snapshot supply denom %s should match %s", s.MetokenSupply.Denom, s.MetokenDenom)
+	}
+	if s.AllocationDrift.IsNil() || s.AllocationDrift.IsNegative() {
+		return fmt.Errorf("invalid snapshot allocation drift %s of %s", s.AllocationDrift, s.MetokenDenom)
+	}
+
+	return IndexBalances{MetokenSupply: s.MetokenSupply, AssetBalances: s.AssetBalances}.Validate()
+}
+
+// InWindow returns true if the snapshot time is within the optional from and to bounds, both included.
+func (s IndexSnapshot) InWindow(from, to *time.Time) bool {
+	return (from == nil || !s.Time.Before(*from)) && (to == nil || !s.Time.After(*to))
+}
+
+// AnnualizedYield returns the change of the meToken price between two snapshots, scaled to one year (not
+// compounded):
+//
+//	yield = (last_price / first_price - 1) * seconds_per_year / elapsed_seconds
+//
+// It returns zero if the snapshots have the same time or the first price is zero.
+func AnnualizedYield(first, last IndexSnapshot) sdk.Dec {
+	elapsed := last.Time.Sub(first.Time)
+	if elapsed <= 0 || !first.Price.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return last.Price.Quo(first.Price).Sub(sdk.OneDec()).
+		MulInt64(secondsPerYear).
+		Quo(sdk.NewDecWithPrec(elapsed.Milliseconds(), 3))
+}
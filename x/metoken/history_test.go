@@ -0,0 +1,59 @@
+package metoken
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func validSnapshot(height int64, t time.Time, price string) IndexSnapshot {
+	return IndexSnapshot{
+		MetokenDenom:    "me/USD",
+		BlockHeight:     height,
+		Time:            t,
+		Price:           sdk.MustNewDecFromStr(price),
+		MetokenSupply:   sdk.NewInt64Coin("me/USD", 100),
+		AssetBalances:   validIndexBalances().AssetBalances,
+		AllocationDrift: sdk.ZeroDec(),
+	}
+}
+
+func TestIndexSnapshot_Validate(t *testing.T) {
+	now := time.Now()
+	assert.NilError(t, validSnapshot(1, now, "1").Validate())
+
+	invalidSupply := validSnapshot(1, now, "1")
+	invalidSupply.MetokenSupply = sdk.NewInt64Coin("me/EUR", 100)
+	assert.ErrorContains(t, invalidSupply.Validate(), "snapshot supply denom me/EUR should match me/USD")
+
+	invalidPrice := validSnapshot(1, now, "1")
+	invalidPrice.Price = sdk.Dec{}
+	assert.ErrorContains(t, invalidPrice.Validate(), "invalid snapshot price")
+
+	g := *DefaultGenesisState()
+	g.IndexHistory = []IndexSnapshot{validSnapshot(1, now, "1"), validSnapshot(1, now, "1.1")}
+	assert.ErrorContains(t, g.Validate(), "duplicated snapshot of me/USD at height 1")
+}
+
+func TestAnnualizedYield(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	first := validSnapshot(1, start, "1")
+
+	// 1% in 365/4 days is 4% a year
+	last := validSnapshot(2, start.Add(365*24*time.Hour/4), "1.01")
+	assert.DeepEqual(t, sdk.MustNewDecFromStr("0.04"), AnnualizedYield(first, last))
+
+	// a price drop is a negative yield
+	last = validSnapshot(2, start.Add(365*24*time.Hour), "0.98")
+	assert.DeepEqual(t, sdk.MustNewDecFromStr("-0.02"), AnnualizedYield(first, last))
+
+	assert.DeepEqual(t, sdk.ZeroDec(), AnnualizedYield(first, first))
+
+	assert.Check(t, first.InWindow(nil, nil))
+	assert.Check(t, first.InWindow(&start, &start))
+	later := start.Add(time.Second)
+	assert.Check(t, !first.InWindow(&later, nil))
+}
@@ -51,6 +51,10 @@ func (i Index) Validate() error {
 		return err
 	}
 
+	if err := i.InterestPolicy.Validate(); err != nil {
+		return err
+	}
+
 	totalAllocation := sdk.ZeroDec()
 	existingAssets := make(map[string]struct{})
 	for _, asset := range i.AcceptedAssets {
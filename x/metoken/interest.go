@@ -0,0 +1,86 @@
+package metoken
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate returns an error if the InterestPolicy is unknown.
+func (p InterestPolicy) Validate() error {
+	if _, ok := InterestPolicy_name[int32(p)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown interest policy %d", p)
+	}
+	return nil
+}
+
+// NewInterestDistribution creates an InterestDistribution with no locked meTokens.
+func NewInterestDistribution(meTokenDenom string) InterestDistribution {
+	return InterestDistribution{
+		MetokenDenom: meTokenDenom,
+		Locked:       sdkmath.ZeroInt(),
+	}
+}
+
+// Validate perform basic validation of the InterestDistribution
+func (d InterestDistribution) Validate() error {
+	if !IsMeToken(d.MetokenDenom) {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"meToken denom %s should have the following format: me/<TokenName>",
+			d.MetokenDenom,
+		)
+	}
+	if d.Locked.IsNil() || d.Locked.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid locked amount of %s", d.MetokenDenom)
+	}
+	if err := d.InterestPerMetoken.Validate(); err != nil {
+		return err
+	}
+	return d.Unclaimed.Validate()
+}
+
+// Distribute adds the interest to the cumulative interest per locked meToken and to the unclaimed interest.
+// It returns false, and distributes nothing, when there are no locked meTokens.
+// The interest per meToken is rounded down, so the sum of the claimable interest of every position never exceeds
+// the unclaimed interest.
+func (d *InterestDistribution) Distribute(interest sdk.Coins) bool {
+	if !d.Locked.IsPositive() || interest.IsZero() {
+		return false
+	}
+
+	locked := sdk.NewDecFromInt(d.Locked)
+	for _, c := range interest {
+		perMetoken := sdk.NewDecFromInt(c.Amount).QuoTruncate(locked)
+		d.InterestPerMetoken = d.InterestPerMetoken.Add(sdk.NewDecCoinFromDec(c.Denom, perMetoken))
+	}
+	d.Unclaimed = d.Unclaimed.Add(interest...)
+
+	return true
+}
+
+// Claimable returns the interest distributed to the position since its last claim.
+func (d InterestDistribution) Claimable(p InterestPosition) sdk.Coins {
+	if !p.Locked.IsPositive() {
+		return sdk.Coins{}
+	}
+
+	claimable, _ := d.InterestPerMetoken.Sub(p.Checkpoint).MulDecTruncate(sdk.NewDecFromInt(p.Locked.Amount)).
+		TruncateDecimal()
+	return claimable
+}
+
+// Validate perform basic validation of the InterestPosition
+func (p InterestPosition) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return err
+	}
+	if !IsMeToken(p.Locked.Denom) {
+		return fmt.Errorf("invalid interest position %s of %s: not a meToken", p.Locked, p.Address)
+	}
+	if err := p.Locked.Validate(); err != nil {
+		return err
+	}
+	return p.Checkpoint.Validate()
+}
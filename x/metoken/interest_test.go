@@ -0,0 +1,42 @@
+package metoken
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInterestDistribution_Distribute(t *testing.T) {
+	d := NewInterestDistribution("me/USD")
+	interest := sdk.NewCoins(sdk.NewInt64Coin("USDT", 100))
+
+	// nothing is distributed without locked meTokens
+	assert.Check(t, !d.Distribute(interest))
+	assert.Check(t, d.Unclaimed.IsZero())
+
+	d.Locked = sdkmath.NewInt(300)
+	assert.Check(t, d.Distribute(interest))
+	assert.DeepEqual(t, interest, d.Unclaimed)
+
+	p1 := InterestPosition{Locked: sdk.NewInt64Coin("me/USD", 100)}
+	p2 := InterestPosition{Locked: sdk.NewInt64Coin("me/USD", 200)}
+	c1, c2 := d.Claimable(p1), d.Claimable(p2)
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("USDT", 33)), c1)
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("USDT", 66)), c2)
+	assert.Check(t, c1.Add(c2...).IsAllLTE(d.Unclaimed))
+
+	// a checkpoint excludes the interest distributed before it
+	p1.Checkpoint = d.InterestPerMetoken
+	assert.Check(t, d.Claimable(p1).IsZero())
+	assert.Check(t, d.Distribute(sdk.NewCoins(sdk.NewInt64Coin("USDT", 300))))
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("USDT", 100)), d.Claimable(p1))
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin("USDT", 266)), d.Claimable(p2))
+}
+
+func TestInterestPolicy_Validate(t *testing.T) {
+	assert.NilError(t, InterestPolicy_INTEREST_POLICY_AUCTION.Validate())
+	assert.ErrorContains(t, InterestPolicy(10).Validate(), "unknown interest policy")
+}
@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/umee-network/umee/v6/x/metoken"
+)
+
+// swapMultiResponse wraps all the coins of a successful basket swap
+type swapMultiResponse struct {
+	meTokens  sdk.Coin
+	fees      sdk.Coins
+	reserved  sdk.Coins
+	leveraged sdk.Coins
+}
+
+// swapMulti executes a swap of a basket of accepted assets for meTokens. It works as the single asset swap for every
+// asset of the basket, except for the fee: a single fee fraction, given by basketFee, is charged in every asset.
+// As in the single asset swap, max_fee is charged for assets we don't want in the index (zero target allocation).
+//
+// It returns: minted meTokens, charged fees, assets transferred to reserves and assets transferred to x/leverage.
+func (k Keeper) swapMulti(userAddr sdk.AccAddress, meTokenDenom string, assets sdk.Coins) (swapMultiResponse, error) {
+	index, err := k.RegisteredIndex(meTokenDenom)
+	if err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	indexPrices, err := k.Prices(index)
+	if err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	balances, err := k.IndexBalances(meTokenDenom)
+	if err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	fee, err := k.swapMultiFee(index, indexPrices, balances, assets)
+	if err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	carries := make([]swapCarry, len(assets))
+	meTokens := sdkmath.ZeroInt()
+	for i, asset := range assets {
+		assetSettings, _ := index.AcceptedAsset(asset.Denom)
+		assetFee := fee
+		if assetSettings.TargetAllocation.IsZero() {
+			assetFee = index.Fee.MaxFee
+		}
+		carries[i], err = newSwapCarry(assetSettings, indexPrices, asset, assetFee.MulInt(asset.Amount).TruncateInt())
+		if err != nil {
+			return swapMultiResponse{}, err
+		}
+		meTokens = meTokens.Add(carries[i].meTokens)
+	}
+
+	if meTokens.IsZero() {
+		return swapMultiResponse{}, fmt.Errorf("insufficient %s for swap", assets)
+	}
+
+	if balances.MetokenSupply.Amount.Add(meTokens).GT(index.MaxSupply) {
+		return swapMultiResponse{}, fmt.Errorf(
+			"not possible to mint the desired amount of %s, reaching the max supply",
+			meTokenDenom,
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(*k.ctx, userAddr, metoken.ModuleName, assets); err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	resp := swapMultiResponse{meTokens: sdk.NewCoin(meTokenDenom, meTokens)}
+	for i, asset := range assets {
+		sc := carries[i]
+		supplied, err := k.supplyToLeverage(sdk.NewCoin(asset.Denom, sc.toLeverage))
+		if err != nil {
+			return swapMultiResponse{}, err
+		}
+
+		// adjust amount if supplied to x/leverage is less than the calculated amount
+		if supplied.LT(sc.toLeverage) {
+			tokenDiff := sc.toLeverage.Sub(supplied)
+			sc.toReserves = sc.toReserves.Add(tokenDiff)
+			sc.toLeverage = sc.toLeverage.Sub(tokenDiff)
+		}
+
+		feeToAuction, feeToRevenue := k.breakFee(sc.fee)
+		if err = k.fundAuction(asset.Denom, feeToAuction); err != nil {
+			return swapMultiResponse{}, err
+		}
+
+		balance, _ := balances.AssetBalance(asset.Denom)
+		balance.Reserved = balance.Reserved.Add(sc.toReserves)
+		balance.Leveraged = balance.Leveraged.Add(sc.toLeverage)
+		balance.Fees = balance.Fees.Add(feeToRevenue)
+		balances.SetAssetBalance(balance)
+
+		resp.fees = resp.fees.Add(sdk.NewCoin(asset.Denom, sc.fee))
+		resp.reserved = resp.reserved.Add(sdk.NewCoin(asset.Denom, sc.toReserves))
+		resp.leveraged = resp.leveraged.Add(sdk.NewCoin(asset.Denom, sc.toLeverage))
+	}
+
+	mintedCoins := sdk.NewCoins(resp.meTokens)
+	if err = k.bankKeeper.MintCoins(*k.ctx, metoken.ModuleName, mintedCoins); err != nil {
+		return swapMultiResponse{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(*k.ctx, metoken.ModuleName, userAddr, mintedCoins)
+	if err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	balances.MetokenSupply.Amount = balances.MetokenSupply.Amount.Add(meTokens)
+	if err = k.setIndexBalances(balances); err != nil {
+		return swapMultiResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// swapMultiFee returns the fee fraction to be charged for swapping a basket of assets, computed with the index
+// balances after the swap of the full basket amounts.
+func (k Keeper) swapMultiFee(
+	index metoken.Index,
+	indexPrices metoken.IndexPrices,
+	balances metoken.IndexBalances,
+	assets sdk.Coins,
+) (sdk.Dec, error) {
+	after := copyIndexBalances(balances)
+	for _, asset := range assets {
+		if !index.HasAcceptedAsset(asset.Denom) {
+			return sdk.Dec{}, sdkerrors.ErrNotFound.Wrapf("asset %s is not accepted in the index", asset.Denom)
+		}
+		balance, i := after.AssetBalance(asset.Denom)
+		if i < 0 {
+			return sdk.Dec{}, sdkerrors.ErrNotFound.Wrapf("balance for denom %s not found", asset.Denom)
+		}
+
+		meTokens, err := indexPrices.SwapRate(asset)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		balance.Reserved = balance.Reserved.Add(asset.Amount)
+		after.SetAssetBalance(balance)
+		after.MetokenSupply.Amount = after.MetokenSupply.Amount.Add(meTokens)
+	}
+
+	return k.basketFee(index, indexPrices, balances, after)
+}
+
+// redeemProportionalResponse wraps all the coins of a successful proportional redemption
+type redeemProportionalResponse struct {
+	fees         sdk.Coins
+	fromReserves sdk.Coins
+	fromLeverage sdk.Coins
+}
+
+// returned is the amount of assets sent to the user: withdrawn assets minus fees.
+func (r redeemProportionalResponse) returned() sdk.Coins {
+	return r.fromReserves.Add(r.fromLeverage...).Sub(r.fees...)
+}
+
+// redeemProportional executes a redemption of meTokens for every accepted asset of the index, pro-rata to the index
+// balances. The share of every asset is withdrawn from x/metoken reserves and x/leverage pools in the proportion
+// they hold it. Since the allocation of the index doesn't change, basketFee charges min_fee, in every asset.
+//
+// It returns: fees charged to the user, assets withdrawn from x/metoken and x/leverage.
+func (k Keeper) redeemProportional(userAddr sdk.AccAddress, meToken sdk.Coin) (redeemProportionalResponse, error) {
+	index, err := k.RegisteredIndex(meToken.Denom)
+	if err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	indexPrices, err := k.Prices(index)
+	if err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	balances, err := k.IndexBalances(meToken.Denom)
+	if err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	if balances.MetokenSupply.Amount.LT(meToken.Amount) {
+		return redeemProportionalResponse{}, fmt.Errorf("not enough %s supply", meToken.Denom)
+	}
+
+	fromReserves, fromLeverage, err := calculateRedeemProportional(balances, meToken)
+	if err != nil {
+		return redeemProportionalResponse{}, err
+	}
+	if fromReserves.Add(fromLeverage...).IsZero() {
+		return redeemProportionalResponse{}, fmt.Errorf("insufficient %s for redemption", meToken.Denom)
+	}
+
+	after := copyIndexBalances(balances)
+	for _, balance := range after.AssetBalances {
+		balance.Reserved = balance.Reserved.Sub(fromReserves.AmountOf(balance.Denom))
+		balance.Leveraged = balance.Leveraged.Sub(fromLeverage.AmountOf(balance.Denom))
+		after.SetAssetBalance(balance)
+	}
+	after.MetokenSupply.Amount = after.MetokenSupply.Amount.Sub(meToken.Amount)
+	fee, err := k.basketFee(index, indexPrices, balances, after)
+	if err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	var resp redeemProportionalResponse
+	for _, asset := range fromReserves.Add(fromLeverage...) {
+		denom := asset.Denom
+		amountFromReserves := fromReserves.AmountOf(denom)
+		amountFromLeverage := fromLeverage.AmountOf(denom)
+
+		tokensWithdrawn, err := k.withdrawFromLeverage(sdk.NewCoin(denom, amountFromLeverage))
+		if err != nil {
+			return redeemProportionalResponse{}, err
+		}
+
+		// if there is a difference between the desired to withdraw from x/leverage and the withdrawn,
+		// take it from x/metoken reserves
+		if tokensWithdrawn.Amount.LT(amountFromLeverage) {
+			tokenDiff := amountFromLeverage.Sub(tokensWithdrawn.Amount)
+			amountFromReserves = amountFromReserves.Add(tokenDiff)
+			amountFromLeverage = amountFromLeverage.Sub(tokenDiff)
+		}
+
+		balance, _ := balances.AssetBalance(denom)
+		if balance.Reserved.LT(amountFromReserves) {
+			return redeemProportionalResponse{}, fmt.Errorf("not enough %s liquidity for redemption", denom)
+		}
+
+		feeAmount := fee.MulInt(amountFromReserves.Add(amountFromLeverage)).TruncateInt()
+		feeToAuction, feeToRevenue := k.breakFee(feeAmount)
+		if err = k.fundAuction(denom, feeToAuction); err != nil {
+			return redeemProportionalResponse{}, err
+		}
+
+		balance.Reserved = balance.Reserved.Sub(amountFromReserves)
+		balance.Leveraged = balance.Leveraged.Sub(amountFromLeverage)
+		balance.Fees = balance.Fees.Add(feeToRevenue)
+		balances.SetAssetBalance(balance)
+
+		resp.fees = resp.fees.Add(sdk.NewCoin(denom, feeAmount))
+		resp.fromReserves = resp.fromReserves.Add(sdk.NewCoin(denom, amountFromReserves))
+		resp.fromLeverage = resp.fromLeverage.Add(sdk.NewCoin(denom, amountFromLeverage))
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(*k.ctx, userAddr, metoken.ModuleName, sdk.Coins{meToken})
+	if err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		*k.ctx,
+		metoken.ModuleName,
+		userAddr,
+		resp.returned(),
+	); err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	balances.MetokenSupply.Amount = balances.MetokenSupply.Amount.Sub(meToken.Amount)
+	if err = k.setIndexBalances(balances); err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	if err = k.bankKeeper.BurnCoins(*k.ctx, metoken.ModuleName, sdk.NewCoins(meToken)); err != nil {
+		return redeemProportionalResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// calculateRedeemProportional returns the amounts of every asset to withdraw from x/metoken reserves and from
+// x/leverage pools for a proportional redemption. The formulas used for the calculations are:
+//
+//	redeem_share = metokens_to_burn / metoken_supply
+//	amount_from_reserves = reserved * redeem_share
+//	amount_from_leverage = leveraged * redeem_share
+func calculateRedeemProportional(balances metoken.IndexBalances, meToken sdk.Coin) (sdk.Coins, sdk.Coins, error) {
+	if !balances.MetokenSupply.IsPositive() {
+		return nil, nil, fmt.Errorf("not enough %s supply", meToken.Denom)
+	}
+
+	share := sdk.NewDecFromInt(meToken.Amount).QuoInt(balances.MetokenSupply.Amount)
+	var fromReserves, fromLeverage sdk.Coins
+	for _, balance := range balances.AssetBalances {
+		fromReserves = fromReserves.Add(sdk.NewCoin(balance.Denom, share.MulInt(balance.Reserved).TruncateInt()))
+		fromLeverage = fromLeverage.Add(sdk.NewCoin(balance.Denom, share.MulInt(balance.Leveraged).TruncateInt()))
+	}
+
+	return fromReserves, fromLeverage, nil
+}
+
+// copyIndexBalances returns a copy of the index balances, which can be modified without affecting the original.
+func copyIndexBalances(balances metoken.IndexBalances) metoken.IndexBalances {
+	balances.AssetBalances = append([]metoken.AssetBalance(nil), balances.AssetBalances...)
+	return balances
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -85,6 +86,11 @@ func (k Keeper) currentAllocation(
 		return sdk.Dec{}, err
 	}
 
+	return allocation(balances, indexPrices, assetDenom)
+}
+
+// allocation returns a factor of the assetDenom supply in the given index balances based on the USD price value.
+func allocation(balances metoken.IndexBalances, indexPrices metoken.IndexPrices, assetDenom string) (sdk.Dec, error) {
 	balance, i := balances.AssetBalance(assetDenom)
 	if i < 0 {
 		return sdk.Dec{}, sdkerrors.ErrNotFound.Wrapf("balance for denom %s not found", assetDenom)
@@ -136,3 +142,58 @@ func (k Keeper) redeemAllocationDeviation(
 
 	return targetAllocation.Sub(currentAllocation).Quo(targetAllocation), nil
 }
+
+// basketFee to be charged to the user for swapping or redeeming a basket of accepted assets at once, given the index
+// balances before and after the operation. It returns the fee in fraction, applied to every asset of the basket.
+// The fee reflects the net effect of the basket on the index allocation drift, relative to the basket size:
+//
+//	allocation_drift = sum(|current_allocation - target_allocation|)
+//	basket_share = |supply_after - supply_before| / max(supply_before, supply_after)
+//	basket_deviation = (drift_after - drift_before) / basket_share - 1
+//	fee = index.Fee.CalculateFee(basket_deviation)
+//
+// A basket matching the current or target allocations (or moving the index towards the targets) pays min_fee.
+// A single asset basket drifts a balanced index by twice its share of the asset deficit or excess, so its deviation
+// is 1 - 2 * target_allocation: above balanced_fee for assets with a target allocation below 0.5.
+func (k Keeper) basketFee(index metoken.Index, indexPrices metoken.IndexPrices, before, after metoken.IndexBalances) (
+	sdk.Dec,
+	error,
+) {
+	supplyDiff := after.MetokenSupply.Amount.Sub(before.MetokenSupply.Amount).Abs()
+	if supplyDiff.IsZero() {
+		return index.Fee.MinFee, nil
+	}
+
+	driftBefore, err := allocationDrift(index, indexPrices, before)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	driftAfter, err := allocationDrift(index, indexPrices, after)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	basketShare := sdk.NewDecFromInt(supplyDiff).QuoInt(
+		sdkmath.MaxInt(before.MetokenSupply.Amount, after.MetokenSupply.Amount),
+	)
+	basketDeviation := driftAfter.Sub(driftBefore).Quo(basketShare).Sub(sdk.OneDec())
+	return index.Fee.CalculateFee(basketDeviation), nil
+}
+
+// allocationDrift returns the sum of the absolute differences between the allocation and the target allocation of
+// every accepted asset in the given index balances.
+func allocationDrift(index metoken.Index, indexPrices metoken.IndexPrices, balances metoken.IndexBalances) (
+	sdk.Dec,
+	error,
+) {
+	drift := sdk.ZeroDec()
+	for _, aa := range index.AcceptedAssets {
+		a, err := allocation(balances, indexPrices, aa.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		drift = drift.Add(a.Sub(aa.TargetAllocation).Abs())
+	}
+
+	return drift, nil
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/umee-network/umee/v6/x/metoken"
 	"github.com/umee-network/umee/v6/x/metoken/mocks"
 )
 
@@ -91,3 +92,78 @@ func TestRedeemFee(t *testing.T) {
 	require.True(t, feeAmount.Amount.Equal(sdkmath.NewInt(2_553455)))
 	require.True(t, feeFraction.Equal(sdk.MustNewDecFromStr("0.255345547309833024")))
 }
+
+func TestSwapIndexFee(t *testing.T) {
+	k := initMeUSDNoopKeper(t)
+
+	index, err := k.RegisteredIndex(mocks.MeUSDDenom)
+	require.NoError(t, err)
+
+	balance, err := k.IndexBalances(mocks.MeUSDDenom)
+	require.NoError(t, err)
+	prices, err := k.Prices(index)
+	require.NoError(t, err)
+
+	meToken := sdk.NewCoin(mocks.MeUSDDenom, sdkmath.NewInt(1_000000))
+	oneUSDT := sdk.NewCoin(mocks.USDTBaseDenom, sdkmath.NewInt(1_000000))
+	swapIndexFee := func(from, to metoken.Index) (sdk.Dec, sdk.Dec) {
+		fromFee, toFee, err := k.swapIndexFee(
+			from, prices, balance, to, prices, balance, meToken, oneUSDT, oneUSDT.Amount, sdkmath.ZeroInt(),
+		)
+		require.NoError(t, err)
+		return fromFee, toFee
+	}
+
+	// redeeming the under-allocated USDT from the first Index costs more than min_fee, so its half is kept, while
+	// swapping it into the second Index costs min_fee, and halving it would drop below min_fee
+	fromFee, toFee := swapIndexFee(index, index)
+	require.True(t, fromFee.GT(index.Fee.MinFee))
+	require.Equal(t, index.Fee.MinFee, toFee)
+
+	// the halved fee of every Index is never lower than its min_fee
+	highMinFee := index
+	highMinFee.Fee.MinFee = fromFee.Add(sdk.MustNewDecFromStr("0.01"))
+	fromFee2, toFee2 := swapIndexFee(highMinFee, index)
+	require.Equal(t, highMinFee.Fee.MinFee, fromFee2)
+	require.Equal(t, toFee, toFee2)
+
+	highMinFee.Fee.MinFee = toFee.Add(sdk.MustNewDecFromStr("0.01"))
+	fromFee2, toFee2 = swapIndexFee(index, highMinFee)
+	require.Equal(t, fromFee, fromFee2)
+	require.Equal(t, highMinFee.Fee.MinFee, toFee2)
+}
+
+func TestBasketFee(t *testing.T) {
+	index := mocks.StableIndex(mocks.MeUSDDenom)
+	prices := metoken.EmptyIndexPrices(index)
+	prices.Price = sdk.OneDec()
+	for _, aa := range index.AcceptedAssets {
+		prices.SetPrice(metoken.AssetPrice{BaseDenom: aa.Denom, Price: sdk.OneDec(), Exponent: 6})
+	}
+	balances := func(supply, usdt, usdc, ist int64) metoken.IndexBalances {
+		return metoken.NewIndexBalances(
+			sdk.NewCoin(mocks.MeUSDDenom, sdkmath.NewInt(supply)),
+			[]metoken.AssetBalance{
+				metoken.NewAssetBalance(mocks.USDTBaseDenom, sdkmath.ZeroInt(), sdkmath.NewInt(usdt),
+					sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+				metoken.NewAssetBalance(mocks.USDCBaseDenom, sdkmath.ZeroInt(), sdkmath.NewInt(usdc),
+					sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+				metoken.NewAssetBalance(mocks.ISTBaseDenom, sdkmath.ZeroInt(), sdkmath.NewInt(ist),
+					sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+			},
+		)
+	}
+	k := Keeper{}
+	balanced := balances(1000_000000, 330_000000, 340_000000, 330_000000)
+
+	// a balanced basket keeps the allocation and pays min_fee
+	fee, err := k.basketFee(index, prices, balanced, balances(1100_000000, 363_000000, 374_000000, 363_000000))
+	require.NoError(t, err)
+	require.Equal(t, index.Fee.MinFee, fee)
+
+	// a single USDT basket in a balanced index: basket_deviation = 1 - 2 * 0.33 = 0.34
+	// fee = 0.34 * 0.2 + 0.2 = 0.268
+	fee, err = k.basketFee(index, prices, balanced, balances(1100_000000, 430_000000, 340_000000, 330_000000))
+	require.NoError(t, err)
+	require.InDelta(t, 0.268, fee.MustFloat64(), 0.000001)
+}
@@ -17,6 +17,18 @@ func (k Keeper) InitGenesis(genState metoken.GenesisState) {
 		util.Panic(k.setIndexBalances(balance))
 	}
 
+	for _, d := range genState.InterestDistributions {
+		util.Panic(k.setInterestDistribution(d))
+	}
+
+	for _, p := range genState.InterestPositions {
+		util.Panic(k.setInterestPosition(p))
+	}
+
+	for _, s := range genState.IndexHistory {
+		util.Panic(k.setIndexSnapshot(s))
+	}
+
 	k.setNextRebalancingTime(genState.NextRebalancingTime)
 	k.setNextInterestClaimTime(genState.NextInterestClaimTime)
 }
@@ -29,5 +41,8 @@ func (k Keeper) ExportGenesis() *metoken.GenesisState {
 		Balances:              k.GetAllIndexesBalances(),
 		NextRebalancingTime:   k.getNextRebalancingTime(),
 		NextInterestClaimTime: k.getNextInterestClaimTime(),
+		InterestDistributions: k.GetAllInterestDistributions(),
+		InterestPositions:     k.GetAllInterestPositions(),
+		IndexHistory:          k.GetAllIndexHistory(),
 	}
 }
@@ -86,6 +86,7 @@ func TestKeeper_ExportGenesis(t *testing.T) {
 					sdk.MustNewDecFromStr("1.0"),
 				),
 			},
+			InterestPolicy: metoken.InterestPolicy_INTEREST_POLICY_CLAIM,
 		},
 	}
 	expectedGenesis.Balances = []metoken.IndexBalances{
@@ -102,6 +103,34 @@ func TestKeeper_ExportGenesis(t *testing.T) {
 			},
 		},
 	}
+	expectedGenesis.InterestDistributions = []metoken.InterestDistribution{
+		{
+			MetokenDenom:       mocks.MeUSDDenom,
+			Locked:             sdkmath.NewInt(100),
+			InterestPerMetoken: sdk.NewDecCoins(sdk.NewDecCoinFromDec(usdt, sdk.MustNewDecFromStr("0.5"))),
+			Unclaimed:          sdk.NewCoins(sdk.NewInt64Coin(usdt, 50)),
+		},
+	}
+	expectedGenesis.InterestPositions = []metoken.InterestPosition{
+		{
+			Address:    sdk.AccAddress("addr________________").String(),
+			Locked:     sdk.NewInt64Coin(mocks.MeUSDDenom, 100),
+			Checkpoint: sdk.NewDecCoins(sdk.NewDecCoinFromDec(usdt, sdk.MustNewDecFromStr("0.25"))),
+		},
+	}
+	expectedGenesis.IndexHistory = []metoken.IndexSnapshot{
+		{
+			MetokenDenom:  mocks.MeUSDDenom,
+			BlockHeight:   10,
+			Time:          time.Unix(1700000000, 0).UTC(),
+			Price:         sdk.MustNewDecFromStr("1.02"),
+			MetokenSupply: sdk.NewInt64Coin(mocks.MeUSDDenom, 100),
+			AssetBalances: []metoken.AssetBalance{
+				metoken.NewAssetBalance(usdt, sdkmath.NewInt(80), sdkmath.NewInt(20), sdkmath.NewInt(1), int0),
+			},
+			AllocationDrift: sdk.MustNewDecFromStr("0.1"),
+		},
+	}
 	expectedGenesis.NextRebalancingTime = time.UnixMilli(time.Now().UnixMilli())
 	expectedGenesis.NextInterestClaimTime = time.UnixMilli(time.Now().UnixMilli())
 
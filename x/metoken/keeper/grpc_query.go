@@ -71,13 +71,16 @@ func (q Querier) SwapFee(goCtx context.Context, req *metoken.QuerySwapFee) (*met
 		return nil, err
 	}
 
-	// calculate the fee for the asset amount
-	_, feeAmount, err := k.swapFee(index, indexPrices, asset)
+	// calculate the fee for the asset amount, and the meTokens to be minted
+	sc, err := k.calculateSwap(index, indexPrices, asset)
 	if err != nil {
 		return nil, err
 	}
 
-	return &metoken.QuerySwapFeeResponse{Asset: feeAmount}, nil
+	return &metoken.QuerySwapFeeResponse{
+		Asset:    sdk.NewCoin(asset.Denom, sc.fee),
+		Returned: sdk.NewCoin(index.Denom, sc.meTokens),
+	}, nil
 }
 
 // RedeemFee returns the fee for the redeem operation, given a specific amount of meTokens and the asset denom.
@@ -121,7 +124,87 @@ func (q Querier) RedeemFee(goCtx context.Context, req *metoken.QueryRedeemFee) (
 		return nil, err
 	}
 
-	return &metoken.QueryRedeemFeeResponse{Asset: feeAmount}, nil
+	return &metoken.QueryRedeemFeeResponse{
+		Asset:    feeAmount,
+		Returned: sdk.NewCoin(req.AssetDenom, toRedeem.Amount.Sub(feeAmount.Amount)),
+	}, nil
+}
+
+// SwapIndexFee returns the fee for the swap between Indexes operation, given a specific amount of meTokens, the
+// shared asset denom and the meToken denom to receive.
+func (q Querier) SwapIndexFee(goCtx context.Context, req *metoken.QuerySwapIndexFee) (
+	*metoken.QuerySwapIndexFeeResponse,
+	error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k := q.Keeper(&ctx)
+
+	meToken, err := sdk.ParseCoinNormalized(req.Metoken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := meToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	from, fromPrices, fromBalances, err := k.indexState(meToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+	to, toPrices, toBalances, err := k.indexState(req.ToMetokenDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// calculate the fee for the shared asset amount, and the meTokens to be minted
+	carry, err := k.calculateSwapIndex(
+		from, fromPrices, fromBalances,
+		to, toPrices, toBalances,
+		meToken, req.AssetDenom,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metoken.QuerySwapIndexFeeResponse{
+		Asset:    sdk.NewCoin(req.AssetDenom, carry.fee()),
+		Returned: sdk.NewCoin(to.Denom, carry.swap.meTokens),
+	}, nil
+}
+
+// IndexHistory returns the snapshots of an Index within the requested window, and the annualized yield of the
+// meToken price over the window.
+func (q Querier) IndexHistory(goCtx context.Context, req *metoken.QueryIndexHistory) (
+	*metoken.QueryIndexHistoryResponse,
+	error,
+) {
+	if req.From != nil && req.To != nil && req.From.After(*req.To) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("from %s is after to %s", req.From, req.To)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k := q.Keeper(&ctx)
+
+	if _, err := k.RegisteredIndex(req.MetokenDenom); err != nil {
+		return nil, err
+	}
+
+	snapshots, annualizedYield, pageRes, err := k.PaginateIndexHistory(
+		req.MetokenDenom,
+		req.From,
+		req.To,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metoken.QueryIndexHistoryResponse{
+		Snapshots:       snapshots,
+		AnnualizedYield: annualizedYield,
+		Pagination:      pageRes,
+	}, nil
 }
 
 // IndexBalances returns balances from the x/metoken module. If index balance denom is not specified,
@@ -173,6 +256,35 @@ func (q Querier) IndexPrices(
 	}, nil
 }
 
+// InterestPosition returns the meTokens an account locked for interest in an Index and its claimable interest.
+func (q Querier) InterestPosition(
+	goCtx context.Context,
+	req *metoken.QueryInterestPosition,
+) (*metoken.QueryInterestPositionResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !metoken.IsMeToken(req.MetokenDenom) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"meToken denom %s should have the following format: me/<TokenName>",
+			req.MetokenDenom,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k := q.Keeper(&ctx)
+	position := k.interestPosition(req.MetokenDenom, addr)
+
+	return &metoken.QueryInterestPositionResponse{
+		Locked:    position.Locked,
+		Claimable: k.interestDistribution(req.MetokenDenom).Claimable(position),
+	}, nil
+}
+
 func (q Querier) getPrices(k Keeper, meTokenDenom string) ([]metoken.IndexPrices, error) {
 	var indexes []metoken.Index
 	if len(meTokenDenom) > 0 {
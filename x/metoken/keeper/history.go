@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/metoken"
+)
+
+// recordIndexHistory saves a snapshot of every registered Index with minted meTokens, and prunes the snapshots
+// exceeding Params.max_history_snapshots. A second snapshot in the same block replaces the first one.
+// Errors are logged and skip the Index, so they don't stop the reserves re-balancing or the interest claiming.
+func (k Keeper) recordIndexHistory() {
+	maxSnapshots := k.GetParams().MaxHistorySnapshots
+	if maxSnapshots == 0 {
+		return
+	}
+
+	for _, index := range k.GetAllRegisteredIndexes() {
+		if err := k.recordIndexSnapshot(index); err != nil {
+			k.Logger().Debug(
+				"index history: couldn't record snapshot",
+				"error", err.Error(),
+				"index", index.Denom,
+				"block_time", k.ctx.BlockTime(),
+			)
+			continue
+		}
+
+		k.pruneIndexHistory(index.Denom, maxSnapshots)
+	}
+}
+
+// recordIndexSnapshot saves a snapshot of the Index current price, balances and allocation drift.
+func (k Keeper) recordIndexSnapshot(index metoken.Index) error {
+	balances, err := k.IndexBalances(index.Denom)
+	if err != nil {
+		return err
+	}
+
+	// if no meToken were minted, there is nothing to record
+	if !balances.MetokenSupply.IsPositive() {
+		return nil
+	}
+
+	indexPrices, err := k.Prices(index)
+	if err != nil {
+		return err
+	}
+
+	drift, err := allocationDrift(index, indexPrices, balances)
+	if err != nil {
+		return err
+	}
+
+	snapshot := metoken.IndexSnapshot{
+		MetokenDenom:    index.Denom,
+		BlockHeight:     k.ctx.BlockHeight(),
+		Time:            k.ctx.BlockTime(),
+		Price:           indexPrices.Price,
+		MetokenSupply:   balances.MetokenSupply,
+		AssetBalances:   balances.AssetBalances,
+		AllocationDrift: drift,
+	}
+
+	return k.setIndexSnapshot(snapshot)
+}
+
+func (k Keeper) setIndexSnapshot(s metoken.IndexSnapshot) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	return store.SetValue(k.store, keyIndexSnapshot(s.MetokenDenom, s.BlockHeight), &s, "index snapshot")
+}
+
+// pruneIndexHistory deletes the oldest snapshots of an Index, keeping maxSnapshots.
+func (k Keeper) pruneIndexHistory(meTokenDenom string, maxSnapshots uint32) {
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(k.store, keyIndexHistory(meTokenDenom))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+	}
+	iter.Close()
+
+	if len(keys) <= int(maxSnapshots) {
+		return
+	}
+	for _, key := range keys[:len(keys)-int(maxSnapshots)] {
+		k.store.Delete(key)
+	}
+}
+
+// GetAllIndexHistory returns the snapshots of every Index.
+func (k Keeper) GetAllIndexHistory() []metoken.IndexSnapshot {
+	return store.MustLoadAll[*metoken.IndexSnapshot](k.store, keyPrefixIndexHistory)
+}
+
+// PaginateIndexHistory pages through the snapshots of an Index with a block time within the optional from and to
+// bounds. It also returns the annualized yield of the meToken price between the first and the last snapshot within
+// the bounds, regardless of the pagination.
+func (k Keeper) PaginateIndexHistory(
+	meTokenDenom string,
+	from, to *time.Time,
+	pagination *query.PageRequest,
+) ([]metoken.IndexSnapshot, sdk.Dec, *query.PageResponse, error) {
+	var first, last *metoken.IndexSnapshot
+	err := store.Iterate(k.store, keyIndexHistory(meTokenDenom), func(_, value []byte) error {
+		var s metoken.IndexSnapshot
+		if err := s.Unmarshal(value); err != nil {
+			return err
+		}
+		if s.InWindow(from, to) {
+			if first == nil {
+				first = &s
+			}
+			last = &s
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sdk.Dec{}, nil, err
+	}
+
+	annualizedYield := sdk.ZeroDec()
+	if first != nil {
+		annualizedYield = metoken.AnnualizedYield(*first, *last)
+	}
+
+	snapshots := []metoken.IndexSnapshot{}
+	historyStore := prefix.NewStore(k.store, keyIndexHistory(meTokenDenom))
+	pageRes, err := query.FilteredPaginate(historyStore, pagination,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var s metoken.IndexSnapshot
+			if err := s.Unmarshal(value); err != nil {
+				return false, err
+			}
+			if !s.InWindow(from, to) {
+				return false, nil
+			}
+			if accumulate {
+				snapshots = append(snapshots, s)
+			}
+			return true, nil
+		})
+	if err != nil {
+		return nil, sdk.Dec{}, nil, err
+	}
+
+	return snapshots, annualizedYield, pageRes, nil
+}
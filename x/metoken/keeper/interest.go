@@ -5,12 +5,16 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/umee-network/umee/v6/util/store"
 
 	"github.com/umee-network/umee/v6/x/metoken"
 	lerrors "github.com/umee-network/umee/v6/x/metoken/errors"
 )
 
-// ClaimLeverageInterest sends accrued interest from x/leverage module to x/metoken account.
+// ClaimLeverageInterest sends accrued interest from x/leverage module to x/metoken account, and distributes it
+// according to the interest policy of every Index. Then it records a snapshot of every Index.
 func (k Keeper) ClaimLeverageInterest() error {
 	if k.ctx.BlockTime().Before(k.getNextInterestClaimTime()) {
 		return nil
@@ -64,9 +68,247 @@ func (k Keeper) ClaimLeverageInterest() error {
 		if err = k.updateBalances(balances, updatedBalances); err != nil {
 			return err
 		}
+
+		if err = k.distributeInterest(index); err != nil {
+			return err
+		}
 	}
 
+	k.recordIndexHistory()
 	k.setNextInterestClaimTime(k.ctx.BlockTime().Add(time.Duration(k.GetParams().ClaimingFrequency) * time.Second))
 
 	return nil
 }
+
+// distributeInterest applies the Index interest policy to the interest balances not distributed yet:
+//   - INTEREST_POLICY_UNSPECIFIED: the interest is kept in the interest balances.
+//   - INTEREST_POLICY_YIELD_BEARING: the interest is moved to the reserves, raising the meToken price.
+//   - INTEREST_POLICY_CLAIM: the interest is distributed to the meTokens locked for interest. When no meTokens are
+//     locked, it's moved to the reserves instead, so it's not captured by the next locker.
+//   - INTEREST_POLICY_AUCTION: the interest is sent to the rewards auction.
+func (k Keeper) distributeInterest(index metoken.Index) error {
+	if index.InterestPolicy == metoken.InterestPolicy_INTEREST_POLICY_UNSPECIFIED {
+		return nil
+	}
+
+	balances, err := k.IndexBalances(index.Denom)
+	if err != nil {
+		return err
+	}
+	distribution := k.interestDistribution(index.Denom)
+
+	// interest distributed to locked meTokens and not claimed yet is kept in the interest balances
+	var undistributed sdk.Coins
+	for _, balance := range balances.AssetBalances {
+		amount := balance.Interest.Sub(distribution.Unclaimed.AmountOf(balance.Denom))
+		if amount.IsPositive() {
+			undistributed = undistributed.Add(sdk.NewCoin(balance.Denom, amount))
+		}
+	}
+	if undistributed.IsZero() {
+		return nil
+	}
+
+	switch index.InterestPolicy {
+	case metoken.InterestPolicy_INTEREST_POLICY_CLAIM:
+		if distribution.Distribute(undistributed) {
+			return k.setInterestDistribution(distribution)
+		}
+	case metoken.InterestPolicy_INTEREST_POLICY_AUCTION:
+		for _, c := range undistributed {
+			if err = k.fundAuction(c.Denom, c.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, c := range undistributed {
+		balance, _ := balances.AssetBalance(c.Denom)
+		balance.Interest = balance.Interest.Sub(c.Amount)
+		if index.InterestPolicy != metoken.InterestPolicy_INTEREST_POLICY_AUCTION {
+			balance.Reserved = balance.Reserved.Add(c.Amount)
+		}
+		balances.SetAssetBalance(balance)
+	}
+
+	return k.setIndexBalances(balances)
+}
+
+// interestDistribution returns the interest distribution of an Index. If it's not found returns a distribution
+// with no locked meTokens.
+func (k Keeper) interestDistribution(meTokenDenom string) metoken.InterestDistribution {
+	d := store.GetValue[*metoken.InterestDistribution](
+		k.store, keyInterestDistribution(meTokenDenom), "interest distribution",
+	)
+	if d == nil {
+		return metoken.NewInterestDistribution(meTokenDenom)
+	}
+
+	return *d
+}
+
+func (k Keeper) setInterestDistribution(d metoken.InterestDistribution) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	return store.SetValue(k.store, keyInterestDistribution(d.MetokenDenom), &d, "interest distribution")
+}
+
+// GetAllInterestDistributions returns the interest distribution of every Index.
+func (k Keeper) GetAllInterestDistributions() []metoken.InterestDistribution {
+	return store.MustLoadAll[*metoken.InterestDistribution](k.store, keyPrefixInterestDistribution)
+}
+
+// interestPosition returns the meTokens an account locked for interest in an Index.
+func (k Keeper) interestPosition(meTokenDenom string, addr sdk.AccAddress) metoken.InterestPosition {
+	p := store.GetValue[*metoken.InterestPosition](k.store, keyInterestPosition(meTokenDenom, addr), "interest position")
+	if p == nil {
+		return metoken.InterestPosition{Address: addr.String(), Locked: sdk.NewInt64Coin(meTokenDenom, 0)}
+	}
+
+	return *p
+}
+
+// setInterestPosition saves an interest position, or deletes it when there are no meTokens locked.
+func (k Keeper) setInterestPosition(p metoken.InterestPosition) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(p.Address)
+	if p.Locked.IsZero() {
+		k.store.Delete(keyInterestPosition(p.Locked.Denom, addr))
+		return nil
+	}
+
+	return store.SetValue(k.store, keyInterestPosition(p.Locked.Denom, addr), &p, "interest position")
+}
+
+// GetAllInterestPositions returns every account interest position.
+func (k Keeper) GetAllInterestPositions() []metoken.InterestPosition {
+	return store.MustLoadAll[*metoken.InterestPosition](k.store, keyPrefixInterestPosition)
+}
+
+// claimInterest sends to the account the interest distributed to its locked meTokens since the last claim, and
+// moves the position checkpoint to the current interest per meToken.
+func (k Keeper) claimInterest(addr sdk.AccAddress, meTokenDenom string) (sdk.Coins, error) {
+	distribution := k.interestDistribution(meTokenDenom)
+	position := k.interestPosition(meTokenDenom, addr)
+
+	claimable := distribution.Claimable(position)
+	position.Checkpoint = distribution.InterestPerMetoken
+	if err := k.setInterestPosition(position); err != nil {
+		return nil, err
+	}
+	if claimable.IsZero() {
+		return claimable, nil
+	}
+
+	unclaimed, negative := distribution.Unclaimed.SafeSub(claimable...)
+	if negative {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"claimable interest %s exceeds the unclaimed interest %s", claimable, distribution.Unclaimed,
+		)
+	}
+	distribution.Unclaimed = unclaimed
+	if err := k.setInterestDistribution(distribution); err != nil {
+		return nil, err
+	}
+
+	balances, err := k.IndexBalances(meTokenDenom)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range claimable {
+		balance, i := balances.AssetBalance(c.Denom)
+		if i < 0 {
+			return nil, sdkerrors.ErrNotFound.Wrapf("balance for denom %s not found", c.Denom)
+		}
+		balance.Interest = balance.Interest.Sub(c.Amount)
+		balances.SetAssetBalance(balance)
+	}
+	if err = k.setIndexBalances(balances); err != nil {
+		return nil, err
+	}
+
+	return claimable, k.bankKeeper.SendCoinsFromModuleToAccount(*k.ctx, metoken.ModuleName, addr, claimable)
+}
+
+// lockForInterest claims the pending interest of the account position and locks meTokens of an Index with
+// INTEREST_POLICY_CLAIM in the x/metoken module.
+func (k Keeper) lockForInterest(addr sdk.AccAddress, meToken sdk.Coin) (sdk.Coins, error) {
+	index, err := k.RegisteredIndex(meToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if index.InterestPolicy != metoken.InterestPolicy_INTEREST_POLICY_CLAIM {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"index %s interest policy is %s", index.Denom, index.InterestPolicy,
+		)
+	}
+
+	claimed, err := k.claimInterest(addr, meToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(*k.ctx, addr, metoken.ModuleName, sdk.NewCoins(meToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, k.updateLocked(addr, meToken, true)
+}
+
+// unlockFromInterest claims the pending interest of the account position and unlocks meTokens locked for interest.
+// While the Index has INTEREST_POLICY_CLAIM, meTokens can't be unlocked before the position unlock time, so they
+// can't be locked only around an interest claim. It's allowed with any other interest policy, so meTokens are not
+// locked when governance changes the policy.
+func (k Keeper) unlockFromInterest(addr sdk.AccAddress, meToken sdk.Coin) (sdk.Coins, error) {
+	index, err := k.RegisteredIndex(meToken.Denom)
+	if err == nil && index.InterestPolicy == metoken.InterestPolicy_INTEREST_POLICY_CLAIM {
+		position := k.interestPosition(meToken.Denom, addr)
+		if k.ctx.BlockTime().Before(position.UnlockTime) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"meTokens locked until %s", position.UnlockTime.Format(time.RFC3339),
+			)
+		}
+	}
+
+	claimed, err := k.claimInterest(addr, meToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if position := k.interestPosition(meToken.Denom, addr); position.Locked.IsLT(meToken) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("locked %s, requested %s", position.Locked, meToken)
+	}
+	if err = k.updateLocked(addr, meToken, false); err != nil {
+		return nil, err
+	}
+
+	return claimed, k.bankKeeper.SendCoinsFromModuleToAccount(*k.ctx, metoken.ModuleName, addr, sdk.NewCoins(meToken))
+}
+
+// updateLocked adds or subtracts meTokens locked in the account position and in the Index interest distribution.
+// The position pending interest must be claimed before, as its checkpoint is moved to the current interest per
+// meToken. Locking moves the position unlock time to one claiming period after the current block time.
+func (k Keeper) updateLocked(addr sdk.AccAddress, meToken sdk.Coin, lock bool) error {
+	distribution := k.interestDistribution(meToken.Denom)
+	position := k.interestPosition(meToken.Denom, addr)
+	position.Checkpoint = distribution.InterestPerMetoken
+	if lock {
+		distribution.Locked = distribution.Locked.Add(meToken.Amount)
+		position.Locked = position.Locked.Add(meToken)
+		position.UnlockTime = k.ctx.BlockTime().Add(time.Duration(k.GetParams().ClaimingFrequency) * time.Second)
+	} else {
+		distribution.Locked = distribution.Locked.Sub(meToken.Amount)
+		position.Locked = position.Locked.Sub(meToken)
+	}
+
+	if err := k.setInterestDistribution(distribution); err != nil {
+		return err
+	}
+	return k.setInterestPosition(position)
+}
@@ -232,6 +232,8 @@ func TestQuerier_SwapFee_meUSD(t *testing.T) {
 		result := fee.MulInt(asset.Amount).TruncateInt()
 
 		assert.Check(t, result.Equal(resp.Asset.Amount))
+		assert.Equal(t, mocks.MeUSDDenom, resp.Returned.Denom)
+		assert.Check(t, resp.Returned.Amount.IsPositive())
 	}
 }
 
@@ -302,6 +304,7 @@ func TestQuerier_RedeemFee_meUSD(t *testing.T) {
 		totalFee := fee.MulInt(toRedeem).TruncateInt()
 
 		assert.Check(t, totalFee.Equal(resp.Asset.Amount))
+		assert.Check(t, toRedeem.Sub(totalFee).Equal(resp.Returned.Amount))
 	}
 }
 
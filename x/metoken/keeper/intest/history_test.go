@@ -0,0 +1,103 @@
+package intest
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/v6/util/checkers"
+	"github.com/umee-network/umee/v6/util/coin"
+	"github.com/umee-network/umee/v6/x/metoken"
+	"github.com/umee-network/umee/v6/x/metoken/mocks"
+)
+
+func TestIndexHistory(t *testing.T) {
+	index := mocks.StableIndex(mocks.MeUSDDenom)
+
+	s := initTestSuite(t, nil, nil)
+	msgServer, ctx, app, querier := s.msgServer, s.ctx, s.app, s.queryClient
+
+	_, err := msgServer.GovUpdateRegistry(
+		ctx, &metoken.MsgGovUpdateRegistry{
+			Authority:   checkers.GovModuleAddr,
+			AddIndex:    []metoken.Index{index},
+			UpdateIndex: nil,
+		},
+	)
+	require := require.New(t)
+	require.NoError(err)
+
+	user := s.newAccount(
+		t,
+		coin.New(mocks.USDTBaseDenom, 1000_000000),
+		coin.New(mocks.USDCBaseDenom, 1000_000000),
+		coin.New(mocks.ISTBaseDenom, 1000_000000),
+	)
+	_, err = msgServer.Swap(ctx, metoken.NewMsgSwap(user, coin.New(mocks.USDTBaseDenom, 500_000000), index.Denom))
+	require.NoError(err)
+	_, err = msgServer.Swap(ctx, metoken.NewMsgSwap(user, coin.New(mocks.USDCBaseDenom, 300_000000), index.Denom))
+	require.NoError(err)
+
+	k := app.MetokenKeeperB.Keeper(&ctx)
+	params := k.GetParams()
+	params.MaxHistorySnapshots = 2
+	require.NoError(k.SetParams(params))
+
+	// re-balance the reserves every day, claiming the interest on the last day in the same block
+	start := ctx.BlockTime()
+	for i := 0; i < 4; i++ {
+		dayCtx := ctx.WithBlockHeight(ctx.BlockHeight() + int64(i)).
+			WithBlockTime(start.Add(time.Duration(i) * 24 * time.Hour))
+		dayKeeper := app.MetokenKeeperB.Keeper(&dayCtx)
+		if i == 3 {
+			require.NoError(dayKeeper.ClaimLeverageInterest())
+		}
+		require.NoError(dayKeeper.RebalanceReserves())
+	}
+
+	// only the last two snapshots are kept, one per block
+	resp, err := querier.IndexHistory(ctx, &metoken.QueryIndexHistory{MetokenDenom: index.Denom})
+	require.NoError(err)
+	require.Len(resp.Snapshots, 2)
+
+	balances, err := k.IndexBalances(index.Denom)
+	require.NoError(err)
+	prices, err := k.Prices(index)
+	require.NoError(err)
+	for i, snapshot := range resp.Snapshots {
+		require.Equal(ctx.BlockHeight()+int64(i)+2, snapshot.BlockHeight)
+		require.True(start.Add(time.Duration(i+2) * 24 * time.Hour).Equal(snapshot.Time))
+		require.Equal(balances.MetokenSupply, snapshot.MetokenSupply)
+		require.Equal(balances.AssetBalances, snapshot.AssetBalances)
+		require.Equal(prices.Price, snapshot.Price)
+		// IST was not swapped, so the allocation drifts from the targets
+		require.True(snapshot.AllocationDrift.IsPositive())
+	}
+	// the prices didn't change
+	require.Equal(sdk.ZeroDec(), resp.AnnualizedYield)
+
+	// pagination
+	resp, err = querier.IndexHistory(ctx, &metoken.QueryIndexHistory{
+		MetokenDenom: index.Denom,
+		Pagination:   &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(resp.Snapshots, 1)
+	require.NotEmpty(resp.Pagination.NextKey)
+
+	// window
+	from := start.Add(72 * time.Hour)
+	resp, err = querier.IndexHistory(ctx, &metoken.QueryIndexHistory{MetokenDenom: index.Denom, From: &from})
+	require.NoError(err)
+	require.Len(resp.Snapshots, 1)
+	require.Equal(ctx.BlockHeight()+3, resp.Snapshots[0].BlockHeight)
+
+	to := start
+	_, err = querier.IndexHistory(ctx, &metoken.QueryIndexHistory{MetokenDenom: index.Denom, From: &from, To: &to})
+	require.ErrorContains(err, "is after to")
+	_, err = querier.IndexHistory(ctx, &metoken.QueryIndexHistory{MetokenDenom: "me/Unknown"})
+	require.ErrorContains(err, "index me/Unknown not found")
+}
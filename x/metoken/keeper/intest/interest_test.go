@@ -8,10 +8,12 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/umee-network/umee/v6/app"
 	"github.com/umee-network/umee/v6/util/checkers"
 	"github.com/umee-network/umee/v6/util/coin"
+	"github.com/umee-network/umee/v6/x/auction"
 	"github.com/umee-network/umee/v6/x/metoken"
 	"github.com/umee-network/umee/v6/x/metoken/keeper"
 	"github.com/umee-network/umee/v6/x/metoken/mocks"
@@ -19,7 +21,20 @@ import (
 
 func TestInterestClaiming(t *testing.T) {
 	index := mocks.StableIndex(mocks.MeUSDDenom)
+	s, _ := setupInterest(t, index)
+	ctx, app := s.ctx, s.app
 
+	err := app.MetokenKeeperB.Keeper(&ctx).ClaimLeverageInterest()
+	require.NoError(t, err)
+
+	// confirm meToken account received accrued interest and the balances in meToken state and x/bank module
+	// are the same
+	checkInterest(t, ctx, app, index.Denom, false)
+}
+
+// setupInterest registers the index, swaps 1000 USDT, 1000 USDC and 1000 IST for a user, borrows from x/leverage
+// and accrues interest, ready to be claimed by x/metoken. It returns the test suite and the user.
+func setupInterest(t *testing.T, index metoken.Index) (*KeeperTestSuite, sdk.AccAddress) {
 	s := initTestSuite(t, nil, nil)
 	msgServer, ctx, app := s.msgServer, s.ctx, s.app
 
@@ -104,12 +119,161 @@ func TestInterestClaiming(t *testing.T) {
 	err = app.LeverageKeeper.AccrueAllInterest(futureCtx)
 	require.NoError(t, err)
 
-	err = app.MetokenKeeperB.Keeper(&ctx).ClaimLeverageInterest()
-	require.NoError(t, err)
+	return s, user
+}
 
-	// confirm meToken account received accrued interest and the balances in meToken state and x/bank module
-	// are the same
-	checkInterest(t, ctx, app, index.Denom, false)
+func TestInterestPolicies(t *testing.T) {
+	auctionAddr := authtypes.NewModuleAddress(auction.ModuleName)
+
+	t.Run("yield bearing", func(t *testing.T) {
+		index := mocks.StableIndex(mocks.MeUSDDenom)
+		index.InterestPolicy = metoken.InterestPolicy_INTEREST_POLICY_YIELD_BEARING
+		s, _ := setupInterest(t, index)
+		k := s.app.MetokenKeeperB.Keeper(&s.ctx)
+
+		iPrices, err := k.Prices(index)
+		require.NoError(t, err)
+		iBalances, err := k.IndexBalances(index.Denom)
+		require.NoError(t, err)
+
+		require.NoError(t, k.ClaimLeverageInterest())
+		checkInterest(t, s.ctx, s.app, index.Denom, true)
+
+		// the interest is compounded in the reserves, raising the meToken price
+		fBalances, err := k.IndexBalances(index.Denom)
+		require.NoError(t, err)
+		for _, ib := range iBalances.AssetBalances {
+			fb, _ := fBalances.AssetBalance(ib.Denom)
+			require.True(t, fb.Reserved.GT(ib.Reserved))
+		}
+		fPrices, err := k.Prices(index)
+		require.NoError(t, err)
+		require.True(t, fPrices.Price.GT(iPrices.Price))
+		require.Equal(t, index.InterestPolicy, fPrices.InterestPolicy)
+	})
+
+	t.Run("auction", func(t *testing.T) {
+		index := mocks.StableIndex(mocks.MeUSDDenom)
+		index.InterestPolicy = metoken.InterestPolicy_INTEREST_POLICY_AUCTION
+		s, _ := setupInterest(t, index)
+		k := s.app.MetokenKeeperB.Keeper(&s.ctx)
+
+		iAuction := s.app.BankKeeper.GetAllBalances(s.ctx, auctionAddr)
+		require.NoError(t, k.ClaimLeverageInterest())
+		checkInterest(t, s.ctx, s.app, index.Denom, true)
+
+		fAuction := s.app.BankKeeper.GetAllBalances(s.ctx, auctionAddr)
+		for _, aa := range index.AcceptedAssets {
+			require.True(t, fAuction.AmountOf(aa.Denom).GT(iAuction.AmountOf(aa.Denom)))
+		}
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		index := mocks.StableIndex(mocks.MeUSDDenom)
+		index.InterestPolicy = metoken.InterestPolicy_INTEREST_POLICY_CLAIM
+		s, user := setupInterest(t, index)
+		ctx, app, msgServer, querier := s.ctx, s.app, s.msgServer, s.queryClient
+		k := app.MetokenKeeperB.Keeper(&ctx)
+
+		locked := sdk.NewCoin(index.Denom, app.BankKeeper.GetBalance(ctx, user, index.Denom).Amount.QuoRaw(2))
+		other := s.newAccount(t)
+		_, err := msgServer.LockForInterest(ctx, metoken.NewMsgLockForInterest(user, locked))
+		require.NoError(t, err)
+
+		require.NoError(t, k.ClaimLeverageInterest())
+		checkInterest(t, ctx, app, index.Denom, false)
+
+		// the whole interest is distributed to the only position
+		balances, err := k.IndexBalances(index.Denom)
+		require.NoError(t, err)
+		resp, err := querier.InterestPosition(ctx, &metoken.QueryInterestPosition{
+			Address: user.String(), MetokenDenom: index.Denom,
+		})
+		require.NoError(t, err)
+		require.Equal(t, locked, resp.Locked)
+		for _, b := range balances.AssetBalances {
+			require.True(t, resp.Claimable.AmountOf(b.Denom).IsPositive())
+			require.True(t, resp.Claimable.AmountOf(b.Denom).LTE(b.Interest))
+		}
+
+		// accounts without locked meTokens can't claim
+		claimResp, err := msgServer.ClaimInterest(ctx, metoken.NewMsgClaimInterest(other, index.Denom))
+		require.NoError(t, err)
+		require.True(t, claimResp.Claimed.IsZero())
+
+		iUser := app.BankKeeper.GetAllBalances(ctx, user)
+		claimResp, err = msgServer.ClaimInterest(ctx, metoken.NewMsgClaimInterest(user, index.Denom))
+		require.NoError(t, err)
+		require.Equal(t, resp.Claimable, claimResp.Claimed)
+		require.Equal(t, iUser.Add(claimResp.Claimed...), app.BankKeeper.GetAllBalances(ctx, user))
+		checkInterest(t, ctx, app, index.Denom, false)
+
+		// claiming again returns nothing
+		claimResp, err = msgServer.ClaimInterest(ctx, metoken.NewMsgClaimInterest(user, index.Denom))
+		require.NoError(t, err)
+		require.True(t, claimResp.Claimed.IsZero())
+
+		// meTokens locked just before the interest claim can't be unlocked right after it, until a whole claiming
+		// period passed since the lock
+		_, err = msgServer.UnlockFromInterest(ctx, metoken.NewMsgUnlockFromInterest(user, locked))
+		require.ErrorContains(t, err, "meTokens locked until")
+		lockDuration := time.Duration(k.GetParams().ClaimingFrequency) * time.Second
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(lockDuration - time.Second))
+		_, err = msgServer.UnlockFromInterest(ctx, metoken.NewMsgUnlockFromInterest(user, locked))
+		require.ErrorContains(t, err, "meTokens locked until")
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+
+		_, err = msgServer.UnlockFromInterest(ctx, metoken.NewMsgUnlockFromInterest(user, locked.AddAmount(sdkmath.OneInt())))
+		require.ErrorContains(t, err, "insufficient funds")
+		_, err = msgServer.UnlockFromInterest(ctx, metoken.NewMsgUnlockFromInterest(user, locked))
+		require.NoError(t, err)
+		resp, err = querier.InterestPosition(ctx, &metoken.QueryInterestPosition{
+			Address: user.String(), MetokenDenom: index.Denom,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Locked.IsZero())
+
+		// meTokens can only be locked in indexes with the claim policy
+		index.InterestPolicy = metoken.InterestPolicy_INTEREST_POLICY_UNSPECIFIED
+		_, err = msgServer.GovUpdateRegistry(ctx, &metoken.MsgGovUpdateRegistry{
+			Authority:   checkers.GovModuleAddr,
+			UpdateIndex: []metoken.Index{index},
+		})
+		require.NoError(t, err)
+		_, err = msgServer.LockForInterest(ctx, metoken.NewMsgLockForInterest(user, locked))
+		require.ErrorContains(t, err, "interest policy is INTEREST_POLICY_UNSPECIFIED")
+	})
+
+	t.Run("claim without locked meTokens", func(t *testing.T) {
+		index := mocks.StableIndex(mocks.MeUSDDenom)
+		index.InterestPolicy = metoken.InterestPolicy_INTEREST_POLICY_CLAIM
+		s, user := setupInterest(t, index)
+		ctx, app, msgServer, querier := s.ctx, s.app, s.msgServer, s.queryClient
+		k := app.MetokenKeeperB.Keeper(&ctx)
+
+		iBalances, err := k.IndexBalances(index.Denom)
+		require.NoError(t, err)
+		require.NoError(t, k.ClaimLeverageInterest())
+		checkInterest(t, ctx, app, index.Denom, true)
+
+		// the interest accrued while nothing is locked is moved to the reserves
+		fBalances, err := k.IndexBalances(index.Denom)
+		require.NoError(t, err)
+		for _, ib := range iBalances.AssetBalances {
+			fb, _ := fBalances.AssetBalance(ib.Denom)
+			require.True(t, fb.Reserved.GT(ib.Reserved))
+		}
+
+		// so it can't be claimed by a later locker
+		locked := sdk.NewCoin(index.Denom, app.BankKeeper.GetBalance(ctx, user, index.Denom).Amount)
+		_, err = msgServer.LockForInterest(ctx, metoken.NewMsgLockForInterest(user, locked))
+		require.NoError(t, err)
+		resp, err := querier.InterestPosition(ctx, &metoken.QueryInterestPosition{
+			Address: user.String(), MetokenDenom: index.Denom,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Claimable.IsZero())
+	})
 }
 
 func checkInterest(
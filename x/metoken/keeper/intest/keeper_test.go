@@ -52,6 +52,7 @@ func initTestSuite(t *testing.T, registry []metoken.Index, balances []metoken.In
 		AllMedianPrices(gomock.Any()).
 		Return(mocks.ValidPrices()).
 		AnyTimes()
+	oracleMock.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).AnyTimes()
 	oracleMock.EXPECT().SetExchangeRate(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	app.MetokenKeeperB = keeper.NewBuilder(
@@ -3,6 +3,7 @@ package intest
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -10,6 +11,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/umee-network/umee/v6/util/checkers"
 	"github.com/umee-network/umee/v6/util/coin"
@@ -608,6 +610,7 @@ func TestMsgServer_Swap_Depegging(t *testing.T) {
 		AllMedianPrices(gomock.Any()).
 		Return(initialPrices).
 		AnyTimes()
+	oracleMock.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).AnyTimes()
 
 	kb := keeper.NewBuilder(
 		app.AppCodec(),
@@ -843,6 +846,301 @@ func TestMsgServer_Swap_EdgeCase(t *testing.T) {
 	require.Equal(iMeTokenBalance, fMeTokenBalance)
 }
 
+func TestMsgServer_Slippage(t *testing.T) {
+	index := mocks.StableIndex(mocks.MeUSDDenom)
+
+	s := initTestSuite(t, nil, nil)
+	msgServer, querier, ctx := s.msgServer, s.queryClient, s.ctx
+
+	_, err := msgServer.GovUpdateRegistry(
+		ctx, &metoken.MsgGovUpdateRegistry{
+			Authority:   checkers.GovModuleAddr,
+			AddIndex:    []metoken.Index{index},
+			UpdateIndex: nil,
+		},
+	)
+	require := require.New(t)
+	require.NoError(err)
+
+	user := s.newAccount(t, coin.New(mocks.USDTBaseDenom, 100_000000))
+	asset := coin.New(mocks.USDTBaseDenom, 10_000000)
+	past := ctx.BlockTime().Add(-time.Second)
+	future := ctx.BlockTime().Add(time.Minute)
+
+	feeResp, err := querier.SwapFee(ctx, &metoken.QuerySwapFee{Asset: asset.String(), MetokenDenom: mocks.MeUSDDenom})
+	require.NoError(err)
+	require.True(feeResp.Returned.IsPositive())
+
+	// failed messages are executed on a cache context, the same way the tx runner discards their writes
+	swap := func(minAmountOut sdkmath.Int, deadline *time.Time, commit bool) (*metoken.MsgSwapResponse, error) {
+		execCtx := ctx
+		if !commit {
+			execCtx, _ = ctx.CacheContext()
+		}
+		return msgServer.Swap(execCtx, &metoken.MsgSwap{
+			User: user.String(), Asset: asset, MetokenDenom: mocks.MeUSDDenom,
+			MinAmountOut: minAmountOut, Deadline: deadline,
+		})
+	}
+	_, err = swap(feeResp.Returned.Amount.AddRaw(1), nil, false)
+	require.ErrorIs(err, metoken.ErrMinAmountOut)
+	_, err = swap(sdkmath.Int{}, &past, false)
+	require.ErrorIs(err, metoken.ErrDeadlineExceeded)
+	swapResp, err := swap(feeResp.Returned.Amount, &future, true)
+	require.NoError(err)
+	require.Equal(feeResp.Returned, swapResp.Returned)
+	require.Equal(feeResp.Asset, swapResp.Fee)
+
+	redeemFeeResp, err := querier.RedeemFee(ctx, &metoken.QueryRedeemFee{
+		Metoken: swapResp.Returned.String(), AssetDenom: mocks.USDTBaseDenom,
+	})
+	require.NoError(err)
+
+	redeem := func(minAmountOut sdkmath.Int, deadline *time.Time, commit bool) (*metoken.MsgRedeemResponse, error) {
+		execCtx := ctx
+		if !commit {
+			execCtx, _ = ctx.CacheContext()
+		}
+		return msgServer.Redeem(execCtx, &metoken.MsgRedeem{
+			User: user.String(), Metoken: swapResp.Returned, AssetDenom: mocks.USDTBaseDenom,
+			MinAmountOut: minAmountOut, Deadline: deadline,
+		})
+	}
+	_, err = redeem(redeemFeeResp.Returned.Amount.AddRaw(1), nil, false)
+	require.ErrorIs(err, metoken.ErrMinAmountOut)
+	_, err = redeem(sdkmath.ZeroInt(), &past, false)
+	require.ErrorIs(err, metoken.ErrDeadlineExceeded)
+	redeemResp, err := redeem(redeemFeeResp.Returned.Amount, &future, true)
+	require.NoError(err)
+	require.Equal(redeemFeeResp.Returned, redeemResp.Returned)
+	require.Equal(redeemFeeResp.Asset, redeemResp.Fee)
+}
+
+func TestMsgServer_SwapMulti_RedeemProportional(t *testing.T) {
+	index := mocks.StableIndex(mocks.MeUSDDenom)
+
+	s := initTestSuite(t, nil, nil)
+	msgServer, ctx, app := s.msgServer, s.ctx, s.app
+
+	_, err := msgServer.GovUpdateRegistry(
+		ctx, &metoken.MsgGovUpdateRegistry{
+			Authority:   checkers.GovModuleAddr,
+			AddIndex:    []metoken.Index{index},
+			UpdateIndex: nil,
+		},
+	)
+	require := require.New(t)
+	require.NoError(err)
+
+	user := s.newAccount(
+		t,
+		coin.New(mocks.USDTBaseDenom, 1000_000000),
+		coin.New(mocks.USDCBaseDenom, 1000_000000),
+		coin.New(mocks.ISTBaseDenom, 1000_000000),
+	)
+	balancedBasket := sdk.NewCoins(
+		coin.New(mocks.USDTBaseDenom, 330_000000),
+		coin.New(mocks.USDCBaseDenom, 340_000000),
+		coin.New(mocks.ISTBaseDenom, 330_000000),
+	)
+	minFees := func(assets sdk.Coins) sdk.Coins {
+		var fees sdk.Coins
+		for _, a := range assets {
+			fees = fees.Add(sdk.NewCoin(a.Denom, index.Fee.MinFee.MulInt(a.Amount).TruncateInt()))
+		}
+		return fees
+	}
+	swapMulti := func(assets sdk.Coins) *metoken.MsgSwapMultiResponse {
+		resp, err := msgServer.SwapMulti(ctx, metoken.NewMsgSwapMulti(user, assets, mocks.MeUSDDenom))
+		require.NoError(err)
+		return resp
+	}
+
+	// a balanced basket pays min_fee
+	resp := swapMulti(balancedBasket)
+	require.Equal(minFees(balancedBasket), resp.Fee)
+	require.True(resp.Returned.IsPositive())
+
+	// assets with a zero target allocation pay max_fee
+	cacheCtx, _ := ctx.CacheContext()
+	unwanted := index
+	unwanted.AcceptedAssets = []metoken.AcceptedAsset{
+		index.AcceptedAssets[0],
+		metoken.NewAcceptedAsset(mocks.USDCBaseDenom, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.67")),
+		metoken.NewAcceptedAsset(mocks.ISTBaseDenom, sdk.MustNewDecFromStr("0.2"), sdk.ZeroDec()),
+	}
+	_, err = msgServer.GovUpdateRegistry(cacheCtx, &metoken.MsgGovUpdateRegistry{
+		Authority:   checkers.GovModuleAddr,
+		UpdateIndex: []metoken.Index{unwanted},
+	})
+	require.NoError(err)
+	basket := sdk.NewCoins(coin.New(mocks.USDCBaseDenom, 100_000000), coin.New(mocks.ISTBaseDenom, 100_000000))
+	unwantedResp, err := msgServer.SwapMulti(cacheCtx, metoken.NewMsgSwapMulti(user, basket, mocks.MeUSDDenom))
+	require.NoError(err)
+	require.Equal(index.Fee.MaxFee.MulInt(basket.AmountOf(mocks.ISTBaseDenom)).TruncateInt(),
+		unwantedResp.Fee.AmountOf(mocks.ISTBaseDenom))
+	require.True(unwantedResp.Fee.AmountOf(mocks.USDCBaseDenom).LT(unwantedResp.Fee.AmountOf(mocks.ISTBaseDenom)))
+
+	// a single asset basket drifts the allocation and pays more
+	single := sdk.NewCoins(coin.New(mocks.USDTBaseDenom, 100_000000))
+	resp = swapMulti(single)
+	require.True(resp.Fee.IsAllGT(minFees(single)))
+	require.True(index.Fee.MaxFee.MulInt(single[0].Amount).TruncateInt().GTE(resp.Fee[0].Amount))
+
+	// a basket moving the index back to its target allocations pays min_fee
+	rebalancing := sdk.NewCoins(
+		coin.New(mocks.USDCBaseDenom, 100_000000),
+		coin.New(mocks.ISTBaseDenom, 100_000000),
+	)
+	resp = swapMulti(rebalancing)
+	require.Equal(minFees(rebalancing), resp.Fee)
+
+	// unaccepted assets are rejected
+	_, err = msgServer.SwapMulti(
+		ctx, metoken.NewMsgSwapMulti(user, sdk.NewCoins(coin.New(mocks.WBTCBaseDenom, 1)), mocks.MeUSDDenom),
+	)
+	require.ErrorContains(err, "is not accepted in the index")
+
+	// a proportional redemption keeps the allocations and pays min_fee
+	k := app.MetokenKeeperB.Keeper(&ctx)
+	iBalances, err := k.IndexBalances(mocks.MeUSDDenom)
+	require.NoError(err)
+	toRedeem := sdk.NewCoin(mocks.MeUSDDenom, iBalances.MetokenSupply.Amount.QuoRaw(2))
+
+	msg := metoken.NewMsgRedeemProportional(user, toRedeem)
+	cacheCtx, _ = ctx.CacheContext()
+	msg.MinAmountsOut = sdk.NewCoins(coin.New(mocks.USDTBaseDenom, 1000_000000))
+	_, err = msgServer.RedeemProportional(cacheCtx, msg)
+	require.ErrorIs(err, metoken.ErrMinAmountOut)
+	msg.MinAmountsOut = nil
+
+	iUserBalances := app.BankKeeper.GetAllBalances(ctx, user)
+	redeemResp, err := msgServer.RedeemProportional(ctx, msg)
+	require.NoError(err)
+	require.Len(redeemResp.Returned, len(index.AcceptedAssets))
+	require.Equal(minFees(redeemResp.Returned.Add(redeemResp.Fee...)), redeemResp.Fee)
+
+	fBalances, err := k.IndexBalances(mocks.MeUSDDenom)
+	require.NoError(err)
+	require.Equal(iBalances.MetokenSupply.Sub(toRedeem), fBalances.MetokenSupply)
+	for _, ib := range iBalances.AssetBalances {
+		fb, _ := fBalances.AssetBalance(ib.Denom)
+		withdrawn := ib.AvailableSupply().Sub(fb.AvailableSupply())
+		require.Equal(redeemResp.Returned.AmountOf(ib.Denom).Add(redeemResp.Fee.AmountOf(ib.Denom)), withdrawn)
+		// half of the meToken supply redeems half of every asset, reserved and leveraged amounts rounded down
+		require.InDelta(ib.AvailableSupply().QuoRaw(2).Int64(), withdrawn.Int64(), 2)
+	}
+
+	fUserBalances := app.BankKeeper.GetAllBalances(ctx, user)
+	require.Equal(iUserBalances.Add(redeemResp.Returned...).Sub(toRedeem), fUserBalances)
+}
+
+func TestMsgServer_SwapIndex(t *testing.T) {
+	const meUSD2Denom = "me/USD2"
+	index, index2 := mocks.StableIndex(mocks.MeUSDDenom), mocks.StableIndex(meUSD2Denom)
+
+	s := initTestSuite(t, nil, nil)
+	msgServer, ctx, app, querier := s.msgServer, s.ctx, s.app, s.queryClient
+
+	_, err := msgServer.GovUpdateRegistry(
+		ctx, &metoken.MsgGovUpdateRegistry{
+			Authority:   checkers.GovModuleAddr,
+			AddIndex:    []metoken.Index{index, index2},
+			UpdateIndex: nil,
+		},
+	)
+	require := require.New(t)
+	require.NoError(err)
+
+	user := s.newAccount(
+		t,
+		coin.New(mocks.USDTBaseDenom, 2000_000000),
+		coin.New(mocks.USDCBaseDenom, 2000_000000),
+		coin.New(mocks.ISTBaseDenom, 2000_000000),
+	)
+	balancedBasket := sdk.NewCoins(
+		coin.New(mocks.USDTBaseDenom, 330_000000),
+		coin.New(mocks.USDCBaseDenom, 340_000000),
+		coin.New(mocks.ISTBaseDenom, 330_000000),
+	)
+	for _, denom := range []string{index.Denom, index2.Denom} {
+		_, err = msgServer.SwapMulti(ctx, metoken.NewMsgSwapMulti(user, balancedBasket, denom))
+		require.NoError(err)
+	}
+
+	toSwap := coin.New(index.Denom, 100_000000)
+	feeResp, err := querier.SwapIndexFee(
+		ctx, &metoken.QuerySwapIndexFee{
+			Metoken:        toSwap.String(),
+			AssetDenom:     mocks.USDTBaseDenom,
+			ToMetokenDenom: index2.Denom,
+		},
+	)
+	require.NoError(err)
+	require.True(feeResp.Asset.IsPositive())
+	require.Equal(index2.Denom, feeResp.Returned.Denom)
+
+	// the combined fee is lower than the fees of a redemption followed by a swap
+	redeemFeeResp, err := querier.RedeemFee(
+		ctx, &metoken.QueryRedeemFee{Metoken: toSwap.String(), AssetDenom: mocks.USDTBaseDenom},
+	)
+	require.NoError(err)
+	swapFeeResp, err := querier.SwapFee(
+		ctx, &metoken.QuerySwapFee{Asset: redeemFeeResp.Returned.String(), MetokenDenom: index2.Denom},
+	)
+	require.NoError(err)
+	require.True(feeResp.Asset.IsLT(redeemFeeResp.Asset.Add(swapFeeResp.Asset)))
+	require.True(feeResp.Returned.IsGTE(swapFeeResp.Returned))
+
+	// slippage protection
+	msg := metoken.NewMsgSwapIndex(user, toSwap, mocks.USDTBaseDenom, index2.Denom)
+	msg.MinAmountOut = feeResp.Returned.Amount.AddRaw(1)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.SwapIndex(cacheCtx, msg)
+	require.ErrorIs(err, metoken.ErrMinAmountOut)
+
+	// the asset must be accepted by both indexes
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.SwapIndex(cacheCtx, metoken.NewMsgSwapIndex(user, toSwap, mocks.WBTCBaseDenom, index2.Denom))
+	require.ErrorContains(err, "is not accepted in the index")
+
+	k := app.MetokenKeeperB.Keeper(&ctx)
+	iBalances, err := k.IndexBalances(index.Denom)
+	require.NoError(err)
+	iBalances2, err := k.IndexBalances(index2.Denom)
+	require.NoError(err)
+	iUserBalances := app.BankKeeper.GetAllBalances(ctx, user)
+
+	msg.MinAmountOut = feeResp.Returned.Amount
+	resp, err := msgServer.SwapIndex(ctx, msg)
+	require.NoError(err)
+	require.Equal(feeResp.Asset, resp.Fee)
+	require.Equal(feeResp.Returned, resp.Returned)
+	require.Equal(
+		iUserBalances.Sub(toSwap).Add(resp.Returned),
+		app.BankKeeper.GetAllBalances(ctx, user),
+	)
+
+	fBalances, err := k.IndexBalances(index.Denom)
+	require.NoError(err)
+	fBalances2, err := k.IndexBalances(index2.Denom)
+	require.NoError(err)
+	require.Equal(iBalances.MetokenSupply.Sub(toSwap), fBalances.MetokenSupply)
+	require.Equal(iBalances2.MetokenSupply.Add(resp.Returned), fBalances2.MetokenSupply)
+
+	// the asset withdrawn from the first index, minus the fee, is supplied to the second index
+	ib, _ := iBalances.AssetBalance(mocks.USDTBaseDenom)
+	fb, _ := fBalances.AssetBalance(mocks.USDTBaseDenom)
+	ib2, _ := iBalances2.AssetBalance(mocks.USDTBaseDenom)
+	fb2, _ := fBalances2.AssetBalance(mocks.USDTBaseDenom)
+	withdrawn := ib.AvailableSupply().Sub(fb.AvailableSupply())
+	require.Equal(withdrawn.Sub(resp.Fee.Amount), fb2.AvailableSupply().Sub(ib2.AvailableSupply()))
+
+	// the module balance matches the reserves and fees of both indexes
+	moduleBalance := app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(metoken.ModuleName), mocks.USDTBaseDenom)
+	require.Equal(fb.Reserved.Add(fb.Fees).Add(fb2.Reserved).Add(fb2.Fees), moduleBalance.Amount)
+}
+
 // i=initial  f=final
 func verifySwap(
 	t *testing.T, tc testCase, params metoken.Params, index metoken.Index,
@@ -1351,6 +1649,7 @@ func TestMsgServer_Redeem_Depegging(t *testing.T) {
 		AllMedianPrices(gomock.Any()).
 		Return(initialPrices).
 		AnyTimes()
+	oracleMock.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).AnyTimes()
 
 	kb := keeper.NewBuilder(
 		app.AppCodec(),
@@ -1,6 +1,10 @@
 package keeper
 
-import "github.com/umee-network/umee/v6/util"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+)
 
 var (
 	// Regular state
@@ -9,7 +13,10 @@ var (
 	keyPrefixNextRebalancingTime   = []byte{0x03}
 	keyPrefixNextInterestClaimTime = []byte{0x04}
 	// keyPrefixParams is the key to query all gov params
-	keyPrefixParams = []byte{0x05}
+	keyPrefixParams               = []byte{0x05}
+	keyPrefixInterestDistribution = []byte{0x06}
+	keyPrefixInterestPosition     = []byte{0x07}
+	keyPrefixIndexHistory         = []byte{0x08}
 )
 
 // keyIndex returns a KVStore key for index parameters for specific Index.
@@ -23,3 +30,27 @@ func keyBalance(meTokendenom string) []byte {
 	// keyPrefixBalances | meTokendenom
 	return util.ConcatBytes(0, keyPrefixBalances, []byte(meTokendenom))
 }
+
+// keyInterestDistribution returns a KVStore key for the interest distribution of a specific Index.
+func keyInterestDistribution(meTokendenom string) []byte {
+	// keyPrefixInterestDistribution | meTokendenom
+	return util.ConcatBytes(0, keyPrefixInterestDistribution, []byte(meTokendenom))
+}
+
+// keyInterestPosition returns a KVStore key for the interest position of an account in a specific Index.
+func keyInterestPosition(meTokendenom string, addr sdk.AccAddress) []byte {
+	// keyPrefixInterestPosition | meTokendenom | 0x00 | addr
+	return util.ConcatBytes(0, keyPrefixInterestPosition, []byte(meTokendenom), []byte{0}, addr)
+}
+
+// keyIndexHistory returns a KVStore key prefix for the snapshots of a specific Index.
+func keyIndexHistory(meTokendenom string) []byte {
+	// keyPrefixIndexHistory | meTokendenom | 0x00
+	return util.ConcatBytes(0, keyPrefixIndexHistory, []byte(meTokendenom), []byte{0})
+}
+
+// keyIndexSnapshot returns a KVStore key for the snapshot of a specific Index at a block height.
+func keyIndexSnapshot(meTokendenom string, height int64) []byte {
+	// keyPrefixIndexHistory | meTokendenom | 0x00 | height
+	return util.KeyWithUint64(keyIndexHistory(meTokendenom), uint64(height))
+}
@@ -11,13 +11,25 @@ import (
 )
 
 type Oracle struct {
-	prices otypes.Prices
+	prices        otypes.Prices
+	stale         map[string]bool
+	lowConfidence map[string]bool
 }
 
 func (o Oracle) AllMedianPrices(_ sdk.Context) otypes.Prices {
 	return o.prices
 }
 
+func (o Oracle) GetExchangeRate(_ sdk.Context, symbol string) (otypes.ExchangeRate, error) {
+	if o.stale[symbol] {
+		return otypes.ExchangeRate{}, otypes.ErrStalePrice.Wrap(symbol)
+	}
+	if o.lowConfidence[symbol] {
+		return otypes.ExchangeRate{}, otypes.ErrLowConfidence.Wrap(symbol)
+	}
+	return otypes.ExchangeRate{}, nil
+}
+
 func (o Oracle) SetExchangeRate(_ sdk.Context, _ string, _ sdk.Dec) {
 }
 
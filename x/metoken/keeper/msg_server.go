@@ -39,6 +39,9 @@ func (m msgServer) Swap(goCtx context.Context, msg *metoken.MsgSwap) (*metoken.M
 	if err != nil {
 		return nil, err
 	}
+	if err = metoken.CheckSlippage(ctx.BlockTime(), msg.Deadline, msg.MinAmountOut, resp.meTokens); err != nil {
+		return nil, err
+	}
 
 	k.Logger().Debug(
 		"swap executed",
@@ -95,6 +98,10 @@ func (m msgServer) Redeem(goCtx context.Context, msg *metoken.MsgRedeem) (*metok
 		msg.AssetDenom,
 		resp.fromReserves.Amount.Add(resp.fromLeverage.Amount).Sub(resp.fee.Amount),
 	)
+	if err = metoken.CheckSlippage(ctx.BlockTime(), msg.Deadline, msg.MinAmountOut, totalRedeemed); err != nil {
+		return nil, err
+	}
+
 	sdkutil.Emit(
 		&ctx, &metoken.EventRedeem{
 			Recipient: msg.User,
@@ -110,6 +117,215 @@ func (m msgServer) Redeem(goCtx context.Context, msg *metoken.MsgRedeem) (*metok
 	}, nil
 }
 
+// SwapMulti handles the request for the basket swap, delegates the execution and returns the response.
+func (m msgServer) SwapMulti(goCtx context.Context, msg *metoken.MsgSwapMulti) (*metoken.MsgSwapMultiResponse, error) {
+	ctx, err := sdkutil.StartMsg(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return nil, err
+	}
+
+	k := m.kb.Keeper(&ctx)
+	resp, err := k.swapMulti(userAddr, msg.MetokenDenom, msg.Assets)
+	if err != nil {
+		return nil, err
+	}
+	if err = metoken.CheckSlippage(ctx.BlockTime(), msg.Deadline, msg.MinAmountOut, resp.meTokens); err != nil {
+		return nil, err
+	}
+
+	k.Logger().Debug(
+		"swap multi executed",
+		"user", userAddr,
+		"meTokens", resp.meTokens.String(),
+		"fees", resp.fees.String(),
+		"reserved", resp.reserved.String(),
+		"leveraged", resp.leveraged.String(),
+	)
+
+	sdkutil.Emit(
+		&ctx, &metoken.EventSwapMulti{
+			Recipient: msg.User,
+			Assets:    resp.reserved.Add(resp.leveraged...),
+			Metoken:   resp.meTokens,
+			Fee:       resp.fees,
+		},
+	)
+
+	return &metoken.MsgSwapMultiResponse{
+		Fee:      resp.fees,
+		Returned: resp.meTokens,
+	}, nil
+}
+
+// RedeemProportional handles the request for the proportional redemption, delegates the execution and returns
+// the response.
+func (m msgServer) RedeemProportional(goCtx context.Context, msg *metoken.MsgRedeemProportional) (
+	*metoken.MsgRedeemProportionalResponse,
+	error,
+) {
+	ctx, err := sdkutil.StartMsg(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return nil, err
+	}
+
+	k := m.kb.Keeper(&ctx)
+	resp, err := k.redeemProportional(userAddr, msg.Metoken)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger().Debug(
+		"redeem proportional executed",
+		"user", userAddr,
+		"fees", resp.fees.String(),
+		"from_reserves", resp.fromReserves.String(),
+		"from_leverage", resp.fromLeverage.String(),
+		"burned", msg.Metoken.String(),
+	)
+
+	totalRedeemed := resp.returned()
+	if err = metoken.CheckSlippageCoins(ctx.BlockTime(), msg.Deadline, msg.MinAmountsOut, totalRedeemed); err != nil {
+		return nil, err
+	}
+
+	sdkutil.Emit(
+		&ctx, &metoken.EventRedeemProportional{
+			Recipient: msg.User,
+			Metoken:   msg.Metoken,
+			Assets:    totalRedeemed,
+			Fee:       resp.fees,
+		},
+	)
+
+	return &metoken.MsgRedeemProportionalResponse{
+		Returned: totalRedeemed,
+		Fee:      resp.fees,
+	}, nil
+}
+
+// SwapIndex handles the request for the swap between Indexes, delegates the execution and returns the response.
+func (m msgServer) SwapIndex(goCtx context.Context, msg *metoken.MsgSwapIndex) (*metoken.MsgSwapIndexResponse, error) {
+	ctx, err := sdkutil.StartMsg(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return nil, err
+	}
+
+	k := m.kb.Keeper(&ctx)
+	resp, err := k.swapIndex(userAddr, msg.Metoken, msg.AssetDenom, msg.ToMetokenDenom)
+	if err != nil {
+		return nil, err
+	}
+	if err = metoken.CheckSlippage(ctx.BlockTime(), msg.Deadline, msg.MinAmountOut, resp.meTokens); err != nil {
+		return nil, err
+	}
+
+	k.Logger().Debug(
+		"swap index executed",
+		"user", userAddr,
+		"burned", msg.Metoken.String(),
+		"meTokens", resp.meTokens.String(),
+		"fee", resp.fee.String(),
+	)
+
+	sdkutil.Emit(
+		&ctx, &metoken.EventSwapIndex{
+			Recipient:   msg.User,
+			FromMetoken: msg.Metoken,
+			ToMetoken:   resp.meTokens,
+			Fee:         resp.fee,
+		},
+	)
+
+	return &metoken.MsgSwapIndexResponse{
+		Fee:      resp.fee,
+		Returned: resp.meTokens,
+	}, nil
+}
+
+// LockForInterest handles the request for locking meTokens for interest.
+func (m msgServer) LockForInterest(goCtx context.Context, msg *metoken.MsgLockForInterest) (
+	*metoken.MsgLockForInterestResponse,
+	error,
+) {
+	ctx, err := sdkutil.StartMsg(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := m.kb.Keeper(&ctx).lockForInterest(userAddr, msg.Metoken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metoken.MsgLockForInterestResponse{Claimed: claimed}, nil
+}
+
+// UnlockFromInterest handles the request for unlocking meTokens locked for interest.
+func (m msgServer) UnlockFromInterest(goCtx context.Context, msg *metoken.MsgUnlockFromInterest) (
+	*metoken.MsgUnlockFromInterestResponse,
+	error,
+) {
+	ctx, err := sdkutil.StartMsg(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := m.kb.Keeper(&ctx).unlockFromInterest(userAddr, msg.Metoken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metoken.MsgUnlockFromInterestResponse{Claimed: claimed}, nil
+}
+
+// ClaimInterest handles the request for claiming the interest distributed to locked meTokens.
+func (m msgServer) ClaimInterest(goCtx context.Context, msg *metoken.MsgClaimInterest) (
+	*metoken.MsgClaimInterestResponse,
+	error,
+) {
+	ctx, err := sdkutil.StartMsg(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	userAddr, err := sdk.AccAddressFromBech32(msg.User)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := m.kb.Keeper(&ctx).claimInterest(userAddr, msg.MetokenDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metoken.MsgClaimInterestResponse{Claimed: claimed}, nil
+}
+
 // GovSetParams handles the request for updating Params.
 func (m msgServer) GovSetParams(goCtx context.Context, msg *metoken.MsgGovSetParams) (
 	*metoken.MsgGovSetParamsResponse,
@@ -34,6 +34,13 @@ func (k Keeper) Prices(index metoken.Index) (metoken.IndexPrices, error) {
 			return indexPrices, err
 		}
 
+		// medians keep being stamped from the last exchange rate when ballots are dropped,
+		// so prices are rejected when the exchange rate is stale or has a low confidence.
+		_, err = k.oracleKeeper.GetExchangeRate(*k.ctx, tokenSettings.SymbolDenom)
+		if otypes.ErrStalePrice.Is(err) || otypes.ErrLowConfidence.Is(err) {
+			return indexPrices, err
+		}
+
 		assetPrice, err := latestPrice(allPrices, tokenSettings.SymbolDenom)
 		if err != nil {
 			return indexPrices, err
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/umee-network/umee/v6/x/metoken"
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
 )
 
 func TestIndexPrices_Prices(t *testing.T) {
@@ -63,6 +64,28 @@ func TestIndexPrices_Prices(t *testing.T) {
 	require.True(t, ip.Price.Equal(sdk.MustNewDecFromStr("1.006")))
 }
 
+func TestIndexPrices_StalePrice(t *testing.T) {
+	o := NewOracleMock()
+	o.stale = map[string]bool{mocks.USDTSymbolDenom: true}
+	k := initMeUSDKeeper(t, nil, NewLeverageMock(), o)
+	index, err := k.RegisteredIndex(mocks.MeUSDDenom)
+	require.NoError(t, err)
+
+	_, err = k.Prices(index)
+	require.ErrorIs(t, err, otypes.ErrStalePrice)
+}
+
+func TestIndexPrices_LowConfidencePrice(t *testing.T) {
+	o := NewOracleMock()
+	o.lowConfidence = map[string]bool{mocks.USDTSymbolDenom: true}
+	k := initMeUSDKeeper(t, nil, NewLeverageMock(), o)
+	index, err := k.RegisteredIndex(mocks.MeUSDDenom)
+	require.NoError(t, err)
+
+	_, err = k.Prices(index)
+	require.ErrorIs(t, err, otypes.ErrLowConfidence)
+}
+
 func TestIndexPrices_Convert(t *testing.T) {
 	o := NewOracleMock()
 	l := NewLeverageMock()
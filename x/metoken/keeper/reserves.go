@@ -11,7 +11,7 @@ import (
 )
 
 // RebalanceReserves checks if the portion of reserves is below the desired and transfer the missing amount from
-// x/leverage to x/metoken reserves, or vice versa.
+// x/leverage to x/metoken reserves, or vice versa. Then it records a snapshot of every Index.
 func (k Keeper) RebalanceReserves() error {
 	if k.ctx.BlockTime().Before(k.getNextRebalancingTime()) {
 		return nil
@@ -101,6 +101,7 @@ func (k Keeper) RebalanceReserves() error {
 		}
 	}
 
+	k.recordIndexHistory()
 	k.setNextRebalancingTime(k.ctx.BlockTime().Add(time.Duration(k.GetParams().RebalancingFrequency) * time.Second))
 
 	return nil
@@ -215,7 +215,17 @@ func (k Keeper) calculateSwap(index metoken.Index, indexPrices metoken.IndexPric
 		return swapCarry{}, err
 	}
 
-	amountToSwap := asset.Amount.Sub(fee.Amount)
+	return newSwapCarry(assetSettings, indexPrices, asset, fee.Amount)
+}
+
+// newSwapCarry returns the amounts of a swap of the asset, once the fee amount is known.
+func newSwapCarry(
+	assetSettings metoken.AcceptedAsset,
+	indexPrices metoken.IndexPrices,
+	asset sdk.Coin,
+	fee sdkmath.Int,
+) (swapCarry, error) {
+	amountToSwap := asset.Amount.Sub(fee)
 	meTokens, err := indexPrices.SwapRate(sdk.NewCoin(asset.Denom, amountToSwap))
 	if err != nil {
 		return swapCarry{}, err
@@ -224,7 +234,7 @@ func (k Keeper) calculateSwap(index metoken.Index, indexPrices metoken.IndexPric
 	toReserves := assetSettings.ReservePortion.MulInt(amountToSwap).TruncateInt()
 	toLeverage := amountToSwap.Sub(toReserves)
 
-	return swapCarry{meTokens: meTokens, fee: fee.Amount, toReserves: toReserves, toLeverage: toLeverage}, nil
+	return swapCarry{meTokens: meTokens, fee: fee, toReserves: toReserves, toLeverage: toLeverage}, nil
 }
 
 func (k Keeper) fundAuction(denom string, amount sdkmath.Int) error {
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/umee-network/umee/v6/x/metoken"
+)
+
+// swapIndexResponse wraps all the coins of a successful swap between Indexes
+type swapIndexResponse struct {
+	meTokens sdk.Coin
+	fee      sdk.Coin
+}
+
+// swapIndexCarry holds the amounts of a swap between Indexes: the redemption from the first Index and the swap for
+// the meTokens of the second Index, through the shared asset.
+type swapIndexCarry struct {
+	fromReserves sdkmath.Int
+	fromLeverage sdkmath.Int
+	// fromFee and toFee are the parts of the fee kept by the redeemed and the minted Index.
+	fromFee sdkmath.Int
+	toFee   sdkmath.Int
+	swap    swapCarry
+}
+
+// fee returns the total fee charged to the user.
+func (c swapIndexCarry) fee() sdkmath.Int {
+	return c.fromFee.Add(c.toFee)
+}
+
+// swapIndex executes a swap of meTokens of an Index for meTokens of another Index, through an asset accepted by
+// both. It works as a redemption of the asset followed by a swap of the redeemed asset, except that the shared
+// asset never leaves the x/metoken module and a single fee, given by swapIndexFee, is charged.
+//
+// It returns: minted meTokens and charged fee.
+func (k Keeper) swapIndex(userAddr sdk.AccAddress, meToken sdk.Coin, assetDenom, toMetokenDenom string) (
+	swapIndexResponse,
+	error,
+) {
+	from, fromPrices, fromBalances, err := k.indexState(meToken.Denom)
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+	to, toPrices, toBalances, err := k.indexState(toMetokenDenom)
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	carry, err := k.calculateSwapIndex(
+		from, fromPrices, fromBalances,
+		to, toPrices, toBalances,
+		meToken, assetDenom,
+	)
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	if toBalances.MetokenSupply.Amount.Add(carry.swap.meTokens).GT(to.MaxSupply) {
+		return swapIndexResponse{}, fmt.Errorf(
+			"not possible to mint the desired amount of %s, reaching the max supply",
+			toMetokenDenom,
+		)
+	}
+
+	tokensWithdrawn, err := k.withdrawFromLeverage(sdk.NewCoin(assetDenom, carry.fromLeverage))
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	// if there is a difference between the desired to withdraw from x/leverage and the withdrawn,
+	// take it from x/metoken reserves
+	if tokensWithdrawn.Amount.LT(carry.fromLeverage) {
+		tokenDiff := carry.fromLeverage.Sub(tokensWithdrawn.Amount)
+		carry.fromReserves = carry.fromReserves.Add(tokenDiff)
+		carry.fromLeverage = carry.fromLeverage.Sub(tokenDiff)
+	}
+
+	fromBalance, _ := fromBalances.AssetBalance(assetDenom)
+	if fromBalance.Reserved.LT(carry.fromReserves) {
+		return swapIndexResponse{}, fmt.Errorf("not enough %s liquidity for redemption", assetDenom)
+	}
+
+	supplied, err := k.supplyToLeverage(sdk.NewCoin(assetDenom, carry.swap.toLeverage))
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	// adjust amount if supplied to x/leverage is less than the calculated amount
+	if supplied.LT(carry.swap.toLeverage) {
+		tokenDiff := carry.swap.toLeverage.Sub(supplied)
+		carry.swap.toReserves = carry.swap.toReserves.Add(tokenDiff)
+		carry.swap.toLeverage = carry.swap.toLeverage.Sub(tokenDiff)
+	}
+
+	fromFeeToAuction, fromFeeToRevenue := k.breakFee(carry.fromFee)
+	toFeeToAuction, toFeeToRevenue := k.breakFee(carry.toFee)
+	if err = k.fundAuction(assetDenom, fromFeeToAuction.Add(toFeeToAuction)); err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(*k.ctx, userAddr, metoken.ModuleName, sdk.NewCoins(meToken))
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+	if err = k.bankKeeper.BurnCoins(*k.ctx, metoken.ModuleName, sdk.NewCoins(meToken)); err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	meTokens := sdk.NewCoins(sdk.NewCoin(toMetokenDenom, carry.swap.meTokens))
+	if err = k.bankKeeper.MintCoins(*k.ctx, metoken.ModuleName, meTokens); err != nil {
+		return swapIndexResponse{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(*k.ctx, metoken.ModuleName, userAddr, meTokens)
+	if err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	fromBalances.MetokenSupply.Amount = fromBalances.MetokenSupply.Amount.Sub(meToken.Amount)
+	fromBalance.Reserved = fromBalance.Reserved.Sub(carry.fromReserves)
+	fromBalance.Leveraged = fromBalance.Leveraged.Sub(carry.fromLeverage)
+	fromBalance.Fees = fromBalance.Fees.Add(fromFeeToRevenue)
+	fromBalances.SetAssetBalance(fromBalance)
+	if err = k.setIndexBalances(fromBalances); err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	toBalances.MetokenSupply.Amount = toBalances.MetokenSupply.Amount.Add(carry.swap.meTokens)
+	toBalance, _ := toBalances.AssetBalance(assetDenom)
+	toBalance.Reserved = toBalance.Reserved.Add(carry.swap.toReserves)
+	toBalance.Leveraged = toBalance.Leveraged.Add(carry.swap.toLeverage)
+	toBalance.Fees = toBalance.Fees.Add(toFeeToRevenue)
+	toBalances.SetAssetBalance(toBalance)
+	if err = k.setIndexBalances(toBalances); err != nil {
+		return swapIndexResponse{}, err
+	}
+
+	return swapIndexResponse{
+		meTokens: meTokens[0],
+		fee:      sdk.NewCoin(assetDenom, carry.fee()),
+	}, nil
+}
+
+// indexState returns the registered Index, its prices and its balances.
+func (k Keeper) indexState(meTokenDenom string) (metoken.Index, metoken.IndexPrices, metoken.IndexBalances, error) {
+	index, err := k.RegisteredIndex(meTokenDenom)
+	if err != nil {
+		return metoken.Index{}, metoken.IndexPrices{}, metoken.IndexBalances{}, err
+	}
+
+	indexPrices, err := k.Prices(index)
+	if err != nil {
+		return metoken.Index{}, metoken.IndexPrices{}, metoken.IndexBalances{}, err
+	}
+
+	balances, err := k.IndexBalances(meTokenDenom)
+	if err != nil {
+		return metoken.Index{}, metoken.IndexPrices{}, metoken.IndexBalances{}, err
+	}
+
+	return index, indexPrices, balances, nil
+}
+
+// calculateSwapIndex returns the amounts of a swap of meTokens of the from Index for meTokens of the to Index through
+// the shared asset. The formulas used for the calculations are:
+//
+//	assets_to_withdraw = metokens_to_burn * from_exchange_rate
+//	amount_from_reserves = assets_to_withdraw * from_reserve_portion
+//	amount_from_leverage = assets_to_withdraw - amount_from_reserves
+//	from_fee_amount = assets_to_withdraw * max(from_fee / 2, from_min_fee)
+//	to_fee_amount = assets_to_withdraw * max(to_fee / 2, to_min_fee)
+//	assets_to_swap = assets_to_withdraw - from_fee_amount - to_fee_amount
+//	metokens_to_mint = assets_to_swap * to_exchange_rate
+//	amount_to_reserves = assets_to_swap * to_reserve_portion
+//	amount_to_leverage = assets_to_swap - amount_to_reserves
+func (k Keeper) calculateSwapIndex(
+	from metoken.Index,
+	fromPrices metoken.IndexPrices,
+	fromBalances metoken.IndexBalances,
+	to metoken.Index,
+	toPrices metoken.IndexPrices,
+	toBalances metoken.IndexBalances,
+	meToken sdk.Coin,
+	assetDenom string,
+) (swapIndexCarry, error) {
+	if from.Denom == to.Denom {
+		return swapIndexCarry{}, sdkerrors.ErrInvalidRequest.Wrapf("can't swap %s for itself", to.Denom)
+	}
+	toSettings, i := to.AcceptedAsset(assetDenom)
+	if i < 0 {
+		return swapIndexCarry{}, sdkerrors.ErrNotFound.Wrapf(
+			"asset %s is not accepted in the index %s",
+			assetDenom,
+			to.Denom,
+		)
+	}
+
+	if fromBalances.MetokenSupply.Amount.LT(meToken.Amount) {
+		return swapIndexCarry{}, fmt.Errorf("not enough %s supply", meToken.Denom)
+	}
+
+	fromReserves, fromLeverage, err := k.calculateRedeem(from, fromPrices, meToken, assetDenom)
+	if err != nil {
+		return swapIndexCarry{}, err
+	}
+	withdrawn := fromReserves.Add(fromLeverage)
+	if withdrawn.IsZero() {
+		return swapIndexCarry{}, fmt.Errorf("insufficient %s for swap", meToken.Denom)
+	}
+
+	fromFee, toFee, err := k.swapIndexFee(
+		from, fromPrices, fromBalances,
+		to, toPrices, toBalances,
+		meToken, sdk.NewCoin(assetDenom, withdrawn), fromReserves, fromLeverage,
+	)
+	if err != nil {
+		return swapIndexCarry{}, err
+	}
+
+	carry := swapIndexCarry{
+		fromReserves: fromReserves,
+		fromLeverage: fromLeverage,
+		fromFee:      fromFee.MulInt(withdrawn).TruncateInt(),
+		toFee:        toFee.MulInt(withdrawn).TruncateInt(),
+	}
+	carry.swap, err = newSwapCarry(toSettings, toPrices, sdk.NewCoin(assetDenom, withdrawn), carry.fee())
+	if err != nil {
+		return swapIndexCarry{}, err
+	}
+	if carry.swap.meTokens.IsZero() {
+		return swapIndexCarry{}, fmt.Errorf("insufficient %s for swap", meToken.Denom)
+	}
+
+	return carry, nil
+}
+
+// swapIndexFee returns the fee fractions kept by the from and the to Index for a swap between them, given the
+// withdrawn amount of the shared asset. The fee of every Index is computed with basketFee, from the Index balances
+// before and after its side of the swap, and halved, but never below the Index min_fee:
+//
+//	fee = max(from_fee / 2, from_min_fee) + max(to_fee / 2, to_min_fee)
+//
+// So every Index charges half of the fee of its side of the swap, instead of the full fees of a redemption followed
+// by a swap, while still keeping at least the min_fee it charges on any other operation.
+func (k Keeper) swapIndexFee(
+	from metoken.Index,
+	fromPrices metoken.IndexPrices,
+	fromBalances metoken.IndexBalances,
+	to metoken.Index,
+	toPrices metoken.IndexPrices,
+	toBalances metoken.IndexBalances,
+	meToken sdk.Coin,
+	withdrawn sdk.Coin,
+	fromReserves, fromLeverage sdkmath.Int,
+) (sdk.Dec, sdk.Dec, error) {
+	fromAfter := copyIndexBalances(fromBalances)
+	balance, i := fromAfter.AssetBalance(withdrawn.Denom)
+	if i < 0 {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.ErrNotFound.Wrapf("balance for denom %s not found", withdrawn.Denom)
+	}
+	balance.Reserved = balance.Reserved.Sub(fromReserves)
+	balance.Leveraged = balance.Leveraged.Sub(fromLeverage)
+	fromAfter.SetAssetBalance(balance)
+	fromAfter.MetokenSupply.Amount = fromAfter.MetokenSupply.Amount.Sub(meToken.Amount)
+
+	fromFee, err := k.basketFee(from, fromPrices, fromBalances, fromAfter)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	toFee, err := k.swapMultiFee(to, toPrices, toBalances, sdk.NewCoins(withdrawn))
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	half := sdk.NewDecWithPrec(5, 1)
+	return sdk.MaxDec(fromFee.Mul(half), from.Fee.MinFee), sdk.MaxDec(toFee.Mul(half), to.Fee.MinFee), nil
+}
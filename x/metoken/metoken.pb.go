@@ -26,6 +26,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InterestPolicy defines how the interest claimed from x/leverage by an Index is distributed.
+type InterestPolicy int32
+
+const (
+	// INTEREST_POLICY_UNSPECIFIED keeps the claimed interest in the Index interest balances.
+	InterestPolicy_INTEREST_POLICY_UNSPECIFIED InterestPolicy = 0
+	// INTEREST_POLICY_YIELD_BEARING adds the claimed interest to the Index reserves, raising the meToken price and
+	// the amount of assets returned by redemptions.
+	InterestPolicy_INTEREST_POLICY_YIELD_BEARING InterestPolicy = 1
+	// INTEREST_POLICY_CLAIM distributes the claimed interest pro-rata to the meTokens locked with
+	// MsgLockForInterest. Holders withdraw their share with MsgClaimInterest.
+	InterestPolicy_INTEREST_POLICY_CLAIM InterestPolicy = 2
+	// INTEREST_POLICY_AUCTION sends the claimed interest to the rewards auction.
+	InterestPolicy_INTEREST_POLICY_AUCTION InterestPolicy = 3
+)
+
+var InterestPolicy_name = map[int32]string{
+	0: "INTEREST_POLICY_UNSPECIFIED",
+	1: "INTEREST_POLICY_YIELD_BEARING",
+	2: "INTEREST_POLICY_CLAIM",
+	3: "INTEREST_POLICY_AUCTION",
+}
+
+var InterestPolicy_value = map[string]int32{
+	"INTEREST_POLICY_UNSPECIFIED":   0,
+	"INTEREST_POLICY_YIELD_BEARING": 1,
+	"INTEREST_POLICY_CLAIM":         2,
+	"INTEREST_POLICY_AUCTION":       3,
+}
+
+func (x InterestPolicy) String() string {
+	return proto.EnumName(InterestPolicy_name, int32(x))
+}
+
+func (InterestPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dda977db8ad52437, []int{0}
+}
+
 // Params defines the parameters for the metoken module.
 type Params struct {
 	// Reserves Re-balancing Frequency in seconds, determines how often the re-balancing of the module reserves will be
@@ -39,6 +77,9 @@ type Params struct {
 	// the rewards auction. Note: this is different than x/leverage Params.rewards_auction_fee.
 	// Valid values: 0-10000 (in basis points, 2000 = 20%).
 	RewardsAuctionFeeFactor github_com_umee_network_umee_v6_util_bpmath.FixedBP `protobuf:"varint,3,opt,name=rewards_auction_fee_factor,json=rewardsAuctionFeeFactor,proto3,customtype=github.com/umee-network/umee/v6/util/bpmath.FixedBP" json:"rewards_auction_fee_factor"`
+	// Max History Snapshots is the number of IndexSnapshots kept for every Index, recorded every time the reserves
+	// re-balancing or the interest claiming runs. The oldest snapshots are pruned. Zero disables the history.
+	MaxHistorySnapshots uint32 `protobuf:"varint,4,opt,name=max_history_snapshots,json=maxHistorySnapshots,proto3" json:"max_history_snapshots,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -95,6 +136,9 @@ type Index struct {
 	// Accepted Assets is the list of underlying Tokens that can be swapped and redeemed for the Index's meToken,
 	// along with their token-specific parameters.
 	AcceptedAssets []AcceptedAsset `protobuf:"bytes,5,rep,name=accepted_assets,json=acceptedAssets,proto3" json:"accepted_assets"`
+	// Interest Policy defines how the interest accrued by the Index's assets supplied to x/leverage, and claimed every
+	// Params.claiming_frequency, is distributed.
+	InterestPolicy InterestPolicy `protobuf:"varint,6,opt,name=interest_policy,json=interestPolicy,proto3,enum=umee.metoken.v1.InterestPolicy" json:"interest_policy,omitempty"`
 }
 
 func (m *Index) Reset()         { *m = Index{} }
@@ -235,12 +279,15 @@ var xxx_messageInfo_AcceptedAsset proto.InternalMessageInfo
 type IndexPrices struct {
 	// meToken denom.
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// Price in USD of one unit of meToken, expressed in decimals.
+	// Price in USD of one unit of meToken, expressed in decimals. It includes the interest compounded in the reserves
+	// when the Index interest policy is INTEREST_POLICY_YIELD_BEARING.
 	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 	// Exponent is the power of ten by which to multiply, in order to convert
 	// an amount of the meToken for the exchange operations.
 	Exponent uint32       `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
 	Assets   []AssetPrice `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets"`
+	// Interest policy of the Index.
+	InterestPolicy InterestPolicy `protobuf:"varint,5,opt,name=interest_policy,json=interestPolicy,proto3,enum=umee.metoken.v1.InterestPolicy" json:"interest_policy,omitempty"`
 }
 
 func (m *IndexPrices) Reset()         { *m = IndexPrices{} }
@@ -331,6 +378,7 @@ func (m *AssetPrice) XXX_DiscardUnknown() {
 var xxx_messageInfo_AssetPrice proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("umee.metoken.v1.InterestPolicy", InterestPolicy_name, InterestPolicy_value)
 	proto.RegisterType((*Params)(nil), "umee.metoken.v1.Params")
 	proto.RegisterType((*Index)(nil), "umee.metoken.v1.Index")
 	proto.RegisterType((*Fee)(nil), "umee.metoken.v1.Fee")
@@ -342,53 +390,62 @@ func init() {
 func init() { proto.RegisterFile("umee/metoken/v1/metoken.proto", fileDescriptor_dda977db8ad52437) }
 
 var fileDescriptor_dda977db8ad52437 = []byte{
-	// 723 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6b, 0xdb, 0x48,
-	0x14, 0xc0, 0xad, 0xc8, 0x76, 0xe2, 0xe7, 0xfc, 0xd9, 0x08, 0x87, 0x35, 0x59, 0xa2, 0x78, 0x73,
-	0x58, 0xbc, 0xb0, 0x91, 0x48, 0x02, 0x0b, 0xfd, 0x73, 0xb1, 0x31, 0x0a, 0xa1, 0x94, 0xba, 0xea,
-	0xa1, 0xd0, 0x1e, 0xc4, 0x58, 0x7a, 0x76, 0x84, 0x2d, 0x8d, 0xaa, 0x19, 0x3b, 0xca, 0xb7, 0xe8,
-	0x47, 0xe8, 0xa7, 0x29, 0x39, 0xe6, 0x58, 0x7a, 0x08, 0x4d, 0x72, 0xe9, 0xa1, 0x94, 0x9e, 0x7b,
-	0x2a, 0x1a, 0x8d, 0x13, 0x27, 0x21, 0x2d, 0x88, 0x9e, 0xa4, 0x99, 0xf7, 0xde, 0x6f, 0xe6, 0x37,
-	0x7a, 0x92, 0x60, 0x63, 0x1c, 0x20, 0x9a, 0x01, 0x72, 0x3a, 0xc4, 0xd0, 0x9c, 0xec, 0x4c, 0x6f,
-	0x8d, 0x28, 0xa6, 0x9c, 0x6a, 0x2b, 0x69, 0xd8, 0x98, 0xce, 0x4d, 0x76, 0xd6, 0x6b, 0x03, 0x3a,
-	0xa0, 0x22, 0x66, 0xa6, 0x77, 0x59, 0xda, 0xd6, 0x17, 0x05, 0xca, 0x5d, 0x12, 0x93, 0x80, 0x69,
-	0x7b, 0xb0, 0x16, 0x63, 0x8f, 0x8c, 0x48, 0xe8, 0xfa, 0xe1, 0xc0, 0xe9, 0xc7, 0xf8, 0x66, 0x8c,
-	0xa1, 0x7b, 0x5c, 0x57, 0x1a, 0x4a, 0x53, 0xb5, 0x6b, 0x33, 0x41, 0x6b, 0x1a, 0xd3, 0xb6, 0x41,
-	0x73, 0x47, 0xc4, 0x0f, 0x6e, 0x56, 0xcc, 0x89, 0x8a, 0xd5, 0x69, 0xe4, 0x3a, 0x3d, 0x81, 0xf5,
-	0x18, 0x8f, 0x48, 0xec, 0x31, 0x87, 0x8c, 0x5d, 0xee, 0xd3, 0xd0, 0xe9, 0x23, 0x3a, 0x7d, 0xe2,
-	0x72, 0x1a, 0xd7, 0xd5, 0x86, 0xd2, 0x5c, 0x6a, 0x3f, 0x3a, 0x39, 0xdb, 0x2c, 0x7c, 0x3c, 0xdb,
-	0xdc, 0x1b, 0xf8, 0xfc, 0x70, 0xdc, 0x33, 0x5c, 0x1a, 0x98, 0xa9, 0xcc, 0x76, 0x88, 0xfc, 0x88,
-	0xc6, 0x43, 0x31, 0x30, 0x27, 0xff, 0x9b, 0x63, 0xee, 0x8f, 0xcc, 0x5e, 0x14, 0x10, 0x7e, 0x68,
-	0x58, 0x7e, 0x82, 0x5e, 0xbb, 0x6b, 0xff, 0x29, 0xf1, 0xad, 0x8c, 0x6e, 0x21, 0x5a, 0x82, 0xfd,
-	0xb0, 0xf8, 0xf9, 0xdd, 0xa6, 0xb2, 0xf5, 0x5d, 0x81, 0xd2, 0x41, 0xe8, 0x61, 0xa2, 0xd5, 0xa0,
-	0xe4, 0x61, 0x48, 0x03, 0x61, 0x57, 0xb1, 0xb3, 0x81, 0xf6, 0x18, 0x20, 0x20, 0x89, 0xc3, 0xc6,
-	0x51, 0x34, 0xca, 0x34, 0x2a, 0xed, 0x0d, 0xb9, 0x9f, 0x35, 0x97, 0xb2, 0x80, 0x32, 0xe6, 0x0d,
-	0x0d, 0x9f, 0x9a, 0x62, 0xd5, 0x83, 0x90, 0xdb, 0x95, 0x80, 0x24, 0x2f, 0x44, 0xbe, 0xb6, 0x0e,
-	0x0b, 0x98, 0x44, 0x34, 0xc4, 0x90, 0x67, 0x2e, 0xf6, 0xd5, 0x58, 0xfb, 0x0f, 0xd4, 0x3e, 0x62,
-	0xbd, 0xd8, 0x50, 0x9a, 0xd5, 0xdd, 0x9a, 0x71, 0xeb, 0xe9, 0x18, 0x16, 0x62, 0xbb, 0x98, 0x2e,
-	0x64, 0xa7, 0x69, 0xda, 0x53, 0x58, 0x21, 0xae, 0x8b, 0x11, 0x47, 0xcf, 0x21, 0x8c, 0x21, 0x67,
-	0xf5, 0x52, 0x43, 0x6d, 0x56, 0x77, 0xf5, 0x3b, 0x95, 0x2d, 0x99, 0xd7, 0x4a, 0xd3, 0x24, 0x63,
-	0x99, 0xcc, 0x4e, 0x32, 0x29, 0xff, 0x4d, 0x01, 0xd5, 0x42, 0xd4, 0xf6, 0x61, 0x3e, 0xf0, 0xc5,
-	0xc1, 0x67, 0xf2, 0x6d, 0x43, 0x1a, 0xfe, 0x33, 0x73, 0xe2, 0x99, 0xac, 0xbc, 0x6c, 0x33, 0x6f,
-	0x68, 0xf2, 0xe3, 0x08, 0x99, 0xd1, 0x41, 0xd7, 0x2e, 0x07, 0x7e, 0x7a, 0xb2, 0xda, 0x73, 0x58,
-	0xcc, 0x5a, 0x02, 0x3d, 0x41, 0x9b, 0xcb, 0x45, 0xab, 0x4e, 0x19, 0xd3, 0xbd, 0x91, 0x44, 0xd0,
-	0xd4, 0x9c, 0x7b, 0x23, 0x89, 0x85, 0x28, 0x95, 0xcf, 0x15, 0x58, 0xba, 0x71, 0x40, 0xf7, 0x3c,
-	0xf7, 0x97, 0xb0, 0x12, 0x23, 0xc3, 0x78, 0x82, 0x4e, 0x44, 0xe3, 0xb4, 0x73, 0x72, 0xca, 0x2c,
-	0x4b, 0x4c, 0x37, 0xa3, 0x68, 0xaf, 0x61, 0x95, 0x93, 0x78, 0x80, 0xdc, 0x21, 0xa3, 0x11, 0x75,
-	0x89, 0x40, 0xe7, 0x33, 0xfb, 0x23, 0x03, 0xb5, 0xae, 0x38, 0xd2, 0xf1, 0xbd, 0x02, 0x55, 0xd1,
-	0xd3, 0xdd, 0xd8, 0x77, 0x91, 0xdd, 0x63, 0xd8, 0x81, 0x52, 0x94, 0xc6, 0x73, 0x7a, 0x65, 0xc5,
-	0x3f, 0xed, 0xf0, 0x07, 0x50, 0x96, 0xad, 0x5a, 0x14, 0xad, 0xfa, 0xd7, 0xdd, 0x56, 0x4d, 0xc3,
-	0x62, 0x97, 0xb2, 0x4f, 0x65, 0x81, 0x14, 0xf9, 0xaa, 0x02, 0x5c, 0xa7, 0x68, 0x1b, 0x00, 0x3d,
-	0xc2, 0xd0, 0x99, 0x95, 0xa9, 0xa4, 0x33, 0x1d, 0x21, 0xf4, 0x37, 0x2c, 0xb2, 0xe3, 0xa0, 0x47,
-	0x47, 0x32, 0x41, 0x78, 0xd9, 0xd5, 0x6c, 0xae, 0x73, 0xd3, 0x59, 0xfd, 0x5d, 0xce, 0xc5, 0x5b,
-	0xce, 0x4f, 0xa0, 0xc2, 0x8e, 0x48, 0xe4, 0xc4, 0x84, 0x63, 0xbd, 0x94, 0x6b, 0x95, 0x85, 0x14,
-	0x60, 0x13, 0x8e, 0xda, 0x33, 0xa8, 0xc6, 0xe8, 0x21, 0x06, 0x19, 0xae, 0x9c, 0x0b, 0x07, 0x19,
-	0x42, 0x00, 0x0f, 0x40, 0xc0, 0xc5, 0xdb, 0x34, 0x9f, 0x8b, 0x36, 0x9f, 0xd6, 0x5b, 0xe2, 0x83,
-	0x24, 0xc1, 0x02, 0xb6, 0x90, 0x0b, 0x56, 0xc9, 0x08, 0x57, 0x6f, 0x67, 0x7b, 0xff, 0xe4, 0x5c,
-	0x2f, 0x9c, 0x5c, 0xe8, 0xca, 0xe9, 0x85, 0xae, 0x7c, 0xba, 0xd0, 0x95, 0xb7, 0x97, 0x7a, 0xe1,
-	0xf4, 0x52, 0x2f, 0x7c, 0xb8, 0xd4, 0x0b, 0xaf, 0xfe, 0xfd, 0xd5, 0xf7, 0x3f, 0x99, 0xfe, 0xf2,
-	0x7a, 0x65, 0xf1, 0x33, 0xdb, 0xfb, 0x11, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xff, 0x31, 0x27, 0x14,
-	0x07, 0x00, 0x00,
+	// 879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x45, 0x7d, 0xd9, 0x1a, 0x25, 0xb2, 0xb2, 0xb5, 0x11, 0xd5, 0x86, 0x25, 0x27, 0x87,
+	0xc2, 0x2d, 0x6a, 0x12, 0xb1, 0x81, 0x02, 0xfd, 0xb8, 0x48, 0x96, 0xe8, 0x10, 0x75, 0x6c, 0x95,
+	0x76, 0x50, 0xa4, 0x3d, 0x10, 0x2b, 0x72, 0x2c, 0x13, 0x16, 0xb9, 0x2c, 0x77, 0x65, 0x53, 0xef,
+	0xd0, 0x43, 0x1e, 0x21, 0x0f, 0xd3, 0x83, 0x8f, 0x39, 0x16, 0x3d, 0x04, 0x8d, 0x7d, 0xe9, 0xa9,
+	0xe8, 0x23, 0x14, 0x5c, 0x52, 0x8e, 0x25, 0xc3, 0x2d, 0xc0, 0xf6, 0x24, 0xee, 0xce, 0x7f, 0x7f,
+	0x3b, 0xf3, 0xdf, 0x59, 0x91, 0xb0, 0x3e, 0xf6, 0x10, 0x35, 0x0f, 0x05, 0x3b, 0x43, 0x5f, 0x3b,
+	0x7f, 0x36, 0x7d, 0x54, 0x83, 0x90, 0x09, 0x46, 0x96, 0xe2, 0xb0, 0x3a, 0x9d, 0x3b, 0x7f, 0xb6,
+	0xba, 0x3c, 0x64, 0x43, 0x26, 0x63, 0x5a, 0xfc, 0x94, 0xc8, 0x9e, 0xbe, 0xc9, 0x43, 0xb9, 0x4f,
+	0x43, 0xea, 0x71, 0xb2, 0x03, 0x2b, 0x21, 0x0e, 0xe8, 0x88, 0xfa, 0xb6, 0xeb, 0x0f, 0xad, 0x93,
+	0x10, 0x7f, 0x1a, 0xa3, 0x6f, 0x4f, 0x1a, 0xca, 0x86, 0xb2, 0x59, 0x30, 0x97, 0x6f, 0x05, 0xf5,
+	0x69, 0x8c, 0x6c, 0x01, 0xb1, 0x47, 0xd4, 0xf5, 0x66, 0x57, 0xe4, 0xe5, 0x8a, 0x47, 0xd3, 0xc8,
+	0x07, 0x79, 0x04, 0xab, 0x21, 0x5e, 0xd0, 0xd0, 0xe1, 0x16, 0x1d, 0xdb, 0xc2, 0x65, 0xbe, 0x75,
+	0x82, 0x68, 0x9d, 0x50, 0x5b, 0xb0, 0xb0, 0x51, 0xd8, 0x50, 0x36, 0x1f, 0x76, 0xbe, 0xbe, 0x7c,
+	0xd7, 0xca, 0xfd, 0xf6, 0xae, 0xb5, 0x33, 0x74, 0xc5, 0xe9, 0x78, 0xa0, 0xda, 0xcc, 0xd3, 0xe2,
+	0x62, 0xb6, 0x7c, 0x14, 0x17, 0x2c, 0x3c, 0x93, 0x03, 0xed, 0xfc, 0x0b, 0x6d, 0x2c, 0xdc, 0x91,
+	0x36, 0x08, 0x3c, 0x2a, 0x4e, 0x55, 0xdd, 0x8d, 0xd0, 0xe9, 0xf4, 0xcd, 0xc7, 0x29, 0xbe, 0x9d,
+	0xd0, 0x75, 0x44, 0x5d, 0xb2, 0xc9, 0x36, 0xac, 0x78, 0x34, 0xb2, 0x4e, 0x5d, 0x2e, 0x58, 0x38,
+	0xb1, 0xb8, 0x4f, 0x03, 0x7e, 0xca, 0x04, 0x6f, 0x14, 0xe3, 0x4d, 0xcd, 0x8f, 0x3c, 0x1a, 0x3d,
+	0x4f, 0x62, 0x47, 0xd3, 0xd0, 0x57, 0xc5, 0x3f, 0xde, 0xb4, 0x94, 0xa7, 0xbf, 0xe4, 0xa1, 0x64,
+	0xf8, 0x0e, 0x46, 0x64, 0x19, 0x4a, 0x0e, 0xfa, 0xcc, 0x93, 0x8e, 0x54, 0xcc, 0x64, 0x40, 0xbe,
+	0x01, 0x88, 0xc9, 0x7c, 0x1c, 0x04, 0xa3, 0xa4, 0xf4, 0x4a, 0x67, 0x3d, 0xad, 0x61, 0xc5, 0x66,
+	0xdc, 0x63, 0x9c, 0x3b, 0x67, 0xaa, 0xcb, 0x34, 0x99, 0xa9, 0xe1, 0x0b, 0xb3, 0xe2, 0xd1, 0xe8,
+	0x48, 0xea, 0xc9, 0x2a, 0x2c, 0x62, 0x14, 0x30, 0x1f, 0x7d, 0x91, 0xd4, 0x6f, 0xde, 0x8c, 0xc9,
+	0xe7, 0x50, 0x38, 0x41, 0x94, 0x19, 0x56, 0xb7, 0x97, 0xd5, 0xb9, 0x13, 0x55, 0x75, 0xc4, 0x4e,
+	0x31, 0xde, 0xc8, 0x8c, 0x65, 0xe4, 0x05, 0x2c, 0x51, 0xdb, 0xc6, 0x40, 0xa0, 0x63, 0x51, 0xce,
+	0x51, 0xf0, 0x46, 0x69, 0xa3, 0xb0, 0x59, 0xdd, 0x6e, 0xde, 0x59, 0xd9, 0x4e, 0x75, 0xed, 0x58,
+	0x96, 0x32, 0x6a, 0xf4, 0xf6, 0x24, 0x27, 0xcf, 0x61, 0xc9, 0xf5, 0x05, 0x86, 0xc8, 0x85, 0x15,
+	0xb0, 0x91, 0x6b, 0x4f, 0x1a, 0xe5, 0x0d, 0x65, 0xb3, 0xb6, 0xdd, 0xba, 0x83, 0x33, 0x52, 0x5d,
+	0x5f, 0xca, 0xcc, 0x9a, 0x3b, 0x33, 0x4e, 0x6d, 0xfc, 0x4b, 0x81, 0x82, 0x8e, 0x48, 0xf6, 0x60,
+	0xc1, 0x73, 0xe5, 0xb1, 0x27, 0x36, 0x76, 0xd4, 0xd4, 0xab, 0x4f, 0x6e, 0x9d, 0x77, 0x62, 0x5b,
+	0xfa, 0xb3, 0xc5, 0x9d, 0x33, 0x4d, 0x4c, 0x02, 0xe4, 0x6a, 0x17, 0x6d, 0xb3, 0xec, 0xb9, 0xf1,
+	0xb9, 0x92, 0xef, 0xe0, 0x41, 0xd2, 0x90, 0xe8, 0x48, 0x5a, 0x3e, 0x13, 0xad, 0x3a, 0x65, 0x4c,
+	0x73, 0xa3, 0x91, 0xa4, 0x15, 0x32, 0xe6, 0x46, 0x23, 0x1d, 0x31, 0x2d, 0xf9, 0xbd, 0x02, 0x0f,
+	0x67, 0xac, 0xbe, 0xa7, 0x83, 0xbe, 0x87, 0xa5, 0x10, 0x39, 0x86, 0xe7, 0x68, 0x05, 0x2c, 0x8c,
+	0xfb, 0x36, 0x63, 0x31, 0xb5, 0x14, 0xd3, 0x4f, 0x28, 0xe4, 0x47, 0x78, 0x24, 0x68, 0x38, 0x44,
+	0x61, 0xd1, 0xd1, 0x88, 0xd9, 0x54, 0xa2, 0xb3, 0x55, 0x56, 0x4f, 0x40, 0xed, 0x1b, 0x4e, 0x5a,
+	0xe3, 0xeb, 0x3c, 0x54, 0xe5, 0xed, 0xe8, 0x87, 0xae, 0x8d, 0xfc, 0x9e, 0x0a, 0xbb, 0x50, 0x0a,
+	0xe2, 0x78, 0xc6, 0xba, 0x92, 0xc5, 0xff, 0x78, 0x57, 0xbe, 0x84, 0x72, 0xda, 0xf4, 0x45, 0xd9,
+	0xf4, 0x6b, 0x77, 0x9b, 0x3e, 0x0e, 0xcb, 0x2c, 0xd3, 0x8e, 0x2f, 0xd3, 0x7b, 0x3b, 0xbd, 0xf4,
+	0x5f, 0x3a, 0xfd, 0xcf, 0x02, 0xc0, 0x87, 0xcd, 0xc8, 0x3a, 0xc0, 0x80, 0x72, 0xb4, 0x6e, 0xdb,
+	0x52, 0x89, 0x67, 0xba, 0xd2, 0x9a, 0x27, 0xf0, 0x80, 0x4f, 0xbc, 0x01, 0x1b, 0xa5, 0x02, 0xe9,
+	0x90, 0x59, 0x4d, 0xe6, 0xba, 0xb3, 0xee, 0x15, 0xfe, 0x2f, 0xf7, 0x8a, 0x73, 0xee, 0x7d, 0x0b,
+	0x15, 0x7e, 0x41, 0x03, 0x2b, 0xa4, 0x02, 0x1b, 0xa5, 0x4c, 0xbb, 0x2c, 0xc6, 0x00, 0x93, 0x0a,
+	0x24, 0x87, 0x50, 0x0d, 0xd1, 0x41, 0xf4, 0x12, 0x5c, 0x39, 0x13, 0x0e, 0x12, 0x84, 0x04, 0x1a,
+	0x20, 0xe1, 0xf2, 0x5e, 0x2e, 0x64, 0xa2, 0x2d, 0xc4, 0xeb, 0x75, 0xf9, 0x27, 0x99, 0x82, 0x25,
+	0x6c, 0x31, 0x13, 0xac, 0x92, 0x10, 0x6e, 0xee, 0xf9, 0x67, 0x3f, 0x2b, 0x50, 0x9b, 0xed, 0x0c,
+	0xd2, 0x82, 0x35, 0xe3, 0xe0, 0xb8, 0x67, 0xf6, 0x8e, 0x8e, 0xad, 0xfe, 0xe1, 0xbe, 0xb1, 0xfb,
+	0xca, 0x7a, 0x79, 0x70, 0xd4, 0xef, 0xed, 0x1a, 0xba, 0xd1, 0xeb, 0xd6, 0x73, 0xe4, 0x09, 0xac,
+	0xcf, 0x0b, 0x5e, 0x19, 0xbd, 0xfd, 0xae, 0xd5, 0xe9, 0xb5, 0x4d, 0xe3, 0x60, 0xaf, 0xae, 0x90,
+	0x8f, 0x61, 0x65, 0x5e, 0xb2, 0xbb, 0xdf, 0x36, 0x5e, 0xd4, 0xf3, 0x64, 0x0d, 0x1e, 0xcf, 0x87,
+	0xda, 0x2f, 0x77, 0x8f, 0x8d, 0xc3, 0x83, 0x7a, 0xa1, 0xb3, 0x77, 0xf9, 0xbe, 0x99, 0xbb, 0xbc,
+	0x6a, 0x2a, 0x6f, 0xaf, 0x9a, 0xca, 0xef, 0x57, 0x4d, 0xe5, 0xf5, 0x75, 0x33, 0xf7, 0xf6, 0xba,
+	0x99, 0xfb, 0xf5, 0xba, 0x99, 0xfb, 0xe1, 0xd3, 0x7f, 0x7b, 0xad, 0x46, 0xd3, 0x2f, 0x89, 0x41,
+	0x59, 0x7e, 0x23, 0xec, 0xfc, 0x3d, 0x00, 0xb8, 0x20, 0xe3, 0x29, 0x6b, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -419,6 +476,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RewardsAuctionFeeFactor.Equal(that1.RewardsAuctionFeeFactor) {
 		return false
 	}
+	if this.MaxHistorySnapshots != that1.MaxHistorySnapshots {
+		return false
+	}
 	return true
 }
 func (this *Index) Equal(that interface{}) bool {
@@ -460,6 +520,9 @@ func (this *Index) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.InterestPolicy != that1.InterestPolicy {
+		return false
+	}
 	return true
 }
 func (this *Fee) Equal(that interface{}) bool {
@@ -558,6 +621,9 @@ func (this *IndexPrices) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.InterestPolicy != that1.InterestPolicy {
+		return false
+	}
 	return true
 }
 func (this *AssetPrice) Equal(that interface{}) bool {
@@ -625,6 +691,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxHistorySnapshots != 0 {
+		i = encodeVarintMetoken(dAtA, i, uint64(m.MaxHistorySnapshots))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RewardsAuctionFeeFactor != 0 {
 		i = encodeVarintMetoken(dAtA, i, uint64(m.RewardsAuctionFeeFactor))
 		i--
@@ -663,6 +734,11 @@ func (m *Index) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InterestPolicy != 0 {
+		i = encodeVarintMetoken(dAtA, i, uint64(m.InterestPolicy))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.AcceptedAssets) > 0 {
 		for iNdEx := len(m.AcceptedAssets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -835,6 +911,11 @@ func (m *IndexPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InterestPolicy != 0 {
+		i = encodeVarintMetoken(dAtA, i, uint64(m.InterestPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Assets) > 0 {
 		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -992,6 +1073,9 @@ func (m *Params) Size() (n int) {
 	if m.RewardsAuctionFeeFactor != 0 {
 		n += 1 + sovMetoken(uint64(m.RewardsAuctionFeeFactor))
 	}
+	if m.MaxHistorySnapshots != 0 {
+		n += 1 + sovMetoken(uint64(m.MaxHistorySnapshots))
+	}
 	return n
 }
 
@@ -1018,6 +1102,9 @@ func (m *Index) Size() (n int) {
 			n += 1 + l + sovMetoken(uint64(l))
 		}
 	}
+	if m.InterestPolicy != 0 {
+		n += 1 + sovMetoken(uint64(m.InterestPolicy))
+	}
 	return n
 }
 
@@ -1074,6 +1161,9 @@ func (m *IndexPrices) Size() (n int) {
 			n += 1 + l + sovMetoken(uint64(l))
 		}
 	}
+	if m.InterestPolicy != 0 {
+		n += 1 + sovMetoken(uint64(m.InterestPolicy))
+	}
 	return n
 }
 
@@ -1199,6 +1289,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHistorySnapshots", wireType)
+			}
+			m.MaxHistorySnapshots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHistorySnapshots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetoken(dAtA[iNdEx:])
@@ -1401,6 +1510,25 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPolicy", wireType)
+			}
+			m.InterestPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestPolicy |= InterestPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetoken(dAtA[iNdEx:])
@@ -1872,6 +2000,25 @@ func (m *IndexPrices) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPolicy", wireType)
+			}
+			m.InterestPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestPolicy |= InterestPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetoken(dAtA[iNdEx:])
@@ -275,6 +275,21 @@ func (mr *MockOracleKeeperMockRecorder) AllMedianPrices(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllMedianPrices", reflect.TypeOf((*MockOracleKeeper)(nil).AllMedianPrices), ctx)
 }
 
+// GetExchangeRate mocks base method.
+func (m *MockOracleKeeper) GetExchangeRate(ctx types.Context, symbol string) (types1.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", ctx, symbol)
+	ret0, _ := ret[0].(types1.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockOracleKeeperMockRecorder) GetExchangeRate(ctx, symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockOracleKeeper)(nil).GetExchangeRate), ctx, symbol)
+}
+
 // SetExchangeRate mocks base method.
 func (m *MockOracleKeeper) SetExchangeRate(ctx types.Context, denom string, rate types.Dec) {
 	m.ctrl.T.Helper()
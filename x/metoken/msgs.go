@@ -1,7 +1,10 @@
 package metoken
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/umee-network/umee/v6/util/checkers"
@@ -10,6 +13,12 @@ import (
 var (
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgSwapMulti{}
+	_ sdk.Msg = &MsgRedeemProportional{}
+	_ sdk.Msg = &MsgSwapIndex{}
+	_ sdk.Msg = &MsgLockForInterest{}
+	_ sdk.Msg = &MsgUnlockFromInterest{}
+	_ sdk.Msg = &MsgClaimInterest{}
 	_ sdk.Msg = &MsgGovSetParams{}
 	_ sdk.Msg = &MsgGovUpdateRegistry{}
 )
@@ -24,7 +33,10 @@ func NewMsgSwap(user sdk.AccAddress, asset sdk.Coin, metokenDenom string) *MsgSw
 
 // ValidateBasic implements Msg
 func (msg *MsgSwap) ValidateBasic() error {
-	return validateUserAndAssetAndDenom(msg.User, &msg.Asset, msg.MetokenDenom)
+	if err := validateUserAndAssetAndDenom(msg.User, &msg.Asset, msg.MetokenDenom); err != nil {
+		return err
+	}
+	return validateMinAmountOut(msg.MinAmountOut)
 }
 
 // GetSigners implements Msg
@@ -50,7 +62,10 @@ func NewMsgRedeem(user sdk.AccAddress, metoken sdk.Coin, assetDenom string) *Msg
 
 // ValidateBasic implements Msg
 func (msg *MsgRedeem) ValidateBasic() error {
-	return validateUserAndAssetAndDenom(msg.User, &msg.Metoken, msg.AssetDenom)
+	if err := validateUserAndAssetAndDenom(msg.User, &msg.Metoken, msg.AssetDenom); err != nil {
+		return err
+	}
+	return validateMinAmountOut(msg.MinAmountOut)
 }
 
 // GetSigners implements Msg
@@ -66,6 +81,189 @@ func (msg MsgRedeem) GetSignBytes() []byte {
 }
 func (msg MsgRedeem) Type() string { return sdk.MsgTypeURL(&msg) }
 
+func NewMsgSwapMulti(user sdk.AccAddress, assets sdk.Coins, metokenDenom string) *MsgSwapMulti {
+	return &MsgSwapMulti{
+		User:         user.String(),
+		Assets:       assets,
+		MetokenDenom: metokenDenom,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg *MsgSwapMulti) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.User); err != nil {
+		return err
+	}
+	if len(msg.Assets) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty assets")
+	}
+	if err := msg.Assets.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if err := sdk.ValidateDenom(msg.MetokenDenom); err != nil {
+		return err
+	}
+	return validateMinAmountOut(msg.MinAmountOut)
+}
+
+// GetSigners implements Msg
+func (msg *MsgSwapMulti) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.User)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgSwapMulti) Route() string { return "" }
+
+func (msg MsgSwapMulti) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSwapMulti) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func NewMsgRedeemProportional(user sdk.AccAddress, metoken sdk.Coin) *MsgRedeemProportional {
+	return &MsgRedeemProportional{
+		User:    user.String(),
+		Metoken: metoken,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg *MsgRedeemProportional) ValidateBasic() error {
+	if err := validateUserAndAssetAndDenom(msg.User, &msg.Metoken, msg.Metoken.Denom); err != nil {
+		return err
+	}
+	if err := msg.MinAmountsOut.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("min amounts out: %s", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (msg *MsgRedeemProportional) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.User)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgRedeemProportional) Route() string { return "" }
+
+func (msg MsgRedeemProportional) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgRedeemProportional) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func NewMsgSwapIndex(user sdk.AccAddress, metoken sdk.Coin, assetDenom, toMetokenDenom string) *MsgSwapIndex {
+	return &MsgSwapIndex{
+		User:           user.String(),
+		Metoken:        metoken,
+		AssetDenom:     assetDenom,
+		ToMetokenDenom: toMetokenDenom,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg *MsgSwapIndex) ValidateBasic() error {
+	if err := validateUserAndAssetAndDenom(msg.User, &msg.Metoken, msg.AssetDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(msg.ToMetokenDenom); err != nil {
+		return err
+	}
+	if msg.Metoken.Denom == msg.ToMetokenDenom {
+		return sdkerrors.ErrInvalidRequest.Wrapf("can't swap %s for itself", msg.ToMetokenDenom)
+	}
+	return validateMinAmountOut(msg.MinAmountOut)
+}
+
+// GetSigners implements Msg
+func (msg *MsgSwapIndex) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.User)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgSwapIndex) Route() string { return "" }
+
+func (msg MsgSwapIndex) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSwapIndex) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func NewMsgLockForInterest(user sdk.AccAddress, metoken sdk.Coin) *MsgLockForInterest {
+	return &MsgLockForInterest{
+		User:    user.String(),
+		Metoken: metoken,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg *MsgLockForInterest) ValidateBasic() error {
+	return validateUserAndAssetAndDenom(msg.User, &msg.Metoken, msg.Metoken.Denom)
+}
+
+// GetSigners implements Msg
+func (msg *MsgLockForInterest) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.User)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgLockForInterest) Route() string { return "" }
+
+func (msg MsgLockForInterest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgLockForInterest) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func NewMsgUnlockFromInterest(user sdk.AccAddress, metoken sdk.Coin) *MsgUnlockFromInterest {
+	return &MsgUnlockFromInterest{
+		User:    user.String(),
+		Metoken: metoken,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg *MsgUnlockFromInterest) ValidateBasic() error {
+	return validateUserAndAssetAndDenom(msg.User, &msg.Metoken, msg.Metoken.Denom)
+}
+
+// GetSigners implements Msg
+func (msg *MsgUnlockFromInterest) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.User)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgUnlockFromInterest) Route() string { return "" }
+
+func (msg MsgUnlockFromInterest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgUnlockFromInterest) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func NewMsgClaimInterest(user sdk.AccAddress, metokenDenom string) *MsgClaimInterest {
+	return &MsgClaimInterest{
+		User:         user.String(),
+		MetokenDenom: metokenDenom,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg *MsgClaimInterest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.User); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(msg.MetokenDenom)
+}
+
+// GetSigners implements Msg
+func (msg *MsgClaimInterest) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.User)
+}
+
+// LegacyMsg.Type implementations
+func (msg MsgClaimInterest) Route() string { return "" }
+
+func (msg MsgClaimInterest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgClaimInterest) Type() string { return sdk.MsgTypeURL(&msg) }
+
 func NewMsgGovSetParams(authority string, params Params) *MsgGovSetParams {
 	return &MsgGovSetParams{
 		Authority: authority,
@@ -154,6 +352,39 @@ func validateUserAndAssetAndDenom(sender string, asset *sdk.Coin, denom string)
 	return sdk.ValidateDenom(denom)
 }
 
+func validateMinAmountOut(minAmountOut sdkmath.Int) error {
+	if !minAmountOut.IsNil() && minAmountOut.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("negative min amount out %s", minAmountOut)
+	}
+	return nil
+}
+
+// CheckSlippage returns ErrDeadlineExceeded if the block time is after the deadline, when set,
+// and ErrMinAmountOut if the amount out is below the minimum amount out, when set.
+func CheckSlippage(blockTime time.Time, deadline *time.Time, minAmountOut sdkmath.Int, out sdk.Coin) error {
+	if deadline != nil && blockTime.After(*deadline) {
+		return ErrDeadlineExceeded.Wrapf("block time %s, deadline %s",
+			blockTime.UTC().Format(time.RFC3339), deadline.UTC().Format(time.RFC3339))
+	}
+	if !minAmountOut.IsNil() && out.Amount.LT(minAmountOut) {
+		return ErrMinAmountOut.Wrapf("got %s, min %s%s", out, minAmountOut, out.Denom)
+	}
+	return nil
+}
+
+// CheckSlippageCoins is CheckSlippage for multiple coins: every coin of minAmountsOut must be covered by out.
+func CheckSlippageCoins(blockTime time.Time, deadline *time.Time, minAmountsOut, out sdk.Coins) error {
+	if err := CheckSlippage(blockTime, deadline, sdkmath.Int{}, sdk.Coin{}); err != nil {
+		return err
+	}
+	for _, c := range minAmountsOut {
+		if got := out.AmountOf(c.Denom); got.LT(c.Amount) {
+			return ErrMinAmountOut.Wrapf("got %s%s, min %s", got, c.Denom, c)
+		}
+	}
+	return nil
+}
+
 func validateDuplicates(addIndex, updateIndex []Index) error {
 	indexes := make(map[string]struct{})
 	for _, index := range addIndex {
@@ -6,5 +6,6 @@ func DefaultParams() Params {
 		RebalancingFrequency:    60 * 60 * 12,     // 12h
 		ClaimingFrequency:       60 * 60 * 24 * 7, // 7d
 		RewardsAuctionFeeFactor: 1000,             // 10% of fees goes to rewards auction
+		MaxHistorySnapshots:     730,              // 1 year of 12h re-balancing snapshots
 	}
 }
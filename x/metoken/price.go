@@ -17,6 +17,8 @@ func EmptyIndexPrices(index Index) IndexPrices {
 		Price:    sdk.Dec{},
 		Exponent: index.Exponent,
 		Assets:   make([]AssetPrice, 0),
+
+		InterestPolicy: index.InterestPolicy,
 	}
 }
 
@@ -6,23 +6,29 @@ package metoken
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -226,6 +232,8 @@ var xxx_messageInfo_QuerySwapFee proto.InternalMessageInfo
 // QuerySwapFeeResponse defines the response structure for the SwapFee gRPC service handler.
 type QuerySwapFeeResponse struct {
 	Asset types.Coin `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// returned is the amount of meTokens which would be minted, to set the swap min_amount_out.
+	Returned types.Coin `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned"`
 }
 
 func (m *QuerySwapFeeResponse) Reset()         { *m = QuerySwapFeeResponse{} }
@@ -303,6 +311,9 @@ var xxx_messageInfo_QueryRedeemFee proto.InternalMessageInfo
 // QueryRedeemFeeResponse defines the response structure for the RedeemFee gRPC service handler.
 type QueryRedeemFeeResponse struct {
 	Asset types.Coin `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// returned is the amount of asset which would be returned, after fees, to set the redemption
+	// min_amount_out.
+	Returned types.Coin `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned"`
 }
 
 func (m *QueryRedeemFeeResponse) Reset()         { *m = QueryRedeemFeeResponse{} }
@@ -338,6 +349,86 @@ func (m *QueryRedeemFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRedeemFeeResponse proto.InternalMessageInfo
 
+// QuerySwapIndexFee defines the request structure for the SwapIndexFee gRPC service handler.
+type QuerySwapIndexFee struct {
+	Metoken        string `protobuf:"bytes,1,opt,name=metoken,proto3" json:"metoken,omitempty"`
+	AssetDenom     string `protobuf:"bytes,2,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	ToMetokenDenom string `protobuf:"bytes,3,opt,name=to_metoken_denom,json=toMetokenDenom,proto3" json:"to_metoken_denom,omitempty"`
+}
+
+func (m *QuerySwapIndexFee) Reset()         { *m = QuerySwapIndexFee{} }
+func (m *QuerySwapIndexFee) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapIndexFee) ProtoMessage()    {}
+func (*QuerySwapIndexFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f141a376167f31d, []int{8}
+}
+func (m *QuerySwapIndexFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapIndexFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapIndexFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapIndexFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapIndexFee.Merge(m, src)
+}
+func (m *QuerySwapIndexFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapIndexFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapIndexFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapIndexFee proto.InternalMessageInfo
+
+// QuerySwapIndexFeeResponse defines the response structure for the SwapIndexFee gRPC service handler.
+type QuerySwapIndexFeeResponse struct {
+	Asset types.Coin `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// returned is the amount of the other Index's meTokens which would be minted, to set the swap min_amount_out.
+	Returned types.Coin `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned"`
+}
+
+func (m *QuerySwapIndexFeeResponse) Reset()         { *m = QuerySwapIndexFeeResponse{} }
+func (m *QuerySwapIndexFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapIndexFeeResponse) ProtoMessage()    {}
+func (*QuerySwapIndexFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f141a376167f31d, []int{9}
+}
+func (m *QuerySwapIndexFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapIndexFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapIndexFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapIndexFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapIndexFeeResponse.Merge(m, src)
+}
+func (m *QuerySwapIndexFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapIndexFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapIndexFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapIndexFeeResponse proto.InternalMessageInfo
+
 // QueryIndexBalances defines the request structure for the IndexBalances gRPC service handler.
 // metoken_denom param is optional, if it is not informed the query will return all the Indexes.
 type QueryIndexBalances struct {
@@ -348,7 +439,7 @@ func (m *QueryIndexBalances) Reset()         { *m = QueryIndexBalances{} }
 func (m *QueryIndexBalances) String() string { return proto.CompactTextString(m) }
 func (*QueryIndexBalances) ProtoMessage()    {}
 func (*QueryIndexBalances) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f141a376167f31d, []int{8}
+	return fileDescriptor_2f141a376167f31d, []int{10}
 }
 func (m *QueryIndexBalances) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +478,7 @@ func (m *QueryIndexBalancesResponse) Reset()         { *m = QueryIndexBalancesRe
 func (m *QueryIndexBalancesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIndexBalancesResponse) ProtoMessage()    {}
 func (*QueryIndexBalancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f141a376167f31d, []int{9}
+	return fileDescriptor_2f141a376167f31d, []int{11}
 }
 func (m *QueryIndexBalancesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +517,7 @@ func (m *QueryIndexPrices) Reset()         { *m = QueryIndexPrices{} }
 func (m *QueryIndexPrices) String() string { return proto.CompactTextString(m) }
 func (*QueryIndexPrices) ProtoMessage()    {}
 func (*QueryIndexPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f141a376167f31d, []int{10}
+	return fileDescriptor_2f141a376167f31d, []int{12}
 }
 func (m *QueryIndexPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +555,7 @@ func (m *QueryIndexPricesResponse) Reset()         { *m = QueryIndexPricesRespon
 func (m *QueryIndexPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIndexPricesResponse) ProtoMessage()    {}
 func (*QueryIndexPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f141a376167f31d, []int{11}
+	return fileDescriptor_2f141a376167f31d, []int{13}
 }
 func (m *QueryIndexPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,6 +584,171 @@ func (m *QueryIndexPricesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryIndexPricesResponse proto.InternalMessageInfo
 
+// QueryInterestPosition defines the request structure for the InterestPosition gRPC service handler.
+type QueryInterestPosition struct {
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MetokenDenom string `protobuf:"bytes,2,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+}
+
+func (m *QueryInterestPosition) Reset()         { *m = QueryInterestPosition{} }
+func (m *QueryInterestPosition) String() string { return proto.CompactTextString(m) }
+func (*QueryInterestPosition) ProtoMessage()    {}
+func (*QueryInterestPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f141a376167f31d, []int{14}
+}
+func (m *QueryInterestPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterestPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterestPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterestPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterestPosition.Merge(m, src)
+}
+func (m *QueryInterestPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterestPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterestPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterestPosition proto.InternalMessageInfo
+
+// QueryInterestPositionResponse defines the response structure for the InterestPosition gRPC service handler.
+type QueryInterestPositionResponse struct {
+	Locked types.Coin `protobuf:"bytes,1,opt,name=locked,proto3" json:"locked"`
+	// Claimable is the interest distributed to the locked meTokens, not claimed yet.
+	Claimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable"`
+}
+
+func (m *QueryInterestPositionResponse) Reset()         { *m = QueryInterestPositionResponse{} }
+func (m *QueryInterestPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterestPositionResponse) ProtoMessage()    {}
+func (*QueryInterestPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f141a376167f31d, []int{15}
+}
+func (m *QueryInterestPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterestPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterestPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterestPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterestPositionResponse.Merge(m, src)
+}
+func (m *QueryInterestPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterestPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterestPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterestPositionResponse proto.InternalMessageInfo
+
+// QueryIndexHistory defines the request structure for the IndexHistory gRPC service handler.
+type QueryIndexHistory struct {
+	MetokenDenom string `protobuf:"bytes,1,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+	// From is the start of the window of snapshots, by block time. Optional: no lower bound by default.
+	From *time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	// To is the end of the window of snapshots, by block time. Optional: no upper bound by default.
+	To         *time.Time         `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIndexHistory) Reset()         { *m = QueryIndexHistory{} }
+func (m *QueryIndexHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexHistory) ProtoMessage()    {}
+func (*QueryIndexHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f141a376167f31d, []int{16}
+}
+func (m *QueryIndexHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexHistory.Merge(m, src)
+}
+func (m *QueryIndexHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexHistory proto.InternalMessageInfo
+
+// QueryIndexHistoryResponse defines the response structure for the IndexHistory gRPC service handler.
+type QueryIndexHistoryResponse struct {
+	// Snapshots of the Index within the window, ordered by block height.
+	Snapshots []IndexSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// Annualized Yield is the change of the meToken price between the first and the last snapshot of the window,
+	// scaled to one year (not compounded). It's zero when the window has less than two snapshots.
+	AnnualizedYield github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annualized_yield,json=annualizedYield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annualized_yield"`
+	Pagination      *query.PageResponse                    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIndexHistoryResponse) Reset()         { *m = QueryIndexHistoryResponse{} }
+func (m *QueryIndexHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexHistoryResponse) ProtoMessage()    {}
+func (*QueryIndexHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f141a376167f31d, []int{17}
+}
+func (m *QueryIndexHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexHistoryResponse.Merge(m, src)
+}
+func (m *QueryIndexHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexHistoryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParams)(nil), "umee.metoken.v1.QueryParams")
 	proto.RegisterType((*QueryParamsResponse)(nil), "umee.metoken.v1.QueryParamsResponse")
@@ -502,60 +758,93 @@ func init() {
 	proto.RegisterType((*QuerySwapFeeResponse)(nil), "umee.metoken.v1.QuerySwapFeeResponse")
 	proto.RegisterType((*QueryRedeemFee)(nil), "umee.metoken.v1.QueryRedeemFee")
 	proto.RegisterType((*QueryRedeemFeeResponse)(nil), "umee.metoken.v1.QueryRedeemFeeResponse")
+	proto.RegisterType((*QuerySwapIndexFee)(nil), "umee.metoken.v1.QuerySwapIndexFee")
+	proto.RegisterType((*QuerySwapIndexFeeResponse)(nil), "umee.metoken.v1.QuerySwapIndexFeeResponse")
 	proto.RegisterType((*QueryIndexBalances)(nil), "umee.metoken.v1.QueryIndexBalances")
 	proto.RegisterType((*QueryIndexBalancesResponse)(nil), "umee.metoken.v1.QueryIndexBalancesResponse")
 	proto.RegisterType((*QueryIndexPrices)(nil), "umee.metoken.v1.QueryIndexPrices")
 	proto.RegisterType((*QueryIndexPricesResponse)(nil), "umee.metoken.v1.QueryIndexPricesResponse")
+	proto.RegisterType((*QueryInterestPosition)(nil), "umee.metoken.v1.QueryInterestPosition")
+	proto.RegisterType((*QueryInterestPositionResponse)(nil), "umee.metoken.v1.QueryInterestPositionResponse")
+	proto.RegisterType((*QueryIndexHistory)(nil), "umee.metoken.v1.QueryIndexHistory")
+	proto.RegisterType((*QueryIndexHistoryResponse)(nil), "umee.metoken.v1.QueryIndexHistoryResponse")
 }
 
 func init() { proto.RegisterFile("umee/metoken/v1/query.proto", fileDescriptor_2f141a376167f31d) }
 
 var fileDescriptor_2f141a376167f31d = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x4f, 0x13, 0x5d,
-	0x14, 0xc6, 0x3b, 0xbc, 0x2f, 0x45, 0x4e, 0x29, 0x9a, 0x2b, 0x81, 0x32, 0xd0, 0x29, 0x0c, 0x12,
-	0x20, 0xc6, 0x99, 0xb4, 0xc4, 0xbf, 0x4b, 0x34, 0x1a, 0x82, 0x46, 0xac, 0x89, 0x0b, 0x37, 0x64,
-	0x5a, 0x8e, 0xe3, 0x04, 0xe6, 0xde, 0x61, 0xee, 0x50, 0xc0, 0x25, 0x4b, 0x57, 0x26, 0x7e, 0x06,
-	0xbf, 0x0b, 0x4b, 0x12, 0x37, 0xae, 0x8c, 0x82, 0x3b, 0xbf, 0x84, 0x99, 0x7b, 0xef, 0x4c, 0x4b,
-	0xa7, 0x2d, 0x18, 0x77, 0xbd, 0xf7, 0x3c, 0xe7, 0x79, 0x7e, 0x33, 0x67, 0x0e, 0xc0, 0xcc, 0xbe,
-	0x8f, 0x68, 0xfb, 0x18, 0xb1, 0x1d, 0xa4, 0x76, 0xab, 0x6a, 0xef, 0xed, 0x63, 0x78, 0x64, 0x05,
-	0x21, 0x8b, 0x18, 0xb9, 0x1e, 0x17, 0x2d, 0x55, 0xb4, 0x5a, 0x55, 0x7d, 0xd6, 0x65, 0xcc, 0xdd,
-	0x45, 0xdb, 0x09, 0x3c, 0xdb, 0xa1, 0x94, 0x45, 0x4e, 0xe4, 0x31, 0xca, 0xa5, 0x5c, 0x9f, 0x70,
-	0x99, 0xcb, 0xc4, 0x4f, 0x3b, 0xfe, 0xa5, 0x6e, 0x8d, 0x26, 0xe3, 0x3e, 0xe3, 0x76, 0xc3, 0xe1,
-	0x68, 0xb7, 0xaa, 0x0d, 0x8c, 0x9c, 0xaa, 0xdd, 0x64, 0x1e, 0x55, 0xf5, 0x72, 0x37, 0x41, 0x92,
-	0xd7, 0xa7, 0xec, 0x22, 0x45, 0xee, 0xa9, 0x4c, 0xb3, 0x08, 0x85, 0x57, 0x31, 0xf1, 0xa6, 0x13,
-	0x3a, 0x3e, 0x37, 0x9f, 0xc3, 0xcd, 0x8e, 0x63, 0x1d, 0x79, 0xc0, 0x28, 0x47, 0x72, 0x17, 0xf2,
-	0x81, 0xb8, 0x29, 0x69, 0x73, 0xda, 0x72, 0xa1, 0x36, 0x65, 0x75, 0x3d, 0x99, 0x25, 0x1b, 0xd6,
-	0xfe, 0x3f, 0xf9, 0x5e, 0xc9, 0xd5, 0x95, 0xd8, 0x5c, 0x85, 0x31, 0xe1, 0xb6, 0x4e, 0xb7, 0xf1,
-	0x10, 0x39, 0x59, 0x80, 0xa2, 0x6a, 0xd9, 0xda, 0x46, 0xca, 0x7c, 0xe1, 0x36, 0x5a, 0x1f, 0x53,
-	0x97, 0x4f, 0xe2, 0x3b, 0x73, 0x13, 0x26, 0x3a, 0x9b, 0x52, 0x86, 0x07, 0x70, 0x2d, 0x44, 0xd7,
-	0xe3, 0x51, 0x78, 0x54, 0xd2, 0xe6, 0xfe, 0x5b, 0x2e, 0xd4, 0x26, 0x33, 0x14, 0xa2, 0x47, 0x41,
-	0xa4, 0x6a, 0x73, 0x5d, 0x61, 0xbc, 0x3e, 0x70, 0x82, 0xa7, 0x88, 0x64, 0x02, 0x86, 0x1d, 0xce,
-	0x31, 0x52, 0xf1, 0xf2, 0x90, 0x85, 0x1b, 0xea, 0x01, 0xf7, 0x42, 0xc1, 0x29, 0xab, 0x8e, 0x17,
-	0xd4, 0x61, 0x59, 0xa8, 0x4d, 0x5b, 0x72, 0x68, 0x56, 0x3c, 0x34, 0x4b, 0x0d, 0xcd, 0x7a, 0xcc,
-	0x3c, 0xaa, 0xe0, 0xa4, 0xda, 0xdc, 0x80, 0x71, 0x61, 0x57, 0xc7, 0x6d, 0x44, 0x3f, 0x66, 0x2b,
-	0xc1, 0x88, 0x0a, 0x54, 0x74, 0xc9, 0x91, 0x54, 0xa0, 0x20, 0x9a, 0x2e, 0xd0, 0x81, 0xb8, 0x92,
-	0x6c, 0x2f, 0x61, 0xf2, 0xa2, 0xd9, 0xbf, 0xd2, 0x3d, 0x04, 0xd2, 0x9e, 0xc4, 0x9a, 0xb3, 0xeb,
-	0xd0, 0xe6, 0x55, 0x87, 0xf8, 0x45, 0x03, 0x3d, 0xdb, 0x9b, 0x02, 0x6d, 0xc0, 0xb8, 0x17, 0x17,
-	0xb6, 0x1a, 0xaa, 0xa2, 0x26, 0x6a, 0xf4, 0x99, 0xa8, 0x52, 0x29, 0xbc, 0xa2, 0x77, 0x01, 0xe8,
-	0x11, 0xe4, 0x83, 0xd0, 0x8b, 0x4d, 0x86, 0x84, 0xc9, 0x6c, 0x6f, 0x93, 0x4d, 0xa1, 0x49, 0xbf,
-	0x50, 0x71, 0x32, 0xef, 0xc3, 0x8d, 0x36, 0xa6, 0x54, 0x5c, 0xed, 0x01, 0xdf, 0x40, 0xa9, 0xbb,
-	0x31, 0x7d, 0xba, 0x36, 0x90, 0xf6, 0xb7, 0x40, 0xb5, 0xdf, 0xc3, 0x30, 0x2c, 0x8c, 0x89, 0x0f,
-	0x79, 0xb9, 0x54, 0x24, 0xdb, 0xdf, 0xb1, 0xa3, 0xfa, 0xad, 0x41, 0xd5, 0x84, 0xc9, 0xac, 0x1c,
-	0x7f, 0xfd, 0xf5, 0x79, 0x68, 0x9a, 0x4c, 0xd9, 0xdd, 0x7f, 0x0f, 0xe4, 0xae, 0x92, 0x3d, 0x18,
-	0x49, 0xd6, 0xb4, 0xdc, 0xdb, 0x51, 0x95, 0xf5, 0xc5, 0x81, 0xe5, 0x34, 0x71, 0x4e, 0x24, 0xea,
-	0xa4, 0x94, 0x49, 0xf4, 0x54, 0x4e, 0x08, 0x23, 0xc9, 0x4a, 0xf6, 0x89, 0x54, 0xe5, 0x7e, 0x91,
-	0x5d, 0x5b, 0x68, 0xce, 0x8b, 0xc8, 0x19, 0x32, 0x9d, 0x89, 0xe4, 0x07, 0x4e, 0xb0, 0xf5, 0x0e,
-	0x91, 0x7c, 0x80, 0xd1, 0xf6, 0xb2, 0x55, 0x7a, 0xdb, 0xa6, 0x02, 0x7d, 0xe9, 0x12, 0x41, 0x9a,
-	0xbc, 0x20, 0x92, 0xcb, 0x64, 0x26, 0x93, 0x1c, 0x0a, 0xad, 0xc8, 0xfe, 0xa8, 0x41, 0xb1, 0x6b,
-	0x97, 0x06, 0xbc, 0xca, 0x44, 0xa4, 0xdf, 0xbe, 0x82, 0x28, 0x05, 0x59, 0x12, 0x20, 0xf3, 0xa4,
-	0xd2, 0xfb, 0xad, 0xa7, 0x0b, 0x47, 0x8e, 0x35, 0x28, 0x74, 0x7e, 0xf5, 0xf3, 0x03, 0x52, 0xa4,
-	0x44, 0x5f, 0xb9, 0x54, 0x92, 0x62, 0x2c, 0x0a, 0x8c, 0x0a, 0x29, 0xf7, 0xc1, 0x90, 0x5f, 0xfb,
-	0xda, 0xb3, 0x93, 0x9f, 0x46, 0xee, 0xe4, 0xcc, 0xd0, 0x4e, 0xcf, 0x0c, 0xed, 0xc7, 0x99, 0xa1,
-	0x7d, 0x3a, 0x37, 0x72, 0xa7, 0xe7, 0x46, 0xee, 0xdb, 0xb9, 0x91, 0x7b, 0xbb, 0xe2, 0x7a, 0xd1,
-	0xfb, 0xfd, 0x86, 0xd5, 0x64, 0xbe, 0xb0, 0xb9, 0x43, 0x31, 0x3a, 0x60, 0xe1, 0x8e, 0xf4, 0x6c,
-	0xdd, 0xb3, 0x0f, 0x13, 0xe3, 0x46, 0x5e, 0xfc, 0x37, 0x5b, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x66, 0xef, 0x99, 0x00, 0x8f, 0x07, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x69, 0x82, 0x9f, 0x93, 0x34, 0x0c, 0xa1, 0x75, 0x36, 0x8d, 0x9d, 0x6c, 0x9b,
+	0x8f, 0x06, 0x75, 0xb7, 0x49, 0x81, 0xf2, 0x71, 0x33, 0x55, 0x4b, 0x55, 0x90, 0x82, 0x8b, 0x2a,
+	0x95, 0x8b, 0x35, 0xb6, 0x27, 0xce, 0x28, 0xde, 0x9d, 0xcd, 0xce, 0x38, 0x1f, 0x3d, 0x16, 0x09,
+	0xa4, 0x0a, 0x89, 0x4a, 0x48, 0xf0, 0x17, 0x70, 0xe1, 0xdf, 0xe0, 0x92, 0x63, 0x25, 0x2e, 0x88,
+	0x43, 0x0b, 0x09, 0x07, 0xfe, 0x0c, 0xb4, 0x33, 0xb3, 0xeb, 0xf5, 0xf7, 0x22, 0x0e, 0x3d, 0xd9,
+	0xbb, 0xef, 0xf7, 0x7e, 0xbf, 0xdf, 0x7b, 0x6f, 0x67, 0xdf, 0xc2, 0x62, 0xcb, 0x25, 0xc4, 0x71,
+	0x89, 0x60, 0xfb, 0xc4, 0x73, 0x0e, 0xb7, 0x9c, 0x83, 0x16, 0x09, 0x4e, 0x6c, 0x3f, 0x60, 0x82,
+	0xa1, 0x8b, 0x61, 0xd0, 0xd6, 0x41, 0xfb, 0x70, 0xcb, 0xbc, 0xd2, 0x60, 0xac, 0xd1, 0x24, 0x0e,
+	0xf6, 0xa9, 0x83, 0x3d, 0x8f, 0x09, 0x2c, 0x28, 0xf3, 0xb8, 0x82, 0x9b, 0xf3, 0x0d, 0xd6, 0x60,
+	0xf2, 0xaf, 0x13, 0xfe, 0xd3, 0x77, 0x37, 0x6b, 0x8c, 0xbb, 0x8c, 0x3b, 0x55, 0xcc, 0x89, 0x62,
+	0x77, 0x0e, 0xb7, 0xaa, 0x44, 0xe0, 0x2d, 0xc7, 0xc7, 0x0d, 0xea, 0x49, 0x0a, 0x8d, 0x2d, 0x24,
+	0xb1, 0x11, 0xaa, 0xc6, 0x68, 0x14, 0x2f, 0x6a, 0x7d, 0x79, 0x55, 0x6d, 0xed, 0x3a, 0x82, 0xba,
+	0x84, 0x0b, 0xec, 0xfa, 0x1a, 0xb0, 0xd4, 0x5d, 0x8e, 0xfe, 0x3b, 0x28, 0xdc, 0x20, 0x1e, 0xe1,
+	0x54, 0x17, 0x60, 0xcd, 0x40, 0xee, 0x8b, 0xd0, 0xe0, 0x0e, 0x0e, 0xb0, 0xcb, 0xad, 0xcf, 0xe0,
+	0xad, 0xc4, 0x65, 0x99, 0x70, 0x9f, 0x79, 0x9c, 0xa0, 0xf7, 0x60, 0xd2, 0x97, 0x77, 0xf2, 0xc6,
+	0xb2, 0xb1, 0x91, 0xdb, 0xbe, 0x6c, 0x77, 0xb5, 0xc9, 0x56, 0x09, 0xa5, 0x89, 0xd3, 0x97, 0xc5,
+	0xb1, 0xb2, 0x06, 0x5b, 0xb7, 0x60, 0x5a, 0xb2, 0xdd, 0xf7, 0xea, 0xe4, 0x98, 0x70, 0x74, 0x15,
+	0x66, 0x74, 0x4a, 0xa5, 0x4e, 0x3c, 0xe6, 0x4a, 0xb6, 0x6c, 0x79, 0x5a, 0xdf, 0xbc, 0x13, 0xde,
+	0xb3, 0x76, 0x60, 0x3e, 0x99, 0x14, 0x7b, 0xf8, 0x00, 0xde, 0x08, 0x48, 0x83, 0x72, 0x11, 0x9c,
+	0xe4, 0x8d, 0xe5, 0xf1, 0x8d, 0xdc, 0xf6, 0xa5, 0x1e, 0x17, 0x32, 0x47, 0x9b, 0x88, 0xd1, 0xd6,
+	0x7d, 0x6d, 0xe3, 0xe1, 0x11, 0xf6, 0xef, 0x12, 0x82, 0xe6, 0xe1, 0x02, 0xe6, 0x9c, 0x08, 0x2d,
+	0xaf, 0x2e, 0x7a, 0xcd, 0x65, 0xfa, 0x98, 0x7b, 0x66, 0xc0, 0x7c, 0x92, 0x2b, 0xd1, 0xa1, 0x04,
+	0x67, 0x6e, 0x7b, 0xc1, 0x56, 0x63, 0xb5, 0xc3, 0xb1, 0xda, 0x7a, 0xac, 0xf6, 0x27, 0x8c, 0x7a,
+	0xda, 0x9d, 0x16, 0xfd, 0x38, 0x2c, 0x4a, 0xb4, 0x02, 0x8f, 0xd4, 0xf3, 0x99, 0x74, 0x99, 0x71,
+	0x82, 0xf5, 0x00, 0x66, 0xa5, 0x97, 0x32, 0xa9, 0x13, 0xe2, 0x86, 0x95, 0xe5, 0x61, 0x4a, 0xdb,
+	0xd5, 0xb5, 0x45, 0x97, 0xa8, 0x08, 0x39, 0xa9, 0xd8, 0x51, 0x1b, 0xc8, 0x5b, 0xaa, 0xb2, 0xef,
+	0x0c, 0xb8, 0xd4, 0xc9, 0xf6, 0x5a, 0x6b, 0x3b, 0x86, 0x37, 0xe3, 0x3e, 0xcb, 0xa9, 0xfe, 0xbf,
+	0xf2, 0xd0, 0x06, 0xcc, 0x09, 0x56, 0xe9, 0x1c, 0xf0, 0xb8, 0x44, 0xcd, 0x0a, 0xf6, 0x79, 0x72,
+	0xc4, 0xdf, 0x1b, 0xb0, 0xd0, 0x23, 0xfd, 0x5a, 0x7b, 0xf1, 0x21, 0xa0, 0xf6, 0x89, 0x28, 0xe1,
+	0x26, 0xf6, 0x6a, 0x69, 0x0f, 0xd3, 0xcf, 0x06, 0x98, 0xbd, 0xb9, 0x71, 0x35, 0x0f, 0x60, 0x96,
+	0x86, 0x81, 0x4a, 0x55, 0x47, 0xf4, 0xc9, 0x2a, 0x0c, 0x38, 0x59, 0x1a, 0xa5, 0x1d, 0xce, 0xd0,
+	0x0e, 0x43, 0x1f, 0xc1, 0xa4, 0x1f, 0xd0, 0x90, 0x24, 0x23, 0x49, 0xae, 0xf4, 0x27, 0xd9, 0x09,
+	0x68, 0x9b, 0x42, 0x67, 0x58, 0xb7, 0x61, 0xae, 0x6d, 0x53, 0x21, 0xd2, 0x15, 0xf8, 0x08, 0xf2,
+	0xdd, 0x89, 0x71, 0x75, 0x6d, 0x43, 0xc6, 0x7f, 0x36, 0xf4, 0x08, 0xde, 0xd6, 0xbc, 0x82, 0x04,
+	0x84, 0x8b, 0x1d, 0xc6, 0x69, 0xf8, 0xd6, 0x0e, 0x9f, 0x41, 0x5c, 0xaf, 0x07, 0x84, 0xf3, 0xe8,
+	0x19, 0xd4, 0x97, 0xe9, 0x5e, 0x20, 0xbf, 0x1a, 0xb0, 0xd4, 0x97, 0x38, 0x76, 0x7d, 0x1b, 0x26,
+	0x9b, 0xac, 0xb6, 0x4f, 0xea, 0x69, 0x1f, 0x31, 0x0d, 0x47, 0x14, 0xb2, 0xb5, 0x26, 0xa6, 0x2e,
+	0xae, 0x36, 0x89, 0x1e, 0xc1, 0x90, 0xdc, 0x9b, 0x61, 0xee, 0x2f, 0xaf, 0x8a, 0x1b, 0x0d, 0x2a,
+	0xf6, 0x5a, 0x55, 0xbb, 0xc6, 0x5c, 0x47, 0xaf, 0x22, 0xf5, 0x73, 0x83, 0xd7, 0xf7, 0x1d, 0x71,
+	0xe2, 0x13, 0x2e, 0x13, 0x78, 0xb9, 0xcd, 0x6e, 0xfd, 0x63, 0xe8, 0xe3, 0x29, 0x1b, 0xf8, 0x29,
+	0xe5, 0x82, 0x05, 0x27, 0xa9, 0x06, 0x86, 0xde, 0x85, 0x89, 0xdd, 0x40, 0x37, 0x27, 0xb7, 0x6d,
+	0xda, 0x6a, 0xbd, 0xd9, 0xd1, 0x7a, 0xb3, 0xbf, 0x8c, 0xd6, 0x5b, 0x69, 0xe2, 0xf9, 0xab, 0xa2,
+	0x51, 0x96, 0x68, 0x74, 0x13, 0x32, 0x82, 0xe5, 0xc7, 0x53, 0xe6, 0x64, 0x04, 0x43, 0x77, 0x01,
+	0xda, 0xbb, 0x36, 0x3f, 0x21, 0x33, 0xd7, 0x3a, 0xda, 0xa1, 0xd6, 0x7e, 0xd4, 0x94, 0x1d, 0xdc,
+	0x20, 0x65, 0x72, 0xd0, 0x22, 0x5c, 0x94, 0x13, 0x99, 0xd6, 0xd7, 0x19, 0x58, 0xe8, 0x29, 0x35,
+	0x1e, 0x56, 0x09, 0xb2, 0xdc, 0xc3, 0x3e, 0xdf, 0x63, 0x62, 0xc4, 0xd9, 0x79, 0xa8, 0x61, 0x7a,
+	0x68, 0xed, 0x34, 0xf4, 0x18, 0xe6, 0xb0, 0xe7, 0xb5, 0x70, 0x93, 0x3e, 0x21, 0xf5, 0xca, 0x09,
+	0x25, 0x4d, 0xf5, 0x8e, 0xc8, 0x96, 0xec, 0x10, 0xfa, 0xc7, 0xcb, 0xe2, 0x5a, 0x8a, 0x19, 0xdd,
+	0x21, 0xb5, 0xf2, 0xc5, 0x36, 0xcf, 0xe3, 0x90, 0x06, 0xdd, 0xeb, 0x68, 0x82, 0x6a, 0xdf, 0xfa,
+	0xc8, 0x26, 0xa8, 0xda, 0x92, 0x5d, 0xd8, 0xfe, 0x29, 0x0b, 0x17, 0x64, 0x17, 0x90, 0x0b, 0x93,
+	0x6a, 0xd7, 0xa3, 0xde, 0xe3, 0x94, 0xf8, 0x74, 0x30, 0xaf, 0x0d, 0x8b, 0x46, 0x1a, 0x56, 0xf1,
+	0xe9, 0x6f, 0x7f, 0xff, 0x90, 0x59, 0x40, 0x97, 0x9d, 0xee, 0xcf, 0x14, 0xf5, 0x09, 0x81, 0x0e,
+	0x60, 0x2a, 0xfa, 0x7a, 0x58, 0xea, 0xcf, 0xa8, 0xc3, 0xe6, 0xea, 0xd0, 0x70, 0xac, 0xb8, 0x2c,
+	0x15, 0x4d, 0x94, 0xef, 0x51, 0xa4, 0x5a, 0x27, 0x80, 0xa9, 0xe8, 0x4b, 0x61, 0x80, 0xa4, 0x0e,
+	0x9b, 0xab, 0x43, 0xc3, 0xb1, 0xe4, 0x8a, 0x94, 0x5c, 0x44, 0x0b, 0x3d, 0x92, 0xfc, 0x08, 0xfb,
+	0x95, 0x5d, 0x42, 0xd0, 0x13, 0xc8, 0xb6, 0xb7, 0x78, 0xb1, 0x3f, 0x6d, 0x0c, 0x30, 0xd7, 0x47,
+	0x00, 0x62, 0xe5, 0xab, 0x52, 0x79, 0x09, 0x2d, 0xf6, 0x28, 0x07, 0x12, 0x2b, 0xb5, 0xbf, 0x35,
+	0x60, 0xba, 0x63, 0xcd, 0x5a, 0x83, 0xcb, 0x8a, 0x30, 0xe6, 0xe6, 0x68, 0x4c, 0xec, 0x62, 0x5d,
+	0xba, 0x58, 0x41, 0xc5, 0xfe, 0xf5, 0xab, 0x0d, 0x14, 0x3a, 0x79, 0x66, 0xc0, 0x4c, 0xd7, 0x92,
+	0x1b, 0x32, 0xd4, 0x08, 0x64, 0xbe, 0x93, 0x02, 0x94, 0xc2, 0x4c, 0xe7, 0x26, 0x44, 0x4f, 0x0d,
+	0xc8, 0x25, 0xd7, 0xd1, 0xca, 0x10, 0x15, 0x05, 0x31, 0xaf, 0x8f, 0x84, 0xc4, 0x36, 0x56, 0xa5,
+	0x8d, 0x22, 0x5a, 0x1a, 0x60, 0x43, 0xad, 0x21, 0xf4, 0x8d, 0x01, 0xd3, 0x1d, 0xef, 0x58, 0x6b,
+	0x88, 0x84, 0xc6, 0x98, 0x9b, 0xa3, 0x31, 0xb1, 0x8f, 0x35, 0xe9, 0x63, 0x19, 0x15, 0x06, 0xf8,
+	0xd8, 0xd3, 0xba, 0x3f, 0x1a, 0x30, 0xd7, 0xb3, 0x0b, 0xd7, 0x06, 0x09, 0x75, 0xe2, 0x4c, 0x3b,
+	0x1d, 0x2e, 0x36, 0xb5, 0x29, 0x4d, 0x5d, 0x43, 0x56, 0x1f, 0x53, 0x2a, 0xa5, 0xe2, 0xeb, 0x9c,
+	0xd2, 0xbd, 0xd3, 0xbf, 0x0a, 0x63, 0xa7, 0x67, 0x05, 0xe3, 0xc5, 0x59, 0xc1, 0xf8, 0xf3, 0xac,
+	0x60, 0x3c, 0x3f, 0x2f, 0x8c, 0xbd, 0x38, 0x2f, 0x8c, 0xfd, 0x7e, 0x5e, 0x18, 0xfb, 0xea, 0x7a,
+	0xe2, 0xcd, 0x19, 0x72, 0xdd, 0xf0, 0x88, 0x38, 0x62, 0xc1, 0xbe, 0x22, 0x3e, 0x7c, 0xdf, 0x39,
+	0x8e, 0xd8, 0xab, 0x93, 0x72, 0x9d, 0xdc, 0xfa, 0x77, 0x00, 0x4b, 0x2c, 0xa5, 0xa9, 0x1f, 0x0e,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -578,11 +867,19 @@ type QueryClient interface {
 	SwapFee(ctx context.Context, in *QuerySwapFee, opts ...grpc.CallOption) (*QuerySwapFeeResponse, error)
 	// RedeemFee computes a fee that would be applied when executing MsgRedeem.
 	RedeemFee(ctx context.Context, in *QueryRedeemFee, opts ...grpc.CallOption) (*QueryRedeemFeeResponse, error)
+	// SwapIndexFee computes the fee that would be applied when executing MsgSwapIndex.
+	SwapIndexFee(ctx context.Context, in *QuerySwapIndexFee, opts ...grpc.CallOption) (*QuerySwapIndexFeeResponse, error)
 	// IndexBalances queries for Index's balances of a specific or all the registered indexes.
 	IndexBalances(ctx context.Context, in *QueryIndexBalances, opts ...grpc.CallOption) (*QueryIndexBalancesResponse, error)
 	// IndexPrices queries for Index's price of a specific or all the registered indexes. It also includes the
 	// underlying assets prices as well as swap and redeem rates.
 	IndexPrices(ctx context.Context, in *QueryIndexPrices, opts ...grpc.CallOption) (*QueryIndexPricesResponse, error)
+	// IndexHistory queries for the snapshots of an Index recorded every time the reserves re-balancing or the
+	// interest claiming runs, and the annualized yield of the meToken price over the requested window.
+	IndexHistory(ctx context.Context, in *QueryIndexHistory, opts ...grpc.CallOption) (*QueryIndexHistoryResponse, error)
+	// InterestPosition queries for the meTokens an account locked for interest in an Index, and its
+	// claimable interest.
+	InterestPosition(ctx context.Context, in *QueryInterestPosition, opts ...grpc.CallOption) (*QueryInterestPositionResponse, error)
 }
 
 type queryClient struct {
@@ -629,6 +926,15 @@ func (c *queryClient) RedeemFee(ctx context.Context, in *QueryRedeemFee, opts ..
 	return out, nil
 }
 
+func (c *queryClient) SwapIndexFee(ctx context.Context, in *QuerySwapIndexFee, opts ...grpc.CallOption) (*QuerySwapIndexFeeResponse, error) {
+	out := new(QuerySwapIndexFeeResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Query/SwapIndexFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) IndexBalances(ctx context.Context, in *QueryIndexBalances, opts ...grpc.CallOption) (*QueryIndexBalancesResponse, error) {
 	out := new(QueryIndexBalancesResponse)
 	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Query/IndexBalances", in, out, opts...)
@@ -647,6 +953,24 @@ func (c *queryClient) IndexPrices(ctx context.Context, in *QueryIndexPrices, opt
 	return out, nil
 }
 
+func (c *queryClient) IndexHistory(ctx context.Context, in *QueryIndexHistory, opts ...grpc.CallOption) (*QueryIndexHistoryResponse, error) {
+	out := new(QueryIndexHistoryResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Query/IndexHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterestPosition(ctx context.Context, in *QueryInterestPosition, opts ...grpc.CallOption) (*QueryInterestPositionResponse, error) {
+	out := new(QueryInterestPositionResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Query/InterestPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the x/metoken module.
@@ -657,11 +981,19 @@ type QueryServer interface {
 	SwapFee(context.Context, *QuerySwapFee) (*QuerySwapFeeResponse, error)
 	// RedeemFee computes a fee that would be applied when executing MsgRedeem.
 	RedeemFee(context.Context, *QueryRedeemFee) (*QueryRedeemFeeResponse, error)
+	// SwapIndexFee computes the fee that would be applied when executing MsgSwapIndex.
+	SwapIndexFee(context.Context, *QuerySwapIndexFee) (*QuerySwapIndexFeeResponse, error)
 	// IndexBalances queries for Index's balances of a specific or all the registered indexes.
 	IndexBalances(context.Context, *QueryIndexBalances) (*QueryIndexBalancesResponse, error)
 	// IndexPrices queries for Index's price of a specific or all the registered indexes. It also includes the
 	// underlying assets prices as well as swap and redeem rates.
 	IndexPrices(context.Context, *QueryIndexPrices) (*QueryIndexPricesResponse, error)
+	// IndexHistory queries for the snapshots of an Index recorded every time the reserves re-balancing or the
+	// interest claiming runs, and the annualized yield of the meToken price over the requested window.
+	IndexHistory(context.Context, *QueryIndexHistory) (*QueryIndexHistoryResponse, error)
+	// InterestPosition queries for the meTokens an account locked for interest in an Index, and its
+	// claimable interest.
+	InterestPosition(context.Context, *QueryInterestPosition) (*QueryInterestPositionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -680,12 +1012,21 @@ func (*UnimplementedQueryServer) SwapFee(ctx context.Context, req *QuerySwapFee)
 func (*UnimplementedQueryServer) RedeemFee(ctx context.Context, req *QueryRedeemFee) (*QueryRedeemFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RedeemFee not implemented")
 }
+func (*UnimplementedQueryServer) SwapIndexFee(ctx context.Context, req *QuerySwapIndexFee) (*QuerySwapIndexFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapIndexFee not implemented")
+}
 func (*UnimplementedQueryServer) IndexBalances(ctx context.Context, req *QueryIndexBalances) (*QueryIndexBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexBalances not implemented")
 }
 func (*UnimplementedQueryServer) IndexPrices(ctx context.Context, req *QueryIndexPrices) (*QueryIndexPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexPrices not implemented")
 }
+func (*UnimplementedQueryServer) IndexHistory(ctx context.Context, req *QueryIndexHistory) (*QueryIndexHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexHistory not implemented")
+}
+func (*UnimplementedQueryServer) InterestPosition(ctx context.Context, req *QueryInterestPosition) (*QueryInterestPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterestPosition not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -763,6 +1104,24 @@ func _Query_RedeemFee_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SwapIndexFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapIndexFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapIndexFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Query/SwapIndexFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapIndexFee(ctx, req.(*QuerySwapIndexFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_IndexBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryIndexBalances)
 	if err := dec(in); err != nil {
@@ -799,6 +1158,42 @@ func _Query_IndexPrices_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IndexHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Query/IndexHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexHistory(ctx, req.(*QueryIndexHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterestPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterestPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterestPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Query/InterestPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterestPosition(ctx, req.(*QueryInterestPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "umee.metoken.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -819,6 +1214,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RedeemFee",
 			Handler:    _Query_RedeemFee_Handler,
 		},
+		{
+			MethodName: "SwapIndexFee",
+			Handler:    _Query_SwapIndexFee_Handler,
+		},
 		{
 			MethodName: "IndexBalances",
 			Handler:    _Query_IndexBalances_Handler,
@@ -827,6 +1226,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IndexPrices",
 			Handler:    _Query_IndexPrices_Handler,
 		},
+		{
+			MethodName: "IndexHistory",
+			Handler:    _Query_IndexHistory_Handler,
+		},
+		{
+			MethodName: "InterestPosition",
+			Handler:    _Query_InterestPosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "umee/metoken/v1/query.proto",
@@ -1012,6 +1419,16 @@ func (m *QuerySwapFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1082,6 +1499,16 @@ func (m *QueryRedeemFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1095,7 +1522,7 @@ func (m *QueryRedeemFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndexBalances) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapIndexFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1105,27 +1532,41 @@ func (m *QueryIndexBalances) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIndexBalances) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapIndexFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIndexBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapIndexFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MetokenDenom) > 0 {
-		i -= len(m.MetokenDenom)
-		copy(dAtA[i:], m.MetokenDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MetokenDenom)))
+	if len(m.ToMetokenDenom) > 0 {
+		i -= len(m.ToMetokenDenom)
+		copy(dAtA[i:], m.ToMetokenDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToMetokenDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Metoken) > 0 {
+		i -= len(m.Metoken)
+		copy(dAtA[i:], m.Metoken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metoken)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndexBalancesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapIndexFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1135,30 +1576,103 @@ func (m *QueryIndexBalancesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIndexBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapIndexFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIndexBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapIndexFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.IndexBalances) > 0 {
 		for iNdEx := len(m.IndexBalances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1243,6 +1757,211 @@ func (m *QueryIndexPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInterestPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterestPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.To):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.From, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.From):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.AnnualizedYield.Size()
+		i -= size
+		if _, err := m.AnnualizedYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1327,6 +2046,8 @@ func (m *QuerySwapFeeResponse) Size() (n int) {
 	_ = l
 	l = m.Asset.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.Returned.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1355,32 +2076,68 @@ func (m *QueryRedeemFeeResponse) Size() (n int) {
 	_ = l
 	l = m.Asset.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.Returned.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryIndexBalances) Size() (n int) {
+func (m *QuerySwapIndexFee) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MetokenDenom)
+	l = len(m.Metoken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToMetokenDenom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryIndexBalancesResponse) Size() (n int) {
+func (m *QuerySwapIndexFeeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.IndexBalances) > 0 {
-		for _, e := range m.IndexBalances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Returned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndexBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IndexBalances) > 0 {
+		for _, e := range m.IndexBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	if len(m.Prices) > 0 {
@@ -1417,16 +2174,855 @@ func (m *QueryIndexPricesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryInterestPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterestPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIndexHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.From != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.AnnualizedYield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registry = append(m.Registry, Index{})
+			if err := m.Registry[len(m.Registry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedeemFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedeemFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedeemFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metoken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedeemFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedeemFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedeemFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParams) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapIndexFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1449,12 +3045,108 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapIndexFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapIndexFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metoken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToMetokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToMetokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1476,7 +3168,7 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapIndexFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,15 +3191,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapIndexFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapIndexFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1534,7 +3226,40 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1559,7 +3284,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndexes) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexBalances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1582,10 +3307,10 @@ func (m *QueryIndexes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexes: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexBalances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexBalances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1641,7 +3366,7 @@ func (m *QueryIndexes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndexesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1664,15 +3389,15 @@ func (m *QueryIndexesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexBalances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1699,8 +3424,42 @@ func (m *QueryIndexesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Registry = append(m.Registry, Index{})
-			if err := m.Registry[len(m.Registry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IndexBalances = append(m.IndexBalances, IndexBalances{})
+			if err := m.IndexBalances[len(m.IndexBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, IndexPrices{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1725,68 +3484,36 @@ func (m *QueryIndexesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapFee) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapFee: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
 			}
@@ -1839,7 +3566,7 @@ func (m *QuerySwapFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1862,15 +3589,15 @@ func (m *QuerySwapFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1897,7 +3624,8 @@ func (m *QuerySwapFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, IndexPrices{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1922,7 +3650,7 @@ func (m *QuerySwapFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRedeemFee) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,15 +3673,15 @@ func (m *QueryRedeemFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedeemFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedeemFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1981,11 +3709,11 @@ func (m *QueryRedeemFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metoken = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2013,7 +3741,7 @@ func (m *QueryRedeemFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2036,7 +3764,7 @@ func (m *QueryRedeemFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRedeemFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterestPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2059,15 +3787,15 @@ func (m *QueryRedeemFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedeemFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterestPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedeemFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterestPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2094,7 +3822,41 @@ func (m *QueryRedeemFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2119,7 +3881,7 @@ func (m *QueryRedeemFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndexBalances) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,10 +3904,10 @@ func (m *QueryIndexBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2180,59 +3942,45 @@ func (m *QueryIndexBalances) Unmarshal(dAtA []byte) error {
 			}
 			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIndexBalancesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.From == nil {
+				m.From = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexBalancesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2259,14 +4007,16 @@ func (m *QueryIndexBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexBalances = append(m.IndexBalances, IndexBalances{})
-			if err := m.IndexBalances[len(m.IndexBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.To == nil {
+				m.To = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2293,8 +4043,10 @@ func (m *QueryIndexBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, IndexPrices{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2319,7 +4071,7 @@ func (m *QueryIndexBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndexPrices) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2342,17 +4094,17 @@ func (m *QueryIndexPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2362,77 +4114,63 @@ func (m *QueryIndexPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Snapshots = append(m.Snapshots, IndexSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualizedYield", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIndexPricesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AnnualizedYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexPricesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2459,8 +4197,10 @@ func (m *QueryIndexPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, IndexPrices{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
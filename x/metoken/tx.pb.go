@@ -5,25 +5,31 @@ package metoken
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +43,11 @@ type MsgSwap struct {
 	User         string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Asset        types.Coin `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
 	MetokenDenom string     `protobuf:"bytes,3,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+	// MinAmountOut is the minimum amount of meTokens to receive, otherwise the swap fails.
+	// Zero (or empty) means no minimum.
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+	// Deadline is the latest block time at which the swap can be executed. Optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -127,6 +138,11 @@ type MsgRedeem struct {
 	User       string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Metoken    types.Coin `protobuf:"bytes,2,opt,name=metoken,proto3" json:"metoken"`
 	AssetDenom string     `protobuf:"bytes,3,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// MinAmountOut is the minimum amount of the asset to receive, after fees, otherwise the
+	// redemption fails. Zero (or empty) means no minimum.
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+	// Deadline is the latest block time at which the redemption can be executed. Optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 func (m *MsgRedeem) Reset()         { *m = MsgRedeem{} }
@@ -211,25 +227,32 @@ func (*MsgRedeemResponse) XXX_MessageName() string {
 	return "umee.metoken.v1.MsgRedeemResponse"
 }
 
-// MsgGovSetParams defines the Msg/GovSetParams request type.
-type MsgGovSetParams struct {
-	// authority must be the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgSwapMulti represents a user's request to swap a basket of accepted assets for Index's meToken.
+type MsgSwapMulti struct {
+	// User is the account address swapping assets and the signer of the message.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Assets to swap. Every asset must be accepted by the Index.
+	Assets       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=assets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"assets"`
+	MetokenDenom string                                   `protobuf:"bytes,3,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+	// MinAmountOut is the minimum amount of meTokens to receive, otherwise the swap fails.
+	// Zero (or empty) means no minimum.
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+	// Deadline is the latest block time at which the swap can be executed. Optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
-func (m *MsgGovSetParams) Reset()         { *m = MsgGovSetParams{} }
-func (m *MsgGovSetParams) String() string { return proto.CompactTextString(m) }
-func (*MsgGovSetParams) ProtoMessage()    {}
-func (*MsgGovSetParams) Descriptor() ([]byte, []int) {
+func (m *MsgSwapMulti) Reset()         { *m = MsgSwapMulti{} }
+func (m *MsgSwapMulti) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapMulti) ProtoMessage()    {}
+func (*MsgSwapMulti) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4fa56b8f5850b02d, []int{4}
 }
-func (m *MsgGovSetParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgSwapMulti) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSwapMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovSetParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSwapMulti.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -239,38 +262,42 @@ func (m *MsgGovSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgGovSetParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovSetParams.Merge(m, src)
+func (m *MsgSwapMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapMulti.Merge(m, src)
 }
-func (m *MsgGovSetParams) XXX_Size() int {
+func (m *MsgSwapMulti) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovSetParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovSetParams.DiscardUnknown(m)
+func (m *MsgSwapMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapMulti.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovSetParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSwapMulti proto.InternalMessageInfo
 
-func (*MsgGovSetParams) XXX_MessageName() string {
-	return "umee.metoken.v1.MsgGovSetParams"
+func (*MsgSwapMulti) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgSwapMulti"
 }
 
-// MsgGovSetParamsResponse defines the Msg/GovSetParams response type.
-type MsgGovSetParamsResponse struct {
+// MsgSwapMultiResponse defines the Msg/SwapMulti response type.
+type MsgSwapMultiResponse struct {
+	// Fee is the amount of every accepted asset charged to the user as the fee for the transaction.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// Returned is the amount of Index's meToken minted and returned to the user.
+	Returned types.Coin `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned"`
 }
 
-func (m *MsgGovSetParamsResponse) Reset()         { *m = MsgGovSetParamsResponse{} }
-func (m *MsgGovSetParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGovSetParamsResponse) ProtoMessage()    {}
-func (*MsgGovSetParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSwapMultiResponse) Reset()         { *m = MsgSwapMultiResponse{} }
+func (m *MsgSwapMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapMultiResponse) ProtoMessage()    {}
+func (*MsgSwapMultiResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4fa56b8f5850b02d, []int{5}
 }
-func (m *MsgGovSetParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSwapMultiResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovSetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSwapMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovSetParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSwapMultiResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -280,44 +307,47 @@ func (m *MsgGovSetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgGovSetParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovSetParamsResponse.Merge(m, src)
+func (m *MsgSwapMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapMultiResponse.Merge(m, src)
 }
-func (m *MsgGovSetParamsResponse) XXX_Size() int {
+func (m *MsgSwapMultiResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovSetParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovSetParamsResponse.DiscardUnknown(m)
+func (m *MsgSwapMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapMultiResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovSetParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSwapMultiResponse proto.InternalMessageInfo
 
-func (*MsgGovSetParamsResponse) XXX_MessageName() string {
-	return "umee.metoken.v1.MsgGovSetParamsResponse"
+func (*MsgSwapMultiResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgSwapMultiResponse"
 }
 
-// MsgGovUpdateRegistry defines the Msg/GovUpdateRegistry request type.
-type MsgGovUpdateRegistry struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// add_index defines new index settings.
-	AddIndex []Index `protobuf:"bytes,2,rep,name=add_index,json=addIndex,proto3" json:"add_index"`
-	// update_index defines the new settings for existing index.
-	UpdateIndex []Index `protobuf:"bytes,3,rep,name=update_index,json=updateIndex,proto3" json:"update_index"`
+// MsgRedeemProportional represents a user's request to redeem Index's meTokens for every accepted asset,
+// pro-rata to the Index balances.
+type MsgRedeemProportional struct {
+	// User is the account address redeeming assets and the signer of the message.
+	User    string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Metoken types.Coin `protobuf:"bytes,2,opt,name=metoken,proto3" json:"metoken"`
+	// MinAmountsOut are the minimum amounts of assets to receive, after fees, otherwise the redemption fails.
+	// Assets not listed have no minimum.
+	MinAmountsOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_amounts_out,json=minAmountsOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amounts_out"`
+	// Deadline is the latest block time at which the redemption can be executed. Optional.
+	Deadline *time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
-func (m *MsgGovUpdateRegistry) Reset()         { *m = MsgGovUpdateRegistry{} }
-func (m *MsgGovUpdateRegistry) String() string { return proto.CompactTextString(m) }
-func (*MsgGovUpdateRegistry) ProtoMessage()    {}
-func (*MsgGovUpdateRegistry) Descriptor() ([]byte, []int) {
+func (m *MsgRedeemProportional) Reset()         { *m = MsgRedeemProportional{} }
+func (m *MsgRedeemProportional) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemProportional) ProtoMessage()    {}
+func (*MsgRedeemProportional) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4fa56b8f5850b02d, []int{6}
 }
-func (m *MsgGovUpdateRegistry) XXX_Unmarshal(b []byte) error {
+func (m *MsgRedeemProportional) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovUpdateRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedeemProportional) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovUpdateRegistry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedeemProportional.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -327,38 +357,42 @@ func (m *MsgGovUpdateRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgGovUpdateRegistry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovUpdateRegistry.Merge(m, src)
+func (m *MsgRedeemProportional) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemProportional.Merge(m, src)
 }
-func (m *MsgGovUpdateRegistry) XXX_Size() int {
+func (m *MsgRedeemProportional) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovUpdateRegistry) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovUpdateRegistry.DiscardUnknown(m)
+func (m *MsgRedeemProportional) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemProportional.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovUpdateRegistry proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedeemProportional proto.InternalMessageInfo
 
-func (*MsgGovUpdateRegistry) XXX_MessageName() string {
-	return "umee.metoken.v1.MsgGovUpdateRegistry"
+func (*MsgRedeemProportional) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgRedeemProportional"
 }
 
-// MsgGovUpdateRegistryResponse defines the Msg/GovUpdateRegistry response type.
-type MsgGovUpdateRegistryResponse struct {
+// MsgRedeemProportionalResponse defines the Msg/RedeemProportional response type.
+type MsgRedeemProportionalResponse struct {
+	// Returned is the amount of every accepted asset returned to the user.
+	Returned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=returned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"returned"`
+	// Fee is the amount of every accepted asset charged to the user as the fee for the transaction.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *MsgGovUpdateRegistryResponse) Reset()         { *m = MsgGovUpdateRegistryResponse{} }
-func (m *MsgGovUpdateRegistryResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGovUpdateRegistryResponse) ProtoMessage()    {}
-func (*MsgGovUpdateRegistryResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRedeemProportionalResponse) Reset()         { *m = MsgRedeemProportionalResponse{} }
+func (m *MsgRedeemProportionalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemProportionalResponse) ProtoMessage()    {}
+func (*MsgRedeemProportionalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4fa56b8f5850b02d, []int{7}
 }
-func (m *MsgGovUpdateRegistryResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRedeemProportionalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovUpdateRegistryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedeemProportionalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovUpdateRegistryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedeemProportionalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -368,808 +402,4141 @@ func (m *MsgGovUpdateRegistryResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgGovUpdateRegistryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovUpdateRegistryResponse.Merge(m, src)
+func (m *MsgRedeemProportionalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemProportionalResponse.Merge(m, src)
 }
-func (m *MsgGovUpdateRegistryResponse) XXX_Size() int {
+func (m *MsgRedeemProportionalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovUpdateRegistryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovUpdateRegistryResponse.DiscardUnknown(m)
+func (m *MsgRedeemProportionalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemProportionalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovUpdateRegistryResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedeemProportionalResponse proto.InternalMessageInfo
 
-func (*MsgGovUpdateRegistryResponse) XXX_MessageName() string {
-	return "umee.metoken.v1.MsgGovUpdateRegistryResponse"
-}
-func init() {
-	proto.RegisterType((*MsgSwap)(nil), "umee.metoken.v1.MsgSwap")
-	proto.RegisterType((*MsgSwapResponse)(nil), "umee.metoken.v1.MsgSwapResponse")
-	proto.RegisterType((*MsgRedeem)(nil), "umee.metoken.v1.MsgRedeem")
-	proto.RegisterType((*MsgRedeemResponse)(nil), "umee.metoken.v1.MsgRedeemResponse")
-	proto.RegisterType((*MsgGovSetParams)(nil), "umee.metoken.v1.MsgGovSetParams")
-	proto.RegisterType((*MsgGovSetParamsResponse)(nil), "umee.metoken.v1.MsgGovSetParamsResponse")
-	proto.RegisterType((*MsgGovUpdateRegistry)(nil), "umee.metoken.v1.MsgGovUpdateRegistry")
-	proto.RegisterType((*MsgGovUpdateRegistryResponse)(nil), "umee.metoken.v1.MsgGovUpdateRegistryResponse")
+func (*MsgRedeemProportionalResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgRedeemProportionalResponse"
 }
 
-func init() { proto.RegisterFile("umee/metoken/v1/tx.proto", fileDescriptor_4fa56b8f5850b02d) }
-
-var fileDescriptor_4fa56b8f5850b02d = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6a, 0xd4, 0x50,
-	0x14, 0x9e, 0x3b, 0x53, 0x5b, 0xe7, 0x4c, 0xa5, 0xf4, 0x52, 0x6c, 0x1a, 0x34, 0x1d, 0x22, 0xc2,
-	0x28, 0x34, 0x61, 0x2a, 0x2d, 0x54, 0x17, 0xe2, 0x28, 0xa8, 0x48, 0x41, 0xa6, 0xb8, 0xe9, 0xa6,
-	0x64, 0x9a, 0x63, 0x1a, 0x4a, 0x72, 0x87, 0xdc, 0x9b, 0x69, 0x8b, 0x2b, 0xf5, 0x05, 0x5c, 0xf8,
-	0x00, 0x3e, 0x82, 0x0b, 0x1f, 0x62, 0x96, 0xc5, 0x95, 0xb8, 0x28, 0xda, 0x59, 0xe8, 0x63, 0x48,
-	0xee, 0xbd, 0x49, 0xff, 0xa6, 0x18, 0xdd, 0xe5, 0x9e, 0xef, 0x3b, 0xe7, 0xfb, 0xee, 0x97, 0x93,
-	0x80, 0x91, 0x46, 0x88, 0x6e, 0x84, 0x82, 0xed, 0x62, 0xec, 0x0e, 0xda, 0xae, 0xd8, 0x77, 0xfa,
-	0x09, 0x13, 0x8c, 0xce, 0x64, 0x88, 0xa3, 0x11, 0x67, 0xd0, 0x36, 0xad, 0x6d, 0xc6, 0x23, 0xc6,
-	0xdd, 0x9e, 0xc7, 0xd1, 0x1d, 0xb4, 0x7b, 0x28, 0xbc, 0xb6, 0xbb, 0xcd, 0xc2, 0x58, 0x35, 0x98,
-	0x0b, 0x0a, 0xdf, 0x92, 0x27, 0x57, 0x1d, 0x34, 0x34, 0xaf, 0x5b, 0x23, 0x1e, 0x64, 0x1a, 0x11,
-	0x0f, 0x34, 0x30, 0x17, 0xb0, 0x80, 0xa9, 0x86, 0xec, 0x49, 0x57, 0x6f, 0x9e, 0x37, 0x95, 0xbb,
-	0x90, 0xb0, 0x7d, 0x00, 0x53, 0xeb, 0x3c, 0xd8, 0xd8, 0xf3, 0xfa, 0x94, 0xc2, 0x44, 0xca, 0x31,
-	0x31, 0x48, 0x93, 0xb4, 0xea, 0x5d, 0xf9, 0x4c, 0x57, 0xe0, 0x8a, 0xc7, 0x39, 0x0a, 0xa3, 0xda,
-	0x24, 0xad, 0xc6, 0xf2, 0x82, 0xa3, 0xad, 0x64, 0xbe, 0x1d, 0xed, 0xdb, 0x79, 0xcc, 0xc2, 0xb8,
-	0x33, 0x31, 0x3c, 0x5a, 0xac, 0x74, 0x15, 0x9b, 0xde, 0x82, 0x6b, 0x5a, 0x66, 0xcb, 0xc7, 0x98,
-	0x45, 0x46, 0x4d, 0xce, 0x9c, 0xd6, 0xc5, 0x27, 0x59, 0xcd, 0x7e, 0x4b, 0x60, 0x46, 0x6b, 0x77,
-	0x91, 0xf7, 0x59, 0xcc, 0x91, 0xb6, 0xa1, 0xf6, 0x1a, 0x51, 0x5a, 0x28, 0xa1, 0x96, 0x71, 0xe9,
-	0x03, 0xb8, 0x9a, 0xa0, 0x48, 0x93, 0x18, 0xfd, 0xb2, 0x2e, 0x8b, 0x06, 0xfb, 0x0d, 0xd4, 0xd7,
-	0x79, 0xd0, 0x45, 0x1f, 0x31, 0x1a, 0x1b, 0xc0, 0x1a, 0x4c, 0x69, 0xd3, 0x65, 0x87, 0xe7, 0x7c,
-	0xba, 0x08, 0x0d, 0x99, 0xc6, 0x99, 0x08, 0x40, 0x96, 0x54, 0x00, 0xef, 0x09, 0xcc, 0x16, 0xea,
-	0x45, 0x04, 0xa7, 0xef, 0x43, 0xfe, 0xf1, 0x3e, 0x79, 0x7e, 0xd5, 0xf2, 0xf9, 0xd9, 0x1f, 0xd5,
-	0x6b, 0x78, 0xca, 0x06, 0x1b, 0x28, 0x5e, 0x7a, 0x89, 0x17, 0x71, 0xba, 0x0a, 0x75, 0x2f, 0x15,
-	0x3b, 0x2c, 0x09, 0xc5, 0x81, 0x8a, 0xa3, 0x63, 0x7c, 0xfd, 0xb2, 0x34, 0xa7, 0xe7, 0x3d, 0xf2,
-	0xfd, 0x04, 0x39, 0xdf, 0x10, 0x49, 0x18, 0x07, 0xdd, 0x13, 0x2a, 0x5d, 0x81, 0xc9, 0xbe, 0x9c,
-	0xa0, 0x1d, 0xcc, 0x3b, 0xe7, 0x16, 0xdf, 0x51, 0x02, 0x5a, 0x5f, 0x93, 0xef, 0xd3, 0xdf, 0x9f,
-	0x16, 0xc9, 0xbb, 0x5f, 0x9f, 0xef, 0x9e, 0x8c, 0xb2, 0x17, 0x60, 0xfe, 0x9c, 0xab, 0x3c, 0x21,
-	0xfb, 0x88, 0xc0, 0x9c, 0xc2, 0x5e, 0xf5, 0x7d, 0x4f, 0x60, 0x17, 0x83, 0x90, 0x8b, 0xe4, 0xe0,
-	0xbf, 0x6d, 0xaf, 0x41, 0xdd, 0xf3, 0xfd, 0xad, 0x30, 0xf6, 0x71, 0xdf, 0xa8, 0x36, 0x6b, 0xad,
-	0xc6, 0xf2, 0xf5, 0x0b, 0xce, 0x9f, 0x67, 0x68, 0x1e, 0xb8, 0xe7, 0xfb, 0xf2, 0x4c, 0x1f, 0xc2,
-	0x74, 0x2a, 0x4d, 0xe8, 0xee, 0x5a, 0x89, 0xee, 0x86, 0xea, 0x90, 0xa5, 0xb1, 0x77, 0xb7, 0xe0,
-	0xc6, 0xb8, 0xfb, 0xe5, 0x01, 0x2c, 0x7f, 0xaf, 0x42, 0x6d, 0x9d, 0x07, 0xb4, 0x03, 0x13, 0xf2,
-	0xcb, 0x35, 0x2e, 0xc8, 0xe9, 0xef, 0xca, 0x6c, 0x5e, 0x86, 0x14, 0xeb, 0xf6, 0x0c, 0x26, 0xf5,
-	0xfa, 0x9b, 0xe3, 0xb8, 0x0a, 0x33, 0xed, 0xcb, 0xb1, 0x62, 0xd2, 0x26, 0x4c, 0x9f, 0x59, 0xa2,
-	0xb1, 0xda, 0xa7, 0x19, 0x66, 0xeb, 0x6f, 0x8c, 0x62, 0x76, 0x08, 0xb3, 0x17, 0x5f, 0xf7, 0xed,
-	0x4b, 0xda, 0xcf, 0xd2, 0xcc, 0xa5, 0x52, 0xb4, 0x5c, 0xaa, 0xf3, 0x62, 0xf8, 0xd3, 0xaa, 0x0c,
-	0x8f, 0x2d, 0x72, 0x78, 0x6c, 0x91, 0x1f, 0xc7, 0x16, 0xf9, 0x30, 0xb2, 0x2a, 0xc3, 0x91, 0x45,
-	0x0e, 0x47, 0x56, 0xe5, 0xdb, 0xc8, 0xaa, 0x6c, 0xde, 0x09, 0x42, 0xb1, 0x93, 0xf6, 0x9c, 0x6d,
-	0x16, 0xb9, 0xd9, 0xe8, 0xa5, 0x18, 0xc5, 0x1e, 0x4b, 0x76, 0xe5, 0xc1, 0x1d, 0xac, 0xba, 0xfb,
-	0xf9, 0x4f, 0xb6, 0x37, 0x29, 0xff, 0xb2, 0xf7, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x7c,
-	0x48, 0x50, 0x1b, 0x06, 0x00, 0x00,
+// MsgSwapIndex represents a user's request to swap meTokens of an Index for meTokens of another Index. The meTokens
+// are redeemed for an asset accepted by both Indexes, which is swapped for the meTokens of the other Index.
+type MsgSwapIndex struct {
+	// User is the account address swapping meTokens and the signer of the message.
+	User    string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Metoken types.Coin `protobuf:"bytes,2,opt,name=metoken,proto3" json:"metoken"`
+	// AssetDenom is the denom of the asset accepted by both Indexes.
+	AssetDenom string `protobuf:"bytes,3,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// ToMetokenDenom is the denom of the meToken to receive.
+	ToMetokenDenom string `protobuf:"bytes,4,opt,name=to_metoken_denom,json=toMetokenDenom,proto3" json:"to_metoken_denom,omitempty"`
+	// MinAmountOut is the minimum amount of meTokens to receive, otherwise the swap fails.
+	// Zero (or empty) means no minimum.
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+	// Deadline is the latest block time at which the swap can be executed. Optional.
+	Deadline *time.Time `protobuf:"bytes,6,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
-func (this *MsgGovSetParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgGovSetParams)
-	if !ok {
-		that2, ok := that.(MsgGovSetParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	if !this.Params.Equal(&that1.Params) {
-		return false
-	}
-	return true
+func (m *MsgSwapIndex) Reset()         { *m = MsgSwapIndex{} }
+func (m *MsgSwapIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapIndex) ProtoMessage()    {}
+func (*MsgSwapIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{8}
 }
-func (this *MsgGovUpdateRegistry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgGovUpdateRegistry)
-	if !ok {
-		that2, ok := that.(MsgGovUpdateRegistry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	if len(this.AddIndex) != len(that1.AddIndex) {
-		return false
-	}
-	for i := range this.AddIndex {
-		if !this.AddIndex[i].Equal(&that1.AddIndex[i]) {
-			return false
-		}
-	}
-	if len(this.UpdateIndex) != len(that1.UpdateIndex) {
-		return false
-	}
-	for i := range this.UpdateIndex {
-		if !this.UpdateIndex[i].Equal(&that1.UpdateIndex[i]) {
-			return false
+func (m *MsgSwapIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
+}
+func (m *MsgSwapIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapIndex.Merge(m, src)
+}
+func (m *MsgSwapIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapIndex.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSwapIndex proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Swap defines a method for swapping an accepted asset for Index's meToken.
-	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
-	// Redeem defines a method for redeeming Index's meToken for an accepted asset.
-	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
-	// GovSetParams is used by governance proposals to update parameters.
-	GovSetParams(ctx context.Context, in *MsgGovSetParams, opts ...grpc.CallOption) (*MsgGovSetParamsResponse, error)
-	// GovUpdateRegistry adds new index to the index registry or
-	// updates existing index with new settings.
-	GovUpdateRegistry(ctx context.Context, in *MsgGovUpdateRegistry, opts ...grpc.CallOption) (*MsgGovUpdateRegistryResponse, error)
+func (*MsgSwapIndex) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgSwapIndex"
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgSwapIndexResponse defines the Msg/SwapIndex response type.
+type MsgSwapIndexResponse struct {
+	// Fee is the amount of the shared asset charged to the user as the fee for the transaction.
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// Returned is the amount of the other Index's meToken minted and returned to the user.
+	Returned types.Coin `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSwapIndexResponse) Reset()         { *m = MsgSwapIndexResponse{} }
+func (m *MsgSwapIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapIndexResponse) ProtoMessage()    {}
+func (*MsgSwapIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{9}
 }
-
-func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
-	out := new(MsgSwapResponse)
-	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/Swap", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSwapIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
-	out := new(MsgRedeemResponse)
-	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/Redeem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSwapIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) GovSetParams(ctx context.Context, in *MsgGovSetParams, opts ...grpc.CallOption) (*MsgGovSetParamsResponse, error) {
-	out := new(MsgGovSetParamsResponse)
-	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/GovSetParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSwapIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapIndexResponse.Merge(m, src)
 }
-
-func (c *msgClient) GovUpdateRegistry(ctx context.Context, in *MsgGovUpdateRegistry, opts ...grpc.CallOption) (*MsgGovUpdateRegistryResponse, error) {
-	out := new(MsgGovUpdateRegistryResponse)
-	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/GovUpdateRegistry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSwapIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapIndexResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Swap defines a method for swapping an accepted asset for Index's meToken.
-	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
-	// Redeem defines a method for redeeming Index's meToken for an accepted asset.
-	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
-	// GovSetParams is used by governance proposals to update parameters.
-	GovSetParams(context.Context, *MsgGovSetParams) (*MsgGovSetParamsResponse, error)
-	// GovUpdateRegistry adds new index to the index registry or
-	// updates existing index with new settings.
-	GovUpdateRegistry(context.Context, *MsgGovUpdateRegistry) (*MsgGovUpdateRegistryResponse, error)
+var xxx_messageInfo_MsgSwapIndexResponse proto.InternalMessageInfo
+
+func (*MsgSwapIndexResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgSwapIndexResponse"
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgLockForInterest represents a user's request to lock meTokens to receive the Index interest distributions.
+// The pending interest of the user's position is claimed.
+type MsgLockForInterest struct {
+	// User is the account address locking meTokens and the signer of the message.
+	User    string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Metoken types.Coin `protobuf:"bytes,2,opt,name=metoken,proto3" json:"metoken"`
 }
 
-func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+func (m *MsgLockForInterest) Reset()         { *m = MsgLockForInterest{} }
+func (m *MsgLockForInterest) String() string { return proto.CompactTextString(m) }
+func (*MsgLockForInterest) ProtoMessage()    {}
+func (*MsgLockForInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{10}
 }
-func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+func (m *MsgLockForInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) GovSetParams(ctx context.Context, req *MsgGovSetParams) (*MsgGovSetParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovSetParams not implemented")
+func (m *MsgLockForInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockForInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) GovUpdateRegistry(ctx context.Context, req *MsgGovUpdateRegistry) (*MsgGovUpdateRegistryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateRegistry not implemented")
+func (m *MsgLockForInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockForInterest.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgLockForInterest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Swap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.metoken.v1.Msg/Swap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgLockForInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockForInterest.DiscardUnknown(m)
 }
 
-func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeem)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Redeem(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.metoken.v1.Msg/Redeem",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgLockForInterest proto.InternalMessageInfo
+
+func (*MsgLockForInterest) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgLockForInterest"
 }
 
-func _Msg_GovSetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovSetParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovSetParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.metoken.v1.Msg/GovSetParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovSetParams(ctx, req.(*MsgGovSetParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgLockForInterestResponse defines the Msg/LockForInterest response type.
+type MsgLockForInterestResponse struct {
+	// Claimed is the interest withdrawn to the user.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
 }
 
-func _Msg_GovUpdateRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovUpdateRegistry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovUpdateRegistry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.metoken.v1.Msg/GovUpdateRegistry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovUpdateRegistry(ctx, req.(*MsgGovUpdateRegistry))
+func (m *MsgLockForInterestResponse) Reset()         { *m = MsgLockForInterestResponse{} }
+func (m *MsgLockForInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockForInterestResponse) ProtoMessage()    {}
+func (*MsgLockForInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{11}
+}
+func (m *MsgLockForInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockForInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockForInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "umee.metoken.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Swap",
-			Handler:    _Msg_Swap_Handler,
-		},
-		{
-			MethodName: "Redeem",
-			Handler:    _Msg_Redeem_Handler,
-		},
-		{
-			MethodName: "GovSetParams",
-			Handler:    _Msg_GovSetParams_Handler,
-		},
-		{
-			MethodName: "GovUpdateRegistry",
-			Handler:    _Msg_GovUpdateRegistry_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "umee/metoken/v1/tx.proto",
+func (m *MsgLockForInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockForInterestResponse.Merge(m, src)
+}
+func (m *MsgLockForInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockForInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockForInterestResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgLockForInterestResponse proto.InternalMessageInfo
+
+func (*MsgLockForInterestResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgLockForInterestResponse"
 }
 
-func (m *MsgSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnlockFromInterest represents a user's request to unlock meTokens locked for interest.
+// The pending interest of the user's position is claimed.
+type MsgUnlockFromInterest struct {
+	// User is the account address unlocking meTokens and the signer of the message.
+	User    string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Metoken types.Coin `protobuf:"bytes,2,opt,name=metoken,proto3" json:"metoken"`
 }
 
-func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetokenDenom) > 0 {
-		i -= len(m.MetokenDenom)
-		copy(dAtA[i:], m.MetokenDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetokenDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnlockFromInterest) Reset()         { *m = MsgUnlockFromInterest{} }
+func (m *MsgUnlockFromInterest) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockFromInterest) ProtoMessage()    {}
+func (*MsgUnlockFromInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{12}
+}
+func (m *MsgUnlockFromInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockFromInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockFromInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnlockFromInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockFromInterest.Merge(m, src)
+}
+func (m *MsgUnlockFromInterest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockFromInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockFromInterest.DiscardUnknown(m)
 }
 
-func (m *MsgSwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUnlockFromInterest proto.InternalMessageInfo
+
+func (*MsgUnlockFromInterest) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgUnlockFromInterest"
 }
 
-func (m *MsgSwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnlockFromInterestResponse defines the Msg/UnlockFromInterest response type.
+type MsgUnlockFromInterestResponse struct {
+	// Claimed is the interest withdrawn to the user.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
 }
 
-func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnlockFromInterestResponse) Reset()         { *m = MsgUnlockFromInterestResponse{} }
+func (m *MsgUnlockFromInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockFromInterestResponse) ProtoMessage()    {}
+func (*MsgUnlockFromInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{13}
+}
+func (m *MsgUnlockFromInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockFromInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockFromInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUnlockFromInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockFromInterestResponse.Merge(m, src)
+}
+func (m *MsgUnlockFromInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockFromInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockFromInterestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockFromInterestResponse proto.InternalMessageInfo
+
+func (*MsgUnlockFromInterestResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgUnlockFromInterestResponse"
+}
+
+// MsgClaimInterest represents a user's request to withdraw the interest distributed to its locked meTokens.
+type MsgClaimInterest struct {
+	// User is the account address claiming interest and the signer of the message.
+	User         string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	MetokenDenom string `protobuf:"bytes,2,opt,name=metoken_denom,json=metokenDenom,proto3" json:"metoken_denom,omitempty"`
+}
+
+func (m *MsgClaimInterest) Reset()         { *m = MsgClaimInterest{} }
+func (m *MsgClaimInterest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimInterest) ProtoMessage()    {}
+func (*MsgClaimInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{14}
+}
+func (m *MsgClaimInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimInterest.Merge(m, src)
+}
+func (m *MsgClaimInterest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimInterest.DiscardUnknown(m)
 }
 
-func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgClaimInterest proto.InternalMessageInfo
+
+func (*MsgClaimInterest) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgClaimInterest"
 }
 
-func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClaimInterestResponse defines the Msg/ClaimInterest response type.
+type MsgClaimInterestResponse struct {
+	// Claimed is the interest withdrawn to the user.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
 }
 
-func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetDenom) > 0 {
-		i -= len(m.AssetDenom)
-		copy(dAtA[i:], m.AssetDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimInterestResponse) Reset()         { *m = MsgClaimInterestResponse{} }
+func (m *MsgClaimInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimInterestResponse) ProtoMessage()    {}
+func (*MsgClaimInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{15}
+}
+func (m *MsgClaimInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimInterestResponse.Merge(m, src)
+}
+func (m *MsgClaimInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimInterestResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgClaimInterestResponse proto.InternalMessageInfo
+
+func (*MsgClaimInterestResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgClaimInterestResponse"
 }
 
-func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGovSetParams defines the Msg/GovSetParams request type.
+type MsgGovSetParams struct {
+	// authority must be the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgGovSetParams) Reset()         { *m = MsgGovSetParams{} }
+func (m *MsgGovSetParams) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetParams) ProtoMessage()    {}
+func (*MsgGovSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{16}
+}
+func (m *MsgGovSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgGovSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetParams.Merge(m, src)
+}
+func (m *MsgGovSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetParams proto.InternalMessageInfo
+
+func (*MsgGovSetParams) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgGovSetParams"
+}
+
+// MsgGovSetParamsResponse defines the Msg/GovSetParams response type.
+type MsgGovSetParamsResponse struct {
+}
+
+func (m *MsgGovSetParamsResponse) Reset()         { *m = MsgGovSetParamsResponse{} }
+func (m *MsgGovSetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetParamsResponse) ProtoMessage()    {}
+func (*MsgGovSetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{17}
+}
+func (m *MsgGovSetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgGovSetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetParamsResponse.Merge(m, src)
+}
+func (m *MsgGovSetParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgGovSetParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgGovSetParamsResponse proto.InternalMessageInfo
+
+func (*MsgGovSetParamsResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgGovSetParamsResponse"
 }
 
-func (m *MsgGovSetParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGovUpdateRegistry defines the Msg/GovUpdateRegistry request type.
+type MsgGovUpdateRegistry struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// add_index defines new index settings.
+	AddIndex []Index `protobuf:"bytes,2,rep,name=add_index,json=addIndex,proto3" json:"add_index"`
+	// update_index defines the new settings for existing index.
+	UpdateIndex []Index `protobuf:"bytes,3,rep,name=update_index,json=updateIndex,proto3" json:"update_index"`
 }
 
-func (m *MsgGovSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgGovUpdateRegistry) Reset()         { *m = MsgGovUpdateRegistry{} }
+func (m *MsgGovUpdateRegistry) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateRegistry) ProtoMessage()    {}
+func (*MsgGovUpdateRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{18}
+}
+func (m *MsgGovUpdateRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateRegistry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgGovSetParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgGovUpdateRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateRegistry.Merge(m, src)
+}
+func (m *MsgGovUpdateRegistry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateRegistry.DiscardUnknown(m)
 }
 
-func (m *MsgGovSetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgGovUpdateRegistry proto.InternalMessageInfo
+
+func (*MsgGovUpdateRegistry) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgGovUpdateRegistry"
 }
 
-func (m *MsgGovSetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgGovUpdateRegistryResponse defines the Msg/GovUpdateRegistry response type.
+type MsgGovUpdateRegistryResponse struct {
 }
 
-func (m *MsgGovUpdateRegistry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGovUpdateRegistryResponse) Reset()         { *m = MsgGovUpdateRegistryResponse{} }
+func (m *MsgGovUpdateRegistryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateRegistryResponse) ProtoMessage()    {}
+func (*MsgGovUpdateRegistryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fa56b8f5850b02d, []int{19}
+}
+func (m *MsgGovUpdateRegistryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateRegistryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateRegistryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgGovUpdateRegistry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGovUpdateRegistryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateRegistryResponse.Merge(m, src)
 }
-
-func (m *MsgGovUpdateRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdateIndex) > 0 {
-		for iNdEx := len(m.UpdateIndex) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UpdateIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *MsgGovUpdateRegistryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateRegistryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateRegistryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateRegistryResponse proto.InternalMessageInfo
+
+func (*MsgGovUpdateRegistryResponse) XXX_MessageName() string {
+	return "umee.metoken.v1.MsgGovUpdateRegistryResponse"
+}
+func init() {
+	proto.RegisterType((*MsgSwap)(nil), "umee.metoken.v1.MsgSwap")
+	proto.RegisterType((*MsgSwapResponse)(nil), "umee.metoken.v1.MsgSwapResponse")
+	proto.RegisterType((*MsgRedeem)(nil), "umee.metoken.v1.MsgRedeem")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "umee.metoken.v1.MsgRedeemResponse")
+	proto.RegisterType((*MsgSwapMulti)(nil), "umee.metoken.v1.MsgSwapMulti")
+	proto.RegisterType((*MsgSwapMultiResponse)(nil), "umee.metoken.v1.MsgSwapMultiResponse")
+	proto.RegisterType((*MsgRedeemProportional)(nil), "umee.metoken.v1.MsgRedeemProportional")
+	proto.RegisterType((*MsgRedeemProportionalResponse)(nil), "umee.metoken.v1.MsgRedeemProportionalResponse")
+	proto.RegisterType((*MsgSwapIndex)(nil), "umee.metoken.v1.MsgSwapIndex")
+	proto.RegisterType((*MsgSwapIndexResponse)(nil), "umee.metoken.v1.MsgSwapIndexResponse")
+	proto.RegisterType((*MsgLockForInterest)(nil), "umee.metoken.v1.MsgLockForInterest")
+	proto.RegisterType((*MsgLockForInterestResponse)(nil), "umee.metoken.v1.MsgLockForInterestResponse")
+	proto.RegisterType((*MsgUnlockFromInterest)(nil), "umee.metoken.v1.MsgUnlockFromInterest")
+	proto.RegisterType((*MsgUnlockFromInterestResponse)(nil), "umee.metoken.v1.MsgUnlockFromInterestResponse")
+	proto.RegisterType((*MsgClaimInterest)(nil), "umee.metoken.v1.MsgClaimInterest")
+	proto.RegisterType((*MsgClaimInterestResponse)(nil), "umee.metoken.v1.MsgClaimInterestResponse")
+	proto.RegisterType((*MsgGovSetParams)(nil), "umee.metoken.v1.MsgGovSetParams")
+	proto.RegisterType((*MsgGovSetParamsResponse)(nil), "umee.metoken.v1.MsgGovSetParamsResponse")
+	proto.RegisterType((*MsgGovUpdateRegistry)(nil), "umee.metoken.v1.MsgGovUpdateRegistry")
+	proto.RegisterType((*MsgGovUpdateRegistryResponse)(nil), "umee.metoken.v1.MsgGovUpdateRegistryResponse")
+}
+
+func init() { proto.RegisterFile("umee/metoken/v1/tx.proto", fileDescriptor_4fa56b8f5850b02d) }
+
+var fileDescriptor_4fa56b8f5850b02d = []byte{
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x8e, 0x53, 0xbf, 0x38, 0x4d, 0xbb, 0x4a, 0xbf, 0xd9, 0xac, 0xbe, 0x59, 0x07,
+	0x57, 0x41, 0x2e, 0x28, 0xbb, 0xb8, 0xa8, 0x95, 0x0a, 0x48, 0xa8, 0x0e, 0xa2, 0x44, 0xc5, 0xa2,
+	0x6c, 0xe8, 0xa5, 0x52, 0x65, 0xad, 0xbd, 0x93, 0xcd, 0xca, 0xde, 0x1d, 0x6b, 0x67, 0xd6, 0x4d,
+	0x8e, 0x80, 0xd4, 0x73, 0x0f, 0x5c, 0xb8, 0xf5, 0x0c, 0x07, 0x10, 0xe2, 0x8f, 0xc8, 0xb1, 0xe2,
+	0x84, 0x38, 0xa4, 0x90, 0x1c, 0xca, 0x1f, 0x81, 0x10, 0xda, 0xd9, 0xd9, 0xf5, 0xaf, 0xb5, 0xeb,
+	0x50, 0x27, 0xc0, 0x29, 0x3b, 0xf3, 0x3e, 0xef, 0xe7, 0x67, 0xde, 0x9b, 0x71, 0x40, 0xf2, 0x1d,
+	0x84, 0x34, 0x07, 0x51, 0xdc, 0x42, 0xae, 0xd6, 0xad, 0x68, 0x74, 0x5f, 0xed, 0x78, 0x98, 0x62,
+	0x71, 0x29, 0x90, 0xa8, 0x5c, 0xa2, 0x76, 0x2b, 0xb2, 0xd2, 0xc4, 0xc4, 0xc1, 0x44, 0x6b, 0x18,
+	0x04, 0x69, 0xdd, 0x4a, 0x03, 0x51, 0xa3, 0xa2, 0x35, 0xb1, 0xed, 0x86, 0x0a, 0xf2, 0x6a, 0x28,
+	0xaf, 0xb3, 0x95, 0x16, 0x2e, 0xb8, 0x68, 0x85, 0xab, 0x3a, 0xc4, 0x0a, 0x7c, 0x38, 0xc4, 0xe2,
+	0x82, 0x65, 0x0b, 0x5b, 0x38, 0x54, 0x08, 0xbe, 0xf8, 0x6e, 0xd1, 0xc2, 0xd8, 0x6a, 0x23, 0x8d,
+	0xad, 0x1a, 0xfe, 0xae, 0x46, 0x6d, 0x07, 0x11, 0x6a, 0x38, 0x1d, 0x0e, 0x58, 0x1b, 0x8e, 0x9a,
+	0x7f, 0x86, 0xe2, 0xd2, 0x1f, 0x02, 0xcc, 0xd7, 0x88, 0xb5, 0xf3, 0xc8, 0xe8, 0x88, 0x22, 0x64,
+	0x7d, 0x82, 0x3c, 0x49, 0x58, 0x17, 0xca, 0x79, 0x9d, 0x7d, 0x8b, 0x37, 0x60, 0xce, 0x20, 0x04,
+	0x51, 0x29, 0xbd, 0x2e, 0x94, 0x17, 0xae, 0xaf, 0xaa, 0x3c, 0xd8, 0x20, 0x33, 0x95, 0x67, 0xa6,
+	0x6e, 0x61, 0xdb, 0xad, 0x66, 0x0f, 0x8f, 0x8a, 0x29, 0x3d, 0x44, 0x8b, 0x57, 0x61, 0x91, 0xfb,
+	0xa9, 0x9b, 0xc8, 0xc5, 0x8e, 0x94, 0x61, 0x36, 0x0b, 0x7c, 0xf3, 0x83, 0x60, 0x4f, 0xdc, 0x82,
+	0x8b, 0x8e, 0xed, 0xd6, 0x0d, 0x07, 0xfb, 0x2e, 0xad, 0x63, 0x9f, 0x4a, 0xd9, 0x00, 0x55, 0x5d,
+	0x0b, 0x2c, 0xfd, 0x72, 0x54, 0xbc, 0x12, 0xfa, 0x22, 0x66, 0x4b, 0xb5, 0xb1, 0xe6, 0x18, 0x74,
+	0x4f, 0xdd, 0x76, 0xa9, 0x5e, 0x70, 0x6c, 0xf7, 0x36, 0xd3, 0xf9, 0xc4, 0xa7, 0xe2, 0x7b, 0x70,
+	0xc1, 0x44, 0x86, 0xd9, 0xb6, 0x5d, 0x24, 0xcd, 0xb1, 0x18, 0x65, 0x35, 0xac, 0x89, 0x1a, 0xd5,
+	0x44, 0xfd, 0x2c, 0xaa, 0x49, 0x35, 0xfb, 0xe4, 0x79, 0x51, 0xd0, 0x63, 0x8d, 0xd2, 0xe7, 0x02,
+	0x2c, 0xf1, 0xf4, 0x75, 0x44, 0x3a, 0xd8, 0x25, 0x48, 0xac, 0x40, 0x66, 0x17, 0x21, 0x49, 0x98,
+	0x2e, 0xe1, 0x00, 0x2b, 0xbe, 0x0b, 0x17, 0x3c, 0x44, 0x7d, 0xcf, 0x45, 0xe6, 0xb4, 0x85, 0x8a,
+	0x15, 0x4a, 0x7f, 0x0a, 0x90, 0xaf, 0x11, 0x4b, 0x47, 0x26, 0x42, 0x4e, 0x22, 0x09, 0xb7, 0x60,
+	0x9e, 0x17, 0x6e, 0x5a, 0xeb, 0x11, 0x5e, 0x2c, 0xc2, 0x02, 0x63, 0x64, 0x80, 0x06, 0x60, 0x5b,
+	0xff, 0x1a, 0x12, 0xbe, 0x14, 0xe0, 0x72, 0x5c, 0x80, 0x98, 0x86, 0xfe, 0x9a, 0x0a, 0xa7, 0xac,
+	0x69, 0xc4, 0x61, 0x7a, 0x7a, 0x0e, 0x4b, 0xdf, 0xa5, 0xa1, 0xc0, 0x8f, 0x42, 0xcd, 0x6f, 0x53,
+	0x3b, 0x91, 0x89, 0x26, 0xe4, 0x58, 0xed, 0x88, 0x94, 0x5e, 0xcf, 0x4c, 0x36, 0xfd, 0x56, 0x60,
+	0xfa, 0x9b, 0xe7, 0xc5, 0xb2, 0x65, 0xd3, 0x3d, 0xbf, 0xa1, 0x36, 0xb1, 0xc3, 0x3b, 0x9d, 0xff,
+	0xd9, 0x24, 0x66, 0x4b, 0xa3, 0x07, 0x1d, 0x44, 0x98, 0x02, 0xd1, 0xb9, 0xe9, 0xff, 0x4c, 0xf3,
+	0xfc, 0x20, 0xc0, 0x72, 0x7f, 0xc5, 0x62, 0xea, 0x1e, 0x46, 0x1d, 0x34, 0xf3, 0x12, 0xbd, 0x7a,
+	0xb7, 0x7d, 0x9d, 0x86, 0x2b, 0xf1, 0x61, 0xbb, 0xe7, 0xe1, 0x0e, 0xf6, 0xa8, 0x8d, 0x5d, 0xa3,
+	0x3d, 0xeb, 0xce, 0x23, 0xb0, 0xd4, 0x23, 0x88, 0x30, 0x86, 0x32, 0xb3, 0x2f, 0xc8, 0x62, 0xcc,
+	0x27, 0x19, 0x26, 0x34, 0x7b, 0x6a, 0x42, 0x5f, 0x08, 0xb0, 0x96, 0x58, 0x9b, 0x98, 0x59, 0x6b,
+	0xa0, 0x29, 0x67, 0x9e, 0x4d, 0xaf, 0x81, 0x1f, 0x46, 0x0d, 0x7c, 0x26, 0x47, 0xa8, 0xf4, 0x6d,
+	0xaf, 0xd9, 0xb7, 0x5d, 0x13, 0xed, 0x9f, 0xfb, 0xd8, 0x2d, 0xc3, 0x25, 0x8a, 0xeb, 0x83, 0x6d,
+	0xce, 0x1a, 0x58, 0xbf, 0x48, 0x71, 0x6d, 0x72, 0xa3, 0xcf, 0xbd, 0x5a, 0xa3, 0xe7, 0x4e, 0x7d,
+	0x2e, 0x1e, 0xf7, 0x1a, 0x9d, 0x55, 0xeb, 0x1f, 0xbb, 0x2a, 0x9b, 0x20, 0xd6, 0x88, 0xf5, 0x31,
+	0x6e, 0xb6, 0x3e, 0xc4, 0xde, 0xb6, 0x4b, 0x91, 0x87, 0x08, 0x9d, 0x31, 0x77, 0xc1, 0x75, 0x24,
+	0x8f, 0x7a, 0x89, 0x73, 0x46, 0x30, 0xdf, 0x6c, 0x1b, 0xb6, 0x73, 0x36, 0x1d, 0x10, 0xd9, 0x2e,
+	0xed, 0xb2, 0x31, 0x75, 0xdf, 0x6d, 0x07, 0x61, 0x78, 0xd8, 0x39, 0xab, 0x6c, 0x1f, 0x87, 0x3d,
+	0x3f, 0xea, 0xe8, 0xbc, 0x13, 0xbe, 0x0b, 0x97, 0x6a, 0xc4, 0xda, 0x0a, 0x56, 0x13, 0x73, 0x1d,
+	0xb9, 0x1d, 0xd3, 0xa3, 0xb7, 0x63, 0xf0, 0xae, 0x93, 0x86, 0xad, 0x9d, 0x77, 0x42, 0x5f, 0x85,
+	0x6f, 0xcb, 0x3b, 0xb8, 0xbb, 0x83, 0xe8, 0x3d, 0xc3, 0x33, 0x1c, 0x22, 0xde, 0x84, 0xbc, 0xe1,
+	0xd3, 0x3d, 0xec, 0xd9, 0xf4, 0x20, 0xcc, 0xaa, 0x2a, 0xfd, 0xf4, 0xe3, 0xe6, 0x32, 0xf7, 0x7f,
+	0xdb, 0x34, 0x3d, 0x44, 0xc8, 0x0e, 0xf5, 0x6c, 0xd7, 0xd2, 0x7b, 0x50, 0xf1, 0x06, 0xe4, 0x3a,
+	0xcc, 0x02, 0xe7, 0x77, 0x45, 0x1d, 0xfa, 0xc9, 0xa1, 0x86, 0x0e, 0x38, 0xbb, 0x1c, 0xfc, 0x8e,
+	0xf8, 0xfb, 0xd3, 0xa2, 0xf0, 0xc5, 0x8b, 0xef, 0xdf, 0xe8, 0x99, 0x2a, 0xad, 0xc2, 0xca, 0x50,
+	0x54, 0x51, 0x61, 0x4a, 0x47, 0x61, 0x9f, 0xdf, 0xc1, 0xdd, 0xfb, 0x1d, 0xd3, 0xa0, 0x48, 0x47,
+	0x96, 0x4d, 0xa8, 0x77, 0xf0, 0xb7, 0xc3, 0xbe, 0x05, 0x79, 0xc3, 0x34, 0xeb, 0x76, 0x30, 0x34,
+	0xf8, 0x2c, 0xff, 0xdf, 0x48, 0xe4, 0x6c, 0xa4, 0x44, 0xad, 0x6e, 0x98, 0x26, 0x5b, 0x8b, 0xef,
+	0x43, 0xc1, 0x67, 0x41, 0x70, 0xed, 0xcc, 0x14, 0xda, 0x0b, 0xa1, 0x06, 0xdb, 0x4a, 0xcc, 0x5d,
+	0x81, 0xff, 0x27, 0xe5, 0x17, 0x15, 0xe0, 0xfa, 0xd3, 0x79, 0xc8, 0xd4, 0x88, 0x25, 0x56, 0x21,
+	0xcb, 0x7e, 0x11, 0x49, 0x23, 0xee, 0xf8, 0x18, 0x94, 0xd7, 0xc7, 0x49, 0xe2, 0x53, 0xf6, 0x11,
+	0xe4, 0xf8, 0x93, 0x5e, 0x4e, 0xc2, 0x86, 0x32, 0xb9, 0x34, 0x5e, 0x16, 0x5b, 0xfa, 0x14, 0xf2,
+	0xbd, 0x57, 0xe9, 0xda, 0x38, 0xc7, 0x4c, 0x2c, 0x6f, 0x4c, 0x14, 0xc7, 0x26, 0xdb, 0x20, 0x26,
+	0xbc, 0x80, 0x5e, 0x1f, 0x1f, 0x4c, 0x3f, 0x4e, 0x56, 0xa7, 0xc3, 0x0d, 0x27, 0x10, 0x12, 0x3b,
+	0x36, 0x01, 0x26, 0x96, 0x37, 0x26, 0x8a, 0x63, 0x93, 0x4d, 0x58, 0x1a, 0xbe, 0x06, 0xae, 0x26,
+	0x69, 0x0e, 0x81, 0xe4, 0x37, 0xa7, 0x00, 0xf5, 0x57, 0x29, 0x61, 0x00, 0x27, 0x56, 0x69, 0x14,
+	0x27, 0xab, 0xd3, 0xe1, 0xfa, 0x5e, 0xcd, 0x8b, 0x83, 0xd3, 0xef, 0xb5, 0x24, 0x03, 0x03, 0x10,
+	0xf9, 0xda, 0x4b, 0x21, 0xb1, 0xf9, 0x07, 0x50, 0x18, 0x18, 0x45, 0x89, 0x27, 0xb8, 0x1f, 0x21,
+	0x97, 0x5f, 0x86, 0x88, 0x6d, 0xdb, 0x70, 0x79, 0x74, 0x68, 0x6c, 0x8c, 0x51, 0x1f, 0x84, 0xc9,
+	0x9b, 0x53, 0xc1, 0x22, 0x57, 0xd5, 0xbb, 0x87, 0xbf, 0x29, 0xa9, 0xc3, 0x63, 0x45, 0x78, 0x76,
+	0xac, 0x08, 0xbf, 0x1e, 0x2b, 0xc2, 0x93, 0x13, 0x25, 0x75, 0x78, 0xa2, 0x08, 0xcf, 0x4e, 0x94,
+	0xd4, 0xcf, 0x27, 0x4a, 0xea, 0xc1, 0xb5, 0xbe, 0x51, 0x1d, 0x98, 0xde, 0x74, 0x11, 0x7d, 0x84,
+	0xbd, 0x16, 0x5b, 0x68, 0xdd, 0x9b, 0xda, 0x7e, 0xf4, 0x3f, 0x90, 0x46, 0x8e, 0x3d, 0x7e, 0xde,
+	0xfe, 0x6b, 0x00, 0xb8, 0x4b, 0xba, 0x63, 0xdb, 0x11, 0x00, 0x00,
+}
+
+func (this *MsgGovSetParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.AddIndex) > 0 {
-		for iNdEx := len(m.AddIndex) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+
+	that1, ok := that.(*MsgGovSetParams)
+	if !ok {
+		that2, ok := that.(MsgGovSetParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
 }
+func (this *MsgGovUpdateRegistry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgGovUpdateRegistryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgGovUpdateRegistry)
+	if !ok {
+		that2, ok := that.(MsgGovUpdateRegistry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if len(this.AddIndex) != len(that1.AddIndex) {
+		return false
+	}
+	for i := range this.AddIndex {
+		if !this.AddIndex[i].Equal(&that1.AddIndex[i]) {
+			return false
+		}
+	}
+	if len(this.UpdateIndex) != len(that1.UpdateIndex) {
+		return false
+	}
+	for i := range this.UpdateIndex {
+		if !this.UpdateIndex[i].Equal(&that1.UpdateIndex[i]) {
+			return false
+		}
+	}
+	return true
 }
 
-func (m *MsgGovUpdateRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Swap defines a method for swapping an accepted asset for Index's meToken.
+	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	// Redeem defines a method for redeeming Index's meToken for an accepted asset.
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	// SwapMulti defines a method for swapping a basket of accepted assets for Index's meToken.
+	SwapMulti(ctx context.Context, in *MsgSwapMulti, opts ...grpc.CallOption) (*MsgSwapMultiResponse, error)
+	// RedeemProportional defines a method for redeeming Index's meToken for all the accepted assets,
+	// pro-rata to the Index balances.
+	RedeemProportional(ctx context.Context, in *MsgRedeemProportional, opts ...grpc.CallOption) (*MsgRedeemProportionalResponse, error)
+	// SwapIndex handles the swap of meTokens of an Index for meTokens of another Index through an accepted asset of
+	// both Indexes.
+	SwapIndex(ctx context.Context, in *MsgSwapIndex, opts ...grpc.CallOption) (*MsgSwapIndexResponse, error)
+	// LockForInterest locks meTokens of an Index with INTEREST_POLICY_CLAIM to receive its interest distributions.
+	LockForInterest(ctx context.Context, in *MsgLockForInterest, opts ...grpc.CallOption) (*MsgLockForInterestResponse, error)
+	// UnlockFromInterest unlocks meTokens locked for interest.
+	UnlockFromInterest(ctx context.Context, in *MsgUnlockFromInterest, opts ...grpc.CallOption) (*MsgUnlockFromInterestResponse, error)
+	// ClaimInterest withdraws the interest distributed to the meTokens locked for interest.
+	ClaimInterest(ctx context.Context, in *MsgClaimInterest, opts ...grpc.CallOption) (*MsgClaimInterestResponse, error)
+	// GovSetParams is used by governance proposals to update parameters.
+	GovSetParams(ctx context.Context, in *MsgGovSetParams, opts ...grpc.CallOption) (*MsgGovSetParamsResponse, error)
+	// GovUpdateRegistry adds new index to the index registry or
+	// updates existing index with new settings.
+	GovUpdateRegistry(ctx context.Context, in *MsgGovUpdateRegistry, opts ...grpc.CallOption) (*MsgGovUpdateRegistryResponse, error)
 }
 
-func (m *MsgGovUpdateRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
-func (m *MsgSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Asset.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.MetokenDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
+	out := new(MsgSwapResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/Redeem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Returned.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgRedeem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SwapMulti(ctx context.Context, in *MsgSwapMulti, opts ...grpc.CallOption) (*MsgSwapMultiResponse, error) {
+	out := new(MsgSwapMultiResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/SwapMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Metoken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.AssetDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RedeemProportional(ctx context.Context, in *MsgRedeemProportional, opts ...grpc.CallOption) (*MsgRedeemProportionalResponse, error) {
+	out := new(MsgRedeemProportionalResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/RedeemProportional", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRedeemResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SwapIndex(ctx context.Context, in *MsgSwapIndex, opts ...grpc.CallOption) (*MsgSwapIndexResponse, error) {
+	out := new(MsgSwapIndexResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/SwapIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Returned.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgGovSetParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) LockForInterest(ctx context.Context, in *MsgLockForInterest, opts ...grpc.CallOption) (*MsgLockForInterestResponse, error) {
+	out := new(MsgLockForInterestResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/LockForInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnlockFromInterest(ctx context.Context, in *MsgUnlockFromInterest, opts ...grpc.CallOption) (*MsgUnlockFromInterestResponse, error) {
+	out := new(MsgUnlockFromInterestResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/UnlockFromInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgGovSetParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ClaimInterest(ctx context.Context, in *MsgClaimInterest, opts ...grpc.CallOption) (*MsgClaimInterestResponse, error) {
+	out := new(MsgClaimInterestResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/ClaimInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgGovUpdateRegistry) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) GovSetParams(ctx context.Context, in *MsgGovSetParams, opts ...grpc.CallOption) (*MsgGovSetParamsResponse, error) {
+	out := new(MsgGovSetParamsResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/GovSetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) GovUpdateRegistry(ctx context.Context, in *MsgGovUpdateRegistry, opts ...grpc.CallOption) (*MsgGovUpdateRegistryResponse, error) {
+	out := new(MsgGovUpdateRegistryResponse)
+	err := c.cc.Invoke(ctx, "/umee.metoken.v1.Msg/GovUpdateRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AddIndex) > 0 {
-		for _, e := range m.AddIndex {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Swap defines a method for swapping an accepted asset for Index's meToken.
+	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	// Redeem defines a method for redeeming Index's meToken for an accepted asset.
+	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	// SwapMulti defines a method for swapping a basket of accepted assets for Index's meToken.
+	SwapMulti(context.Context, *MsgSwapMulti) (*MsgSwapMultiResponse, error)
+	// RedeemProportional defines a method for redeeming Index's meToken for all the accepted assets,
+	// pro-rata to the Index balances.
+	RedeemProportional(context.Context, *MsgRedeemProportional) (*MsgRedeemProportionalResponse, error)
+	// SwapIndex handles the swap of meTokens of an Index for meTokens of another Index through an accepted asset of
+	// both Indexes.
+	SwapIndex(context.Context, *MsgSwapIndex) (*MsgSwapIndexResponse, error)
+	// LockForInterest locks meTokens of an Index with INTEREST_POLICY_CLAIM to receive its interest distributions.
+	LockForInterest(context.Context, *MsgLockForInterest) (*MsgLockForInterestResponse, error)
+	// UnlockFromInterest unlocks meTokens locked for interest.
+	UnlockFromInterest(context.Context, *MsgUnlockFromInterest) (*MsgUnlockFromInterestResponse, error)
+	// ClaimInterest withdraws the interest distributed to the meTokens locked for interest.
+	ClaimInterest(context.Context, *MsgClaimInterest) (*MsgClaimInterestResponse, error)
+	// GovSetParams is used by governance proposals to update parameters.
+	GovSetParams(context.Context, *MsgGovSetParams) (*MsgGovSetParamsResponse, error)
+	// GovUpdateRegistry adds new index to the index registry or
+	// updates existing index with new settings.
+	GovUpdateRegistry(context.Context, *MsgGovUpdateRegistry) (*MsgGovUpdateRegistryResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (*UnimplementedMsgServer) SwapMulti(ctx context.Context, req *MsgSwapMulti) (*MsgSwapMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapMulti not implemented")
+}
+func (*UnimplementedMsgServer) RedeemProportional(ctx context.Context, req *MsgRedeemProportional) (*MsgRedeemProportionalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemProportional not implemented")
+}
+func (*UnimplementedMsgServer) SwapIndex(ctx context.Context, req *MsgSwapIndex) (*MsgSwapIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapIndex not implemented")
+}
+func (*UnimplementedMsgServer) LockForInterest(ctx context.Context, req *MsgLockForInterest) (*MsgLockForInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockForInterest not implemented")
+}
+func (*UnimplementedMsgServer) UnlockFromInterest(ctx context.Context, req *MsgUnlockFromInterest) (*MsgUnlockFromInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockFromInterest not implemented")
+}
+func (*UnimplementedMsgServer) ClaimInterest(ctx context.Context, req *MsgClaimInterest) (*MsgClaimInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInterest not implemented")
+}
+func (*UnimplementedMsgServer) GovSetParams(ctx context.Context, req *MsgGovSetParams) (*MsgGovSetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSetParams not implemented")
+}
+func (*UnimplementedMsgServer) GovUpdateRegistry(ctx context.Context, req *MsgGovUpdateRegistry) (*MsgGovUpdateRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateRegistry not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwap)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UpdateIndex) > 0 {
-		for _, e := range m.UpdateIndex {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/Redeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/SwapMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapMulti(ctx, req.(*MsgSwapMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemProportional_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemProportional)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemProportional(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/RedeemProportional",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemProportional(ctx, req.(*MsgRedeemProportional))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/SwapIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapIndex(ctx, req.(*MsgSwapIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockForInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockForInterest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockForInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/LockForInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockForInterest(ctx, req.(*MsgLockForInterest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockFromInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockFromInterest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockFromInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/UnlockFromInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockFromInterest(ctx, req.(*MsgUnlockFromInterest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimInterest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/ClaimInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimInterest(ctx, req.(*MsgClaimInterest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovSetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovSetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovSetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/GovSetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovSetParams(ctx, req.(*MsgGovSetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovUpdateRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateRegistry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.metoken.v1.Msg/GovUpdateRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateRegistry(ctx, req.(*MsgGovUpdateRegistry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "umee.metoken.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "SwapMulti",
+			Handler:    _Msg_SwapMulti_Handler,
+		},
+		{
+			MethodName: "RedeemProportional",
+			Handler:    _Msg_RedeemProportional_Handler,
+		},
+		{
+			MethodName: "SwapIndex",
+			Handler:    _Msg_SwapIndex_Handler,
+		},
+		{
+			MethodName: "LockForInterest",
+			Handler:    _Msg_LockForInterest_Handler,
+		},
+		{
+			MethodName: "UnlockFromInterest",
+			Handler:    _Msg_UnlockFromInterest_Handler,
+		},
+		{
+			MethodName: "ClaimInterest",
+			Handler:    _Msg_ClaimInterest_Handler,
+		},
+		{
+			MethodName: "GovSetParams",
+			Handler:    _Msg_GovSetParams_Handler,
+		},
+		{
+			MethodName: "GovUpdateRegistry",
+			Handler:    _Msg_GovUpdateRegistry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "umee/metoken/v1/tx.proto",
+}
+
+func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapMulti) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapMulti) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapMultiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapMultiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemProportional) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemProportional) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemProportional) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinAmountsOut) > 0 {
+		for iNdEx := len(m.MinAmountsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAmountsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemProportionalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemProportionalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemProportionalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Returned) > 0 {
+		for iNdEx := len(m.Returned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Returned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ToMetokenDenom) > 0 {
+		i -= len(m.ToMetokenDenom)
+		copy(dAtA[i:], m.ToMetokenDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToMetokenDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockForInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockForInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockForInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockForInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockForInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockForInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockFromInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockFromInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockFromInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metoken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockFromInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockFromInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockFromInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetokenDenom) > 0 {
+		i -= len(m.MetokenDenom)
+		copy(dAtA[i:], m.MetokenDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetokenDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovSetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovSetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateIndex) > 0 {
+		for iNdEx := len(m.UpdateIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddIndex) > 0 {
+		for iNdEx := len(m.AddIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Returned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Returned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapMulti) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Returned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemProportional) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.MinAmountsOut) > 0 {
+		for _, e := range m.MinAmountsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemProportionalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Returned) > 0 {
+		for _, e := range m.Returned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToMetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Returned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockForInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockForInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnlockFromInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metoken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnlockFromInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGovSetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovUpdateRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddIndex) > 0 {
+		for _, e := range m.AddIndex {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UpdateIndex) > 0 {
+		for _, e := range m.UpdateIndex {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovUpdateRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapMulti) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapMulti: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapMulti: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, types.Coin{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapMultiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapMultiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemProportional) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemProportional: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemProportional: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountsOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmountsOut = append(m.MinAmountsOut, types.Coin{})
+			if err := m.MinAmountsOut[len(m.MinAmountsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemProportionalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemProportionalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemProportionalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Returned = append(m.Returned, types.Coin{})
+			if err := m.Returned[len(m.Returned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToMetokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToMetokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgGovUpdateRegistryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgLockForInterest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1192,10 +4559,10 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockForInterest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockForInterest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1232,7 +4599,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1259,15 +4626,65 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockForInterestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockForInterestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockForInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1277,23 +4694,25 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1316,7 +4735,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockFromInterest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,17 +4758,17 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockFromInterest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockFromInterest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1359,28 +4778,27 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1407,7 +4825,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1432,7 +4850,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockFromInterestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1455,17 +4873,17 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockFromInterestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockFromInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1475,29 +4893,81 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimInterest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimInterest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimInterest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1507,28 +4977,27 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetokenDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1556,7 +5025,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			m.MetokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1579,7 +5048,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimInterestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,48 +5071,15 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimInterestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1670,7 +5106,8 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
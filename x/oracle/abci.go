@@ -1,7 +1,6 @@
 package oracle
 
 import (
-	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -15,6 +14,8 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.ExpirePriceOverrides(ctx)
+
 	params := k.GetParams(ctx)
 	if k.IsPeriodLastBlock(ctx, params.VotePeriod) {
 		if err := CalcPrices(ctx, params, k); err != nil {
@@ -23,9 +24,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	}
 
 	// Slash oracle providers who missed voting over the threshold and
-	// reset miss counters of all validators at the last block of slash window
+	// reset miss counters and performances of all validators at the last block of slash window
 	if k.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+		k.ClearValidatorPerformances(ctx)
 	}
 
 	k.PruneAllPrices(ctx)
@@ -34,18 +36,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 }
 
 func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
-	// Build claim map over all validators in active set
-	validatorClaimMap := make(map[string]types.Claim)
-	powerReduction := k.StakingKeeper.PowerReduction(ctx)
-	// Calculate total validator power
-	var totalBondedPower int64
-	for _, v := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
-		addr := v.GetOperator()
-		power := v.GetConsensusPower(powerReduction)
-		totalBondedPower += power
-		validatorClaimMap[addr.String()] = types.NewClaim(power, 0, 0, addr)
-	}
-
 	// voteTargets defines the symbol (ticker) denoms that we require votes on
 	voteTargets := make(map[string]bool, 0)
 	voteTargetDenoms := make([]string, 0)
@@ -54,30 +44,23 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 		voteTargetDenoms = append(voteTargetDenoms, v.BaseDenom)
 	}
 
-	// NOTE: it filters out inactive or jailed validators
-	// ballotDenomSlice is oracle votes of the symbol denoms, those are stored by AggregateExchangeRateVote
-	ballotDenomSlice := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
-	threshold := k.VoteThreshold(ctx).MulInt64(types.MaxVoteThresholdMultiplier).TruncateInt64()
-
-	// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
-	for _, ballotDenom := range ballotDenomSlice {
-		// Calculate the portion of votes received as an integer, scaled up using the
-		// same multiplier as the `threshold` computed above
-		support := ballotDenom.Ballot.Power() * types.MaxVoteThresholdMultiplier / totalBondedPower
-		if support < threshold {
-			ctx.Logger().Info("Ballot voting power is under vote threshold, dropping ballot", "denom", ballotDenom)
-			continue
-		}
-
-		denom := strings.ToUpper(ballotDenom.Denom)
-		// Get weighted median of exchange rates
-		exchangeRate, err := Tally(ballotDenom.Ballot, params.RewardBand, validatorClaimMap)
-		if err != nil {
-			return err
-		}
-		// save the exchange rate to store with denom and timestamp
-		k.SetExchangeRate(ctx, denom, exchangeRate)
+	// Tally the ballots of the vote period; dropped ballots have no exchange rate.
+	tally, err := k.TallyVotes(ctx, params)
+	if err != nil {
+		return err
 	}
+	// compare the new exchange rates with the current ones before replacing them
+	k.RecordPriceMoves(ctx, params.AcceptList, tally.ExchangeRates)
+	for i, er := range tally.ExchangeRates {
+		// save the exchange rate to store with denom, timestamp and ballot dispersion
+		k.SetTalliedExchangeRate(ctx, er.Denom, er.ExchangeRate, &tally.Confidences[i])
+	}
+	k.AddVotePerformance(ctx, tally.Performance)
+	// overridden denoms keep their manual exchange rate, even when their ballot is dropped
+	k.ApplyPriceOverrides(ctx)
+
+	// derived feeds are computed from the new exchange rates, and stamped like them
+	k.SetDerivedExchangeRates(ctx)
 
 	if k.IsPeriodLastBlock(ctx, params.HistoricStampPeriod) {
 		k.IterateExchangeRates(ctx, func(denom string, exgRate sdk.Dec, _ time.Time) (stop bool) {
@@ -87,7 +70,6 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 	}
 	// Calculate and stamp median/median deviation if median stamp period has passed
 	if k.IsPeriodLastBlock(ctx, params.MedianStampPeriod) {
-		var err error
 		k.IterateExchangeRates(ctx, func(denom string, _ sdk.Dec, _ time.Time) (stop bool) {
 			err = k.CalcAndSetHistoricMedian(ctx, denom)
 			return err != nil
@@ -99,8 +81,9 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 
 	// update miss counting & slashing
 	voteTargetsLen := len(voteTargets)
-	claimSlice := types.ClaimMapToSlice(validatorClaimMap)
-	for _, claim := range claimSlice {
+	for _, claim := range tally.Claims {
+		k.TrackOffenceHistory(ctx, claim.Validator)
+
 		// Skip valid voters
 		// in MsgAggregateExchangeRateVote we filter tokens from the AcceptList.
 		if int(claim.TokensVoted) == voteTargetsLen {
@@ -117,49 +100,10 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 		int64(params.VotePeriod),
 		int64(params.RewardDistributionWindow),
 		voteTargetDenoms,
-		claimSlice,
+		len(voteTargets),
+		tally.Claims,
 	)
 
 	k.ClearVotes(ctx, params.VotePeriod)
 	return nil
 }
-
-// Tally calculates and returns the median. It sets the set of voters to be
-// rewarded, i.e. voted within a reasonable spread from the weighted median to
-// the store. Note, the ballot is sorted by ExchangeRate.
-func Tally(
-	ballot types.ExchangeRateBallot,
-	rewardBand sdk.Dec,
-	validatorClaimMap map[string]types.Claim,
-) (sdk.Dec, error) {
-	weightedMedian, err := ballot.WeightedMedian()
-	if err != nil {
-		return sdk.ZeroDec(), err
-	}
-	standardDeviation, err := ballot.StandardDeviation()
-	if err != nil {
-		return sdk.ZeroDec(), err
-	}
-
-	// rewardSpread is the MAX((weightedMedian * (rewardBand/2)), standardDeviation)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
-	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
-
-	for _, tallyVote := range ballot {
-		// Filter ballot winners. For voters, we filter out the tally vote iff:
-		// (weightedMedian - rewardSpread) <= ExchangeRate <= (weightedMedian + rewardSpread)
-		if (tallyVote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			tallyVote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
-			!tallyVote.ExchangeRate.IsPositive() {
-
-			key := tallyVote.Voter.String()
-			claim := validatorClaimMap[key]
-
-			claim.Weight += tallyVote.Power
-			claim.TokensVoted++
-			validatorClaimMap[key] = claim
-		}
-	}
-
-	return weightedMedian, nil
-}
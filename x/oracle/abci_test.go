@@ -2,24 +2,29 @@ package oracle_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	tmrand "github.com/cometbft/cometbft/libs/rand"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 
 	umeeapp "github.com/umee-network/umee/v6/app"
 	appparams "github.com/umee-network/umee/v6/app/params"
+	"github.com/umee-network/umee/v6/util/checkers"
 	"github.com/umee-network/umee/v6/util/decmath"
 	"github.com/umee-network/umee/v6/x/oracle"
+	"github.com/umee-network/umee/v6/x/oracle/keeper"
 	"github.com/umee-network/umee/v6/x/oracle/types"
 )
 
@@ -168,12 +173,29 @@ func (s *IntegrationTestSuite) TestEndBlockerVoteThreshold() {
 	for _, denom := range app.OracleKeeper.AcceptList(ctx) {
 		rate, err := app.OracleKeeper.GetExchangeRate(ctx, denom.SymbolDenom)
 		s.Require().NoError(err)
+		// votes: 0.5 (39.8%), 0.6 (0.2%) and 1.0 (59.9%), so the quartiles are 0.5 and 1.0
 		s.Require().Equal(types.ExchangeRate{
 			Rate:      sdk.OneDec(),
 			Timestamp: ctx.BlockTime(),
+			Confidence: &types.PriceConfidence{
+				Denom:      strings.ToUpper(denom.SymbolDenom),
+				Voters:     3,
+				PowerShare: sdk.MustNewDecFromStr("0.999"),
+				Spread:     sdk.MustNewDecFromStr("0.5"),
+			},
 		}, rate)
 	}
 
+	// the ballot spread is above the max confidence spread
+	acceptList := app.OracleKeeper.AcceptList(ctx)
+	maxSpread := sdk.MustNewDecFromStr("0.4")
+	acceptList[0].MaxConfidenceSpread = &maxSpread
+	app.OracleKeeper.SetAcceptList(ctx, acceptList)
+	_, err := app.OracleKeeper.GetExchangeRate(ctx, acceptList[0].SymbolDenom)
+	s.Require().ErrorIs(err, types.ErrLowConfidence)
+	acceptList[0].MaxConfidenceSpread = nil
+	app.OracleKeeper.SetAcceptList(ctx, acceptList)
+
 	// prices during next case will still have this old timestamp
 	expiredTime := ctx.BlockTime()
 
@@ -196,10 +218,8 @@ func (s *IntegrationTestSuite) TestEndBlockerVoteThreshold() {
 		rate, err := app.OracleKeeper.GetExchangeRate(ctx, denom.SymbolDenom)
 		// price must exist, but with old timestamp
 		s.Require().NoError(err)
-		s.Require().Equal(types.ExchangeRate{
-			Rate:      sdk.OneDec(),
-			Timestamp: expiredTime,
-		}, rate)
+		s.Require().Equal(sdk.OneDec(), rate.Rate)
+		s.Require().Equal(expiredTime, rate.Timestamp)
 	}
 
 	// Test: val2 and val3 votes.
@@ -221,7 +241,8 @@ func (s *IntegrationTestSuite) TestEndBlockerVoteThreshold() {
 	for _, denom := range app.OracleKeeper.AcceptList(ctx) {
 		rate, err := app.OracleKeeper.GetExchangeRate(ctx, denom.SymbolDenom)
 		s.Require().NoError(err)
-		s.Require().Equal(types.ExchangeRate{Rate: sdk.NewDecWithPrec(5, 1), Timestamp: ctx.BlockTime()}, rate)
+		s.Require().Equal(sdk.NewDecWithPrec(5, 1), rate.Rate)
+		s.Require().Equal(ctx.BlockTime(), rate.Timestamp)
 	}
 
 	// TODO: check reward distribution
@@ -258,12 +279,215 @@ func (s *IntegrationTestSuite) TestEndBlockerVoteThreshold() {
 
 	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "umee")
 	s.Require().NoError(err)
-	s.Require().Equal(types.ExchangeRate{Rate: sdk.OneDec(), Timestamp: ctx.BlockTime()}, rate)
+	s.Require().Equal(sdk.OneDec(), rate.Rate)
+	s.Require().Equal(ctx.BlockTime(), rate.Timestamp)
 	rate, err = app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
 	s.Require().ErrorIs(err, types.ErrUnknownDenom.Wrap("ATOM"))
 	s.Require().Equal(types.ExchangeRate{}, rate)
 }
 
+func (s *IntegrationTestSuite) TestEndBlockerDenomVoteSettings() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+	votePeriod := int64(app.OracleKeeper.VotePeriod(ctx))
+
+	// val2 has 39.8% of the power, which is under the 40% vote threshold
+	// so only ATOM, which overrides the threshold, is updated
+	threshold := sdk.MustNewDecFromStr("0.35")
+	acceptList := append(app.OracleKeeper.AcceptList(ctx), types.Denom{
+		BaseDenom:     "uatom",
+		SymbolDenom:   "ATOM",
+		Exponent:      6,
+		VoteThreshold: &threshold,
+	})
+
+	vote := func(height int64, minVoters uint32) sdk.Context {
+		for i := range acceptList {
+			acceptList[i].MinVoters = minVoters
+		}
+		app.OracleKeeper.SetAcceptList(ctx, acceptList)
+		tuples := types.ExchangeRateTuples{}
+		for _, d := range acceptList {
+			tuples = append(tuples, types.ExchangeRateTuple{Denom: d.SymbolDenom, ExchangeRate: sdk.OneDec()})
+		}
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(ctx.BlockTime().Add(time.Duration(height) * time.Second))
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr2, types.AggregateExchangeRateVote{
+			ExchangeRateTuples: tuples,
+			Voter:              valAddr2.String(),
+		})
+		require.NoError(oracle.EndBlocker(ctx, app.OracleKeeper))
+		return ctx
+	}
+
+	ctx = vote(votePeriod-1, 0)
+	_, err := app.OracleKeeper.GetExchangeRate(ctx, "UMEE")
+	require.ErrorIs(err, types.ErrUnknownDenom)
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	require.NoError(err)
+	require.Equal(sdk.OneDec(), rate.Rate)
+
+	// a ballot with fewer voters than the minimum is dropped, so the price isn't updated
+	ctx = vote(2*votePeriod-1, 2)
+	updated, err := app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	require.NoError(err)
+	require.Equal(rate.Timestamp, updated.Timestamp)
+	require.True(updated.Timestamp.Before(ctx.BlockTime()))
+
+	resp, err := keeper.NewQuerier(app.OracleKeeper).DenomVoteSettings(ctx, &types.QueryDenomVoteSettings{Denom: "atom"})
+	require.NoError(err)
+	require.Equal([]types.DenomVoteSettings{{
+		SymbolDenom:   "ATOM",
+		VoteThreshold: threshold,
+		RewardBand:    app.OracleKeeper.RewardBand(ctx),
+		MinVoters:     2,
+	}}, resp.Settings)
+	_, err = keeper.NewQuerier(app.OracleKeeper).DenomVoteSettings(ctx, &types.QueryDenomVoteSettings{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrUnknownDenom)
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerDerivedFeeds() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+	votePeriod := app.OracleKeeper.VotePeriod(ctx)
+	app.OracleKeeper.SetHistoricStampPeriod(ctx, votePeriod)
+	app.OracleKeeper.SetMedianStampPeriod(ctx, votePeriod)
+
+	feed := types.DerivedFeed{
+		SymbolDenom: "STATOM",
+		Formula:     types.DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT,
+		Components:  []types.DerivedFeedComponent{{SymbolDenom: "ATOM", Weight: sdk.ZeroDec()}},
+		Multiplier:  sdk.MustNewDecFromStr("1.1"),
+	}
+	require.NoError(app.OracleKeeper.UpdateDerivedFeeds(ctx, []types.DerivedFeed{feed}, nil))
+
+	ctx = ctx.WithBlockHeight(int64(votePeriod) - 1)
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr1, types.AggregateExchangeRateVote{
+		ExchangeRateTuples: types.ExchangeRateTuples{{Denom: "ATOM", ExchangeRate: sdk.NewDec(10)}},
+		Voter:              valAddr1.String(),
+	})
+	require.NoError(oracle.EndBlocker(ctx, app.OracleKeeper))
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "STATOM")
+	require.NoError(err)
+	require.Equal(sdk.NewDec(11), rate.Rate)
+
+	// derived exchange rates are stamped like voted exchange rates
+	blockNum := uint64(ctx.BlockHeight())
+	historic := app.OracleKeeper.AllHistoricPrices(ctx).FilterByBlock(blockNum).FilterByDenom("STATOM")
+	require.Len(historic, 1)
+	require.Equal(sdk.NewDec(11), historic[0].ExchangeRateTuple.ExchangeRate)
+	medians := app.OracleKeeper.AllMedianPrices(ctx).FilterByBlock(blockNum).FilterByDenom("STATOM")
+	require.Len(medians, 1)
+	require.Equal(sdk.NewDec(11), medians[0].ExchangeRateTuple.ExchangeRate)
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerPriceOverride() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+	votePeriod := int64(app.OracleKeeper.VotePeriod(ctx))
+	require.NoError(app.OracleKeeper.OverridePrice(ctx, checkers.GovModuleAddr, displayDenom, sdk.NewDec(7), time.Hour))
+
+	vote := func(period int64, t time.Time, voted bool) sdk.Context {
+		ctx := ctx.WithBlockHeight(period*votePeriod - 1).WithBlockTime(t)
+		if voted {
+			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr1, types.AggregateExchangeRateVote{
+				ExchangeRateTuples: types.ExchangeRateTuples{{Denom: displayDenom, ExchangeRate: sdk.NewDec(10)}},
+				Voter:              valAddr1.String(),
+			})
+		}
+		require.NoError(oracle.EndBlocker(ctx, app.OracleKeeper))
+		return ctx
+	}
+
+	// the override replaces the tallied exchange rate
+	voteCtx := vote(1, ctx.BlockTime().Add(time.Minute), true)
+	rate, err := app.OracleKeeper.GetExchangeRate(voteCtx, displayDenom)
+	require.NoError(err)
+	require.Equal(types.ExchangeRate{Rate: sdk.NewDec(7), Timestamp: voteCtx.BlockTime()}, rate)
+
+	// the override is applied when the ballot is dropped
+	voteCtx = vote(2, ctx.BlockTime().Add(2*time.Minute), false)
+	rate, err = app.OracleKeeper.GetExchangeRate(voteCtx, displayDenom)
+	require.NoError(err)
+	require.Equal(types.ExchangeRate{Rate: sdk.NewDec(7), Timestamp: voteCtx.BlockTime()}, rate)
+
+	// expired overrides are removed before the tally
+	voteCtx = vote(3, ctx.BlockTime().Add(time.Hour), true)
+	require.Empty(app.OracleKeeper.AllPriceOverrides(voteCtx))
+	rate, err = app.OracleKeeper.GetExchangeRate(voteCtx, displayDenom)
+	require.NoError(err)
+	require.Equal(sdk.NewDec(10), rate.Rate)
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerValidatorPerformance() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+	params := app.OracleKeeper.GetParams(ctx)
+	votePeriod := int64(params.VotePeriod)
+	params.SlashWindow = params.VotePeriod * 3
+	app.OracleKeeper.SetParams(ctx, params)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(types.UmeeDenom, 1_000_000_000_000))
+	require.NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, rewards))
+
+	vote := func(period int64, rates map[string]string) sdk.Context {
+		ctx := ctx.WithBlockHeight(period*votePeriod - 1)
+		for _, val := range []sdk.ValAddress{valAddr1, valAddr2, valAddr3} {
+			rate, ok := rates[val.String()]
+			if !ok {
+				continue
+			}
+			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, val, types.AggregateExchangeRateVote{
+				ExchangeRateTuples: types.ExchangeRateTuples{{Denom: "UMEE", ExchangeRate: sdk.MustNewDecFromStr(rate)}},
+				Voter:              val.String(),
+			})
+		}
+		require.NoError(oracle.EndBlocker(ctx, app.OracleKeeper))
+		return ctx
+	}
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	performance := func(ctx sdk.Context, val sdk.ValAddress) types.ValidatorPerformance {
+		resp, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformance{Validator: val.String()})
+		require.NoError(err)
+		require.Len(resp.Performances, 1)
+		return resp.Performances[0]
+	}
+
+	// val2 votes outside of the reward band
+	ctx = vote(1, map[string]string{valAddr1.String(): "1", valAddr2.String(): "2", valAddr3.String(): "1"})
+	perf1 := performance(ctx, valAddr1)
+	require.Equal([]types.DenomPerformance{{
+		Denom: "UMEE", VotesCast: 1, VotesInBand: 1, AvgAbsDeviation: sdk.ZeroDec(),
+	}}, perf1.Denoms)
+	require.True(perf1.Rewards.IsAllPositive())
+	perf2 := performance(ctx, valAddr2)
+	require.Equal([]types.DenomPerformance{{
+		Denom: "UMEE", VotesCast: 1, VotesInBand: 0, AvgAbsDeviation: sdk.OneDec(),
+	}}, perf2.Denoms)
+	require.True(perf2.Rewards.Empty())
+
+	// abstentions aren't counted
+	ctx = vote(2, map[string]string{valAddr1.String(): "1", valAddr2.String(): "1", valAddr3.String(): "0"})
+	require.Equal([]types.DenomPerformance{{
+		Denom: "UMEE", VotesCast: 2, VotesInBand: 1, AvgAbsDeviation: sdk.MustNewDecFromStr("0.5"),
+	}}, performance(ctx, valAddr2).Denoms)
+	require.Equal(uint64(1), performance(ctx, valAddr3).Denoms[0].VotesCast)
+
+	resp, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformance{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(err)
+	require.Len(resp.Performances, 2)
+	require.NotNil(resp.Pagination.NextKey)
+	resp, err = querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformance{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(resp.Performances, 1)
+
+	// performances are reset at the end of the slash window
+	ctx = vote(3, map[string]string{})
+	require.Empty(app.OracleKeeper.AllValidatorPerformances(ctx))
+	require.Empty(performance(ctx, valAddr1).Denoms)
+}
+
 var exchangeRates = map[string][]sdk.Dec{
 	"ATOM": {
 		sdk.MustNewDecFromStr("12.99"),
@@ -370,6 +594,42 @@ func (s *IntegrationTestSuite) TestEndblockerHistoracle() {
 	}
 }
 
+func TestTallyStrategy(t *testing.T) {
+	ballot := types.ExchangeRateBallot{}
+	claims := map[string]types.Claim{}
+	for i, rate := range []int64{1, 9, 10, 10, 11, 100} {
+		valAddr := sdk.ValAddress([]byte{byte(i)})
+		ballot = append(ballot, types.NewVoteForTally(sdk.NewDec(rate), displayDenom, valAddr, 1))
+		claims[valAddr.String()] = types.NewClaim(1, 0, 0, valAddr)
+	}
+	lowVoter := ballot[0].Voter.String()
+	rewardBand := sdk.NewDecWithPrec(2, 2)
+
+	// the standard deviation is large enough for the low vote to be rewarded with the weighted median
+	rate, winners, err := keeper.Tally(ballot, types.Denom{}, rewardBand, claims)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), rate)
+	require.Equal(t, int64(1), claims[lowVoter].Weight)
+	require.Len(t, winners, 5)
+
+	// the low vote is an outlier, which is not rewarded with the MAD filtered median, and the reward
+	// spread is computed without it
+	for addr, claim := range claims {
+		claims[addr] = types.NewClaim(claim.Power, 0, 0, claim.Validator)
+	}
+	madMultiplier := sdk.NewDec(3)
+	denom := types.Denom{
+		TallyStrategy: types.TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN,
+		MadMultiplier: &madMultiplier,
+	}
+	rate, winners, err = keeper.Tally(ballot, denom, rewardBand, claims)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), rate)
+	require.Equal(t, int64(0), claims[lowVoter].Weight)
+	require.Equal(t, int64(1), claims[ballot[2].Voter.String()].Weight)
+	require.NotContains(t, winners, ballot[0])
+}
+
 func TestOracleTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
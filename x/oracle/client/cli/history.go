@@ -0,0 +1,309 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+const (
+	flagDenom    = "denom"
+	flagFrom     = "from"
+	flagTo       = "to"
+	flagFormat   = "format"
+	flagOutput   = "output-file"
+	flagPageSize = "page-size"
+	flagEndBlock = "end-block"
+
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+)
+
+var csvHeader = []string{"kind", "denom", "block", "value"}
+
+// QueryExportHistory implements the export history command.
+func QueryExportHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-history",
+		Args:  cobra.NoArgs,
+		Short: "Export the historic prices, medians and median deviations of a denom",
+		Long: strings.TrimSpace(`
+Export the historic prices, medians and median deviations of a denom, within an optional range
+of blocks, as CSV (kind,denom,block,value) or JSON lines. Records are written page by page, as
+they are fetched: historic prices first, then medians with their median deviations, each kind in
+block order. The output can seed a local network with patch-genesis-history.
+
+$ umeed query oracle export-history --denom UMEE --from 1000 --to 2000 --format csv
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, _ := cmd.Flags().GetString(flagDenom)
+			if denom == "" {
+				return fmt.Errorf("--%s is required", flagDenom)
+			}
+			from, _ := cmd.Flags().GetUint64(flagFrom)
+			to, _ := cmd.Flags().GetUint64(flagTo)
+			pageSize, _ := cmd.Flags().GetUint64(flagPageSize)
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if format != formatCSV && format != formatJSONL {
+				return fmt.Errorf("unknown format %q, expected %s or %s", format, formatCSV, formatJSONL)
+			}
+
+			out := cmd.OutOrStdout()
+			if path, _ := cmd.Flags().GetString(flagOutput); path != "" {
+				f, err := os.Create(path)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			hw, err := NewHistoryWriter(out, format)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			var next []byte
+			for {
+				res, err := queryClient.HistoricPrices(cmd.Context(), &types.QueryHistoricPrices{
+					Denom: denom, FromBlock: from, ToBlock: to,
+					Pagination: &query.PageRequest{Key: next, Limit: pageSize},
+				})
+				if err != nil {
+					return err
+				}
+				if err := hw.Write(types.NewHistoryRecords(types.HistoryKindPrice, res.Prices)); err != nil {
+					return err
+				}
+				if next = res.Pagination.GetNextKey(); len(next) == 0 {
+					break
+				}
+			}
+			for {
+				res, err := queryClient.HistoricMedians(cmd.Context(), &types.QueryHistoricMedians{
+					Denom: denom, FromBlock: from, ToBlock: to,
+					Pagination: &query.PageRequest{Key: next, Limit: pageSize},
+				})
+				if err != nil {
+					return err
+				}
+				if err := hw.Write(types.NewHistoryRecords(types.HistoryKindMedian, res.Medians)); err != nil {
+					return err
+				}
+				err = hw.Write(types.NewHistoryRecords(types.HistoryKindMedianDeviation, res.MedianDeviations))
+				if err != nil {
+					return err
+				}
+				if next = res.Pagination.GetNextKey(); len(next) == 0 {
+					break
+				}
+			}
+			return hw.Flush()
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "symbol denom of the exported history")
+	cmd.Flags().Uint64(flagFrom, 0, "first block of the exported history")
+	cmd.Flags().Uint64(flagTo, 0, "last block of the exported history, 0 for the latest")
+	cmd.Flags().String(flagFormat, formatCSV, "output format: csv or jsonl")
+	cmd.Flags().String(flagOutput, "", "write to the given file instead of stdout")
+	cmd.Flags().Uint64(flagPageSize, 100, "number of stamps fetched per query")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PatchGenesisHistoryCmd returns the command replacing the price history of the denoms of
+// an exported history file in the genesis file.
+func PatchGenesisHistoryCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch-genesis-history [history-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Seed the oracle price history of genesis.json with an exported history",
+		Long: strings.TrimSpace(`
+Replace the historic prices, medians and median deviations of the denoms of a history file,
+exported with "query oracle export-history", in the oracle genesis state of genesis.json.
+The format is taken from the file extension (.csv, or JSON lines otherwise), unless --format is
+set. With --end-block, the history is shifted so that its last stamp lands at that block, and
+stamps shifted before the first block are dropped.
+
+$ umeed patch-genesis-history umee.csv --end-block 1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if format == "" {
+				format = formatJSONL
+				if strings.HasSuffix(args[0], "."+formatCSV) {
+					format = formatCSV
+				}
+			}
+			endBlock, _ := cmd.Flags().GetUint64(flagEndBlock)
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			records, err := ReadHistory(f, format)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			oracleGenState := types.GetGenesisStateFromAppState(cdc, appState)
+			if err := oracleGenState.PatchHistory(records, endBlock); err != nil {
+				return err
+			}
+			if err := types.ValidateGenesis(oracleGenState); err != nil {
+				return err
+			}
+
+			appState[types.ModuleName], err = cdc.MarshalJSON(oracleGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal oracle genesis state: %w", err)
+			}
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagFormat, "", "history file format: csv or jsonl")
+	cmd.Flags().Uint64(flagEndBlock, 0, "shift the history so that it ends at this block")
+	return cmd
+}
+
+// HistoryWriter writes history records in the csv or jsonl format, in batches, so that
+// the whole history doesn't have to be held in memory.
+type HistoryWriter struct {
+	jsonl *json.Encoder
+	csv   *csv.Writer
+}
+
+// NewHistoryWriter creates a HistoryWriter, and writes the csv header.
+func NewHistoryWriter(w io.Writer, format string) (*HistoryWriter, error) {
+	switch format {
+	case formatJSONL:
+		return &HistoryWriter{jsonl: json.NewEncoder(w)}, nil
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		return &HistoryWriter{csv: cw}, cw.Write(csvHeader)
+	}
+	return nil, fmt.Errorf("unknown format %q, expected %s or %s", format, formatCSV, formatJSONL)
+}
+
+// Write writes a batch of history records.
+func (hw *HistoryWriter) Write(records []types.HistoryRecord) error {
+	for _, r := range records {
+		if hw.jsonl != nil {
+			if err := hw.jsonl.Encode(r); err != nil {
+				return err
+			}
+			continue
+		}
+		row := []string{r.Kind, r.Denom, strconv.FormatUint(r.Block, 10), r.Value.String()}
+		if err := hw.csv.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush flushes the buffered csv records. It must be called after the last Write.
+func (hw *HistoryWriter) Flush() error {
+	if hw.csv == nil {
+		return nil
+	}
+	hw.csv.Flush()
+	return hw.csv.Error()
+}
+
+// WriteHistory writes the history records in the csv or jsonl format.
+func WriteHistory(w io.Writer, format string, records []types.HistoryRecord) error {
+	hw, err := NewHistoryWriter(w, format)
+	if err != nil {
+		return err
+	}
+	if err := hw.Write(records); err != nil {
+		return err
+	}
+	return hw.Flush()
+}
+
+// ReadHistory reads history records in the csv or jsonl format.
+func ReadHistory(r io.Reader, format string) ([]types.HistoryRecord, error) {
+	records := []types.HistoryRecord{}
+	switch format {
+	case formatJSONL:
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			var record types.HistoryRecord
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		return records, scanner.Err()
+	case formatCSV:
+		rows, err := csv.NewReader(r).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		for i, row := range rows {
+			if i == 0 && row[0] == csvHeader[0] {
+				continue
+			}
+			if len(row) != len(csvHeader) {
+				return nil, fmt.Errorf("line %d: expected %d fields, got %d", i+1, len(csvHeader), len(row))
+			}
+			block, err := strconv.ParseUint(row[2], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			value, err := sdk.NewDecFromStr(row[3])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			records = append(records, types.HistoryRecord{Kind: row[0], Denom: row[1], Block: block, Value: value})
+		}
+		return records, nil
+	}
+	return nil, fmt.Errorf("unknown format %q, expected %s or %s", format, formatCSV, formatJSONL)
+}
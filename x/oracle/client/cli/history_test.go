@@ -0,0 +1,54 @@
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/client/cli"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestHistoryFormats(t *testing.T) {
+	records := []types.HistoryRecord{
+		{Kind: types.HistoryKindPrice, Denom: "UMEE", Block: 10, Value: sdk.MustNewDecFromStr("0.012")},
+		{Kind: types.HistoryKindMedianDeviation, Denom: "UMEE", Block: 20, Value: sdk.ZeroDec()},
+	}
+
+	var buf bytes.Buffer
+	assert.NilError(t, cli.WriteHistory(&buf, "csv", records))
+	assert.Equal(t, "kind,denom,block,value\n"+
+		"price,UMEE,10,0.012000000000000000\n"+
+		"median_deviation,UMEE,20,0.000000000000000000\n", buf.String())
+	read, err := cli.ReadHistory(&buf, "csv")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, records, read)
+
+	buf.Reset()
+	assert.NilError(t, cli.WriteHistory(&buf, "jsonl", records))
+	assert.Equal(t, `{"kind":"price","denom":"UMEE","block":10,"value":"0.012000000000000000"}`+"\n"+
+		`{"kind":"median_deviation","denom":"UMEE","block":20,"value":"0.000000000000000000"}`+"\n", buf.String())
+	read, err = cli.ReadHistory(&buf, "jsonl")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, records, read)
+
+	// records written in batches
+	buf.Reset()
+	hw, err := cli.NewHistoryWriter(&buf, "csv")
+	assert.NilError(t, err)
+	assert.NilError(t, hw.Write(records[:1]))
+	assert.NilError(t, hw.Write(records[1:]))
+	assert.NilError(t, hw.Flush())
+	read, err = cli.ReadHistory(&buf, "csv")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, records, read)
+
+	_, err = cli.NewHistoryWriter(&buf, "xml")
+	assert.ErrorContains(t, err, `unknown format "xml"`)
+	_, err = cli.ReadHistory(&buf, "xml")
+	assert.ErrorContains(t, err, `unknown format "xml"`)
+	_, err = cli.ReadHistory(bytes.NewBufferString("price,UMEE,ten,1\n"), "csv")
+	assert.ErrorContains(t, err, "line 1")
+}
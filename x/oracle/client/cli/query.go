@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,10 +31,19 @@ func GetQueryCmd() *cobra.Command {
 		QueryExchangeRates(),
 		QueryExchangeRate(),
 		QueryFeederDelegation(),
+		QueryFeeders(),
 		QueryMissCounter(),
 		QuerySlashWindow(),
 		QueryHistoricAvgPrice(),
 		QueryExchangeRatesWithTimestamp(),
+		QueryDenomVoteSettings(),
+		QueryDerivedFeeds(),
+		QueryValidatorPerformance(),
+		QueryPriceWindow(),
+		QueryRewardDistribution(),
+		QueryValidatorOffences(),
+		QueryPriceVolatility(),
+		QueryExportHistory(),
 	)
 
 	return cmd
@@ -231,6 +241,33 @@ func QueryFeederDelegation() *cobra.Command {
 	return cmd
 }
 
+// QueryFeeders implements the query feeders command.
+func QueryFeeders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeders [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the feeder delegation and the standby feeders of a given validator address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err = sdk.ValAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			res, err := queryClient.Feeders(cmd.Context(), &types.QueryFeeders{
+				ValidatorAddr: args[0],
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // QueryMissCounter implements the miss counter query command.
 func QueryMissCounter() *cobra.Command {
 	cmd := &cobra.Command{
@@ -332,3 +369,237 @@ $ umeed query oracle exg-rates-timestamp
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// QueryDenomVoteSettings implements the query denom vote settings command.
+func QueryDenomVoteSettings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-settings [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the effective vote threshold, reward band and minimum voters of accepted denoms",
+		Long: strings.TrimSpace(`
+Query the vote threshold, reward band and minimum number of voters of all accepted denoms,
+or of a single denom, after applying the denom overrides of the accept list to the params.
+
+$ umeed query oracle vote-settings ATOM
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			query := &types.QueryDenomVoteSettings{}
+			if len(args) > 0 {
+				query.Denom = args[0]
+			}
+			res, err := queryClient.DenomVoteSettings(cmd.Context(), query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryDerivedFeeds implements the query derived feeds command.
+func QueryDerivedFeeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derived-feeds",
+		Args:  cobra.NoArgs,
+		Short: "Query the derived price feeds",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DerivedFeeds(cmd.Context(), &types.QueryDerivedFeeds{})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryValidatorPerformance implements the query validator performance command.
+func QueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [validator]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the oracle performance of validators over the current slash window",
+		Long: strings.TrimSpace(`
+Query the votes cast, votes inside the reward band, average absolute deviation from the
+exchange rate by denom, and rewards earned, of all validators or of a single validator,
+over the current slash window.
+
+$ umeed query oracle validator-performance umeevaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			query := &types.QueryValidatorPerformance{Pagination: pageReq}
+			if len(args) > 0 {
+				query.Validator = args[0]
+			}
+			res, err := queryClient.ValidatorPerformance(cmd.Context(), query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-performance")
+	return cmd
+}
+
+// QueryPriceWindow implements the query price window command.
+func QueryPriceWindow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-window [denom] [window-blocks] [resolution-blocks]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query the TWAP, min, max, open and close historic prices of a denom over a block window",
+		Long: strings.TrimSpace(`
+Query the time weighted average, min, max, open and close historic prices of a denom over the
+last window-blocks blocks. If resolution-blocks is provided, OHLC candles of resolution-blocks
+blocks are returned as well. resolution-blocks must divide window-blocks.
+
+$ umeed query oracle price-window UMEE 3600 600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			query := &types.QueryPriceWindow{Denom: strings.ToUpper(args[0]), WindowBlocks: window}
+			if len(args) > 2 {
+				if query.ResolutionBlocks, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+					return err
+				}
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceWindow(cmd.Context(), query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryRewardDistribution implements the query reward distribution command.
+func QueryRewardDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-distribution [formula] [full-coverage-bonus]",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Dry run the oracle rewards distribution of the current vote period",
+		Long: strings.TrimSpace(`
+Tally the votes submitted in the current vote period, and show how the vote period share of
+the reward pool would be split among the validators. The current reward formula and full
+coverage bonus are used, unless provided. Formulas: claim-weight, accuracy-weighted.
+
+$ umeed query oracle reward-distribution accuracy-weighted 0.1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			query := &types.QueryRewardDistribution{}
+			if len(args) > 0 {
+				name := "REWARD_FORMULA_" + strings.ToUpper(strings.ReplaceAll(args[0], "-", "_"))
+				formula, ok := types.RewardFormula_value[name]
+				if !ok {
+					return fmt.Errorf("unknown reward formula %s", args[0])
+				}
+				query.Formula = types.RewardFormula(formula)
+			}
+			if len(args) > 1 {
+				query.FullCoverageBonus = args[1]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RewardDistribution(cmd.Context(), query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryValidatorOffences implements the query validator offences command.
+func QueryValidatorOffences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-offences [validator]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the oracle offence history of validators",
+		Long: strings.TrimSpace(`
+Query the oracle offences (slash windows with a valid vote rate below min_valid_per_window)
+and their penalties, of all validators or of a single validator, with the penalty params.
+
+$ umeed query oracle validator-offences umeevaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			query := &types.QueryValidatorOffences{Pagination: pageReq}
+			if len(args) > 0 {
+				query.Validator = args[0]
+			}
+			res, err := queryClient.ValidatorOffences(cmd.Context(), query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-offences")
+	return cmd
+}
+
+// QueryPriceVolatility implements the query price volatility command.
+func QueryPriceVolatility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-volatility [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the rolling realized volatility of the exchange rates",
+		Long: strings.TrimSpace(`
+Query the estimated standard deviation of the relative change of the exchange rate over a
+vote period, of all denoms or of a single denom.
+
+$ umeed query oracle price-volatility UMEE
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			query := &types.QueryPriceVolatility{}
+			if len(args) > 0 {
+				query.Denom = args[0]
+			}
+			res, err := queryClient.PriceVolatility(cmd.Context(), query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -35,9 +36,16 @@ func GetTxCmd() *cobra.Command {
 // broadcast a transaction with a MsgDelegateFeedConsent message.
 func DelegateFeedConsent() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegate-feed-consent [operator] [feeder]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Delegate oracle feed consent from an operator to another feeder address",
+		Use:   "delegate-feed-consent [operator] [feeder] [standby-feeder]...",
+		Args:  cobra.RangeArgs(2, 2+types.MaxStandbyFeeders),
+		Short: "Delegate oracle feed consent from an operator to another feeder address, and standby feeders",
+		Long: strings.TrimSpace(`
+Delegate oracle feed consent from an operator to a feeder address. Optional standby feeders are
+allowed to vote on behalf of the operator as well, e.g. for high availability or key rotation.
+They replace the previous standby feeders of the operator.
+
+$ umeed tx oracle delegate-feed-consent umeevaloper... umee1feeder... umee1standby...
+`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
 				return err
@@ -53,7 +61,16 @@ func DelegateFeedConsent() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgDelegateFeedConsent(sdk.ValAddress(clientCtx.GetFromAddress()), feederAddr)
+			var standby []sdk.AccAddress
+			for _, a := range args[2:] {
+				standbyAddr, err := sdk.AccAddressFromBech32(a)
+				if err != nil {
+					return err
+				}
+				standby = append(standby, standbyAddr)
+			}
+
+			msg := types.NewMsgDelegateFeedConsent(sdk.ValAddress(clientCtx.GetFromAddress()), feederAddr, standby...)
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
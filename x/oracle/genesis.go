@@ -21,6 +21,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 		util.Panic(err)
 
 		keeper.SetFeederDelegation(ctx, voter, feeder)
+
+		standby := make([]sdk.AccAddress, 0, len(d.StandbyFeederAddresses))
+		for _, f := range d.StandbyFeederAddresses {
+			standbyFeeder, err := sdk.AccAddressFromBech32(f)
+			util.Panic(err)
+			standby = append(standby, standbyFeeder)
+		}
+		keeper.SetStandbyFeeders(ctx, voter, standby)
 	}
 
 	for _, ex := range genState.ExchangeRates {
@@ -67,6 +75,21 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 
 	keeper.SetParams(ctx, genState.Params)
 
+	for _, f := range genState.DerivedFeeds {
+		keeper.SetDerivedFeed(ctx, f)
+	}
+
+	for _, vp := range genState.ValidatorPerformances {
+		operator, err := sdk.ValAddressFromBech32(vp.Validator)
+		util.Panic(err)
+
+		keeper.SetValidatorPerformance(ctx, operator, vp)
+	}
+
+	for _, o := range genState.PriceOverrides {
+		keeper.SetPriceOverride(ctx, o)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -76,6 +99,20 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 	// set historic avg counter params (avgPeriod and avgShift)
 	err := keeper.SetHistoricAvgCounterParams(ctx, genState.AvgCounterParams)
 	util.Panic(err)
+
+	util.Panic(keeper.SetRewardParams(ctx, genState.RewardParams))
+	util.Panic(keeper.SetPenaltyParams(ctx, genState.PenaltyParams))
+
+	for _, h := range genState.OffenceHistories {
+		operator, err := sdk.ValAddressFromBech32(h.Validator)
+		util.Panic(err)
+
+		keeper.SetOffenceHistory(ctx, operator, h)
+	}
+
+	for _, v := range genState.PriceVolatilities {
+		keeper.SetPriceVolatility(ctx, v)
+	}
 }
 
 // ExportGenesis returns the x/oracle module's exported genesis.
@@ -84,9 +121,14 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 
 	feederDelegations := []types.FeederDelegation{}
 	keeper.IterateFeederDelegations(ctx, func(valAddr sdk.ValAddress, feederAddr sdk.AccAddress) (stop bool) {
+		var standby []string
+		for _, f := range keeper.GetStandbyFeeders(ctx, valAddr) {
+			standby = append(standby, f.String())
+		}
 		feederDelegations = append(feederDelegations, types.FeederDelegation{
-			ValidatorAddress: valAddr.String(),
-			FeederAddress:    feederAddr.String(),
+			ValidatorAddress:       valAddr.String(),
+			FeederAddress:          feederAddr.String(),
+			StandbyFeederAddresses: standby,
 		})
 
 		return false
@@ -130,6 +172,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	medianPrices := keeper.AllMedianPrices(ctx)
 	medianDeviationPrices := keeper.AllMedianDeviationPrices(ctx)
 	hacp := keeper.GetHistoricAvgCounterParams(ctx)
+	derivedFeeds := keeper.AllDerivedFeeds(ctx)
+	validatorPerformances := keeper.AllValidatorPerformances(ctx)
+	priceOverrides := keeper.AllPriceOverrides(ctx)
+	rewardParams := keeper.GetRewardParams(ctx)
+	penaltyParams := keeper.GetPenaltyParams(ctx)
+	offenceHistories := keeper.AllOffenceHistories(ctx)
+	priceVolatilities := keeper.AllPriceVolatilities(ctx)
 
 	return types.NewGenesisState(
 		params,
@@ -142,5 +191,12 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		medianPrices,
 		medianDeviationPrices,
 		hacp,
+		derivedFeeds,
+		validatorPerformances,
+		priceOverrides,
+		rewardParams,
+		penaltyParams,
+		offenceHistories,
+		priceVolatilities,
 	)
 }
@@ -55,7 +55,8 @@ func (s *IntegrationTestSuite) TestGenesis_InitGenesis() {
 		{
 			"valid",
 			types.GenesisState{
-				Params: types.DefaultParams(),
+				Params:       types.DefaultParams(),
+				RewardParams: types.DefaultRewardParams(),
 				ExchangeRates: []types.DenomExchangeRate{
 					{
 						Denom:     denom,
@@ -151,8 +152,9 @@ func (s *IntegrationTestSuite) TestGenesis_ExportGenesis() {
 
 	feederDelegations := []types.FeederDelegation{
 		{
-			FeederAddress:    umeeAddr,
-			ValidatorAddress: umeevaloperAddr,
+			FeederAddress:          umeeAddr,
+			ValidatorAddress:       umeevaloperAddr,
+			StandbyFeederAddresses: []string{sdk.AccAddress([]byte("standby_____________")).String()},
 		},
 	}
 	exchangeRateTuples := []types.DenomExchangeRate{
@@ -205,6 +207,42 @@ func (s *IntegrationTestSuite) TestGenesis_ExportGenesis() {
 		},
 	}
 	hacp := types.DefaultAvgCounterParams()
+	rewardParams := types.RewardParams{
+		Formula:           types.RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED,
+		FullCoverageBonus: sdk.NewDecWithPrec(2, 1),
+	}
+	penaltyParams := types.PenaltyParams{
+		Warnings:       1,
+		Jails:          1,
+		SlashFractions: []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 2)},
+		GracePeriod:    100,
+		RepeatWindows:  4,
+	}
+	offenceHistories := []types.OffenceHistory{
+		{
+			Validator:   umeevaloperAddr,
+			SinceHeight: 3,
+			Level:       1,
+			Offences: []types.Offence{{
+				Height:        7,
+				ValidVoteRate: sdk.NewDecWithPrec(2, 1),
+				Level:         1,
+				Penalty:       types.OffencePenalty_OFFENCE_PENALTY_WARNING,
+				SlashFraction: sdk.ZeroDec(),
+			}},
+		},
+	}
+	priceVolatilities := []types.PriceVolatility{
+		{Denom: upperDenom, Variance: sdk.NewDecWithPrec(4, 4), Samples: 12},
+	}
+	derivedFeeds := []types.DerivedFeed{
+		{
+			SymbolDenom: "STUMEE",
+			Formula:     types.DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT,
+			Components:  []types.DerivedFeedComponent{{SymbolDenom: upperDenom, Weight: sdk.ZeroDec()}},
+			Multiplier:  sdk.OneDec(),
+		},
+	}
 
 	genesisState := types.GenesisState{
 		Params:                        params,
@@ -217,6 +255,11 @@ func (s *IntegrationTestSuite) TestGenesis_ExportGenesis() {
 		HistoricPrices:                historicPrices,
 		MedianDeviations:              medianDeviations,
 		AvgCounterParams:              hacp,
+		DerivedFeeds:                  derivedFeeds,
+		RewardParams:                  rewardParams,
+		PenaltyParams:                 penaltyParams,
+		OffenceHistories:              offenceHistories,
+		PriceVolatilities:             priceVolatilities,
 	}
 
 	oracle.InitGenesis(ctx, keeper, genesisState)
@@ -232,4 +275,9 @@ func (s *IntegrationTestSuite) TestGenesis_ExportGenesis() {
 	assert.DeepEqual(s.T(), historicPrices, result.HistoricPrices)
 	assert.DeepEqual(s.T(), medianDeviations, result.MedianDeviations)
 	assert.DeepEqual(s.T(), hacp, result.AvgCounterParams)
+	assert.DeepEqual(s.T(), derivedFeeds, result.DerivedFeeds)
+	assert.DeepEqual(s.T(), rewardParams, result.RewardParams)
+	assert.DeepEqual(s.T(), penaltyParams, result.PenaltyParams)
+	assert.DeepEqual(s.T(), offenceHistories, result.OffenceHistories)
+	assert.DeepEqual(s.T(), priceVolatilities, result.PriceVolatilities)
 }
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// AllDerivedFeeds returns all derived feeds, ordered by symbol denom.
+func (k Keeper) AllDerivedFeeds(ctx sdk.Context) []types.DerivedFeed {
+	return store.MustLoadAll[*types.DerivedFeed](ctx.KVStore(k.storeKey), types.KeyPrefixDerivedFeed)
+}
+
+// derivedFeed returns the derived feed of a symbol denom, or nil if there is none.
+func (k Keeper) derivedFeed(ctx sdk.Context, symbol string) *types.DerivedFeed {
+	return store.GetValue[*types.DerivedFeed](ctx.KVStore(k.storeKey), types.KeyDerivedFeed(symbol), "derived_feed")
+}
+
+// SetDerivedFeed stores a derived feed without validating it.
+// NOTE: must not be used outside of genesis import. Use UpdateDerivedFeeds instead.
+func (k Keeper) SetDerivedFeed(ctx sdk.Context, feed types.DerivedFeed) {
+	err := store.SetValue(ctx.KVStore(k.storeKey), types.KeyDerivedFeed(feed.SymbolDenom), &feed, "derived_feed")
+	util.Panic(err)
+}
+
+// UpdateDerivedFeeds sets and deletes derived feeds. The resulting registry is validated
+// against the accept list. Exchange rates of deleted derived feeds are removed.
+func (k Keeper) UpdateDerivedFeeds(ctx sdk.Context, set []types.DerivedFeed, del []string) error {
+	feeds := map[string]types.DerivedFeed{}
+	for _, f := range k.AllDerivedFeeds(ctx) {
+		feeds[strings.ToUpper(f.SymbolDenom)] = f
+	}
+	for _, d := range del {
+		symbol := strings.ToUpper(d)
+		if _, ok := feeds[symbol]; !ok {
+			return types.ErrUnknownDenom.Wrapf("derived feed %s", d)
+		}
+		delete(feeds, symbol)
+	}
+	for _, f := range set {
+		feeds[strings.ToUpper(f.SymbolDenom)] = f
+	}
+
+	registry := make([]types.DerivedFeed, 0, len(feeds))
+	for _, f := range feeds {
+		registry = append(registry, f)
+	}
+	if err := types.ValidateDerivedFeeds(registry, k.AcceptList(ctx)); err != nil {
+		return err
+	}
+
+	kvs := ctx.KVStore(k.storeKey)
+	for _, d := range del {
+		kvs.Delete(types.KeyDerivedFeed(d))
+		kvs.Delete(types.KeyExchangeRate(d))
+	}
+	for _, f := range set {
+		k.SetDerivedFeed(ctx, f)
+	}
+	return nil
+}
+
+// SetDerivedExchangeRates computes and stores the exchange rates of all derived feeds. It
+// must be called after the voted exchange rates are set. Derived feeds with a missing, stale or
+// low confidence component exchange rate are skipped, and keep their previous exchange rate, which
+// becomes stale after the largest max price age of the components (see DerivedFeed.MaxPriceAge). Derived feeds
+// shadowed by an accept list denom (added after the derived feed) are skipped as well.
+func (k Keeper) SetDerivedExchangeRates(ctx sdk.Context) {
+	acceptList := k.AcceptList(ctx)
+	rate := func(symbol string) (sdk.Dec, error) {
+		er, err := k.getExchangeRate(ctx, symbol, acceptList)
+		return er.Rate, err
+	}
+
+	for _, f := range k.AllDerivedFeeds(ctx) {
+		if acceptList.Contains(f.SymbolDenom) {
+			continue
+		}
+		exchangeRate, err := f.Evaluate(rate)
+		if err != nil {
+			ctx.Logger().Info("Can't compute derived exchange rate, skipping",
+				"denom", f.SymbolDenom, "error", err)
+			continue
+		}
+		k.SetExchangeRate(ctx, strings.ToUpper(f.SymbolDenom), exchangeRate)
+	}
+}
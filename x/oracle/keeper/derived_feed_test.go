@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/checkers"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestDerivedFeeds() {
+	app, ctx := s.app, s.ctx
+	feed := types.DerivedFeed{
+		SymbolDenom: "STUMEE",
+		Formula:     types.DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT,
+		Components:  []types.DerivedFeedComponent{{SymbolDenom: displayDenom, Weight: sdk.ZeroDec()}},
+		Multiplier:  sdk.MustNewDecFromStr("1.5"),
+	}
+
+	// only x/gov can update derived feeds
+	_, err := s.msgServer.GovUpdateDerivedFeeds(ctx,
+		types.NewMsgGovUpdateDerivedFeeds(addr.String(), []types.DerivedFeed{feed}, nil))
+	s.Require().ErrorContains(err, "expected "+checkers.GovModuleAddr)
+
+	// derived feeds can't shadow accepted denoms
+	shadow := feed
+	shadow.SymbolDenom = displayDenom
+	shadow.Components = []types.DerivedFeedComponent{{SymbolDenom: "ATOM"}}
+	_, err = s.msgServer.GovUpdateDerivedFeeds(ctx,
+		types.NewMsgGovUpdateDerivedFeeds(checkers.GovModuleAddr, []types.DerivedFeed{shadow}, nil))
+	s.Require().ErrorContains(err, "is in the accept list")
+
+	_, err = s.msgServer.GovUpdateDerivedFeeds(ctx,
+		types.NewMsgGovUpdateDerivedFeeds(checkers.GovModuleAddr, nil, []string{"STUMEE"}))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	_, err = s.msgServer.GovUpdateDerivedFeeds(ctx,
+		types.NewMsgGovUpdateDerivedFeeds(checkers.GovModuleAddr, []types.DerivedFeed{feed}, nil))
+	s.Require().NoError(err)
+	res, err := s.queryClient.DerivedFeeds(ctx, &types.QueryDerivedFeeds{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DerivedFeed{feed}, res.Feeds)
+
+	// without a component exchange rate, the derived feed is skipped
+	app.OracleKeeper.SetDerivedExchangeRates(ctx)
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, "STUMEE")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	app.OracleKeeper.SetExchangeRate(ctx, displayDenom, sdk.NewDec(2))
+	app.OracleKeeper.SetDerivedExchangeRates(ctx)
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "STUMEE")
+	s.Require().NoError(err)
+	s.Require().Equal(types.ExchangeRate{Rate: sdk.NewDec(3), Timestamp: ctx.BlockTime()}, rate)
+
+	// with a stale component exchange rate, the derived feed keeps its previous exchange rate, which
+	// is stale as well after the max price age of its components
+	acceptList := app.OracleKeeper.AcceptList(ctx)
+	for i := range acceptList {
+		acceptList[i].MaxPriceAge = time.Minute
+	}
+	app.OracleKeeper.SetAcceptList(ctx, acceptList)
+	freshCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	rate, err = app.OracleKeeper.GetExchangeRate(freshCtx, "STUMEE")
+	s.Require().NoError(err)
+	staleCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	app.OracleKeeper.SetDerivedExchangeRates(staleCtx)
+	rate, err = app.OracleKeeper.GetExchangeRate(staleCtx, "STUMEE")
+	s.Require().ErrorIs(err, types.ErrStalePrice)
+	s.Require().Equal(ctx.BlockTime(), rate.Timestamp)
+
+	// deleting a derived feed removes its exchange rate
+	_, err = s.msgServer.GovUpdateDerivedFeeds(ctx,
+		types.NewMsgGovUpdateDerivedFeeds(checkers.GovModuleAddr, nil, []string{"stumee"}))
+	s.Require().NoError(err)
+	s.Require().Empty(app.OracleKeeper.AllDerivedFeeds(ctx))
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, "STUMEE")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+}
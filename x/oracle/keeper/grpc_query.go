@@ -6,7 +6,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -56,22 +58,41 @@ func (q querier) ExchangeRates(
 	// TODO: need to decide if we want to return DecCoins here or list of ExchangeRates with denoms (we
 	// need the latter for genesis anyway)
 	var exchangeRates sdk.DecCoins
+	overrides := []types.PriceOverride{}
+	confidences := []types.PriceConfidence{}
 
 	if len(req.Denom) > 0 {
+		// as when listing all exchange rates, stale and low confidence exchange rates are returned
 		exchangeRate, err := q.GetExchangeRate(ctx, req.Denom)
-		if err != nil {
+		if err != nil && !types.ErrStalePrice.Is(err) && !types.ErrLowConfidence.Is(err) {
 			return nil, err
 		}
 
 		exchangeRates = exchangeRates.Add(sdk.NewDecCoinFromDec(req.Denom, exchangeRate.Rate))
+		if o, ok := q.GetPriceOverride(ctx, req.Denom); ok {
+			overrides = append(overrides, o)
+		}
+		if exchangeRate.Confidence != nil {
+			confidences = append(confidences, *exchangeRate.Confidence)
+		}
 	} else {
-		q.IterateExchangeRates(ctx, func(denom string, exgRate sdk.Dec, _ time.Time) (stop bool) {
-			exchangeRates = exchangeRates.Add(sdk.NewDecCoinFromDec(denom, exgRate))
+		q.IterateExchangeRateValues(ctx, func(denom string, er types.ExchangeRate) (stop bool) {
+			exchangeRates = exchangeRates.Add(sdk.NewDecCoinFromDec(denom, er.Rate))
+			if er.Confidence != nil {
+				confidences = append(confidences, *er.Confidence)
+			}
 			return false
 		})
+		for _, o := range q.AllPriceOverrides(ctx) {
+			if !o.IsExpired(ctx.BlockTime()) {
+				overrides = append(overrides, o)
+			}
+		}
 	}
 
-	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+	return &types.QueryExchangeRatesResponse{
+		ExchangeRates: exchangeRates, Overrides: overrides, Confidences: confidences,
+	}, nil
 }
 
 // ActiveExchangeRates queries all denoms for which exchange rates exist.
@@ -120,6 +141,36 @@ func (q querier) FeederDelegation(
 	}, nil
 }
 
+// Feeders queries the feeder delegation and the standby feeders of a validator.
+func (q querier) Feeders(
+	goCtx context.Context,
+	req *types.QueryFeeders,
+) (*types.QueryFeedersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	feederAddr, err := q.GetFeederDelegation(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	standby := []string{}
+	for _, f := range q.GetStandbyFeeders(ctx, valAddr) {
+		standby = append(standby, f.String())
+	}
+
+	return &types.QueryFeedersResponse{
+		FeederAddr:         feederAddr.String(),
+		StandbyFeederAddrs: standby,
+	}, nil
+}
+
 // MissCounter queries oracle miss counter of a validator.
 func (q querier) MissCounter(
 	goCtx context.Context,
@@ -277,7 +328,7 @@ func (q querier) Medians(
 			req.NumStamps = uint32(q.MaximumMedianStamps(ctx))
 		}
 
-		medians = q.HistoricMedians(ctx, req.Denom, uint64(req.NumStamps))
+		medians = q.Keeper.HistoricMedians(ctx, req.Denom, uint64(req.NumStamps))
 	} else {
 		medians = q.AllMedianPrices(ctx)
 	}
@@ -331,6 +382,52 @@ func (q querier) AvgPrice(
 	return &types.QueryAvgPriceResponse{Price: p}, nil
 }
 
+// PriceWindow queries the TWAP, min, max, open and close historic prices of a denom over the
+// last window_blocks blocks, and optionally OHLC candles of resolution_blocks blocks.
+func (q querier) PriceWindow(
+	goCtx context.Context,
+	req *types.QueryPriceWindow,
+) (*types.QueryPriceWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be defined")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	maxStamps := q.MaximumPriceStamps(ctx)
+	maxWindow := q.HistoricStampPeriod(ctx) * maxStamps
+	if req.WindowBlocks == 0 || req.WindowBlocks > maxWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "window_blocks must be between 1 and %d", maxWindow)
+	}
+	if req.ResolutionBlocks > 0 {
+		if req.WindowBlocks%req.ResolutionBlocks != 0 {
+			return nil, status.Error(codes.InvalidArgument, "resolution_blocks must divide window_blocks")
+		}
+		if req.WindowBlocks/req.ResolutionBlocks > maxStamps {
+			return nil, status.Errorf(codes.InvalidArgument, "can't return more than %d candles", maxStamps)
+		}
+	}
+
+	end := uint64(ctx.BlockHeight())
+	start := uint64(0)
+	if end > req.WindowBlocks {
+		start = end - req.WindowBlocks
+	}
+	prices := q.sortedHistoricPrices(ctx, strings.ToUpper(req.Denom))
+	window, ok := types.NewPriceWindow(prices, start, end)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no historic prices of %s in the window", req.Denom)
+	}
+
+	resp := &types.QueryPriceWindowResponse{Window: window}
+	if req.ResolutionBlocks > 0 {
+		resp.Candles = types.NewPriceCandles(prices, start, end, req.ResolutionBlocks)
+	}
+	return resp, nil
+}
+
 // ExgRatesWithTimestamp queries exchange rates of all denoms with timestamp, or, if specified, returns
 // a single denom.
 func (q querier) ExgRatesWithTimestamp(
@@ -348,14 +445,15 @@ func (q querier) ExgRatesWithTimestamp(
 	var exgRates []types.DenomExchangeRate
 
 	if len(req.Denom) > 0 {
+		// stale and low confidence exchange rates are returned, with the timestamp of their last update
 		exchangeRate, err := q.GetExchangeRate(ctx, req.Denom)
-		if err != nil {
+		if err != nil && !types.ErrStalePrice.Is(err) && !types.ErrLowConfidence.Is(err) {
 			return nil, err
 		}
-		exgRates = append(exgRates, types.NewDenomExchangeRate(req.Denom, exchangeRate.Rate, exchangeRate.Timestamp))
+		exgRates = append(exgRates, types.NewDenomExchangeRateWithConfidence(req.Denom, exchangeRate))
 	} else {
-		q.IterateExchangeRates(ctx, func(denom string, exgRate sdk.Dec, t time.Time) (stop bool) {
-			exgRates = append(exgRates, types.NewDenomExchangeRate(denom, exgRate, t))
+		q.IterateExchangeRateValues(ctx, func(denom string, er types.ExchangeRate) (stop bool) {
+			exgRates = append(exgRates, types.NewDenomExchangeRateWithConfidence(denom, er))
 			return false
 		})
 	}
@@ -387,3 +485,241 @@ func (q querier) MissCounters(goCtx context.Context, req *types.QueryMissCounter
 		MissCounters: pfMissCounts,
 	}, nil
 }
+
+// DenomVoteSettings queries the effective vote settings of all accepted denoms, or of a single
+// denom if specified.
+func (q querier) DenomVoteSettings(goCtx context.Context, req *types.QueryDenomVoteSettings,
+) (*types.QueryDenomVoteSettingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.GetParams(ctx)
+
+	settings := []types.DenomVoteSettings{}
+	// as in the tally, the first entry of duplicate symbol denoms is used
+	seen := map[string]bool{}
+	for _, d := range params.AcceptList {
+		symbol := strings.ToUpper(d.SymbolDenom)
+		if seen[symbol] {
+			continue
+		}
+		seen[symbol] = true
+		if len(req.Denom) == 0 || strings.EqualFold(d.SymbolDenom, req.Denom) {
+			settings = append(settings, d.VoteSettings(params))
+		}
+	}
+	if len(req.Denom) > 0 && len(settings) == 0 {
+		return nil, types.ErrUnknownDenom.Wrap(req.Denom)
+	}
+
+	return &types.QueryDenomVoteSettingsResponse{Settings: settings}, nil
+}
+
+// DerivedFeeds queries all derived price feeds.
+func (q querier) DerivedFeeds(goCtx context.Context, req *types.QueryDerivedFeeds,
+) (*types.QueryDerivedFeedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryDerivedFeedsResponse{Feeds: q.AllDerivedFeeds(ctx)}, nil
+}
+
+// ValidatorPerformance queries the oracle performance of validators over the current slash
+// window, or of a single validator if specified.
+func (q querier) ValidatorPerformance(goCtx context.Context, req *types.QueryValidatorPerformance,
+) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(req.Validator) > 0 {
+		valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &types.QueryValidatorPerformanceResponse{
+			Performances: []types.ValidatorPerformance{q.GetValidatorPerformance(ctx, valAddr)},
+		}, nil
+	}
+
+	performances := []types.ValidatorPerformance{}
+	perfStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixValidatorPerformance)
+	pageRes, err := query.Paginate(perfStore, req.Pagination, func(_, value []byte) error {
+		var vp types.ValidatorPerformance
+		if err := vp.Unmarshal(value); err != nil {
+			return err
+		}
+		performances = append(performances, vp)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValidatorPerformanceResponse{Performances: performances, Pagination: pageRes}, nil
+}
+
+// RewardDistribution queries a dry run of the reward distribution of the current vote period,
+// with the current reward params, or with the formula and full coverage bonus of the request.
+func (q querier) RewardDistribution(goCtx context.Context, req *types.QueryRewardDistribution,
+) (*types.QueryRewardDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rp := q.GetRewardParams(ctx)
+	if req.Formula != types.RewardFormula_REWARD_FORMULA_UNSPECIFIED {
+		rp.Formula = req.Formula
+	}
+	if req.FullCoverageBonus != "" {
+		bonus, err := sdk.NewDecFromStr(req.FullCoverageBonus)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		rp.FullCoverageBonus = bonus
+	}
+	if err := rp.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	periodRewards, rewards, err := q.DryRunRewardDistribution(ctx, rp)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRewardDistributionResponse{
+		Params:        rp,
+		PeriodRewards: periodRewards,
+		Rewards:       rewards,
+	}, nil
+}
+
+// ValidatorOffences queries the oracle offence history of validators, or of a single
+// validator if specified, and the penalty params.
+func (q querier) ValidatorOffences(goCtx context.Context, req *types.QueryValidatorOffences,
+) (*types.QueryValidatorOffencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.GetPenaltyParams(ctx)
+	if len(req.Validator) > 0 {
+		valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &types.QueryValidatorOffencesResponse{
+			Histories: []types.OffenceHistory{q.GetOffenceHistory(ctx, valAddr)},
+			Params:    params,
+		}, nil
+	}
+
+	histories := []types.OffenceHistory{}
+	historyStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixOffenceHistory)
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var h types.OffenceHistory
+		if err := h.Unmarshal(value); err != nil {
+			return err
+		}
+		histories = append(histories, h)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValidatorOffencesResponse{Histories: histories, Params: params, Pagination: pageRes}, nil
+}
+
+// PriceVolatility queries the rolling realized volatility of the exchange rates, or of a
+// single denom if specified.
+func (q querier) PriceVolatility(goCtx context.Context, req *types.QueryPriceVolatility,
+) (*types.QueryPriceVolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var pvs []types.PriceVolatility
+	if len(req.Denom) > 0 {
+		pv := q.GetPriceVolatility(ctx, req.Denom)
+		if pv.Samples == 0 {
+			return nil, status.Error(codes.NotFound, "no volatility estimate for "+req.Denom)
+		}
+		pvs = []types.PriceVolatility{pv}
+	} else {
+		pvs = q.AllPriceVolatilities(ctx)
+	}
+
+	volatilities := make([]types.DenomVolatility, 0, len(pvs))
+	for _, pv := range pvs {
+		v, err := pv.Volatility()
+		if err != nil {
+			return nil, err
+		}
+		volatilities = append(volatilities, types.DenomVolatility{
+			Denom:      pv.Denom,
+			Volatility: v,
+			Samples:    pv.Samples,
+		})
+	}
+	return &types.QueryPriceVolatilityResponse{Volatilities: volatilities}, nil
+}
+
+// HistoricPrices queries the historic price stamps of a denom within a range of blocks.
+func (q querier) HistoricPrices(goCtx context.Context, req *types.QueryHistoricPrices,
+) (*types.QueryHistoricPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prices, pageRes, err := q.PaginateHistoricStamps(ctx, types.KeyPrefixHistoricPrice, req.Denom,
+		req.FromBlock, req.ToBlock, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryHistoricPricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+// HistoricMedians queries the median and median deviation stamps of a denom within a range of
+// blocks.
+func (q querier) HistoricMedians(goCtx context.Context, req *types.QueryHistoricMedians,
+) (*types.QueryHistoricMediansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	medians, pageRes, err := q.PaginateHistoricStamps(ctx, types.KeyPrefixMedian, req.Denom,
+		req.FromBlock, req.ToBlock, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deviations := types.Prices{}
+	for _, m := range medians {
+		if d, ok := q.HistoricMedianDeviationAt(ctx, req.Denom, m.BlockNum); ok {
+			deviations = append(deviations, types.NewPrice(d, req.Denom, m.BlockNum))
+		}
+	}
+
+	return &types.QueryHistoricMediansResponse{
+		Medians:          medians,
+		MedianDeviations: deviations,
+		Pagination:       pageRes,
+	}, nil
+}
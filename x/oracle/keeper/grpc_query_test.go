@@ -2,10 +2,12 @@ package keeper_test
 
 import (
 	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	appparams "github.com/umee-network/umee/v6/app/params"
 	"github.com/umee-network/umee/v6/x/oracle/keeper"
@@ -295,6 +297,64 @@ func (s *IntegrationTestSuite) TestQuerier_MedianDeviations() {
 	s.Require().Equal(res.MedianDeviations, expected)
 }
 
+func (s *IntegrationTestSuite) TestQuerier_HistoricPricesAndMedians() {
+	app, ctx := s.app, s.ctx
+	price := func(block uint64) sdk.Dec { return sdk.NewDec(int64(block)) }
+	for _, block := range []uint64{1, 2, 3, 256, 300} {
+		app.OracleKeeper.SetHistoricPrice(ctx, "ATOM", block, price(block))
+		app.OracleKeeper.SetHistoricMedian(ctx, "ATOM", block, price(block))
+	}
+	app.OracleKeeper.SetHistoricMedianDeviation(ctx, "ATOM", 3, sdk.OneDec())
+	// a denom sharing the prefix must not be included
+	app.OracleKeeper.SetHistoricPrice(ctx, "ATOMX", 2, sdk.OneDec())
+
+	// page through all the stamps within [2, 256], in block order
+	expected := types.Prices{
+		types.NewPrice(price(2), "ATOM", 2),
+		types.NewPrice(price(3), "ATOM", 3),
+		types.NewPrice(price(256), "ATOM", 256),
+	}
+	for _, reverse := range []bool{false, true} {
+		prices := types.Prices{}
+		var next []byte
+		for {
+			res, err := s.queryClient.HistoricPrices(ctx, &types.QueryHistoricPrices{
+				Denom: "ATOM", FromBlock: 2, ToBlock: 256,
+				Pagination: &query.PageRequest{Key: next, Limit: 2, Reverse: reverse},
+			})
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(res.Prices), 2)
+			prices = append(prices, res.Prices...)
+			if next = res.Pagination.NextKey; next == nil {
+				break
+			}
+		}
+		if reverse {
+			slices.Reverse(prices)
+		}
+		s.Require().Equal(expected, prices)
+	}
+
+	// the first page starts at the first block of the range, and ends the range
+	res, err := s.queryClient.HistoricMedians(ctx, &types.QueryHistoricMedians{Denom: "ATOM", FromBlock: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(types.Prices{
+		types.NewPrice(price(3), "ATOM", 3),
+		types.NewPrice(price(256), "ATOM", 256),
+		types.NewPrice(price(300), "ATOM", 300),
+	}, types.Prices(res.Medians))
+	s.Require().Nil(res.Pagination.NextKey)
+	s.Require().Equal(types.Prices{types.NewPrice(sdk.OneDec(), "ATOM", 3)}, types.Prices(res.MedianDeviations))
+
+	_, err = s.queryClient.HistoricPrices(ctx, &types.QueryHistoricPrices{
+		Denom: "ATOM", FromBlock: 2, Pagination: &query.PageRequest{Offset: 1},
+	})
+	s.Require().ErrorContains(err, "offset can't be used with a block range")
+
+	_, err = s.queryClient.HistoricPrices(ctx, &types.QueryHistoricPrices{})
+	s.Require().ErrorContains(err, "denom must be specified")
+}
+
 func (s *IntegrationTestSuite) TestEmptyRequest() {
 	q := keeper.NewQuerier(keeper.Keeper{})
 	const emptyRequestErrorMsg = "empty request"
@@ -375,6 +435,42 @@ func (s *IntegrationTestSuite) TestQuerier_AvgPrice() {
 	s.Require().ErrorContains(err, "malformed denom")
 }
 
+func (s *IntegrationTestSuite) TestQuerier_PriceWindow() {
+	app, ctx := s.app, s.ctx
+	dec := sdk.MustNewDecFromStr
+
+	// block height is 9
+	app.OracleKeeper.SetHistoricPrice(ctx, "ATOM", 1, sdk.NewDec(1))
+	app.OracleKeeper.SetHistoricPrice(ctx, "ATOM", 5, sdk.NewDec(3))
+	app.OracleKeeper.SetHistoricPrice(ctx, "ATOM", 7, sdk.NewDec(5))
+
+	res, err := s.queryClient.PriceWindow(ctx.Context(),
+		&types.QueryPriceWindow{Denom: "atom", WindowBlocks: 8, ResolutionBlocks: 4})
+	s.Require().NoError(err)
+	s.Require().Equal(types.PriceWindow{
+		StartBlock: 1, EndBlock: 9, Twap: dec("2.5"), Min: sdk.NewDec(1), Max: sdk.NewDec(5),
+		Open: sdk.NewDec(1), Close: sdk.NewDec(5), NumStamps: 2,
+	}, res.Window)
+	s.Require().Equal([]types.PriceWindow{
+		{
+			StartBlock: 1, EndBlock: 5, Twap: sdk.NewDec(1), Min: sdk.NewDec(1), Max: sdk.NewDec(3),
+			Open: sdk.NewDec(1), Close: sdk.NewDec(3), NumStamps: 1,
+		},
+		{
+			StartBlock: 5, EndBlock: 9, Twap: sdk.NewDec(4), Min: sdk.NewDec(3), Max: sdk.NewDec(5),
+			Open: sdk.NewDec(3), Close: sdk.NewDec(5), NumStamps: 1,
+		},
+	}, res.Candles)
+
+	_, err = s.queryClient.PriceWindow(ctx.Context(), &types.QueryPriceWindow{Denom: "ATOM"})
+	s.Require().ErrorContains(err, "window_blocks must be between 1 and")
+	_, err = s.queryClient.PriceWindow(ctx.Context(),
+		&types.QueryPriceWindow{Denom: "ATOM", WindowBlocks: 8, ResolutionBlocks: 3})
+	s.Require().ErrorContains(err, "resolution_blocks must divide window_blocks")
+	_, err = s.queryClient.PriceWindow(ctx.Context(), &types.QueryPriceWindow{Denom: "UMEE", WindowBlocks: 8})
+	s.Require().ErrorContains(err, "no historic prices of UMEE")
+}
+
 func (s *IntegrationTestSuite) TestQuerier_ExchangeRatesWithTimestamp() {
 	s.ctx = s.ctx.WithBlockTime(time.Now())
 	s.app.OracleKeeper.SetExchangeRate(s.ctx, displayDenom, sdk.OneDec())
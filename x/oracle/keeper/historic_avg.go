@@ -157,3 +157,20 @@ func (k Keeper) GetHistoricAvgCounterParams(ctx sdk.Context) types.AvgCounterPar
 	return *store.GetValue[*types.AvgCounterParams](kvs, types.KeyAvgCounterParams,
 		"historic avg counter params")
 }
+
+// clearAvgCounters removes all historic avg counters. They are initialized again with the
+// next exchange rates.
+func (k Keeper) clearAvgCounters(ctx sdk.Context) {
+	kvs := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.KeyPrefixAvgCounter, types.KeyLatestAvgCounter} {
+		iter := sdk.KVStorePrefixIterator(kvs, prefix)
+		keys := [][]byte{}
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			kvs.Delete(key)
+		}
+	}
+}
@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/umee-network/umee/v6/util"
 	"github.com/umee-network/umee/v6/util/decmath"
@@ -87,6 +90,19 @@ func (k Keeper) HistoricMedianDeviation(
 	return types.NewPrice(decProto.Dec, denom, blockNum), nil
 }
 
+// HistoricMedianDeviationAt returns the median deviation of a denom stamped at the given
+// block. Returns false if there is none.
+func (k Keeper) HistoricMedianDeviationAt(ctx sdk.Context, denom string, blockNum uint64) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyMedianDeviation(denom, blockNum))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	decProto := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &decProto)
+	return decProto.Dec, true
+}
+
 // WithinHistoricMedianDeviation returns whether or not the current price of a
 // given denom is within the latest stamped Standard Deviation around
 // the Median.
@@ -242,6 +258,27 @@ func (k Keeper) historicPrices(
 	return historicPrices
 }
 
+// sortedHistoricPrices returns all the historic price stamps of a given denom, sorted by
+// block.
+func (k Keeper) sortedHistoricPrices(ctx sdk.Context, denom string) types.Prices {
+	store := ctx.KVStore(k.storeKey)
+	prices := types.Prices{}
+
+	// make sure we have one zero byte to correctly separate denoms
+	prefix := util.ConcatBytes(1, types.KeyPrefixHistoricPrice, []byte(denom))
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denom, block := types.ParseDenomAndBlockFromKey(iter.Key(), types.KeyPrefixHistoricPrice)
+		decProto := sdk.DecProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &decProto)
+		prices = append(prices, types.NewPrice(decProto.Dec, denom, block))
+	}
+
+	return prices.Sort()
+}
+
 // IterateHistoricPrices iterates over historic prices of a given
 // denom in the store in reverse.
 // Iterator stops when exhausting the source, or when the handler returns `true`.
@@ -267,6 +304,98 @@ func (k Keeper) IterateHistoricPrices(
 	}
 }
 
+// PaginateHistoricStamps pages through the stamps of a denom stored under keyPrefix (historic
+// prices, medians or median deviations), with a block number in [fromBlock, toBlock]. A zero
+// toBlock means no upper bound. Stamps are returned in block order (reversed with
+// pagination.Reverse). Without a page key, the first page starts at the first block of the
+// range, so that the stamps before it are not scanned. Offsets can't be combined with a range,
+// and the total is not counted within a range.
+func (k Keeper) PaginateHistoricStamps(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	denom string,
+	fromBlock, toBlock uint64,
+	pagination *query.PageRequest,
+) (types.Prices, *query.PageResponse, error) {
+	req := query.PageRequest{}
+	if pagination != nil {
+		req = *pagination
+	}
+	inRange := func(block uint64) bool {
+		return block >= fromBlock && (toBlock == 0 || block <= toBlock)
+	}
+	if fromBlock > 0 || toBlock > 0 {
+		if req.Offset > 0 {
+			return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("offset can't be used with a block range")
+		}
+		req.CountTotal = false
+		if req.Limit == 0 {
+			req.Limit = query.DefaultLimit
+		}
+		if len(req.Key) == 0 {
+			if req.Reverse && toBlock > 0 {
+				req.Key = sdk.Uint64ToBigEndian(toBlock)
+			} else if !req.Reverse {
+				req.Key = sdk.Uint64ToBigEndian(fromBlock)
+			}
+		}
+	}
+
+	// make sure we have one zero byte to correctly separate denoms
+	stampStore := prefix.NewStore(ctx.KVStore(k.storeKey), util.ConcatBytes(1, keyPrefix, []byte(denom)))
+	prices := types.Prices{}
+	pageRes, err := query.Paginate(stampStore, &req, func(key, value []byte) error {
+		block := sdk.BigEndianToUint64(key)
+		if !inRange(block) {
+			return nil
+		}
+		decProto := sdk.DecProto{}
+		if err := k.cdc.Unmarshal(value, &decProto); err != nil {
+			return err
+		}
+		prices = append(prices, types.NewPrice(decProto.Dec, denom, block))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	// stamps are sorted by block: the range is exhausted once the next stamp is out of it
+	if len(pageRes.NextKey) > 0 && !inRange(sdk.BigEndianToUint64(pageRes.NextKey)) {
+		pageRes.NextKey = nil
+	}
+	return prices, pageRes, nil
+}
+
+// MigrateStampKeys rewrites the historic price, median and median deviation keys stored with a
+// LittleEndian block number to BigEndian, which sorts the stamps of a denom by block.
+func (k Keeper) MigrateStampKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, stamps := range []struct {
+		keyPrefix []byte
+		key       func(denom string, blockNum uint64) []byte
+	}{
+		{types.KeyPrefixHistoricPrice, types.KeyHistoricPrice},
+		{types.KeyPrefixMedian, types.KeyMedian},
+		{types.KeyPrefixMedianDeviation, types.KeyMedianDeviation},
+	} {
+		var keys, values [][]byte
+		iter := sdk.KVStorePrefixIterator(store, stamps.keyPrefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+			values = append(values, iter.Value())
+		}
+		iter.Close()
+
+		// old and new keys share the same prefix: delete all the old keys before writing
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		for i, key := range keys {
+			store.Set(stamps.key(types.ParseDenomAndBlockFromLegacyKey(key, stamps.keyPrefix)), values[i])
+		}
+	}
+}
+
 // IterateHistoricMediansSinceBlock iterates over medians of a given
 // denom in the store in reverse.
 // Iterator stops when exhausting the source, or when the handler returns `true`.
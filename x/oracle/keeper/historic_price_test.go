@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/umee-network/umee/v6/x/oracle/types"
 )
@@ -100,3 +102,36 @@ func (s *IntegrationTestSuite) TestSetHistoraclePricing() {
 	s.Require().Equal(medians[0], types.NewPrice(sdk.MustNewDecFromStr("1.2"), displayDenom, 17))
 	s.Require().Equal(medians[1], types.NewPrice(sdk.MustNewDecFromStr("1.125"), displayDenom, 14))
 }
+
+func (s *IntegrationTestSuite) TestMigrateStampKeys() {
+	app, ctx := s.app, s.ctx
+	kvs := ctx.KVStore(app.GetKey(types.StoreKey))
+	legacyKey := func(keyPrefix []byte, denom string, block uint64) []byte {
+		bz := make([]byte, 9)
+		binary.LittleEndian.PutUint64(bz[1:], block)
+		return append(append(append([]byte{}, keyPrefix...), denom...), bz...)
+	}
+	for _, block := range []uint64{1, 256, 300} {
+		bz := app.AppCodec().MustMarshal(&sdk.DecProto{Dec: sdk.NewDec(int64(block))})
+		kvs.Set(legacyKey(types.KeyPrefixHistoricPrice, "ATOM", block), bz)
+		kvs.Set(legacyKey(types.KeyPrefixMedian, "ATOM", block), bz)
+	}
+	kvs.Set(legacyKey(types.KeyPrefixMedianDeviation, "ATOM", 256), app.AppCodec().MustMarshal(
+		&sdk.DecProto{Dec: sdk.OneDec()}))
+
+	app.OracleKeeper.MigrateStampKeys(ctx)
+	prices, _, err := app.OracleKeeper.PaginateHistoricStamps(ctx, types.KeyPrefixHistoricPrice, "ATOM", 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Prices{
+		types.NewPrice(sdk.NewDec(1), "ATOM", 1),
+		types.NewPrice(sdk.NewDec(256), "ATOM", 256),
+		types.NewPrice(sdk.NewDec(300), "ATOM", 300),
+	}, prices)
+	medians, _, err := app.OracleKeeper.PaginateHistoricStamps(ctx, types.KeyPrefixMedian, "ATOM", 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(prices, medians)
+	d, ok := app.OracleKeeper.HistoricMedianDeviationAt(ctx, "ATOM", 256)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.OneDec(), d)
+	s.Require().False(kvs.Has(legacyKey(types.KeyPrefixHistoricPrice, "ATOM", 256)))
+}
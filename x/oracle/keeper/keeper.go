@@ -17,6 +17,7 @@ import (
 	"github.com/umee-network/umee/v6/util/sdkutil"
 	"github.com/umee-network/umee/v6/util/store"
 	"github.com/umee-network/umee/v6/x/oracle/types"
+	"github.com/umee-network/umee/v6/x/ugov"
 )
 
 var ten = sdk.MustNewDecFromStr("10")
@@ -31,6 +32,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistributionKeeper
 	StakingKeeper types.StakingKeeper
+	ugov          ugov.EmergencyGroupBuilder
 
 	distrName string
 }
@@ -45,6 +47,7 @@ func NewKeeper(
 	distrKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
 	distrName string,
+	ugov ugov.EmergencyGroupBuilder,
 ) Keeper {
 	// ensure oracle module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -64,6 +67,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		StakingKeeper: stakingKeeper,
+		ugov:          ugov,
 		distrName:     distrName,
 	}
 }
@@ -74,13 +78,34 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // GetExchangeRate gets the consensus exchange rate of USD denominated in the
-// denom asset from the store.
+// denom asset from the store. If the exchange rate is older than the max price age
+// of the denom (or of its derived feed), it is returned together with ErrStalePrice. If its ballot doesn't meet the
+// confidence requirements of the denom, it is returned together with ErrLowConfidence.
 func (k Keeper) GetExchangeRate(ctx sdk.Context, symbol string) (types.ExchangeRate, error) {
+	return k.getExchangeRate(ctx, symbol, k.AcceptList(ctx))
+}
+
+// getExchangeRate is GetExchangeRate with the accept list already loaded by the caller, so
+// that callers reading several exchange rates don't load it for each of them.
+func (k Keeper) getExchangeRate(ctx sdk.Context, symbol string, acceptList types.DenomList,
+) (types.ExchangeRate, error) {
 	v := store.GetValue[*types.ExchangeRate](ctx.KVStore(k.storeKey), types.KeyExchangeRate(symbol),
 		"exchange_rate")
 	if v == nil {
 		return types.ExchangeRate{}, types.ErrUnknownDenom.Wrap(symbol)
 	}
+	maxAge := acceptList.MaxPriceAge(symbol)
+	if !acceptList.Contains(symbol) {
+		if feed := k.derivedFeed(ctx, symbol); feed != nil {
+			maxAge = feed.MaxPriceAge(acceptList)
+		}
+	}
+	if age := ctx.BlockTime().Sub(v.Timestamp); maxAge > 0 && age > maxAge {
+		return *v, types.ErrStalePrice.Wrapf("%s: age %s, max age %s", symbol, age, maxAge)
+	}
+	if err := acceptList.CheckConfidence(symbol, v.Confidence); err != nil {
+		return *v, err
+	}
 	return *v, nil
 }
 
@@ -91,8 +116,8 @@ func (k Keeper) GetExchangeRateBase(ctx sdk.Context, denom string) (sdk.Dec, err
 	var symbol string
 	var exponent uint64
 	// Translate the base denom -> symbol
-	params := k.GetParams(ctx)
-	for _, listDenom := range params.AcceptList {
+	acceptList := k.AcceptList(ctx)
+	for _, listDenom := range acceptList {
 		if listDenom.BaseDenom == denom {
 			symbol = listDenom.SymbolDenom
 			exponent = uint64(listDenom.Exponent)
@@ -103,7 +128,7 @@ func (k Keeper) GetExchangeRateBase(ctx sdk.Context, denom string) (sdk.Dec, err
 		return sdk.ZeroDec(), types.ErrUnknownDenom.Wrap(denom)
 	}
 
-	exchangeRate, err := k.GetExchangeRate(ctx, symbol)
+	exchangeRate, err := k.getExchangeRate(ctx, symbol, acceptList)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
@@ -116,16 +141,33 @@ func (k Keeper) GetExchangeRateBase(ctx sdk.Context, denom string) (sdk.Dec, err
 // denom asset to the store with a timestamp specified instead of using ctx.
 // NOTE: must not be used outside of genesis import.
 func (k Keeper) SetExchangeRateWithTimestamp(ctx sdk.Context, denom string, rate sdk.Dec, t time.Time) {
+	k.setExchangeRate(ctx, denom, types.ExchangeRate{Rate: rate, Timestamp: t})
+}
+
+func (k Keeper) setExchangeRate(ctx sdk.Context, denom string, val types.ExchangeRate) {
 	key := types.KeyExchangeRate(denom)
-	val := types.ExchangeRate{Rate: rate, Timestamp: t}
 	err := store.SetValue[*types.ExchangeRate](ctx.KVStore(k.storeKey), key, &val, "exchange_rate")
 	util.Panic(err)
 }
 
 // SetExchangeRate sets an consensus
-// exchange rate to the store with ABCI event
+// exchange rate to the store with ABCI event. If the denom has an active price override, the
+// override exchange rate is set instead.
 func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, rate sdk.Dec) {
-	k.SetExchangeRateWithTimestamp(ctx, denom, rate, ctx.BlockTime())
+	k.SetTalliedExchangeRate(ctx, denom, rate, nil)
+}
+
+// SetTalliedExchangeRate sets a tallied consensus exchange rate to the store, together with the
+// dispersion of its ballot, with ABCI event. If the denom has an active price override, the
+// override exchange rate is set instead, without confidence.
+func (k Keeper) SetTalliedExchangeRate(ctx sdk.Context, denom string, rate sdk.Dec,
+	confidence *types.PriceConfidence,
+) {
+	if o, ok := k.GetPriceOverride(ctx, denom); ok {
+		rate = o.ExchangeRate
+		confidence = nil
+	}
+	k.setExchangeRate(ctx, denom, types.ExchangeRate{Rate: rate, Timestamp: ctx.BlockTime(), Confidence: confidence})
 	sdkutil.Emit(&ctx, &types.EventSetFxRate{
 		Denom: denom, Rate: rate,
 	})
@@ -133,6 +175,14 @@ func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, rate sdk.Dec) {
 
 // IterateExchangeRates iterates over all USD rates in the store.
 func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(string, sdk.Dec, time.Time) bool) {
+	k.IterateExchangeRateValues(ctx, func(denom string, er types.ExchangeRate) bool {
+		return handler(denom, er.Rate, er.Timestamp)
+	})
+}
+
+// IterateExchangeRateValues iterates over all USD rates in the store, with their timestamp
+// and confidence.
+func (k Keeper) IterateExchangeRateValues(ctx sdk.Context, handler func(string, types.ExchangeRate) bool) {
 	store := ctx.KVStore(k.storeKey)
 	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixExchangeRate)
 	defer iter.Close()
@@ -144,7 +194,7 @@ func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(string, sdk.D
 		err := exgRate.Unmarshal(iter.Value())
 		util.Panic(err)
 
-		if handler(denom, exgRate.Rate, exgRate.Timestamp) {
+		if handler(denom, exgRate) {
 			break
 		}
 	}
@@ -183,6 +233,31 @@ func (k Keeper) SetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress, de
 	store.Set(types.KeyFeederDelegation(operator), delegatedFeeder.Bytes())
 }
 
+// GetStandbyFeeders returns the standby feeders of the validator operator, which are allowed
+// to vote on its behalf in addition to its feeder delegation.
+func (k Keeper) GetStandbyFeeders(ctx sdk.Context, operator sdk.ValAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyStandbyFeederPrefix(operator))
+	defer iter.Close()
+
+	feeders := []sdk.AccAddress{}
+	for ; iter.Valid(); iter.Next() {
+		feeders = append(feeders, sdk.AccAddress(iter.Value()))
+	}
+	return feeders
+}
+
+// SetStandbyFeeders replaces the standby feeders of the validator operator.
+func (k Keeper) SetStandbyFeeders(ctx sdk.Context, operator sdk.ValAddress, feeders []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, f := range k.GetStandbyFeeders(ctx, operator) {
+		store.Delete(types.KeyStandbyFeeder(operator, f))
+	}
+	for _, f := range feeders {
+		store.Set(types.KeyStandbyFeeder(operator, f), f.Bytes())
+	}
+}
+
 type IterateFeederDelegationHandler func(delegator sdk.ValAddress, delegate sdk.AccAddress) (stop bool)
 
 // IterateFeederDelegations iterates over the feed delegates and performs a
@@ -388,13 +463,15 @@ func (k Keeper) IterateAggregateExchangeRateVotes(
 	}
 }
 
-// ValidateFeeder returns error if the given feeder is not allowed to feed the message.
+// ValidateFeeder returns error if the given feeder is not allowed to feed the message: it must be
+// the feeder delegation or one of the standby feeders of the validator.
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	delegate, err := k.GetFeederDelegation(ctx, valAddr)
 	if err != nil {
 		return err
 	}
-	if !delegate.Equals(feederAddr) {
+	if !delegate.Equals(feederAddr) &&
+		!ctx.KVStore(k.storeKey).Has(types.KeyStandbyFeeder(valAddr, feederAddr)) {
 		return types.ErrNoVotingPermission.Wrap(feederAddr.String())
 	}
 
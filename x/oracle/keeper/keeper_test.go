@@ -242,6 +242,83 @@ func (s *IntegrationTestSuite) TestGetExchangeRate_Valid() {
 	s.Require().Equal(rate, expected)
 }
 
+func (s *IntegrationTestSuite) TestGetExchangeRate_Stale() {
+	app, ctx := s.app, s.ctx
+	v := sdk.OneDec()
+	app.OracleKeeper.SetExchangeRate(ctx, displayDenom, v)
+	expected := types.ExchangeRate{Rate: v, Timestamp: ctx.BlockTime()}
+
+	// without a max price age, exchange rates never go stale
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, rate)
+
+	acceptList := app.OracleKeeper.AcceptList(ctx)
+	for i := range acceptList {
+		acceptList[i].MaxPriceAge = time.Hour
+	}
+	app.OracleKeeper.SetAcceptList(ctx, acceptList)
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, rate)
+
+	// stale exchange rates are returned with the error
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	s.Require().ErrorIs(err, types.ErrStalePrice)
+	s.Require().Equal(expected, rate)
+
+	// queries return stale exchange rates
+	res, err := keeper.NewQuerier(app.OracleKeeper).ExgRatesWithTimestamp(ctx,
+		&types.QueryExgRatesWithTimestamp{Denom: displayDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(v, res.ExgRates[0].Rate)
+}
+
+func (s *IntegrationTestSuite) TestGetExchangeRate_LowConfidence() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+	v := sdk.OneDec()
+	confidence := types.PriceConfidence{
+		Denom: displayDenom, Voters: 2, PowerShare: sdk.NewDecWithPrec(6, 1), Spread: sdk.NewDecWithPrec(2, 2),
+	}
+	app.OracleKeeper.SetTalliedExchangeRate(ctx, displayDenom, v, &confidence)
+	expected := types.ExchangeRate{Rate: v, Timestamp: ctx.BlockTime(), Confidence: &confidence}
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	require.NoError(err)
+	require.Equal(expected, rate)
+
+	maxSpread := sdk.NewDecWithPrec(1, 2)
+	acceptList := app.OracleKeeper.AcceptList(ctx)
+	for i := range acceptList {
+		acceptList[i].MinConfidenceVoters = 2
+		acceptList[i].MaxConfidenceSpread = &maxSpread
+	}
+	app.OracleKeeper.SetAcceptList(ctx, acceptList)
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	require.ErrorIs(err, types.ErrLowConfidence)
+	require.Equal(expected, rate)
+
+	// queries return low confidence exchange rates, with their confidence
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	res, err := querier.ExchangeRates(ctx, &types.QueryExchangeRates{Denom: displayDenom})
+	require.NoError(err)
+	require.Equal(v, res.ExchangeRates.AmountOf(displayDenom))
+	require.Equal([]types.PriceConfidence{confidence}, res.Confidences)
+	res, err = querier.ExchangeRates(ctx, &types.QueryExchangeRates{})
+	require.NoError(err)
+	require.Equal([]types.PriceConfidence{confidence}, res.Confidences)
+	tsRes, err := querier.ExgRatesWithTimestamp(ctx, &types.QueryExgRatesWithTimestamp{})
+	require.NoError(err)
+	require.Equal(&confidence, tsRes.ExgRates[0].Confidence)
+
+	// exchange rates which aren't tallied have no confidence, and are always accepted
+	app.OracleKeeper.SetExchangeRate(ctx, displayDenom, v)
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	require.NoError(err)
+	require.Nil(rate.Confidence)
+}
+
 func (s *IntegrationTestSuite) TestGetExchangeRateBase() {
 	oracleParams := s.app.OracleKeeper.GetParams(s.ctx)
 
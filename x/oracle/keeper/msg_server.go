@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/umee-network/umee/v6/util/checkers"
 	"github.com/umee-network/umee/v6/util/sdkutil"
 	"github.com/umee-network/umee/v6/x/oracle/types"
 )
@@ -132,11 +133,103 @@ func (ms msgServer) DelegateFeedConsent(
 		return nil, stakingtypes.ErrNoValidatorFound.Wrap(msg.Operator)
 	}
 
+	standby := make([]sdk.AccAddress, 0, len(msg.StandbyDelegates))
+	for _, d := range msg.StandbyDelegates {
+		standbyAddr, err := sdk.AccAddressFromBech32(d)
+		if err != nil {
+			return nil, err
+		}
+		standby = append(standby, standbyAddr)
+	}
+
 	ms.SetFeederDelegation(ctx, operatorAddr, delegateAddr)
+	ms.SetStandbyFeeders(ctx, operatorAddr, standby)
 
 	sdkutil.Emit(&ctx, &types.EventDelegateFeedConsent{
-		Operator: msg.Operator, Delegate: msg.Delegate,
+		Operator: msg.Operator, Delegate: msg.Delegate, StandbyDelegates: msg.StandbyDelegates,
 	})
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+func (ms msgServer) GovUpdateDerivedFeeds(
+	goCtx context.Context,
+	msg *types.MsgGovUpdateDerivedFeeds,
+) (*types.MsgGovUpdateDerivedFeedsResponse, error) {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.UpdateDerivedFeeds(ctx, msg.SetFeeds, msg.DeleteFeeds); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovUpdateDerivedFeedsResponse{}, nil
+}
+
+func (ms msgServer) GovSetPriceOverride(
+	goCtx context.Context,
+	msg *types.MsgGovSetPriceOverride,
+) (*types.MsgGovSetPriceOverrideResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := checkers.EmergencyGroupAuthority(msg.Authority, ms.ugov(&ctx)); err != nil {
+		return nil, err
+	}
+
+	err := ms.OverridePrice(ctx, msg.Authority, msg.SymbolDenom, msg.ExchangeRate, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovSetPriceOverrideResponse{}, nil
+}
+
+func (ms msgServer) GovCancelPriceOverride(
+	goCtx context.Context,
+	msg *types.MsgGovCancelPriceOverride,
+) (*types.MsgGovCancelPriceOverrideResponse, error) {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.CancelPriceOverride(ctx, msg.SymbolDenom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovCancelPriceOverrideResponse{}, nil
+}
+
+func (ms msgServer) GovUpdateParams(
+	goCtx context.Context,
+	msg *types.MsgGovUpdateParams,
+) (*types.MsgGovUpdateParamsResponse, error) {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.UpdateParams(ctx, msg.Keys, msg.Changes, msg.AvgCounterChanges, msg.RewardParams,
+		msg.PenaltyParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovUpdateParamsResponse{}, nil
+}
+
+func (ms msgServer) GovUpdateAcceptList(
+	goCtx context.Context,
+	msg *types.MsgGovUpdateAcceptList,
+) (*types.MsgGovUpdateAcceptListResponse, error) {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.UpdateAcceptList(ctx, msg.SetDenoms, msg.DeleteDenoms); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovUpdateAcceptListResponse{}, nil
+}
@@ -154,4 +154,30 @@ func (s *IntegrationTestSuite) TestMsgServer_DelegateFeedConsent() {
 		Delegate: feederAddr.String(),
 	})
 	s.Require().NoError(err)
+
+	// standby feeders can vote as well
+	standby1 := sdk.AccAddress([]byte("standby1____________"))
+	standby2 := sdk.AccAddress([]byte("standby2____________"))
+	_, err = s.msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx),
+		types.NewMsgDelegateFeedConsent(valAddr, feederAddr, standby1, standby2))
+	s.Require().NoError(err)
+	for _, f := range []sdk.AccAddress{feederAddr, standby1, standby2} {
+		s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, f, valAddr))
+	}
+	s.Require().ErrorIs(app.OracleKeeper.ValidateFeeder(ctx, standby1, valAddr2), types.ErrNoVotingPermission)
+
+	res, err := s.queryClient.Feeders(ctx, &types.QueryFeeders{ValidatorAddr: valAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(feederAddr.String(), res.FeederAddr)
+	s.Require().ElementsMatch([]string{standby1.String(), standby2.String()}, res.StandbyFeederAddrs)
+
+	// rotation: the standby feeder becomes the feeder delegation, and previous standby feeders
+	// are replaced
+	_, err = s.msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx),
+		types.NewMsgDelegateFeedConsent(valAddr, standby1))
+	s.Require().NoError(err)
+	s.Require().NoError(app.OracleKeeper.ValidateFeeder(ctx, standby1, valAddr))
+	s.Require().ErrorIs(app.OracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr), types.ErrNoVotingPermission)
+	s.Require().ErrorIs(app.OracleKeeper.ValidateFeeder(ctx, standby2, valAddr), types.ErrNoVotingPermission)
+	s.Require().Empty(app.OracleKeeper.GetStandbyFeeders(ctx, valAddr))
 }
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/umee-network/umee/v6/util/sdkutil"
 	"github.com/umee-network/umee/v6/x/oracle/types"
 )
 
@@ -136,3 +139,85 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// UpdateParams updates the params, historic avg counter params, reward params and penalty params
+// of the given keys. Historic avg counters are reset when the avg counter params change.
+func (k Keeper) UpdateParams(
+	ctx sdk.Context,
+	keys []string,
+	changes types.Params,
+	acpChanges types.AvgCounterParams,
+	rpChanges types.RewardParams,
+	ppChanges types.PenaltyParams,
+) error {
+	prevAcp := k.GetHistoricAvgCounterParams(ctx)
+	params, acp, err := types.UpdateParams(k.GetParams(ctx), prevAcp, keys, changes, acpChanges)
+	if err != nil {
+		return err
+	}
+	rp := k.GetRewardParams(ctx)
+	if slices.Contains(keys, types.RewardParamsKey) {
+		rp = rpChanges
+		if err := k.SetRewardParams(ctx, rp); err != nil {
+			return err
+		}
+	}
+	pp := k.GetPenaltyParams(ctx)
+	if slices.Contains(keys, types.PenaltyParamsKey) {
+		pp = ppChanges
+		if err := k.SetPenaltyParams(ctx, pp); err != nil {
+			return err
+		}
+	}
+
+	k.SetParams(ctx, params)
+	if !acp.Equal(&prevAcp) {
+		if err := k.SetHistoricAvgCounterParams(ctx, acp); err != nil {
+			return err
+		}
+		k.clearAvgCounters(ctx)
+	}
+
+	sdkutil.Emit(&ctx, &types.EventUpdateParams{
+		Keys:             keys,
+		Params:           params,
+		AvgCounterParams: acp,
+		RewardParams:     rp,
+		PenaltyParams:    pp,
+	})
+	return nil
+}
+
+// UpdateAcceptList adds, updates and removes denoms of the accept list, by base denom. The
+// resulting accept list is validated against the derived feeds. Exchange rates of symbol
+// denoms which are not in the accept list anymore are removed.
+func (k Keeper) UpdateAcceptList(ctx sdk.Context, set types.DenomList, del []string) error {
+	acceptList, err := k.AcceptList(ctx).Update(set, del)
+	if err != nil {
+		return err
+	}
+	if err := types.ValidateDerivedFeeds(k.AllDerivedFeeds(ctx), acceptList); err != nil {
+		return err
+	}
+
+	removed := []string{}
+	for _, d := range k.AcceptList(ctx) {
+		if acceptList.Contains(d.SymbolDenom) {
+			continue
+		}
+		if _, ok := k.GetPriceOverride(ctx, d.SymbolDenom); ok {
+			return types.ErrInvalidParams.Wrapf("%s has an active price override", d.SymbolDenom)
+		}
+		removed = append(removed, d.SymbolDenom)
+	}
+
+	k.SetAcceptList(ctx, acceptList)
+	kvs := ctx.KVStore(k.storeKey)
+	for _, symbol := range removed {
+		kvs.Delete(types.KeyExchangeRate(symbol))
+		kvs.Delete(types.KeyPriceVolatility(symbol))
+	}
+
+	sdkutil.Emit(&ctx, &types.EventUpdateAcceptList{SetDenoms: set, DeleteDenoms: del})
+	return nil
+}
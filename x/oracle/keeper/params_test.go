@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/checkers"
 	"github.com/umee-network/umee/v6/x/oracle/types"
 )
 
@@ -19,3 +23,74 @@ func (s *IntegrationTestSuite) TestVoteThreshold() {
 	voteThresholdDec := app.OracleKeeper.VoteThreshold(ctx)
 	s.Require().Equal(newVoteTreshold, voteThresholdDec)
 }
+
+func (s *IntegrationTestSuite) TestMsgServer_GovUpdateParams() {
+	app, ctx := s.app, s.ctx
+	gov := checkers.GovModuleAddr
+	changes := types.Params{MaximumPriceStamps: 50, SlashWindow: 1}
+	acpChanges := types.AvgCounterParams{AvgPeriod: 4 * time.Hour, AvgShift: time.Hour}
+	rp, pp := types.DefaultRewardParams(), types.DefaultPenaltyParams()
+
+	_, err := s.msgServer.GovUpdateParams(ctx,
+		types.NewMsgGovUpdateParams(addr.String(), []string{"MaximumPriceStamps"}, changes, acpChanges, rp, pp))
+	s.Require().ErrorContains(err, "expected "+gov)
+
+	_, err = s.msgServer.GovUpdateParams(ctx,
+		types.NewMsgGovUpdateParams(gov, []string{"MaximumPriceStamps", "SlashWindow"}, changes, acpChanges, rp, pp))
+	s.Require().ErrorContains(err, "SlashWindow must be greater than or equal with VotePeriod")
+
+	// updating the avg counter params resets the avg counters
+	app.OracleKeeper.AddHistoricPrice(ctx, displayDenom, sdk.NewDec(2))
+	avg, err := app.OracleKeeper.HistoricAvgPrice(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), avg)
+
+	expected := app.OracleKeeper.GetParams(ctx)
+	expected.MaximumPriceStamps = 50
+	keys := []string{"MaximumPriceStamps", types.KeyAvgPeriod, types.KeyAvgShift}
+	_, err = s.msgServer.GovUpdateParams(ctx, types.NewMsgGovUpdateParams(gov, keys, changes, acpChanges, rp, pp))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, app.OracleKeeper.GetParams(ctx))
+	s.Require().Equal(acpChanges, app.OracleKeeper.GetHistoricAvgCounterParams(ctx))
+	avg, err = app.OracleKeeper.HistoricAvgPrice(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().True(avg.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestMsgServer_GovUpdateAcceptList() {
+	app, ctx := s.app, s.ctx
+	gov := checkers.GovModuleAddr
+	atom := types.Denom{BaseDenom: "ibc/atom", SymbolDenom: "ATOM", Exponent: 6}
+	acceptList := app.OracleKeeper.AcceptList(ctx)
+
+	_, err := s.msgServer.GovUpdateAcceptList(ctx,
+		types.NewMsgGovUpdateAcceptList(addr.String(), types.DenomList{atom}, nil))
+	s.Require().ErrorContains(err, "expected "+gov)
+
+	_, err = s.msgServer.GovUpdateAcceptList(ctx,
+		types.NewMsgGovUpdateAcceptList(gov, nil, []string{atom.BaseDenom}))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	_, err = s.msgServer.GovUpdateAcceptList(ctx,
+		types.NewMsgGovUpdateAcceptList(gov, types.DenomList{atom}, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(append(acceptList, atom), app.OracleKeeper.AcceptList(ctx))
+
+	// denoms with an active price override can't be removed
+	_, err = s.msgServer.GovSetPriceOverride(ctx,
+		types.NewMsgGovSetPriceOverride(gov, "", "ATOM", sdk.NewDec(10), time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.GovUpdateAcceptList(ctx,
+		types.NewMsgGovUpdateAcceptList(gov, nil, []string{atom.BaseDenom}))
+	s.Require().ErrorContains(err, "ATOM has an active price override")
+	_, err = s.msgServer.GovCancelPriceOverride(ctx, types.NewMsgGovCancelPriceOverride(gov, "ATOM"))
+	s.Require().NoError(err)
+
+	// removing a denom removes its exchange rate
+	_, err = s.msgServer.GovUpdateAcceptList(ctx,
+		types.NewMsgGovUpdateAcceptList(gov, nil, []string{atom.BaseDenom}))
+	s.Require().NoError(err)
+	s.Require().Equal(acceptList, app.OracleKeeper.AcceptList(ctx))
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// GetPenaltyParams returns the penalty params, or the default ones if they were never set.
+func (k Keeper) GetPenaltyParams(ctx sdk.Context) types.PenaltyParams {
+	pp := store.GetValue[*types.PenaltyParams](ctx.KVStore(k.storeKey), types.KeyPenaltyParams, "penalty_params")
+	if pp == nil {
+		return types.DefaultPenaltyParams()
+	}
+	return *pp
+}
+
+// SetPenaltyParams validates and sets the penalty params.
+func (k Keeper) SetPenaltyParams(ctx sdk.Context, pp types.PenaltyParams) error {
+	if err := pp.Validate(); err != nil {
+		return err
+	}
+	return store.SetValue(ctx.KVStore(k.storeKey), types.KeyPenaltyParams, &pp, "penalty_params")
+}
+
+// GetOffenceHistory returns the offence history of the validator. A validator without
+// history has a zero since height.
+func (k Keeper) GetOffenceHistory(ctx sdk.Context, operator sdk.ValAddress) types.OffenceHistory {
+	h := store.GetValue[*types.OffenceHistory](ctx.KVStore(k.storeKey),
+		types.KeyOffenceHistory(operator), "offence_history")
+	if h == nil {
+		return types.OffenceHistory{Validator: operator.String()}
+	}
+	return *h
+}
+
+// SetOffenceHistory sets the offence history of the validator.
+func (k Keeper) SetOffenceHistory(ctx sdk.Context, operator sdk.ValAddress, h types.OffenceHistory) {
+	err := store.SetValue(ctx.KVStore(k.storeKey), types.KeyOffenceHistory(operator), &h, "offence_history")
+	util.Panic(err)
+}
+
+// AllOffenceHistories returns the offence history of all tracked validators.
+func (k Keeper) AllOffenceHistories(ctx sdk.Context) []types.OffenceHistory {
+	return store.MustLoadAll[*types.OffenceHistory](ctx.KVStore(k.storeKey), types.KeyPrefixOffenceHistory)
+}
+
+// InitOffenceHistories starts the offence history of every bonded validator without one, with a zero
+// since height, so validators already bonded when the offence history is introduced get no grace period.
+func (k Keeper) InitOffenceHistories(ctx sdk.Context) {
+	for _, v := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		operator := v.GetOperator()
+		if ctx.KVStore(k.storeKey).Has(types.KeyOffenceHistory(operator)) {
+			continue
+		}
+		k.SetOffenceHistory(ctx, operator, types.OffenceHistory{Validator: operator.String()})
+	}
+}
+
+// TrackOffenceHistory starts the offence history of a validator taking part in a vote period,
+// if it doesn't have one yet. The grace period of the validator starts at the current height.
+func (k Keeper) TrackOffenceHistory(ctx sdk.Context, operator sdk.ValAddress) {
+	if ctx.KVStore(k.storeKey).Has(types.KeyOffenceHistory(operator)) {
+		return
+	}
+	k.SetOffenceHistory(ctx, operator, types.OffenceHistory{
+		Validator:   operator.String(),
+		SinceHeight: ctx.BlockHeight(),
+	})
+}
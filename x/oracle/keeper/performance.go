@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// GetValidatorPerformance returns the oracle performance of the validator over the current
+// slash window.
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorPerformance {
+	vp := store.GetValue[*types.ValidatorPerformance](ctx.KVStore(k.storeKey),
+		types.KeyValidatorPerformance(operator), "validator_performance")
+	if vp == nil {
+		return types.ValidatorPerformance{Validator: operator.String()}
+	}
+	return *vp
+}
+
+// SetValidatorPerformance sets the oracle performance of the validator.
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, vp types.ValidatorPerformance) {
+	err := store.SetValue(ctx.KVStore(k.storeKey), types.KeyValidatorPerformance(operator), &vp,
+		"validator_performance")
+	util.Panic(err)
+}
+
+// AllValidatorPerformances returns the oracle performance of all validators with votes or
+// rewards in the current slash window.
+func (k Keeper) AllValidatorPerformances(ctx sdk.Context) []types.ValidatorPerformance {
+	return store.MustLoadAll[*types.ValidatorPerformance](ctx.KVStore(k.storeKey),
+		types.KeyPrefixValidatorPerformance)
+}
+
+// AddVotePerformance merges the vote statistics of a vote period, by validator operator
+// address, into the validator performances.
+func (k Keeper) AddVotePerformance(ctx sdk.Context, stats map[string][]types.DenomPerformance) {
+	validators := make([]string, 0, len(stats))
+	for v := range stats {
+		validators = append(validators, v)
+	}
+	sort.Strings(validators)
+
+	for _, v := range validators {
+		operator, err := sdk.ValAddressFromBech32(v)
+		util.Panic(err)
+		vp := k.GetValidatorPerformance(ctx, operator)
+		vp.AddDenoms(stats[v])
+		k.SetValidatorPerformance(ctx, operator, vp)
+	}
+}
+
+// AddValidatorRewards adds oracle rewards earned by the validator to its performance.
+func (k Keeper) AddValidatorRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	vp := k.GetValidatorPerformance(ctx, operator)
+	vp.Rewards = vp.Rewards.Add(rewards...)
+	k.SetValidatorPerformance(ctx, operator, vp)
+}
+
+// ClearValidatorPerformances removes the performance of all validators. It is called at the
+// end of every slash window.
+func (k Keeper) ClearValidatorPerformances(ctx sdk.Context) {
+	kvs := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(kvs, types.KeyPrefixValidatorPerformance)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		kvs.Delete(iter.Key())
+	}
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/util/sdkutil"
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// GetPriceVolatility returns the volatility estimate of a denom. A denom without estimate has
+// zero samples.
+func (k Keeper) GetPriceVolatility(ctx sdk.Context, denom string) types.PriceVolatility {
+	symbol := strings.ToUpper(denom)
+	pv := store.GetValue[*types.PriceVolatility](ctx.KVStore(k.storeKey), types.KeyPriceVolatility(symbol),
+		"price_volatility")
+	if pv == nil {
+		return types.PriceVolatility{Denom: symbol, Variance: sdk.ZeroDec()}
+	}
+	return *pv
+}
+
+// SetPriceVolatility sets the volatility estimate of a denom.
+func (k Keeper) SetPriceVolatility(ctx sdk.Context, pv types.PriceVolatility) {
+	err := store.SetValue(ctx.KVStore(k.storeKey), types.KeyPriceVolatility(pv.Denom), &pv, "price_volatility")
+	util.Panic(err)
+}
+
+// AllPriceVolatilities returns the volatility estimates of all denoms, ordered by denom.
+func (k Keeper) AllPriceVolatilities(ctx sdk.Context) []types.PriceVolatility {
+	return store.MustLoadAll[*types.PriceVolatility](ctx.KVStore(k.storeKey), types.KeyPrefixPriceVolatility)
+}
+
+// RecordPriceMoves compares the new tallied exchange rates with the current ones, before they
+// are replaced. It updates the volatility estimate of every denom with a previous exchange
+// rate, and emits EventPriceMove when the exchange rate moved, from the previous one or from
+// the latest historic median, by more than the price move threshold of the denom.
+func (k Keeper) RecordPriceMoves(ctx sdk.Context, acceptList types.DenomList, rates types.ExchangeRateTuples) {
+	for _, er := range rates {
+		prev := store.GetValue[*types.ExchangeRate](ctx.KVStore(k.storeKey), types.KeyExchangeRate(er.Denom),
+			"exchange_rate")
+		if prev == nil || !prev.Rate.IsPositive() {
+			continue
+		}
+		change := types.RelativeChange(prev.Rate, er.ExchangeRate)
+		k.SetPriceVolatility(ctx, k.GetPriceVolatility(ctx, er.Denom).Update(change))
+
+		threshold := acceptList.PriceMoveThreshold(er.Denom)
+		if threshold == nil {
+			continue
+		}
+		median, medianChange := sdk.ZeroDec(), sdk.ZeroDec()
+		if medians := k.HistoricMedians(ctx, strings.ToUpper(er.Denom), 1); len(medians) > 0 &&
+			medians[0].ExchangeRateTuple.ExchangeRate.IsPositive() {
+			median = medians[0].ExchangeRateTuple.ExchangeRate
+			medianChange = types.RelativeChange(median, er.ExchangeRate)
+		}
+		if change.Abs().LTE(*threshold) && medianChange.Abs().LTE(*threshold) {
+			continue
+		}
+		sdkutil.Emit(&ctx, &types.EventPriceMove{
+			Denom:        strings.ToUpper(er.Denom),
+			PreviousRate: prev.Rate,
+			Rate:         er.ExchangeRate,
+			Change:       change,
+			Median:       median,
+			MedianChange: medianChange,
+			Threshold:    *threshold,
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestRecordPriceMoves() {
+	app, ctx := s.app, s.ctx
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	threshold := sdk.NewDecWithPrec(1, 1)
+	acceptList := types.DenomList{{BaseDenom: bondDenom, SymbolDenom: displayDenom, PriceMoveThreshold: &threshold}}
+	rates := func(rate int64) types.ExchangeRateTuples {
+		return types.ExchangeRateTuples{{Denom: displayDenom, ExchangeRate: sdk.NewDec(rate)}}
+	}
+	priceMoves := func() int {
+		n := 0
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == "umee.oracle.v1.EventPriceMove" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// no previous exchange rate
+	app.OracleKeeper.RecordPriceMoves(ctx, acceptList, rates(10))
+	s.Require().Zero(app.OracleKeeper.GetPriceVolatility(ctx, displayDenom).Samples)
+	s.Require().Zero(priceMoves())
+
+	// +5%: below the threshold
+	app.OracleKeeper.SetExchangeRate(ctx, displayDenom, sdk.NewDec(10))
+	app.OracleKeeper.RecordPriceMoves(ctx, acceptList, types.ExchangeRateTuples{
+		{Denom: displayDenom, ExchangeRate: sdk.MustNewDecFromStr("10.5")},
+	})
+	s.Require().Zero(priceMoves())
+	pv := app.OracleKeeper.GetPriceVolatility(ctx, displayDenom)
+	s.Require().Equal(uint64(1), pv.Samples)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.0025"), pv.Variance)
+
+	// -20%: above the threshold
+	app.OracleKeeper.RecordPriceMoves(ctx, acceptList, rates(8))
+	s.Require().Equal(1, priceMoves())
+	s.Require().Equal(uint64(2), app.OracleKeeper.GetPriceVolatility(ctx, displayDenom).Samples)
+
+	// +5% from the previous exchange rate, but +50% from the latest median
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, uint64(ctx.BlockHeight()), sdk.MustNewDecFromStr("7"))
+	app.OracleKeeper.RecordPriceMoves(ctx, acceptList, types.ExchangeRateTuples{
+		{Denom: displayDenom, ExchangeRate: sdk.MustNewDecFromStr("10.5")},
+	})
+	s.Require().Equal(2, priceMoves())
+
+	// no threshold: the volatility is still updated
+	app.OracleKeeper.RecordPriceMoves(ctx, types.DenomList{}, rates(100))
+	s.Require().Equal(2, priceMoves())
+	s.Require().Equal(uint64(4), app.OracleKeeper.GetPriceVolatility(ctx, displayDenom).Samples)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_PriceVolatility() {
+	app, ctx := s.app, s.ctx
+	app.OracleKeeper.SetPriceVolatility(ctx, types.PriceVolatility{
+		Denom:    "UMEE",
+		Variance: sdk.NewDecWithPrec(4, 2),
+		Samples:  3,
+	})
+
+	res, err := s.queryClient.PriceVolatility(ctx, &types.QueryPriceVolatility{Denom: "umee"})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomVolatility{{Denom: "UMEE", Volatility: sdk.NewDecWithPrec(2, 1), Samples: 3}},
+		res.Volatilities)
+
+	res, err = s.queryClient.PriceVolatility(ctx, &types.QueryPriceVolatility{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Volatilities, 1)
+
+	_, err = s.queryClient.PriceVolatility(ctx, &types.QueryPriceVolatility{Denom: "ATOM"})
+	s.Require().ErrorContains(err, "no volatility estimate for ATOM")
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util"
+	"github.com/umee-network/umee/v6/util/sdkutil"
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// GetPriceOverride returns the active price override of a denom. Returns false if the denom
+// has no price override, or if it is expired.
+func (k Keeper) GetPriceOverride(ctx sdk.Context, denom string) (types.PriceOverride, bool) {
+	o := store.GetValue[*types.PriceOverride](ctx.KVStore(k.storeKey), types.KeyPriceOverride(denom),
+		"price_override")
+	if o == nil || o.IsExpired(ctx.BlockTime()) {
+		return types.PriceOverride{}, false
+	}
+	return *o, true
+}
+
+// SetPriceOverride stores a price override without validating it.
+// NOTE: must not be used outside of genesis import. Use OverridePrice instead.
+func (k Keeper) SetPriceOverride(ctx sdk.Context, o types.PriceOverride) {
+	err := store.SetValue(ctx.KVStore(k.storeKey), types.KeyPriceOverride(o.SymbolDenom), &o, "price_override")
+	util.Panic(err)
+}
+
+// AllPriceOverrides returns all the stored price overrides, including expired ones which
+// are not removed yet, ordered by symbol denom.
+func (k Keeper) AllPriceOverrides(ctx sdk.Context) []types.PriceOverride {
+	return store.MustLoadAll[*types.PriceOverride](ctx.KVStore(k.storeKey), types.KeyPrefixPriceOverride)
+}
+
+// OverridePrice sets a manual exchange rate of an accepted denom or derived feed for the
+// given duration. The exchange rate is set immediately, and replaces the tallied exchange rate
+// of the denom until the override expires or is cancelled.
+func (k Keeper) OverridePrice(
+	ctx sdk.Context,
+	authority, denom string,
+	rate sdk.Dec,
+	duration time.Duration,
+) error {
+	symbol := strings.ToUpper(denom)
+	if !k.AcceptList(ctx).Contains(symbol) && !ctx.KVStore(k.storeKey).Has(types.KeyDerivedFeed(symbol)) {
+		return types.ErrUnknownDenom.Wrap(denom)
+	}
+
+	o := types.PriceOverride{
+		SymbolDenom:  symbol,
+		ExchangeRate: rate,
+		Expiry:       ctx.BlockTime().Add(duration),
+		Authority:    authority,
+	}
+	if err := o.Validate(); err != nil {
+		return err
+	}
+	k.SetPriceOverride(ctx, o)
+	k.SetExchangeRate(ctx, symbol, rate)
+
+	sdkutil.Emit(&ctx, &types.EventSetPriceOverride{
+		Denom: symbol, Rate: rate, Expiry: o.Expiry, Authority: authority,
+	})
+	return nil
+}
+
+// CancelPriceOverride removes the price override of a denom before its expiry. The exchange
+// rate of the denom is kept until the next tally.
+func (k Keeper) CancelPriceOverride(ctx sdk.Context, denom string) error {
+	if _, ok := k.GetPriceOverride(ctx, denom); !ok {
+		return types.ErrUnknownDenom.Wrapf("no active price override of %s", denom)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.KeyPriceOverride(denom))
+	sdkutil.Emit(&ctx, &types.EventRemovePriceOverride{Denom: strings.ToUpper(denom), Cancelled: true})
+	return nil
+}
+
+// ExpirePriceOverrides removes the price overrides which expired. It is called at the end of
+// every block.
+func (k Keeper) ExpirePriceOverrides(ctx sdk.Context) {
+	kvs := ctx.KVStore(k.storeKey)
+	for _, o := range k.AllPriceOverrides(ctx) {
+		if o.IsExpired(ctx.BlockTime()) {
+			kvs.Delete(types.KeyPriceOverride(o.SymbolDenom))
+			sdkutil.Emit(&ctx, &types.EventRemovePriceOverride{Denom: o.SymbolDenom})
+		}
+	}
+}
+
+// ApplyPriceOverrides sets the exchange rates of overridden denoms which were not tallied in
+// the current block, so that their exchange rate doesn't become stale when their ballot is
+// dropped. It must be called after the voted exchange rates are set.
+func (k Keeper) ApplyPriceOverrides(ctx sdk.Context) {
+	acceptList := k.AcceptList(ctx)
+	for _, o := range k.AllPriceOverrides(ctx) {
+		if o.IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		er, err := k.getExchangeRate(ctx, o.SymbolDenom, acceptList)
+		if err == nil && er.Timestamp.Equal(ctx.BlockTime()) {
+			continue
+		}
+		k.SetExchangeRate(ctx, o.SymbolDenom, o.ExchangeRate)
+	}
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/umee-network/umee/v6/util/checkers"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestPriceOverride() {
+	app, ctx := s.app, s.ctx
+	gov := checkers.GovModuleAddr
+	rate := sdk.NewDec(5)
+
+	// only x/gov and the emergency group can override prices
+	_, err := s.msgServer.GovSetPriceOverride(ctx,
+		types.NewMsgGovSetPriceOverride(addr.String(), "depeg", displayDenom, rate, time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.GovSetPriceOverride(ctx,
+		types.NewMsgGovSetPriceOverride(gov, "", "FOO", rate, time.Hour))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	app.UGovKeeperB.Keeper(&ctx).SetEmergencyGroup(addr)
+	_, err = s.msgServer.GovSetPriceOverride(ctx,
+		types.NewMsgGovSetPriceOverride(addr.String(), "depeg", displayDenom, rate, time.Hour))
+	s.Require().NoError(err)
+
+	// the override is set immediately, and replaces tallied exchange rates
+	er, err := app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(rate, er.Rate)
+	app.OracleKeeper.SetExchangeRate(ctx, displayDenom, sdk.NewDec(2))
+	er, err = app.OracleKeeper.GetExchangeRate(ctx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(rate, er.Rate)
+
+	override := types.PriceOverride{
+		SymbolDenom:  displayDenom,
+		ExchangeRate: rate,
+		Expiry:       ctx.BlockTime().Add(time.Hour),
+		Authority:    addr.String(),
+	}
+	res, err := s.queryClient.ExchangeRates(ctx, &types.QueryExchangeRates{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PriceOverride{override}, res.Overrides)
+
+	// expired overrides are removed, and don't replace tallied exchange rates anymore
+	expiredCtx := ctx.WithBlockTime(override.Expiry)
+	_, ok := app.OracleKeeper.GetPriceOverride(expiredCtx, displayDenom)
+	s.Require().False(ok)
+	s.Require().Len(app.OracleKeeper.AllPriceOverrides(expiredCtx), 1)
+	app.OracleKeeper.ExpirePriceOverrides(expiredCtx)
+	s.Require().Empty(app.OracleKeeper.AllPriceOverrides(expiredCtx))
+	app.OracleKeeper.SetExchangeRate(expiredCtx, displayDenom, sdk.NewDec(2))
+	er, err = app.OracleKeeper.GetExchangeRate(expiredCtx, displayDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), er.Rate)
+
+	// only x/gov can cancel overrides
+	_, err = s.msgServer.GovSetPriceOverride(ctx,
+		types.NewMsgGovSetPriceOverride(gov, "", displayDenom, rate, time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.GovCancelPriceOverride(ctx, types.NewMsgGovCancelPriceOverride(addr.String(), displayDenom))
+	s.Require().ErrorContains(err, "expected "+gov)
+	_, err = s.msgServer.GovCancelPriceOverride(ctx, types.NewMsgGovCancelPriceOverride(gov, "atom"))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+	_, err = s.msgServer.GovCancelPriceOverride(ctx, types.NewMsgGovCancelPriceOverride(gov, "umee"))
+	s.Require().NoError(err)
+	_, ok = app.OracleKeeper.GetPriceOverride(ctx, displayDenom)
+	s.Require().False(ok)
+}
@@ -22,25 +22,61 @@ func prependUmeeIfUnique(voteTargets []string) []string {
 
 // RewardBallotWinners is executed at the end of every voting period, where we
 // give out a portion of seigniorage reward(reward-weight) to the oracle voters
-// that voted correctly.
+// that voted correctly. The rewards are split among the claims with the reward formula.
 func (k Keeper) RewardBallotWinners(
 	ctx sdk.Context,
 	votePeriod int64,
 	rewardDistributionWindow int64,
 	voteTargets []string,
+	numVoteTargets int,
 	ballotWinners []types.Claim,
 ) {
-	// sum weight of the claims
-	var ballotPowerSum int64
-	for _, winner := range ballotWinners {
-		ballotPowerSum += winner.Weight
+	shares := k.GetRewardParams(ctx).RewardShares(ballotWinners, numVoteTargets)
+	// early return - ballot was empty
+	if shares == nil {
+		return
 	}
+	periodRewards := k.periodRewards(ctx, votePeriod, rewardDistributionWindow, voteTargets)
+	rewards := validatorRewards(periodRewards, ballotWinners, shares)
 
-	// early return - ballot was empty
-	if ballotPowerSum == 0 {
+	// distribute rewards
+	var distributedReward sdk.Coins
+	for _, r := range rewards {
+		if r.Rewards.IsZero() {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(r.Validator)
+		if err != nil {
+			panic(err)
+		}
+		receiverVal := k.StakingKeeper.Validator(ctx, valAddr)
+		// in case absence of the validator, we just skip distribution
+		if receiverVal == nil {
+			continue
+		}
+
+		k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(r.Rewards...))
+		k.AddValidatorRewards(ctx, valAddr, r.Rewards)
+		distributedReward = distributedReward.Add(r.Rewards...)
+	}
+	if distributedReward.IsZero() {
 		return
 	}
 
+	// move distributed reward to distribution module
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward)
+	if err != nil {
+		panic(fmt.Errorf("failed to send coins to distribution module %w", err))
+	}
+}
+
+// periodRewards returns the share of the reward pool distributed in a vote period.
+func (k Keeper) periodRewards(
+	ctx sdk.Context,
+	votePeriod int64,
+	rewardDistributionWindow int64,
+	voteTargets []string,
+) sdk.DecCoins {
 	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
 	var periodRewards sdk.DecCoins
 	rewardDenoms := prependUmeeIfUnique(voteTargets)
@@ -57,30 +93,48 @@ func (k Keeper) RewardBallotWinners(
 			sdk.NewDecFromInt(rewardPool.Amount).Mul(distributionRatio),
 		))
 	}
+	return periodRewards
+}
 
-	// distribute rewards
-	var distributedReward sdk.Coins
-
-	for _, winner := range ballotWinners {
-		receiverVal := k.StakingKeeper.Validator(ctx, winner.Validator)
-		// in case absence of the validator, we just skip distribution
-		if receiverVal == nil {
+// validatorRewards splits the period rewards among the claims with a positive share.
+func validatorRewards(periodRewards sdk.DecCoins, claims []types.Claim, shares []sdk.Dec) []types.ValidatorReward {
+	rewards := []types.ValidatorReward{}
+	for i, share := range shares {
+		if !share.IsPositive() {
 			continue
 		}
-
 		// reflects contribution
-		rewardCoins, _ := periodRewards.MulDec(sdk.NewDec(winner.Weight).QuoInt64(ballotPowerSum)).TruncateDecimal()
-		if rewardCoins.IsZero() {
-			continue
-		}
-
-		k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
-		distributedReward = distributedReward.Add(rewardCoins...)
+		rewardCoins, _ := periodRewards.MulDec(share).TruncateDecimal()
+		rewards = append(rewards, types.ValidatorReward{
+			Validator: claims[i].Validator.String(),
+			Share:     share,
+			Rewards:   rewardCoins,
+		})
 	}
+	return rewards
+}
 
-	// move distributed reward to distribution module
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward)
+// DryRunRewardDistribution tallies the votes of the current vote period, and returns the
+// period rewards and their split among the validators with the given reward params, as if
+// the vote period ended in the current block.
+func (k Keeper) DryRunRewardDistribution(
+	ctx sdk.Context,
+	rp types.RewardParams,
+) (sdk.DecCoins, []types.ValidatorReward, error) {
+	params := k.GetParams(ctx)
+	tally, err := k.TallyVotes(ctx, params)
 	if err != nil {
-		panic(fmt.Errorf("failed to send coins to distribution module %w", err))
+		return nil, nil, err
+	}
+
+	voteTargets := map[string]bool{}
+	voteTargetDenoms := []string{}
+	for _, v := range params.AcceptList {
+		voteTargets[v.SymbolDenom] = true
+		voteTargetDenoms = append(voteTargetDenoms, v.BaseDenom)
 	}
+	periodRewards := k.periodRewards(ctx, int64(params.VotePeriod), int64(params.RewardDistributionWindow),
+		voteTargetDenoms)
+	shares := rp.RewardShares(tally.Claims, len(voteTargets))
+	return periodRewards, validatorRewards(periodRewards, tally.Claims, shares), nil
 }
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/util/store"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// GetRewardParams returns the reward params, or the default ones if they were never set.
+func (k Keeper) GetRewardParams(ctx sdk.Context) types.RewardParams {
+	rp := store.GetValue[*types.RewardParams](ctx.KVStore(k.storeKey), types.KeyRewardParams, "reward_params")
+	if rp == nil {
+		return types.DefaultRewardParams()
+	}
+	return *rp
+}
+
+// SetRewardParams validates and sets the reward params.
+func (k Keeper) SetRewardParams(ctx sdk.Context, rp types.RewardParams) error {
+	if err := rp.Validate(); err != nil {
+		return err
+	}
+	return store.SetValue(ctx.KVStore(k.storeKey), types.KeyRewardParams, &rp, "reward_params")
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/umee-network/umee/v6/util/checkers"
 	"github.com/umee-network/umee/v6/x/oracle/types"
 )
 
@@ -30,7 +31,7 @@ func (s *IntegrationTestSuite) TestRewardBallotWinners() {
 	votePeriodsPerWindow := sdk.NewDec((int64)(s.app.OracleKeeper.RewardDistributionWindow(s.ctx))).
 		QuoInt64((int64)(s.app.OracleKeeper.VotePeriod(s.ctx))).
 		TruncateInt64()
-	s.app.OracleKeeper.RewardBallotWinners(s.ctx, (int64)(s.app.OracleKeeper.VotePeriod(s.ctx)), (int64)(s.app.OracleKeeper.RewardDistributionWindow(s.ctx)), voteTargets, claims)
+	s.app.OracleKeeper.RewardBallotWinners(s.ctx, (int64)(s.app.OracleKeeper.VotePeriod(s.ctx)), (int64)(s.app.OracleKeeper.RewardDistributionWindow(s.ctx)), voteTargets, len(voteTargets), claims)
 	outstandingRewardsDec := s.app.DistrKeeper.GetValidatorOutstandingRewardsCoins(s.ctx, valAddr)
 	outstandingRewards, _ := outstandingRewardsDec.TruncateDecimal()
 	s.Require().Equal(sdk.NewDecFromInt(givingAmt.AmountOf(types.UmeeDenom)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).TruncateInt(),
@@ -39,7 +40,81 @@ func (s *IntegrationTestSuite) TestRewardBallotWinners() {
 
 func (s *IntegrationTestSuite) TestRewardBallotWinnersZeroPower() {
 	zeroClaim := types.NewClaim(0, 0, 0, valAddr)
-	s.app.OracleKeeper.RewardBallotWinners(s.ctx, 0, 0, []string{}, []types.Claim{zeroClaim})
+	s.app.OracleKeeper.RewardBallotWinners(s.ctx, 0, 0, []string{}, 0, []types.Claim{zeroClaim})
 	outstandingRewardsDec := s.app.DistrKeeper.GetValidatorOutstandingRewardsCoins(s.ctx, valAddr)
 	s.Require().Equal("", outstandingRewardsDec.String())
 }
+
+func (s *IntegrationTestSuite) TestMsgServer_GovUpdateRewardParams() {
+	app, ctx := s.app, s.ctx
+	gov := checkers.GovModuleAddr
+	params := app.OracleKeeper.GetParams(ctx)
+	rp := types.RewardParams{
+		Formula:           types.RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED,
+		FullCoverageBonus: sdk.NewDecWithPrec(1, 1),
+	}
+	s.Require().Equal(types.DefaultRewardParams(), app.OracleKeeper.GetRewardParams(ctx))
+	keys := []string{types.RewardParamsKey}
+
+	// the reward params are only updated with their key
+	_, err := s.msgServer.GovUpdateParams(ctx, types.NewMsgGovUpdateParams(gov, []string{"VotePeriod"}, params,
+		types.AvgCounterParams{}, rp, types.PenaltyParams{}))
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultRewardParams(), app.OracleKeeper.GetRewardParams(ctx))
+
+	_, err = s.msgServer.GovUpdateParams(ctx, types.NewMsgGovUpdateParams(gov, keys, types.Params{},
+		types.AvgCounterParams{}, types.RewardParams{}, types.PenaltyParams{}))
+	s.Require().ErrorContains(err, "full coverage bonus must be in [0, 1]")
+
+	_, err = s.msgServer.GovUpdateParams(ctx, types.NewMsgGovUpdateParams(gov, keys, types.Params{},
+		types.AvgCounterParams{}, rp, types.PenaltyParams{}))
+	s.Require().NoError(err)
+	s.Require().Equal(rp, app.OracleKeeper.GetRewardParams(ctx))
+	s.Require().Equal(params, app.OracleKeeper.GetParams(ctx))
+}
+
+func (s *IntegrationTestSuite) TestQuerier_RewardDistribution() {
+	app, ctx := s.app, s.ctx
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin(types.UmeeDenom, 30000000))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, "leverage", pool))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, "leverage", types.ModuleName, pool))
+
+	// valAddr votes the exchange rate, and valAddr2 is off by half the reward spread
+	s.Require().NoError(app.OracleKeeper.SetVoteThreshold(ctx, sdk.NewDecWithPrec(34, 2)))
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(
+		types.ExchangeRateTuples{{Denom: displayDenom, ExchangeRate: sdk.NewDec(100)}}, valAddr))
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr2, types.NewAggregateExchangeRateVote(
+		types.ExchangeRateTuples{{Denom: displayDenom, ExchangeRate: sdk.MustNewDecFromStr("100.5")}}, valAddr2))
+
+	params := app.OracleKeeper.GetParams(ctx)
+	periodRewards := sdk.NewDecCoinsFromCoins(pool...).MulDec(
+		sdk.NewDec(int64(params.VotePeriod)).QuoInt64(int64(params.RewardDistributionWindow)))
+
+	res, err := s.queryClient.RewardDistribution(ctx, &types.QueryRewardDistribution{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultRewardParams(), res.Params)
+	s.Require().Equal(periodRewards, res.PeriodRewards)
+	s.Require().Len(res.Rewards, 2)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), res.Rewards[0].Share)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), res.Rewards[1].Share)
+
+	// with the accuracy weighted formula, the exact vote earns 2/3 of the rewards
+	res, err = s.queryClient.RewardDistribution(ctx, &types.QueryRewardDistribution{
+		Formula: types.RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rewards, 2)
+	shares := map[string]sdk.Dec{}
+	for _, r := range res.Rewards {
+		shares[r.Validator] = r.Share
+	}
+	s.Require().Equal(sdk.MustNewDecFromStr("0.666666666666666667"), shares[valAddr.String()])
+	s.Require().Equal(sdk.MustNewDecFromStr("0.333333333333333333"), shares[valAddr2.String()])
+
+	_, err = s.queryClient.RewardDistribution(ctx, &types.QueryRewardDistribution{FullCoverageBonus: "2"})
+	s.Require().ErrorContains(err, "full coverage bonus must be in [0, 1]")
+
+	// the dry run doesn't distribute rewards
+	s.Require().Equal(pool, app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName)))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/umee-network/umee/v6/util/sdkutil"
 	"github.com/umee-network/umee/v6/x/oracle/types"
@@ -11,48 +12,28 @@ import (
 // calculates the "valid vote rate" as:
 // (votePeriodsPerWindow - missCounter)/votePeriodsPerWindow.
 //
-// If the valid vote rate is below the minValidPerWindow, the validator will be
-// slashed and jailed.
+// If the valid vote rate is below the minValidPerWindow, the validator commits an
+// offence, penalized according to the penalty params: it is warned, jailed, or slashed
+// and jailed.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	var (
-		height               = ctx.BlockHeight()
-		distributionHeight   = height - sdk.ValidatorUpdateDelay - 1
-		slashWindow          = int64(k.SlashWindow(ctx))
+		slashWindow          = k.SlashWindow(ctx)
 		votePeriod           = int64(k.VotePeriod(ctx))
-		votePeriodsPerWindow = sdk.NewDec(slashWindow).QuoInt64(votePeriod).TruncateInt64()
+		votePeriodsPerWindow = sdk.NewDec(int64(slashWindow)).QuoInt64(votePeriod).TruncateInt64()
 		minValidPerWindow    = k.MinValidPerWindow(ctx)
-		slashFraction        = k.SlashFraction(ctx)
-		powerReduction       = k.StakingKeeper.PowerReduction(ctx)
+		penaltyParams        = k.GetPenaltyParams(ctx)
 	)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
 		diff := sdk.NewInt(votePeriodsPerWindow - int64(missCounter))
 		validVoteRate := sdk.NewDecFromInt(diff).QuoInt64(votePeriodsPerWindow)
 
-		// Slash and jail the validator if their valid vote rate is smaller than the
+		// Penalize the validator if their valid vote rate is smaller than the
 		// minimum threshold.
 		if validVoteRate.LT(minValidPerWindow) {
 			validator := k.StakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
-				consAddr, err := validator.GetConsAddr()
-				if err != nil {
-					panic(err)
-				}
-
-				k.StakingKeeper.Slash(
-					ctx,
-					consAddr,
-					distributionHeight,
-					validator.GetConsensusPower(powerReduction), slashFraction,
-				)
-				k.StakingKeeper.Jail(ctx, consAddr)
-
-				sdkutil.Emit(&ctx, &types.EventSlash{
-					Validator: consAddr.String(),
-					Factor:    slashFraction,
-					Reason:    "voting_rate",
-					Jailed:    true,
-				})
+				k.penalize(ctx, operator, validator, validVoteRate, penaltyParams, slashWindow)
 			}
 		}
 
@@ -60,3 +41,69 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 		return false
 	})
 }
+
+// penalize records an offence of the validator in its offence history, and applies the
+// penalty of the offence level. Validators in their grace period are only warned.
+func (k Keeper) penalize(
+	ctx sdk.Context,
+	operator sdk.ValAddress,
+	validator stakingtypes.ValidatorI,
+	validVoteRate sdk.Dec,
+	pp types.PenaltyParams,
+	slashWindow uint64,
+) {
+	height := ctx.BlockHeight()
+	h := k.GetOffenceHistory(ctx, operator)
+	if pp.InGracePeriod(h.SinceHeight, height) {
+		sdkutil.Emit(&ctx, &types.EventOracleWarning{
+			Validator:     operator.String(),
+			ValidVoteRate: validVoteRate,
+			GracePeriod:   true,
+		})
+		return
+	}
+
+	if !pp.IsRepeated(h, height, slashWindow) {
+		h.Level = 0
+	}
+	h.Level++
+	penalty, slashFraction := pp.Penalty(h.Level, k.SlashFraction(ctx))
+	h.AddOffence(types.Offence{
+		Height:        height,
+		ValidVoteRate: validVoteRate,
+		Level:         h.Level,
+		Penalty:       penalty,
+		SlashFraction: slashFraction,
+	})
+	k.SetOffenceHistory(ctx, operator, h)
+
+	if penalty == types.OffencePenalty_OFFENCE_PENALTY_WARNING {
+		sdkutil.Emit(&ctx, &types.EventOracleWarning{
+			Validator:     operator.String(),
+			ValidVoteRate: validVoteRate,
+			Level:         h.Level,
+		})
+		return
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	if penalty == types.OffencePenalty_OFFENCE_PENALTY_SLASH {
+		k.StakingKeeper.Slash(
+			ctx,
+			consAddr,
+			height-sdk.ValidatorUpdateDelay-1,
+			validator.GetConsensusPower(k.StakingKeeper.PowerReduction(ctx)), slashFraction,
+		)
+	}
+	k.StakingKeeper.Jail(ctx, consAddr)
+
+	sdkutil.Emit(&ctx, &types.EventSlash{
+		Validator: consAddr.String(),
+		Factor:    slashFraction,
+		Reason:    "voting_rate",
+		Jailed:    true,
+	})
+}
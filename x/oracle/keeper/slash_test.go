@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/umee-network/umee/v6/util/checkers"
+	"github.com/umee-network/umee/v6/x/oracle/types"
 )
 
 func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
@@ -57,3 +60,156 @@ func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
 	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	s.Require().Equal(amt, validator.Tokens)
 }
+
+func (s *IntegrationTestSuite) TestGraduatedPenalties() {
+	app, ctx := s.app, s.ctx
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	pp := types.PenaltyParams{
+		Warnings:       1,
+		Jails:          1,
+		SlashFractions: []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 2)},
+	}
+	s.Require().NoError(app.OracleKeeper.SetPenaltyParams(ctx, pp))
+	votePeriodsPerWindow := app.OracleKeeper.SlashWindow(ctx) / app.OracleKeeper.VotePeriod(ctx)
+
+	// resetValidator unjails the validator and restores its tokens
+	resetValidator := func() {
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+		validator.Jailed = false
+		validator.Tokens = amt
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+	offend := func() stakingtypes.Validator {
+		app.OracleKeeper.SetMissCounter(ctx, valAddr, votePeriodsPerWindow)
+		app.OracleKeeper.SlashAndResetMissCounters(ctx)
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+		return validator
+	}
+
+	// 1st offence: warning
+	validator := offend()
+	s.Require().False(validator.Jailed)
+	s.Require().Equal(amt, validator.Tokens)
+
+	// 2nd offence: jail
+	validator = offend()
+	s.Require().True(validator.Jailed)
+	s.Require().Equal(amt, validator.Tokens)
+
+	// 3rd and later offences: escalating slashes
+	resetValidator()
+	validator = offend()
+	s.Require().True(validator.Jailed)
+	s.Require().Equal(amt.Sub(sdk.NewDecWithPrec(1, 2).MulInt(amt).TruncateInt()), validator.Tokens)
+
+	resetValidator()
+	validator = offend()
+	s.Require().Equal(amt.Sub(sdk.NewDecWithPrec(5, 2).MulInt(amt).TruncateInt()), validator.Tokens)
+
+	h := app.OracleKeeper.GetOffenceHistory(ctx, valAddr)
+	s.Require().Equal(uint32(4), h.Level)
+	s.Require().Len(h.Offences, 4)
+	s.Require().Equal(types.OffencePenalty_OFFENCE_PENALTY_WARNING, h.Offences[0].Penalty)
+	s.Require().Equal(types.OffencePenalty_OFFENCE_PENALTY_JAIL, h.Offences[1].Penalty)
+	s.Require().Equal(types.OffencePenalty_OFFENCE_PENALTY_SLASH, h.Offences[3].Penalty)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 2), h.Offences[3].SlashFraction)
+	s.Require().True(h.Offences[0].ValidVoteRate.IsZero())
+
+	// offences older than the repeat windows are forgiven
+	resetValidator()
+	pp.RepeatWindows = 1
+	s.Require().NoError(app.OracleKeeper.SetPenaltyParams(ctx, pp))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2*int64(app.OracleKeeper.SlashWindow(ctx)))
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, votePeriodsPerWindow)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	s.Require().False(validator.Jailed)
+	s.Require().Equal(uint32(1), app.OracleKeeper.GetOffenceHistory(ctx, valAddr).Level)
+}
+
+func (s *IntegrationTestSuite) TestPenaltyGracePeriod() {
+	app, ctx := s.app, s.ctx
+	s.Require().NoError(app.OracleKeeper.SetPenaltyParams(ctx, types.PenaltyParams{GracePeriod: 100}))
+	app.OracleKeeper.TrackOffenceHistory(ctx, valAddr)
+	s.Require().Equal(ctx.BlockHeight(), app.OracleKeeper.GetOffenceHistory(ctx, valAddr).SinceHeight)
+
+	// the breach of a new validator is only warned
+	votePeriodsPerWindow := app.OracleKeeper.SlashWindow(ctx) / app.OracleKeeper.VotePeriod(ctx)
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, votePeriodsPerWindow)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	s.Require().False(validator.Jailed)
+	s.Require().Empty(app.OracleKeeper.GetOffenceHistory(ctx, valAddr).Offences)
+
+	// past the grace period, the validator is slashed and jailed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, votePeriodsPerWindow)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	s.Require().True(validator.Jailed)
+	s.Require().Len(app.OracleKeeper.GetOffenceHistory(ctx, valAddr).Offences, 1)
+}
+
+func (s *IntegrationTestSuite) TestInitOffenceHistories() {
+	app, ctx := s.app, s.ctx
+	s.Require().NoError(app.OracleKeeper.SetPenaltyParams(ctx, types.PenaltyParams{GracePeriod: 100}))
+	app.OracleKeeper.TrackOffenceHistory(ctx, valAddr2)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	// bonded validators are tracked without grace period, existing histories are kept
+	app.OracleKeeper.InitOffenceHistories(ctx)
+	s.Require().Zero(app.OracleKeeper.GetOffenceHistory(ctx, valAddr).SinceHeight)
+	s.Require().Equal(ctx.BlockHeight()-10, app.OracleKeeper.GetOffenceHistory(ctx, valAddr2).SinceHeight)
+	app.OracleKeeper.TrackOffenceHistory(ctx, valAddr)
+	s.Require().Zero(app.OracleKeeper.GetOffenceHistory(ctx, valAddr).SinceHeight)
+
+	votePeriodsPerWindow := app.OracleKeeper.SlashWindow(ctx) / app.OracleKeeper.VotePeriod(ctx)
+	app.OracleKeeper.SetMissCounter(ctx, valAddr, votePeriodsPerWindow)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	s.Require().True(validator.Jailed)
+	s.Require().Len(app.OracleKeeper.GetOffenceHistory(ctx, valAddr).Offences, 1)
+}
+
+func (s *IntegrationTestSuite) TestMsgServer_GovUpdatePenaltyParams() {
+	app, ctx := s.app, s.ctx
+	gov := checkers.GovModuleAddr
+	rp := app.OracleKeeper.GetRewardParams(ctx)
+	pp := types.PenaltyParams{
+		Warnings:       1,
+		SlashFractions: []sdk.Dec{sdk.NewDecWithPrec(1, 2)},
+		GracePeriod:    1000,
+	}
+	s.Require().Equal(types.DefaultPenaltyParams(), app.OracleKeeper.GetPenaltyParams(ctx))
+	keys := []string{types.PenaltyParamsKey}
+
+	invalid := types.PenaltyParams{SlashFractions: []sdk.Dec{sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2)}}
+	_, err := s.msgServer.GovUpdateParams(ctx, types.NewMsgGovUpdateParams(gov, keys, types.Params{},
+		types.AvgCounterParams{}, types.RewardParams{}, invalid))
+	s.Require().ErrorContains(err, "slash fractions must not decrease")
+
+	_, err = s.msgServer.GovUpdateParams(ctx, types.NewMsgGovUpdateParams(gov, keys, types.Params{},
+		types.AvgCounterParams{}, types.RewardParams{}, pp))
+	s.Require().NoError(err)
+	s.Require().Equal(pp, app.OracleKeeper.GetPenaltyParams(ctx))
+	s.Require().Equal(rp, app.OracleKeeper.GetRewardParams(ctx))
+}
+
+func (s *IntegrationTestSuite) TestQuerier_ValidatorOffences() {
+	app, ctx := s.app, s.ctx
+	app.OracleKeeper.TrackOffenceHistory(ctx, valAddr)
+	app.OracleKeeper.TrackOffenceHistory(ctx, valAddr2)
+
+	res, err := s.queryClient.ValidatorOffences(ctx, &types.QueryValidatorOffences{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Histories, 2)
+	s.Require().Equal(types.DefaultPenaltyParams(), res.Params)
+
+	res, err = s.queryClient.ValidatorOffences(ctx, &types.QueryValidatorOffences{Validator: valAddr2.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.OffenceHistory{{Validator: valAddr2.String(), SinceHeight: ctx.BlockHeight()}},
+		res.Histories)
+
+	_, err = s.queryClient.ValidatorOffences(ctx, &types.QueryValidatorOffences{Validator: "invalid"})
+	s.Require().Error(err)
+}
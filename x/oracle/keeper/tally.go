@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+// VoteTally is the result of the tally of the votes of a vote period.
+type VoteTally struct {
+	// ExchangeRates are the exchange rates of the ballots which passed, by upper case symbol
+	// denom, in ballot order.
+	ExchangeRates types.ExchangeRateTuples
+	// Confidences are the dispersions of the ballots which passed, in ballot order.
+	Confidences []types.PriceConfidence
+	// Claims are the claims of the bonded validators, sorted by validator address.
+	Claims []types.Claim
+	// Performance is the vote statistics of the vote period, by validator operator address.
+	Performance map[string][]types.DenomPerformance
+}
+
+// TallyVotes tallies the aggregate votes of the current vote period, without updating the
+// store. Ballots under the vote threshold, or with fewer voters than the minimum, are dropped.
+func (k Keeper) TallyVotes(ctx sdk.Context, params types.Params) (VoteTally, error) {
+	// Build claim map over all validators in active set
+	validatorClaimMap := make(map[string]types.Claim)
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	// Calculate total validator power
+	var totalBondedPower int64
+	for _, v := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		addr := v.GetOperator()
+		power := v.GetConsensusPower(powerReduction)
+		totalBondedPower += power
+		validatorClaimMap[addr.String()] = types.NewClaim(power, 0, 0, addr)
+	}
+
+	// tallyDenoms maps the upper case symbol denoms to their tally configuration
+	tallyDenoms := make(map[string]types.Denom, 0)
+	for _, v := range params.AcceptList {
+		if _, ok := tallyDenoms[strings.ToUpper(v.SymbolDenom)]; !ok {
+			tallyDenoms[strings.ToUpper(v.SymbolDenom)] = v
+		}
+	}
+
+	t := VoteTally{Performance: make(map[string][]types.DenomPerformance)}
+	// NOTE: it filters out inactive or jailed validators
+	// ballotDenomSlice is oracle votes of the symbol denoms, those are stored by AggregateExchangeRateVote
+	ballotDenomSlice := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
+
+	// Iterate through ballots and tally exchange rates; drop if not enough votes have been achieved.
+	for _, ballotDenom := range ballotDenomSlice {
+		denom := strings.ToUpper(ballotDenom.Denom)
+		settings := tallyDenoms[denom].VoteSettings(params)
+
+		// Calculate the portion of votes received as an integer, scaled up using the
+		// same multiplier as the `threshold`
+		threshold := settings.VoteThreshold.MulInt64(types.MaxVoteThresholdMultiplier).TruncateInt64()
+		support := ballotDenom.Ballot.Power() * types.MaxVoteThresholdMultiplier / totalBondedPower
+		if support < threshold {
+			ctx.Logger().Info("Ballot voting power is under vote threshold, dropping ballot", "denom", ballotDenom)
+			continue
+		}
+		if len(ballotDenom.Ballot) < int(settings.MinVoters) {
+			ctx.Logger().Info("Ballot has fewer voters than the minimum, dropping ballot", "denom", ballotDenom)
+			continue
+		}
+
+		// Aggregate the exchange rates using the tally strategy of the denom
+		exchangeRate, winners, err := Tally(ballotDenom.Ballot, tallyDenoms[denom], settings.RewardBand,
+			validatorClaimMap)
+		if err != nil {
+			return VoteTally{}, err
+		}
+		recordVotePerformance(t.Performance, denom, ballotDenom.Ballot, winners, exchangeRate)
+		confidence, err := types.NewPriceConfidence(denom, ballotDenom.Ballot, exchangeRate, totalBondedPower)
+		if err != nil {
+			return VoteTally{}, err
+		}
+		t.ExchangeRates = append(t.ExchangeRates, types.ExchangeRateTuple{Denom: denom, ExchangeRate: exchangeRate})
+		t.Confidences = append(t.Confidences, confidence)
+	}
+
+	t.Claims = types.ClaimMapToSlice(validatorClaimMap)
+	return t, nil
+}
+
+// Tally calculates and returns the exchange rate of the ballot using the tally strategy of the
+// denom. It sets the set of voters to be rewarded, i.e. voted within a reasonable spread from
+// the exchange rate to the store, and returns their votes. Votes discarded as outliers by the
+// tally strategy are never rewarded. Note, the ballot is sorted by ExchangeRate.
+func Tally(
+	ballot types.ExchangeRateBallot,
+	denom types.Denom,
+	rewardBand sdk.Dec,
+	validatorClaimMap map[string]types.Claim,
+) (sdk.Dec, types.ExchangeRateBallot, error) {
+	exchangeRate, voters, err := denom.Tally(ballot)
+	if err != nil {
+		return sdk.ZeroDec(), nil, err
+	}
+	standardDeviation, err := voters.StandardDeviationFrom(exchangeRate)
+	if err != nil {
+		return sdk.ZeroDec(), nil, err
+	}
+
+	// rewardSpread is the MAX((exchangeRate * (rewardBand/2)), standardDeviation)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
+	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
+
+	winners := types.ExchangeRateBallot{}
+	for _, tallyVote := range voters {
+		// Filter ballot winners. For voters, we filter out the tally vote iff:
+		// (exchangeRate - rewardSpread) <= ExchangeRate <= (exchangeRate + rewardSpread)
+		if (tallyVote.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			tallyVote.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))) ||
+			!tallyVote.ExchangeRate.IsPositive() {
+
+			key := tallyVote.Voter.String()
+			claim := validatorClaimMap[key]
+
+			claim.Weight += tallyVote.Power
+			claim.TokensVoted++
+			accuracy := types.VoteAccuracy(tallyVote.ExchangeRate, exchangeRate, rewardSpread)
+			claim.AccuracyWeight = claim.AccuracyWeight.Add(accuracy.MulInt64(tallyVote.Power))
+			validatorClaimMap[key] = claim
+			winners = append(winners, tallyVote)
+		}
+	}
+
+	return exchangeRate, winners, nil
+}
+
+// recordVotePerformance adds the statistics of the ballot votes, other than abstentions, to
+// the vote performance.
+func recordVotePerformance(
+	votePerformance map[string][]types.DenomPerformance,
+	denom string,
+	ballot, winners types.ExchangeRateBallot,
+	exchangeRate sdk.Dec,
+) {
+	inBand := make(map[string]bool, len(winners))
+	for _, w := range winners {
+		inBand[w.Voter.String()] = true
+	}
+	for _, vote := range ballot {
+		if !vote.ExchangeRate.IsPositive() {
+			continue
+		}
+		v := vote.Voter.String()
+		votePerformance[v] = append(votePerformance[v],
+			types.NewDenomPerformance(denom, vote.ExchangeRate, exchangeRate, inBand[v]))
+	}
+}
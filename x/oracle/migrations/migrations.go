@@ -35,3 +35,10 @@ func (m Migrator) HistoracleParams3x4(ctx sdk.Context) error {
 	m.keeper.SetMaximumMedianStamps(ctx, p.MaximumMedianStamps)
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3: the block number of the historic price, median and
+// median deviation keys is serialized with BigEndian.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.MigrateStampKeys(ctx)
+	return nil
+}
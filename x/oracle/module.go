@@ -52,7 +52,7 @@ func (AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-func (AppModuleBasic) ConsensusVersion() uint64 { return 2 }
+func (AppModuleBasic) ConsensusVersion() uint64 { return 3 }
 
 // RegisterInterfaces registers the x/oracle module's interface types.
 func (AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
@@ -133,6 +133,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/oracle from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/oracle from version 2 to 3: %v", err))
+	}
 }
 
 // RegisterInvariants registers the x/oracle module's invariants.
@@ -71,6 +71,99 @@ func (pb ExchangeRateBallot) WeightedMedian() (sdk.Dec, error) {
 	return sdk.ZeroDec(), nil
 }
 
+// WeightedQuantile returns the q quantile, in [0, 1], of the exchange rates weighted by the
+// power of the ExchangeRateVote: the first exchange rate at which the cumulated power reaches
+// q of the ballot power.
+// CONTRACT: The ballot must be sorted.
+func (pb ExchangeRateBallot) WeightedQuantile(q sdk.Dec) (sdk.Dec, error) {
+	if !sort.IsSorted(pb) {
+		return sdk.ZeroDec(), ErrBallotNotSorted
+	}
+	if pb.Len() == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	threshold := q.MulInt64(pb.Power())
+	var pivot int64
+	for _, v := range pb {
+		pivot += v.Power
+		if sdk.NewDec(pivot).GTE(threshold) {
+			return v.ExchangeRate, nil
+		}
+	}
+	return pb[pb.Len()-1].ExchangeRate, nil
+}
+
+// TrimmedMean returns the mean weighted by the power of the ExchangeRateVote, after discarding
+// trimFraction of the total power from each tail of the ballot. Votes which straddle a trim
+// boundary only count with their power inside the boundaries.
+// CONTRACT: The ballot must be sorted.
+func (pb ExchangeRateBallot) TrimmedMean(trimFraction sdk.Dec) (sdk.Dec, error) {
+	if !sort.IsSorted(pb) {
+		return sdk.ZeroDec(), ErrBallotNotSorted
+	}
+
+	totalPower := sdk.NewDec(pb.Power())
+	lower := totalPower.Mul(trimFraction)
+	upper := totalPower.Sub(lower)
+	if !upper.GT(lower) {
+		return sdk.ZeroDec(), nil
+	}
+
+	sum := sdk.ZeroDec()
+	pivot := sdk.ZeroDec()
+	for _, v := range pb {
+		start := pivot
+		pivot = pivot.Add(sdk.NewDec(v.Power))
+		weight := sdk.MinDec(pivot, upper).Sub(sdk.MaxDec(start, lower))
+		if weight.IsPositive() {
+			sum = sum.Add(v.ExchangeRate.Mul(weight))
+		}
+	}
+
+	return sum.Quo(upper.Sub(lower)), nil
+}
+
+// MedianAbsoluteDeviation returns the median, weighted by the power of the ExchangeRateVote, of
+// the absolute deviations of the votes from the given center.
+func (pb ExchangeRateBallot) MedianAbsoluteDeviation(center sdk.Dec) (sdk.Dec, error) {
+	deviations := make(ExchangeRateBallot, len(pb))
+	for i, v := range pb {
+		deviations[i] = NewVoteForTally(v.ExchangeRate.Sub(center).Abs(), v.Denom, v.Voter, v.Power)
+	}
+	sort.Sort(deviations)
+
+	return deviations.WeightedMedian()
+}
+
+// FilterOutliers returns the votes of the ballot which are within multiplier median absolute
+// deviations from the weighted median. All votes are returned if the median absolute deviation
+// is zero. The returned ballot is sorted.
+// CONTRACT: The ballot must be sorted.
+func (pb ExchangeRateBallot) FilterOutliers(multiplier sdk.Dec) (ExchangeRateBallot, error) {
+	median, err := pb.WeightedMedian()
+	if err != nil {
+		return nil, err
+	}
+	mad, err := pb.MedianAbsoluteDeviation(median)
+	if err != nil {
+		return nil, err
+	}
+	if mad.IsZero() {
+		return pb, nil
+	}
+
+	maxDeviation := mad.Mul(multiplier)
+	filtered := ExchangeRateBallot{}
+	for _, v := range pb {
+		if v.ExchangeRate.Sub(median).Abs().LTE(maxDeviation) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered, nil
+}
+
 // StandardDeviation returns the standard deviation by the power of the ExchangeRateVote.
 func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 	if len(pb) == 0 {
@@ -82,6 +175,15 @@ func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 		return sdk.ZeroDec(), err
 	}
 
+	return pb.StandardDeviationFrom(median)
+}
+
+// StandardDeviationFrom returns the standard deviation of the ExchangeRateVote around the given center.
+func (pb ExchangeRateBallot) StandardDeviationFrom(center sdk.Dec) (sdk.Dec, error) {
+	if len(pb) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
 	sum := sdk.ZeroDec()
 	ballotLength := int64(len(pb))
 	for _, v := range pb {
@@ -91,7 +193,7 @@ func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 					ballotLength--
 				}
 			}()
-			deviation := v.ExchangeRate.Sub(median)
+			deviation := v.ExchangeRate.Sub(center)
 			sum = sum.Add(deviation.Mul(deviation))
 		}()
 	}
@@ -157,15 +259,18 @@ type Claim struct {
 	Weight      int64
 	TokensVoted int64
 	Validator   sdk.ValAddress
+	// AccuracyWeight is the sum of the power of the rewarded votes, each scaled by its accuracy.
+	AccuracyWeight sdk.Dec
 }
 
 // NewClaim generates a Claim instance.
 func NewClaim(power, weight, winCount int64, v sdk.ValAddress) Claim {
 	return Claim{
-		Power:       power,
-		Weight:      weight,
-		TokensVoted: winCount,
-		Validator:   v,
+		Power:          power,
+		Weight:         weight,
+		TokensVoted:    winCount,
+		Validator:      v,
+		AccuracyWeight: sdk.ZeroDec(),
 	}
 }
 
@@ -183,6 +183,89 @@ func TestPBWeightedMedian(t *testing.T) {
 	}
 }
 
+func sortedBallot(rates []string, powers []int64) ExchangeRateBallot {
+	pb := ExchangeRateBallot{}
+	for i, rate := range rates {
+		valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+		pb = append(pb, NewVoteForTally(sdk.MustNewDecFromStr(rate), UmeeDenom, valAddr, powers[i]))
+	}
+	sort.Sort(pb)
+	return pb
+}
+
+func TestPBTrimmedMean(t *testing.T) {
+	tests := []struct {
+		rates        []string
+		powers       []int64
+		trimFraction string
+		mean         string
+	}{
+		{
+			// no trimming is the weighted mean
+			[]string{"1", "2", "3", "10"},
+			[]int64{1, 1, 1, 1},
+			"0",
+			"4",
+		},
+		{
+			// the extremes are discarded
+			[]string{"1", "2", "3", "10"},
+			[]int64{1, 1, 1, 1},
+			"0.25",
+			"2.5",
+		},
+		{
+			// votes straddling the boundaries count partially: 5 of 10 power is kept, 4.5 at 2 and 0.5 at 3
+			[]string{"1", "2", "3", "10"},
+			[]int64{1, 6, 2, 1},
+			"0.25",
+			"2.1",
+		},
+		{
+			// no votes
+			[]string{},
+			[]int64{},
+			"0.1",
+			"0",
+		},
+	}
+
+	for _, tc := range tests {
+		mean, err := sortedBallot(tc.rates, tc.powers).TrimmedMean(sdk.MustNewDecFromStr(tc.trimFraction))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, sdk.MustNewDecFromStr(tc.mean), mean)
+	}
+
+	unsorted := ExchangeRateBallot{
+		NewVoteForTally(sdk.NewDec(2), UmeeDenom, sdk.ValAddress("val1"), 1),
+		NewVoteForTally(sdk.NewDec(1), UmeeDenom, sdk.ValAddress("val2"), 1),
+	}
+	_, err := unsorted.TrimmedMean(sdk.ZeroDec())
+	assert.ErrorIs(t, err, ErrBallotNotSorted)
+}
+
+func TestPBFilterOutliers(t *testing.T) {
+	// weighted median is 10, and the deviations 0, 0.5, 1, 1, 90 have a weighted median of 0.5
+	pb := sortedBallot([]string{"9", "10", "10.5", "11", "100"}, []int64{1, 1, 1, 1, 1})
+	mad, err := pb.MedianAbsoluteDeviation(sdk.NewDec(10))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.MustNewDecFromStr("0.5"), mad)
+
+	filtered, err := pb.FilterOutliers(sdk.NewDec(3))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pb[:4], filtered)
+
+	filtered, err = pb.FilterOutliers(sdk.OneDec())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pb[1:3], filtered)
+
+	// nothing is filtered if most votes agree
+	pb = sortedBallot([]string{"10", "10", "10", "100"}, []int64{1, 1, 1, 1})
+	filtered, err = pb.FilterOutliers(sdk.NewDec(3))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pb, filtered)
+}
+
 func TestPBStandardDeviation(t *testing.T) {
 	tests := []struct {
 		inputs            []sdk.Dec
@@ -32,6 +32,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "umee/oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "umee/oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "umee/oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgGovUpdateDerivedFeeds{}, "umee/oracle/MsgGovUpdateDerivedFeeds", nil)
+	cdc.RegisterConcrete(&MsgGovSetPriceOverride{}, "umee/oracle/MsgGovSetPriceOverride", nil)
+	cdc.RegisterConcrete(&MsgGovCancelPriceOverride{}, "umee/oracle/MsgGovCancelPriceOverride", nil)
+	cdc.RegisterConcrete(&MsgGovUpdateParams{}, "umee/oracle/MsgGovUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgGovUpdateAcceptList{}, "umee/oracle/MsgGovUpdateAcceptList", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -40,6 +45,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgGovUpdateDerivedFeeds{},
+		&MsgGovSetPriceOverride{},
+		&MsgGovCancelPriceOverride{},
+		&MsgGovUpdateParams{},
+		&MsgGovUpdateAcceptList{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,11 +1,16 @@
 package types
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v3"
 )
 
+var maxTrimFraction = sdk.NewDecWithPrec(5, 1)
+
 // String implements fmt.Stringer interface
 func (d Denom) String() string {
 	out, _ := yaml.Marshal(d)
@@ -16,7 +21,117 @@ func (d Denom) String() string {
 func (d Denom) Equal(d1 *Denom) bool {
 	return d.BaseDenom == d1.BaseDenom &&
 		d.SymbolDenom == d1.SymbolDenom &&
-		d.Exponent == d1.Exponent
+		d.Exponent == d1.Exponent &&
+		d.TallyStrategy == d1.TallyStrategy &&
+		equalDecs(d.TrimFraction, d1.TrimFraction) &&
+		equalDecs(d.MadMultiplier, d1.MadMultiplier) &&
+		equalDecs(d.VoteThreshold, d1.VoteThreshold) &&
+		equalDecs(d.RewardBand, d1.RewardBand) &&
+		d.MinVoters == d1.MinVoters &&
+		d.MaxPriceAge == d1.MaxPriceAge &&
+		equalDecs(d.PriceMoveThreshold, d1.PriceMoveThreshold) &&
+		d.MinConfidenceVoters == d1.MinConfidenceVoters &&
+		equalDecs(d.MaxConfidenceSpread, d1.MaxConfidenceSpread)
+}
+
+// equalDecs compares optional decimals, where nil is only equal to nil.
+func equalDecs(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// Validate performs a basic validation of the denom fields.
+func (d Denom) Validate() error {
+	if len(d.BaseDenom) == 0 {
+		return fmt.Errorf("oracle parameter AcceptList Denom must have BaseDenom")
+	}
+	if len(d.SymbolDenom) == 0 {
+		return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
+	}
+	if _, ok := TallyStrategy_name[int32(d.TallyStrategy)]; !ok {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s has unknown tally strategy: %d",
+			d.SymbolDenom, d.TallyStrategy)
+	}
+	if d.TrimFraction != nil && (d.TrimFraction.IsNegative() || d.TrimFraction.GTE(maxTrimFraction)) {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s trim fraction must be in [0, 0.5): %s",
+			d.SymbolDenom, d.TrimFraction)
+	}
+	if d.MadMultiplier != nil && !d.MadMultiplier.IsPositive() {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s MAD multiplier must be positive: %s",
+			d.SymbolDenom, d.MadMultiplier)
+	}
+	if d.TallyStrategy == TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN && d.MadMultiplier == nil {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s must have a MAD multiplier", d.SymbolDenom)
+	}
+	if d.VoteThreshold != nil {
+		if err := ValidateVoteThreshold(*d.VoteThreshold); err != nil {
+			return fmt.Errorf("oracle parameter AcceptList Denom %s: %w", d.SymbolDenom, err)
+		}
+	}
+	if d.RewardBand != nil {
+		if err := validateRewardBand(*d.RewardBand); err != nil {
+			return fmt.Errorf("oracle parameter AcceptList Denom %s: %w", d.SymbolDenom, err)
+		}
+	}
+	if d.MaxPriceAge < 0 {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s max price age can't be negative: %s",
+			d.SymbolDenom, d.MaxPriceAge)
+	}
+	if d.PriceMoveThreshold != nil && !d.PriceMoveThreshold.IsPositive() {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s price move threshold must be positive: %s",
+			d.SymbolDenom, d.PriceMoveThreshold)
+	}
+	if d.MaxConfidenceSpread != nil && d.MaxConfidenceSpread.IsNegative() {
+		return fmt.Errorf("oracle parameter AcceptList Denom %s max confidence spread can't be negative: %s",
+			d.SymbolDenom, d.MaxConfidenceSpread)
+	}
+	return nil
+}
+
+// VoteSettings returns the effective vote threshold, reward band and minimum number of voters
+// of the denom: its overrides, or the params where it doesn't override them.
+func (d Denom) VoteSettings(p Params) DenomVoteSettings {
+	settings := DenomVoteSettings{
+		SymbolDenom:   d.SymbolDenom,
+		VoteThreshold: p.VoteThreshold,
+		RewardBand:    p.RewardBand,
+		MinVoters:     d.MinVoters,
+	}
+	if d.VoteThreshold != nil {
+		settings.VoteThreshold = *d.VoteThreshold
+	}
+	if d.RewardBand != nil {
+		settings.RewardBand = *d.RewardBand
+	}
+	return settings
+}
+
+// Tally computes the exchange rate of a sorted ballot of the denom using its tally strategy.
+// It also returns the votes which were used by the strategy. Votes discarded as outliers
+// are not included, so they can't be rewarded.
+func (d Denom) Tally(ballot ExchangeRateBallot) (sdk.Dec, ExchangeRateBallot, error) {
+	switch d.TallyStrategy {
+	case TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN:
+		trimFraction := sdk.ZeroDec()
+		if d.TrimFraction != nil {
+			trimFraction = *d.TrimFraction
+		}
+		rate, err := ballot.TrimmedMean(trimFraction)
+		return rate, ballot, err
+	case TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN:
+		if d.MadMultiplier != nil {
+			filtered, err := ballot.FilterOutliers(*d.MadMultiplier)
+			if err != nil {
+				return sdk.ZeroDec(), nil, err
+			}
+			ballot = filtered
+		}
+	}
+
+	rate, err := ballot.WeightedMedian()
+	return rate, ballot, err
 }
 
 // DenomList is array of Denom
@@ -31,6 +146,71 @@ func (dl DenomList) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// Update returns a copy of the list with the denoms of set added, or replacing the denoms
+// with the same base denom, and without the denoms of the del base denoms. The order of the
+// list is preserved, and new denoms are appended.
+func (dl DenomList) Update(set DenomList, del []string) (DenomList, error) {
+	out := make(DenomList, 0, len(dl)+len(set))
+	deleted := map[string]bool{}
+	for _, d := range del {
+		deleted[d] = true
+	}
+	updated := map[string]bool{}
+	for _, d := range dl {
+		if deleted[d.BaseDenom] {
+			delete(deleted, d.BaseDenom)
+			continue
+		}
+		for _, s := range set {
+			if s.BaseDenom == d.BaseDenom {
+				d = s
+				updated[d.BaseDenom] = true
+				break
+			}
+		}
+		out = append(out, d)
+	}
+	for _, d := range del {
+		if deleted[d] {
+			return nil, ErrUnknownDenom.Wrapf("%s is not in the accept list", d)
+		}
+	}
+	for _, s := range set {
+		if !updated[s.BaseDenom] {
+			out = append(out, s)
+		}
+	}
+
+	for _, d := range out {
+		if err := d.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// MaxPriceAge returns the max price age of the first denom with the given symbol denom,
+// or zero if there is none.
+func (dl DenomList) MaxPriceAge(symbolDenom string) time.Duration {
+	for _, d := range dl {
+		if strings.EqualFold(d.SymbolDenom, symbolDenom) {
+			return d.MaxPriceAge
+		}
+	}
+	return 0
+}
+
+// PriceMoveThreshold returns the price move threshold of the first denom with the given symbol
+// denom, or nil if there is none.
+func (dl DenomList) PriceMoveThreshold(symbolDenom string) *sdk.Dec {
+	for _, d := range dl {
+		if strings.EqualFold(d.SymbolDenom, symbolDenom) {
+			return d.PriceMoveThreshold
+		}
+	}
+	return nil
+}
+
 // Contains checks whether or not a SymbolDenom (e.g. UMEE) is in the DenomList
 func (dl DenomList) Contains(symbolDenom string) bool {
 	for _, d := range dl {
@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/umee-network/umee/v6/x/oracle/types"
 	"gotest.tools/v3/assert"
@@ -67,6 +70,97 @@ func TestDenomEqual(t *testing.T) {
 	for _, testCase := range testCases {
 		assert.Equal(t, testCase.equal, testCase.denom.Equal(&testCase.denomCompared))
 	}
+
+	trimmed := types.DenomUmee
+	trimmed.TallyStrategy = types.TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN
+	assert.Equal(t, false, types.DenomUmee.Equal(&trimmed))
+	fraction := sdk.MustNewDecFromStr("0.1")
+	trimmed2 := trimmed
+	trimmed2.TrimFraction = &fraction
+	assert.Equal(t, false, trimmed.Equal(&trimmed2))
+	sameFraction := sdk.MustNewDecFromStr("0.10")
+	trimmed.TrimFraction = &sameFraction
+	assert.Equal(t, true, trimmed.Equal(&trimmed2))
+}
+
+func TestDenomValidate(t *testing.T) {
+	dec := func(s string) *sdk.Dec {
+		d := sdk.MustNewDecFromStr(s)
+		return &d
+	}
+	withTally := func(strategy types.TallyStrategy, trimFraction, madMultiplier *sdk.Dec) types.Denom {
+		d := types.DenomUmee
+		d.TallyStrategy = strategy
+		d.TrimFraction = trimFraction
+		d.MadMultiplier = madMultiplier
+		return d
+	}
+
+	testCases := []struct {
+		denom  types.Denom
+		errMsg string
+	}{
+		{types.DenomUmee, ""},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_WEIGHTED_MEDIAN, nil, nil), ""},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN, nil, nil), ""},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN, dec("0.2"), nil), ""},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN, dec("0.5"), nil), "trim fraction must be in [0, 0.5)"},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN, dec("-0.1"), nil), "trim fraction must be in [0, 0.5)"},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN, nil, dec("3")), ""},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN, nil, nil), "must have a MAD multiplier"},
+		{withTally(types.TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN, nil, dec("0")), "MAD multiplier must be positive"},
+		{withTally(types.TallyStrategy(4), nil, nil), "unknown tally strategy: 4"},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", VoteThreshold: dec("0.5"), RewardBand: dec("0.1")}, ""},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", VoteThreshold: dec("0.3")}, "threshold must be bigger than"},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", RewardBand: dec("1.1")}, "reward band is too large"},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", MaxPriceAge: -time.Second}, "max price age can't be negative"},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", PriceMoveThreshold: dec("0.1")}, ""},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", PriceMoveThreshold: dec("0")}, "price move threshold must be positive"},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", MinConfidenceVoters: 3, MaxConfidenceSpread: dec("0")}, ""},
+		{types.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", MaxConfidenceSpread: dec("-0.1")}, "max confidence spread can't be negative"},
+	}
+
+	for _, tc := range testCases {
+		err := tc.denom.Validate()
+		if tc.errMsg == "" {
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg)
+		}
+	}
+}
+
+func TestDenomTally(t *testing.T) {
+	ballot := types.ExchangeRateBallot{}
+	for i, rate := range []int64{1, 9, 10, 10, 11, 100} {
+		ballot = append(ballot, types.NewVoteForTally(
+			sdk.NewDec(rate), types.DenomUmee.SymbolDenom, sdk.ValAddress([]byte{byte(i)}), 1,
+		))
+	}
+
+	rate, voters, err := types.DenomUmee.Tally(ballot)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewDec(10), rate)
+	assert.Equal(t, len(ballot), len(voters))
+
+	trimFraction := sdk.MustNewDecFromStr("0.2")
+	trimmed := types.DenomUmee
+	trimmed.TallyStrategy = types.TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN
+	trimmed.TrimFraction = &trimFraction
+	rate, voters, err = trimmed.Tally(ballot)
+	assert.NilError(t, err)
+	// 1.2 of 6 power is trimmed from each tail: 0.8 at 9, 2 at 10 and 0.8 at 11 remain
+	assert.DeepEqual(t, sdk.NewDec(10), rate)
+	assert.Equal(t, len(ballot), len(voters))
+
+	madMultiplier := sdk.NewDec(3)
+	filtered := types.DenomUmee
+	filtered.TallyStrategy = types.TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN
+	filtered.MadMultiplier = &madMultiplier
+	rate, voters, err = filtered.Tally(ballot)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewDec(10), rate)
+	assert.DeepEqual(t, ballot[1:5], voters)
 }
 
 func TestDenomListString(t *testing.T) {
@@ -126,3 +220,42 @@ func TestDenomListContains(t *testing.T) {
 		assert.Equal(t, testCase.symbolInList, testCase.denomList.Contains(testCase.denomSymbol))
 	}
 }
+
+func TestDenomListUpdate(t *testing.T) {
+	dl := types.DenomList{types.DenomUmee, types.DenomAtom}
+	atom := types.DenomAtom
+	atom.MinVoters = 3
+
+	updated, err := dl.Update(types.DenomList{types.DenomLuna, atom}, []string{types.DenomUmee.BaseDenom})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, types.DenomList{atom, types.DenomLuna}, updated)
+	// the list is copied
+	assert.DeepEqual(t, types.DenomList{types.DenomUmee, types.DenomAtom}, dl)
+
+	_, err = dl.Update(nil, []string{types.DenomLuna.BaseDenom})
+	assert.ErrorIs(t, err, types.ErrUnknownDenom)
+	_, err = dl.Update(types.DenomList{{BaseDenom: "uluna"}}, nil)
+	assert.ErrorContains(t, err, "must have SymbolDenom")
+}
+
+func TestDenomVoteSettings(t *testing.T) {
+	params := types.DefaultParams()
+	assert.DeepEqual(t, types.DenomVoteSettings{
+		SymbolDenom:   types.DenomUmee.SymbolDenom,
+		VoteThreshold: params.VoteThreshold,
+		RewardBand:    params.RewardBand,
+	}, types.DenomUmee.VoteSettings(params))
+
+	threshold := sdk.MustNewDecFromStr("0.75")
+	rewardBand := sdk.MustNewDecFromStr("0.1")
+	d := types.DenomUmee
+	d.VoteThreshold = &threshold
+	d.RewardBand = &rewardBand
+	d.MinVoters = 3
+	assert.DeepEqual(t, types.DenomVoteSettings{
+		SymbolDenom:   types.DenomUmee.SymbolDenom,
+		VoteThreshold: threshold,
+		RewardBand:    rewardBand,
+		MinVoters:     3,
+	}, d.VoteSettings(params))
+}
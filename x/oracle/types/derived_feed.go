@@ -0,0 +1,130 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of the derived feed.
+func (f DerivedFeed) Validate() error {
+	if len(f.SymbolDenom) == 0 {
+		return ErrInvalidDerivedFeed.Wrap("empty symbol denom")
+	}
+	if f.Multiplier.IsNil() || !f.Multiplier.IsPositive() {
+		return ErrInvalidDerivedFeed.Wrapf("%s: multiplier must be positive", f.SymbolDenom)
+	}
+	if len(f.Components) == 0 {
+		return ErrInvalidDerivedFeed.Wrapf("%s: no components", f.SymbolDenom)
+	}
+
+	seen := map[string]bool{}
+	for _, c := range f.Components {
+		symbol := strings.ToUpper(c.SymbolDenom)
+		if len(symbol) == 0 {
+			return ErrInvalidDerivedFeed.Wrapf("%s: empty component symbol denom", f.SymbolDenom)
+		}
+		if symbol == strings.ToUpper(f.SymbolDenom) {
+			return ErrInvalidDerivedFeed.Wrapf("%s: can't be its own component", f.SymbolDenom)
+		}
+		if seen[symbol] {
+			return ErrInvalidDerivedFeed.Wrapf("%s: duplicated component %s", f.SymbolDenom, c.SymbolDenom)
+		}
+		seen[symbol] = true
+
+		switch f.Formula {
+		case DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT:
+			if !c.Weight.IsNil() && !c.Weight.IsZero() {
+				return ErrInvalidDerivedFeed.Wrapf("%s: product components can't have a weight", f.SymbolDenom)
+			}
+		case DerivedFeedFormula_DERIVED_FEED_FORMULA_BASKET:
+			if c.Weight.IsNil() || !c.Weight.IsPositive() {
+				return ErrInvalidDerivedFeed.Wrapf("%s: basket component weights must be positive", f.SymbolDenom)
+			}
+			if c.Inverse {
+				return ErrInvalidDerivedFeed.Wrapf("%s: basket components can't be inverse", f.SymbolDenom)
+			}
+		default:
+			return ErrInvalidDerivedFeed.Wrapf("%s: unknown formula %s", f.SymbolDenom, f.Formula)
+		}
+	}
+
+	return nil
+}
+
+// MaxPriceAge returns the largest max price age of the feed components in the accept list. A derived
+// exchange rate is not updated while a component is stale, so it's stale once it's older than any of its
+// components may be. Components without a max price age don't limit it, and zero means no limit.
+func (f DerivedFeed) MaxPriceAge(acceptList DenomList) time.Duration {
+	var maxAge time.Duration
+	for _, c := range f.Components {
+		if age := acceptList.MaxPriceAge(c.SymbolDenom); age > maxAge {
+			maxAge = age
+		}
+	}
+	return maxAge
+}
+
+// Evaluate computes the derived exchange rate, using the rate function to get the exchange
+// rates of the components.
+func (f DerivedFeed) Evaluate(rate func(symbol string) (sdk.Dec, error)) (sdk.Dec, error) {
+	var result sdk.Dec
+	if f.Formula == DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT {
+		result = sdk.OneDec()
+	} else {
+		result = sdk.ZeroDec()
+	}
+
+	for _, c := range f.Components {
+		r, err := rate(c.SymbolDenom)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		if !r.IsPositive() {
+			return sdk.ZeroDec(), ErrNegativeOrZeroRate.Wrap(c.SymbolDenom)
+		}
+
+		switch {
+		case f.Formula == DerivedFeedFormula_DERIVED_FEED_FORMULA_BASKET:
+			result = result.Add(r.Mul(c.Weight))
+		case c.Inverse:
+			result = result.Quo(r)
+		default:
+			result = result.Mul(r)
+		}
+	}
+
+	return result.Mul(f.Multiplier), nil
+}
+
+// ValidateDerivedFeeds validates the derived feed registry against the accept list: symbol
+// denoms must be unique and different from the accept list symbol denoms, and derived feeds
+// can't be components of other derived feeds.
+func ValidateDerivedFeeds(feeds []DerivedFeed, acceptList DenomList) error {
+	symbols := map[string]bool{}
+	for _, f := range feeds {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		symbol := strings.ToUpper(f.SymbolDenom)
+		if symbols[symbol] {
+			return ErrInvalidDerivedFeed.Wrapf("duplicated derived feed %s", f.SymbolDenom)
+		}
+		if acceptList.Contains(f.SymbolDenom) {
+			return ErrInvalidDerivedFeed.Wrapf("%s is in the accept list", f.SymbolDenom)
+		}
+		symbols[symbol] = true
+	}
+
+	for _, f := range feeds {
+		for _, c := range f.Components {
+			if symbols[strings.ToUpper(c.SymbolDenom)] {
+				return ErrInvalidDerivedFeed.Wrapf("%s: component %s is a derived feed",
+					f.SymbolDenom, c.SymbolDenom)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/util/checkers"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+var (
+	productFormula = types.DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT
+	basketFormula  = types.DerivedFeedFormula_DERIVED_FEED_FORMULA_BASKET
+)
+
+func lstFeed() types.DerivedFeed {
+	return types.DerivedFeed{
+		SymbolDenom: "STATOM",
+		Formula:     productFormula,
+		Components:  []types.DerivedFeedComponent{{SymbolDenom: "ATOM"}},
+		Multiplier:  sdk.MustNewDecFromStr("1.2"),
+	}
+}
+
+func TestDerivedFeedValidate(t *testing.T) {
+	withFeed := func(f func(*types.DerivedFeed)) types.DerivedFeed {
+		feed := lstFeed()
+		f(&feed)
+		return feed
+	}
+
+	tcs := []struct {
+		name   string
+		feed   types.DerivedFeed
+		errMsg string
+	}{
+		{"valid product", lstFeed(), ""},
+		{
+			"valid basket",
+			types.DerivedFeed{
+				SymbolDenom: "BASKET",
+				Formula:     basketFormula,
+				Components: []types.DerivedFeedComponent{
+					{SymbolDenom: "ATOM", Weight: sdk.NewDec(2)},
+					{SymbolDenom: "UMEE", Weight: sdk.NewDec(100)},
+				},
+				Multiplier: sdk.OneDec(),
+			},
+			"",
+		},
+		{"empty symbol", withFeed(func(f *types.DerivedFeed) { f.SymbolDenom = "" }), "empty symbol denom"},
+		{"nil multiplier", withFeed(func(f *types.DerivedFeed) { f.Multiplier = sdk.Dec{} }), "multiplier must be positive"},
+		{"zero multiplier", withFeed(func(f *types.DerivedFeed) { f.Multiplier = sdk.ZeroDec() }), "multiplier must be positive"},
+		{"no components", withFeed(func(f *types.DerivedFeed) { f.Components = nil }), "no components"},
+		{
+			"own component",
+			withFeed(func(f *types.DerivedFeed) { f.Components[0].SymbolDenom = "statom" }),
+			"can't be its own component",
+		},
+		{
+			"duplicated component",
+			withFeed(func(f *types.DerivedFeed) { f.Components = append(f.Components, f.Components[0]) }),
+			"duplicated component",
+		},
+		{
+			"product weight",
+			withFeed(func(f *types.DerivedFeed) { f.Components[0].Weight = sdk.OneDec() }),
+			"product components can't have a weight",
+		},
+		{
+			"basket without weight",
+			withFeed(func(f *types.DerivedFeed) { f.Formula = basketFormula }),
+			"basket component weights must be positive",
+		},
+		{
+			"inverse basket",
+			withFeed(func(f *types.DerivedFeed) {
+				f.Formula = basketFormula
+				f.Components[0].Weight = sdk.OneDec()
+				f.Components[0].Inverse = true
+			}),
+			"basket components can't be inverse",
+		},
+		{
+			"unknown formula",
+			withFeed(func(f *types.DerivedFeed) { f.Formula = types.DerivedFeedFormula_DERIVED_FEED_FORMULA_UNSPECIFIED }),
+			"unknown formula",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := tc.feed.Validate()
+		if tc.errMsg == "" {
+			assert.NilError(t, err, tc.name)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg, tc.name)
+		}
+	}
+}
+
+func TestDerivedFeedEvaluate(t *testing.T) {
+	rates := map[string]sdk.Dec{
+		"ATOM": sdk.NewDec(10),
+		"UMEE": sdk.MustNewDecFromStr("0.01"),
+		"ZERO": sdk.ZeroDec(),
+	}
+	rate := func(symbol string) (sdk.Dec, error) {
+		r, ok := rates[symbol]
+		if !ok {
+			return sdk.ZeroDec(), types.ErrUnknownDenom.Wrap(symbol)
+		}
+		return r, nil
+	}
+
+	tcs := []struct {
+		name   string
+		feed   types.DerivedFeed
+		expect string
+		errMsg string
+	}{
+		{"lst", lstFeed(), "12", ""},
+		{
+			"cross rate",
+			types.DerivedFeed{
+				SymbolDenom: "ATOMUMEE",
+				Formula:     productFormula,
+				Components: []types.DerivedFeedComponent{
+					{SymbolDenom: "ATOM"},
+					{SymbolDenom: "UMEE", Inverse: true},
+				},
+				Multiplier: sdk.OneDec(),
+			},
+			"1000",
+			"",
+		},
+		{
+			"basket",
+			types.DerivedFeed{
+				SymbolDenom: "BASKET",
+				Formula:     basketFormula,
+				Components: []types.DerivedFeedComponent{
+					{SymbolDenom: "ATOM", Weight: sdk.MustNewDecFromStr("0.5")},
+					{SymbolDenom: "UMEE", Weight: sdk.NewDec(100)},
+				},
+				Multiplier: sdk.NewDec(2),
+			},
+			"12",
+			"",
+		},
+		{
+			"missing component",
+			types.DerivedFeed{
+				SymbolDenom: "X",
+				Formula:     productFormula,
+				Components:  []types.DerivedFeedComponent{{SymbolDenom: "OSMO"}},
+				Multiplier:  sdk.OneDec(),
+			},
+			"",
+			"unknown denom",
+		},
+		{
+			"zero component",
+			types.DerivedFeed{
+				SymbolDenom: "X",
+				Formula:     productFormula,
+				Components:  []types.DerivedFeedComponent{{SymbolDenom: "ZERO", Inverse: true}},
+				Multiplier:  sdk.OneDec(),
+			},
+			"",
+			"should be positive",
+		},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.feed.Evaluate(rate)
+		if tc.errMsg == "" {
+			assert.NilError(t, err, tc.name)
+			assert.DeepEqual(t, sdk.MustNewDecFromStr(tc.expect), r)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg, tc.name)
+		}
+	}
+}
+
+func TestValidateDerivedFeeds(t *testing.T) {
+	acceptList := types.DenomList{{BaseDenom: "uatom", SymbolDenom: "ATOM"}}
+	assert.NilError(t, types.ValidateDerivedFeeds([]types.DerivedFeed{lstFeed()}, acceptList))
+
+	err := types.ValidateDerivedFeeds([]types.DerivedFeed{lstFeed(), lstFeed()}, acceptList)
+	assert.ErrorContains(t, err, "duplicated derived feed")
+
+	acceptList = append(acceptList, types.Denom{BaseDenom: "ustatom", SymbolDenom: "stAtom"})
+	err = types.ValidateDerivedFeeds([]types.DerivedFeed{lstFeed()}, acceptList)
+	assert.ErrorContains(t, err, "is in the accept list")
+
+	chained := lstFeed()
+	chained.SymbolDenom = "STATOM2"
+	chained.Components[0].SymbolDenom = "STATOM"
+	err = types.ValidateDerivedFeeds([]types.DerivedFeed{lstFeed(), chained}, acceptList[:1])
+	assert.ErrorContains(t, err, "component STATOM is a derived feed")
+}
+
+func TestDerivedFeedMaxPriceAge(t *testing.T) {
+	feed := lstFeed()
+	feed.Formula = basketFormula
+	feed.Components = []types.DerivedFeedComponent{{SymbolDenom: "ATOM"}, {SymbolDenom: "osmo"}, {SymbolDenom: "UMEE"}}
+	acceptList := types.DenomList{
+		{BaseDenom: "uatom", SymbolDenom: "ATOM", MaxPriceAge: time.Minute},
+		{BaseDenom: "uosmo", SymbolDenom: "OSMO", MaxPriceAge: time.Hour},
+		{BaseDenom: "uumee", SymbolDenom: "UMEE"},
+	}
+	assert.Equal(t, time.Hour, feed.MaxPriceAge(acceptList))
+
+	// components without a max price age don't limit it
+	assert.Equal(t, time.Duration(0), feed.MaxPriceAge(acceptList[2:]))
+}
+
+func TestMsgGovUpdateDerivedFeeds(t *testing.T) {
+	gov := checkers.GovModuleAddr
+	tcs := []struct {
+		name   string
+		msg    *types.MsgGovUpdateDerivedFeeds
+		errMsg string
+	}{
+		{"valid", types.NewMsgGovUpdateDerivedFeeds(gov, []types.DerivedFeed{lstFeed()}, []string{"X"}), ""},
+		{
+			"not gov",
+			types.NewMsgGovUpdateDerivedFeeds(
+				sdk.AccAddress([]byte("addr1_______________")).String(), []types.DerivedFeed{lstFeed()}, nil),
+			"expected " + gov,
+		},
+		{"empty", types.NewMsgGovUpdateDerivedFeeds(gov, nil, nil), "no derived feeds"},
+		{
+			"duplicated",
+			types.NewMsgGovUpdateDerivedFeeds(gov, []types.DerivedFeed{lstFeed(), lstFeed()}, nil),
+			"duplicated derived feed",
+		},
+		{
+			"set and delete",
+			types.NewMsgGovUpdateDerivedFeeds(gov, []types.DerivedFeed{lstFeed()}, []string{"statom"}),
+			"both set and deleted",
+		},
+		{"empty delete", types.NewMsgGovUpdateDerivedFeeds(gov, nil, []string{""}), "empty symbol denom"},
+	}
+
+	for _, tc := range tcs {
+		err := tc.msg.ValidateBasic()
+		if tc.errMsg == "" {
+			assert.NilError(t, err, tc.name)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg, tc.name)
+		}
+	}
+}
@@ -30,4 +30,9 @@ var (
 	ErrNoMedianDeviation       = errors.Register(ModuleName, 20, "no median deviation for this denom at this block")
 	ErrMalformedLatestAvgPrice = errors.Register(ModuleName, 21, "malformed latest avg price, expecting one byte")
 	ErrNoLatestAvgPrice        = errors.Register(ModuleName, 22, "no latest average price")
+	ErrStalePrice              = errors.Register(ModuleName, 23, "stale exchange rate")
+	ErrInvalidDerivedFeed      = errors.Register(ModuleName, 24, "invalid derived feed")
+	ErrInvalidPriceOverride    = errors.Register(ModuleName, 25, "invalid price override")
+	ErrInvalidParams           = errors.Register(ModuleName, 26, "invalid params")
+	ErrLowConfidence           = errors.Register(ModuleName, 27, "low confidence exchange rate")
 )
@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +35,8 @@ type EventDelegateFeedConsent struct {
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
 	// Delegate bech32 address
 	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// Standby delegates bech32 addresses
+	StandbyDelegates []string `protobuf:"bytes,3,rep,name=standby_delegates,json=standbyDelegates,proto3" json:"standby_delegates,omitempty"`
 }
 
 func (m *EventDelegateFeedConsent) Reset()         { *m = EventDelegateFeedConsent{} }
@@ -107,7 +113,7 @@ func (m *EventSetFxRate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSetFxRate proto.InternalMessageInfo
 
-// EventSetFxRate is emitted on exchange rate update
+// EventSlash is emitted when a validator is slashed or jailed for missing oracle votes
 type EventSlash struct {
 	// validator consensus address
 	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
@@ -150,40 +156,344 @@ func (m *EventSlash) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSlash proto.InternalMessageInfo
 
+// EventSetPriceOverride is emitted on Msg/GovSetPriceOverride
+type EventSetPriceOverride struct {
+	// symbol denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// manual exchange rate (based to USD)
+	Rate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	Expiry time.Time                              `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// address which set the override
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *EventSetPriceOverride) Reset()         { *m = EventSetPriceOverride{} }
+func (m *EventSetPriceOverride) String() string { return proto.CompactTextString(m) }
+func (*EventSetPriceOverride) ProtoMessage()    {}
+func (*EventSetPriceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6380f28dac582975, []int{3}
+}
+func (m *EventSetPriceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetPriceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetPriceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetPriceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetPriceOverride.Merge(m, src)
+}
+func (m *EventSetPriceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetPriceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetPriceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetPriceOverride proto.InternalMessageInfo
+
+// EventRemovePriceOverride is emitted when a price override expires, or is cancelled by
+// Msg/GovCancelPriceOverride
+type EventRemovePriceOverride struct {
+	// symbol denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// true if the override was cancelled before its expiry
+	Cancelled bool `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *EventRemovePriceOverride) Reset()         { *m = EventRemovePriceOverride{} }
+func (m *EventRemovePriceOverride) String() string { return proto.CompactTextString(m) }
+func (*EventRemovePriceOverride) ProtoMessage()    {}
+func (*EventRemovePriceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6380f28dac582975, []int{4}
+}
+func (m *EventRemovePriceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemovePriceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemovePriceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemovePriceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemovePriceOverride.Merge(m, src)
+}
+func (m *EventRemovePriceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemovePriceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemovePriceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemovePriceOverride proto.InternalMessageInfo
+
+// EventUpdateParams is emitted when the oracle params are updated with Msg/GovUpdateParams.
+type EventUpdateParams struct {
+	// keys of the updated params
+	Keys             []string         `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Params           Params           `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	AvgCounterParams AvgCounterParams `protobuf:"bytes,3,opt,name=avg_counter_params,json=avgCounterParams,proto3" json:"avg_counter_params"`
+	RewardParams     RewardParams     `protobuf:"bytes,4,opt,name=reward_params,json=rewardParams,proto3" json:"reward_params"`
+	PenaltyParams    PenaltyParams    `protobuf:"bytes,5,opt,name=penalty_params,json=penaltyParams,proto3" json:"penalty_params"`
+}
+
+func (m *EventUpdateParams) Reset()         { *m = EventUpdateParams{} }
+func (m *EventUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateParams) ProtoMessage()    {}
+func (*EventUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6380f28dac582975, []int{5}
+}
+func (m *EventUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateParams.Merge(m, src)
+}
+func (m *EventUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateParams proto.InternalMessageInfo
+
+// EventUpdateAcceptList is emitted when the accept list is updated with
+// Msg/GovUpdateAcceptList.
+type EventUpdateAcceptList struct {
+	// set denoms
+	SetDenoms []Denom `protobuf:"bytes,1,rep,name=set_denoms,json=setDenoms,proto3" json:"set_denoms"`
+	// base denoms of the removed denoms
+	DeleteDenoms []string `protobuf:"bytes,2,rep,name=delete_denoms,json=deleteDenoms,proto3" json:"delete_denoms,omitempty"`
+}
+
+func (m *EventUpdateAcceptList) Reset()         { *m = EventUpdateAcceptList{} }
+func (m *EventUpdateAcceptList) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAcceptList) ProtoMessage()    {}
+func (*EventUpdateAcceptList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6380f28dac582975, []int{6}
+}
+func (m *EventUpdateAcceptList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAcceptList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAcceptList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateAcceptList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAcceptList.Merge(m, src)
+}
+func (m *EventUpdateAcceptList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAcceptList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAcceptList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateAcceptList proto.InternalMessageInfo
+
+// EventOracleWarning is emitted when a validator with a valid vote rate below
+// min_valid_per_window is only warned.
+type EventOracleWarning struct {
+	// validator operator address
+	Validator     string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate"`
+	// offence level of the validator, zero during its grace period.
+	Level uint32 `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	// grace_period is true if the validator is in its grace period.
+	GracePeriod bool `protobuf:"varint,4,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+}
+
+func (m *EventOracleWarning) Reset()         { *m = EventOracleWarning{} }
+func (m *EventOracleWarning) String() string { return proto.CompactTextString(m) }
+func (*EventOracleWarning) ProtoMessage()    {}
+func (*EventOracleWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6380f28dac582975, []int{7}
+}
+func (m *EventOracleWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOracleWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOracleWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOracleWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOracleWarning.Merge(m, src)
+}
+func (m *EventOracleWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOracleWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOracleWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOracleWarning proto.InternalMessageInfo
+
+// EventPriceMove is emitted when the new exchange rate of a denom moved, from the previous
+// exchange rate or from the latest historic median, by more than the price move threshold of
+// the denom.
+type EventPriceMove struct {
+	// symbol denom
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PreviousRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=previous_rate,json=previousRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_rate"`
+	Rate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// change is the relative change from the previous exchange rate.
+	Change github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=change,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"change"`
+	// median is the latest historic median, zero if there is none.
+	Median github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median"`
+	// median_change is the relative change from the latest historic median, zero if there is
+	// no median.
+	MedianChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=median_change,json=medianChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median_change"`
+	Threshold    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+}
+
+func (m *EventPriceMove) Reset()         { *m = EventPriceMove{} }
+func (m *EventPriceMove) String() string { return proto.CompactTextString(m) }
+func (*EventPriceMove) ProtoMessage()    {}
+func (*EventPriceMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6380f28dac582975, []int{8}
+}
+func (m *EventPriceMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceMove.Merge(m, src)
+}
+func (m *EventPriceMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceMove proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventDelegateFeedConsent)(nil), "umee.oracle.v1.EventDelegateFeedConsent")
 	proto.RegisterType((*EventSetFxRate)(nil), "umee.oracle.v1.EventSetFxRate")
 	proto.RegisterType((*EventSlash)(nil), "umee.oracle.v1.EventSlash")
+	proto.RegisterType((*EventSetPriceOverride)(nil), "umee.oracle.v1.EventSetPriceOverride")
+	proto.RegisterType((*EventRemovePriceOverride)(nil), "umee.oracle.v1.EventRemovePriceOverride")
+	proto.RegisterType((*EventUpdateParams)(nil), "umee.oracle.v1.EventUpdateParams")
+	proto.RegisterType((*EventUpdateAcceptList)(nil), "umee.oracle.v1.EventUpdateAcceptList")
+	proto.RegisterType((*EventOracleWarning)(nil), "umee.oracle.v1.EventOracleWarning")
+	proto.RegisterType((*EventPriceMove)(nil), "umee.oracle.v1.EventPriceMove")
 }
 
 func init() { proto.RegisterFile("umee/oracle/v1/events.proto", fileDescriptor_6380f28dac582975) }
 
 var fileDescriptor_6380f28dac582975 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xb1, 0x6e, 0xe2, 0x40,
-	0x10, 0xf5, 0xde, 0x71, 0x08, 0xb6, 0xa0, 0xb0, 0xd0, 0xc9, 0xc7, 0x49, 0x06, 0x51, 0x9c, 0x68,
-	0x6c, 0x0b, 0xdd, 0x89, 0xea, 0x9a, 0xe3, 0x38, 0xaa, 0x2b, 0x22, 0x93, 0x2a, 0x4d, 0xb4, 0x78,
-	0x27, 0xc6, 0xc1, 0xf6, 0xa2, 0xdd, 0xc5, 0x21, 0x3f, 0x90, 0x3a, 0x1f, 0xc3, 0x47, 0xa0, 0x54,
-	0x88, 0x2a, 0x4a, 0x81, 0x12, 0xf8, 0x91, 0xc8, 0xde, 0x25, 0xa4, 0x4b, 0x8a, 0x54, 0xf6, 0x9b,
-	0x79, 0xf3, 0xe6, 0xcd, 0xea, 0xe1, 0xef, 0xf3, 0x04, 0xc0, 0x63, 0x9c, 0x04, 0x31, 0x78, 0x59,
-	0xd7, 0x83, 0x0c, 0x52, 0x29, 0xdc, 0x19, 0x67, 0x92, 0x99, 0xb5, 0xbc, 0xe9, 0xaa, 0xa6, 0x9b,
-	0x75, 0x1b, 0xdf, 0x02, 0x26, 0x12, 0x26, 0xce, 0x8b, 0xae, 0xa7, 0x80, 0xa2, 0x36, 0xea, 0x21,
-	0x0b, 0x99, 0xaa, 0xe7, 0x7f, 0xaa, 0xda, 0xbe, 0x41, 0xd8, 0xfa, 0x97, 0x2b, 0x0e, 0x20, 0x86,
-	0x90, 0x48, 0x18, 0x02, 0xd0, 0xbf, 0x2c, 0x15, 0x90, 0x4a, 0xf3, 0x17, 0xae, 0xb0, 0x19, 0x70,
-	0x22, 0x19, 0xb7, 0x50, 0x0b, 0x75, 0xaa, 0x7d, 0x6b, 0xb3, 0x74, 0xea, 0x5a, 0xf6, 0x0f, 0xa5,
-	0x1c, 0x84, 0x18, 0x49, 0x1e, 0xa5, 0xa1, 0xff, 0xc2, 0xcc, 0xa7, 0xa8, 0x16, 0xb3, 0x3e, 0xbd,
-	0x35, 0x75, 0x60, 0xb6, 0x17, 0xb8, 0x56, 0xf8, 0x18, 0x81, 0x1c, 0x2e, 0x7c, 0x22, 0xc1, 0xac,
-	0xe3, 0x2f, 0x14, 0x52, 0x96, 0xa8, 0xd5, 0xbe, 0x02, 0xe6, 0x09, 0x2e, 0xf1, 0xa3, 0xf2, 0xef,
-	0xd5, 0xb6, 0x69, 0x3c, 0x6c, 0x9b, 0x3f, 0xc2, 0x48, 0x4e, 0xe6, 0x63, 0x37, 0x60, 0x89, 0xbe,
-	0x5a, 0x7f, 0x1c, 0x41, 0xa7, 0x9e, 0xbc, 0x9e, 0x81, 0x70, 0x07, 0x10, 0x6c, 0x96, 0x0e, 0xd6,
-	0x3e, 0x06, 0x10, 0xf8, 0x85, 0x52, 0xfb, 0x0e, 0x61, 0xac, 0x56, 0xc7, 0x44, 0x4c, 0xcc, 0x1e,
-	0xae, 0x66, 0x24, 0x8e, 0xe8, 0xbb, 0xae, 0x3e, 0x52, 0xcd, 0x53, 0x5c, 0xbe, 0x20, 0x41, 0x3e,
-	0xf4, 0x11, 0xd6, 0xb4, 0x96, 0xf9, 0x15, 0x97, 0x39, 0x10, 0xc1, 0x52, 0xeb, 0x73, 0xf1, 0x0a,
-	0x1a, 0xe5, 0xf5, 0x4b, 0x12, 0xc5, 0x40, 0xad, 0x52, 0x0b, 0x75, 0x2a, 0xbe, 0x46, 0xfd, 0xff,
-	0xab, 0x27, 0xdb, 0x58, 0xed, 0x6c, 0xb4, 0xde, 0xd9, 0xe8, 0x71, 0x67, 0xa3, 0xdb, 0xbd, 0x6d,
-	0xac, 0xf7, 0xb6, 0x71, 0xbf, 0xb7, 0x8d, 0x33, 0xf7, 0x95, 0x97, 0x3c, 0x39, 0x4e, 0x0a, 0xf2,
-	0x8a, 0xf1, 0x69, 0x01, 0xbc, 0xac, 0xe7, 0x2d, 0x0e, 0x41, 0x2b, 0x7c, 0x8d, 0xcb, 0x45, 0x48,
-	0x7e, 0x3e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x83, 0x34, 0xb1, 0x84, 0x02, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xd7, 0xc9, 0x66, 0xc9, 0xbe, 0x64, 0x43, 0x3b, 0x4a, 0x2a, 0x13, 0xca, 0x26, 0x18,
+	0x09, 0xf5, 0x12, 0x5b, 0x0d, 0x55, 0x0f, 0xa8, 0x97, 0xfc, 0x68, 0x91, 0x50, 0xa1, 0x91, 0x1b,
+	0x40, 0xea, 0xc5, 0x9a, 0xd8, 0xaf, 0x5e, 0x13, 0xdb, 0x63, 0xcd, 0xcc, 0xba, 0xbb, 0xff, 0x45,
+	0xff, 0x98, 0xfe, 0x0d, 0x28, 0x42, 0x42, 0xaa, 0x7a, 0x42, 0x1c, 0x0a, 0x24, 0x47, 0xce, 0xdc,
+	0xd1, 0xfc, 0x70, 0x93, 0x5d, 0x04, 0xad, 0x90, 0xc5, 0x29, 0x7e, 0x6f, 0xde, 0xfb, 0xcc, 0x7b,
+	0xdf, 0x7d, 0xf3, 0x14, 0xf8, 0x70, 0x5c, 0x20, 0x06, 0x8c, 0xd3, 0x38, 0xc7, 0xa0, 0xbe, 0x1d,
+	0x60, 0x8d, 0xa5, 0x14, 0x7e, 0xc5, 0x99, 0x64, 0x64, 0x4d, 0x1d, 0xfa, 0xe6, 0xd0, 0xaf, 0x6f,
+	0x6f, 0x7e, 0x10, 0x33, 0x51, 0x30, 0x11, 0xe9, 0xd3, 0xc0, 0x18, 0x26, 0x74, 0x73, 0x3d, 0x65,
+	0x29, 0x33, 0x7e, 0xf5, 0x65, 0xbd, 0x5b, 0x29, 0x63, 0x69, 0x8e, 0x81, 0xb6, 0x4e, 0xc6, 0x4f,
+	0x03, 0x99, 0x15, 0x28, 0x24, 0x2d, 0x2a, 0x1b, 0x30, 0x7f, 0xbd, 0xbd, 0x4b, 0x1f, 0x7a, 0x3f,
+	0x39, 0xe0, 0xde, 0x57, 0xf5, 0x1c, 0x62, 0x8e, 0x29, 0x95, 0xf8, 0x00, 0x31, 0x39, 0x60, 0xa5,
+	0xc0, 0x52, 0x92, 0x3b, 0xb0, 0xcc, 0x2a, 0xe4, 0x54, 0x32, 0xee, 0x3a, 0xdb, 0xce, 0xad, 0xfe,
+	0xbe, 0xfb, 0xea, 0xc5, 0xce, 0xba, 0x2d, 0x6a, 0x2f, 0x49, 0x38, 0x0a, 0xf1, 0x58, 0xf2, 0xac,
+	0x4c, 0xc3, 0x37, 0x91, 0x2a, 0x2b, 0xb1, 0x30, 0x77, 0xe1, 0x6d, 0x59, 0x4d, 0x24, 0xb9, 0x0f,
+	0xd7, 0x85, 0xa4, 0x65, 0x72, 0x32, 0x8d, 0x1a, 0x9f, 0x70, 0x17, 0xb7, 0x17, 0xff, 0x35, 0xfd,
+	0x9a, 0x4d, 0x69, 0x8a, 0x17, 0xde, 0x04, 0xd6, 0x74, 0x3b, 0x8f, 0x51, 0x3e, 0x98, 0x84, 0x0a,
+	0xbc, 0x0e, 0x4b, 0x09, 0x96, 0xac, 0x30, 0x1d, 0x84, 0xc6, 0x20, 0x47, 0xd0, 0xe5, 0x97, 0x05,
+	0xde, 0x3b, 0x7b, 0xbd, 0xd5, 0xf9, 0xe5, 0xf5, 0xd6, 0xa7, 0x69, 0x26, 0x47, 0xe3, 0x13, 0x3f,
+	0x66, 0x85, 0x95, 0xde, 0xfe, 0xd9, 0x11, 0xc9, 0x69, 0x20, 0xa7, 0x15, 0x0a, 0xff, 0x10, 0xe3,
+	0x57, 0x2f, 0x76, 0xc0, 0xd6, 0x73, 0x88, 0x71, 0xa8, 0x49, 0xde, 0x8f, 0x0e, 0x80, 0xb9, 0x3a,
+	0xa7, 0x62, 0x44, 0xee, 0x42, 0xbf, 0xa6, 0x79, 0x96, 0xbc, 0x93, 0x78, 0x97, 0xa1, 0xe4, 0x18,
+	0x7a, 0x4f, 0x69, 0xac, 0x92, 0xda, 0x28, 0xcd, 0xb2, 0xc8, 0x0d, 0xe8, 0x71, 0xa4, 0x82, 0x95,
+	0xee, 0xa2, 0x56, 0xc1, 0x5a, 0xca, 0xff, 0x3d, 0xcd, 0x72, 0x4c, 0xdc, 0xee, 0xb6, 0x73, 0x6b,
+	0x39, 0xb4, 0x96, 0xf7, 0xa7, 0x03, 0x1b, 0x8d, 0x8e, 0x47, 0x3c, 0x8b, 0xf1, 0x51, 0x8d, 0x9c,
+	0x67, 0xc9, 0xff, 0x26, 0x27, 0xb9, 0x07, 0x3d, 0x9c, 0x54, 0x19, 0x9f, 0xea, 0x8a, 0x57, 0x76,
+	0x37, 0x7d, 0x33, 0xe7, 0x7e, 0x33, 0xe7, 0xfe, 0x71, 0x33, 0xe7, 0xfb, 0xcb, 0xea, 0xbe, 0xe7,
+	0xbf, 0x6e, 0x39, 0xa1, 0xcd, 0x51, 0xea, 0xd3, 0xb1, 0x1c, 0x31, 0x9e, 0xc9, 0xa9, 0xdb, 0x7d,
+	0x9b, 0xfa, 0x6f, 0x42, 0xbd, 0xaf, 0xed, 0x6b, 0x08, 0xb1, 0x60, 0x35, 0xbe, 0x4b, 0xe7, 0x37,
+	0xa1, 0x1f, 0xd3, 0x32, 0xc6, 0x5c, 0x89, 0xb8, 0xa0, 0x45, 0xbc, 0x74, 0x78, 0x67, 0x0b, 0x70,
+	0x5d, 0x03, 0xbf, 0xa9, 0x12, 0x2a, 0xf1, 0x88, 0x72, 0x5a, 0x08, 0x42, 0xa0, 0x7b, 0x8a, 0x53,
+	0xe1, 0x3a, 0x6a, 0xbc, 0x43, 0xfd, 0x4d, 0xee, 0x40, 0xaf, 0xd2, 0xa7, 0x1a, 0xb2, 0xb2, 0x7b,
+	0xc3, 0x9f, 0x5d, 0x0c, 0xbe, 0xc9, 0xdd, 0xef, 0xaa, 0x5e, 0x43, 0x1b, 0x4b, 0x8e, 0x81, 0xd0,
+	0x3a, 0x8d, 0x62, 0x36, 0x2e, 0x25, 0xf2, 0xc8, 0x12, 0x8c, 0x62, 0xdb, 0xf3, 0x84, 0xbd, 0x3a,
+	0x3d, 0x30, 0x81, 0x33, 0xac, 0x6b, 0x74, 0xce, 0x4f, 0xbe, 0x80, 0x01, 0xc7, 0x67, 0x94, 0x27,
+	0x0d, 0xb0, 0xab, 0x81, 0x37, 0xe7, 0x81, 0xa1, 0x0e, 0x9a, 0x81, 0xad, 0xf2, 0x2b, 0x3e, 0xf2,
+	0x25, 0xac, 0x55, 0x58, 0xd2, 0x5c, 0x4e, 0x1b, 0xd2, 0x92, 0x26, 0x7d, 0xf4, 0xb7, 0xe6, 0x4c,
+	0xd4, 0x0c, 0x6a, 0x50, 0x5d, 0x75, 0x7a, 0x13, 0xd8, 0xb8, 0xa2, 0xe4, 0x5e, 0x1c, 0x63, 0x25,
+	0x1f, 0x66, 0x42, 0x92, 0xcf, 0x01, 0x04, 0xca, 0x48, 0xff, 0x1c, 0x46, 0xd3, 0x95, 0xdd, 0x8d,
+	0xf9, 0x0b, 0x0e, 0xd5, 0xa9, 0x05, 0xf7, 0x05, 0x4a, 0x6d, 0x0b, 0xf2, 0x09, 0x0c, 0xd4, 0xb6,
+	0x91, 0xd8, 0xa4, 0x2f, 0xe8, 0x9f, 0x64, 0xd5, 0x38, 0x4d, 0x90, 0xf7, 0x87, 0x03, 0x44, 0x5f,
+	0xfd, 0x48, 0xe3, 0xbe, 0xa3, 0xbc, 0xcc, 0xca, 0xf4, 0x3f, 0xbf, 0xf0, 0x04, 0xde, 0xd7, 0x46,
+	0x54, 0x33, 0x89, 0x51, 0x6b, 0xcf, 0x66, 0xa0, 0xa1, 0xdf, 0x32, 0x89, 0xcd, 0xda, 0xcb, 0xb1,
+	0xc6, 0x5c, 0x0f, 0xc3, 0x20, 0x34, 0x06, 0xf9, 0x18, 0x56, 0x53, 0x4e, 0x63, 0x8c, 0x2a, 0xe4,
+	0x19, 0x6b, 0x5e, 0xfd, 0x8a, 0xf6, 0x1d, 0x69, 0x97, 0xf7, 0x43, 0xd7, 0xae, 0x50, 0x3d, 0xfd,
+	0x5f, 0xb1, 0xfa, 0x9f, 0x26, 0x9f, 0xc2, 0xa0, 0xe2, 0x58, 0x67, 0x6c, 0x2c, 0xda, 0xeb, 0x62,
+	0xb5, 0x41, 0xea, 0x26, 0x9a, 0xb5, 0xb2, 0xd8, 0xda, 0x5a, 0x39, 0x86, 0x5e, 0x3c, 0xa2, 0x65,
+	0x8a, 0x6e, 0xb7, 0x05, 0xa6, 0x65, 0x29, 0x6a, 0x81, 0x49, 0x46, 0x4b, 0x77, 0xa9, 0x0d, 0xaa,
+	0x61, 0x29, 0x81, 0xcd, 0x57, 0x64, 0x4b, 0xee, 0xb5, 0x21, 0xb0, 0x41, 0x1e, 0x98, 0xc2, 0x9f,
+	0x40, 0x5f, 0x8e, 0x38, 0x8a, 0x11, 0xcb, 0x13, 0xf7, 0xbd, 0x16, 0xf0, 0x97, 0xb8, 0xfd, 0x87,
+	0x67, 0xbf, 0x0f, 0x3b, 0x67, 0xe7, 0x43, 0xe7, 0xe5, 0xf9, 0xd0, 0xf9, 0xed, 0x7c, 0xe8, 0x3c,
+	0xbf, 0x18, 0x76, 0x5e, 0x5e, 0x0c, 0x3b, 0x3f, 0x5f, 0x0c, 0x3b, 0x4f, 0xfc, 0x2b, 0x78, 0xf5,
+	0x56, 0x77, 0x4a, 0x94, 0xcf, 0x18, 0x3f, 0xd5, 0x46, 0x50, 0xdf, 0x0d, 0x26, 0xcd, 0xbf, 0x2c,
+	0xfa, 0xaa, 0x93, 0x9e, 0xde, 0xfb, 0x9f, 0xfd, 0x35, 0x00, 0x90, 0x74, 0x83, 0x7f, 0x4d, 0x09,
+	0x00, 0x00,
 }
 
 func (m *EventDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
@@ -206,6 +516,15 @@ func (m *EventDelegateFeedConsent) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.StandbyDelegates) > 0 {
+		for iNdEx := len(m.StandbyDelegates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StandbyDelegates[iNdEx])
+			copy(dAtA[i:], m.StandbyDelegates[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.StandbyDelegates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Delegate) > 0 {
 		i -= len(m.Delegate)
 		copy(dAtA[i:], m.Delegate)
@@ -320,78 +639,1496 @@ func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventSetPriceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventDelegateFeedConsent) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventSetPriceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetPriceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
 	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemovePriceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemovePriceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemovePriceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSetFxRate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.PenaltyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RewardParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.AvgCounterParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAcceptList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAcceptList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAcceptList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeleteDenoms) > 0 {
+		for iNdEx := len(m.DeleteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeleteDenoms[iNdEx])
+			copy(dAtA[i:], m.DeleteDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.DeleteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SetDenoms) > 0 {
+		for iNdEx := len(m.SetDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SetDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOracleWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOracleWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOracleWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriod {
+		i--
+		if m.GracePeriod {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Level != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPriceMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MedianChange.Size()
+		i -= size
+		if _, err := m.MedianChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Change.Size()
+		i -= size
+		if _, err := m.Change.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreviousRate.Size()
+		i -= size
+		if _, err := m.PreviousRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDelegateFeedConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.StandbyDelegates) > 0 {
+		for _, s := range m.StandbyDelegates {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetFxRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Factor.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSetPriceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemovePriceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.AvgCounterParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.RewardParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PenaltyParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateAcceptList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SetDenoms) > 0 {
+		for _, e := range m.SetDenoms {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DeleteDenoms) > 0 {
+		for _, s := range m.DeleteDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventOracleWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Level != 0 {
+		n += 1 + sovEvents(uint64(m.Level))
+	}
+	if m.GracePeriod {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventPriceMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Change.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Median.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MedianChange.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegateFeedConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyDelegates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyDelegates = append(m.StandbyDelegates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetFxRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetFxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetPriceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetPriceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetPriceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemovePriceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemovePriceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemovePriceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgCounterParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvgCounterParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *EventSlash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Factor.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Jailed {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateAcceptList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -414,17 +2151,17 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateAcceptList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateAcceptList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDenoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -434,27 +2171,29 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.SetDenoms = append(m.SetDenoms, Denom{})
+			if err := m.SetDenoms[len(m.SetDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -482,7 +2221,7 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			m.DeleteDenoms = append(m.DeleteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -505,7 +2244,7 @@ func (m *EventDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
+func (m *EventOracleWarning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,15 +2267,15 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetFxRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOracleWarning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetFxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOracleWarning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -564,11 +2303,11 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -596,10 +2335,49 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GracePeriod = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -621,7 +2399,7 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSlash) Unmarshal(dAtA []byte) error {
+func (m *EventPriceMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -644,15 +2422,15 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlash: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPriceMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPriceMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -680,11 +2458,11 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -712,13 +2490,13 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -746,13 +2524,15 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -762,12 +2542,128 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MedianChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -17,15 +17,25 @@ func NewDenomExchangeRate(denom string, exchangeRate sdk.Dec, t time.Time) Denom
 	}
 }
 
+// NewDenomExchangeRateWithConfidence creates a DenomExchangeRate instance from a stored
+// exchange rate, including its confidence.
+func NewDenomExchangeRateWithConfidence(denom string, er ExchangeRate) DenomExchangeRate {
+	v := NewDenomExchangeRate(denom, er.Rate, er.Timestamp)
+	v.Confidence = er.Confidence
+	return v
+}
+
 func (v DenomExchangeRate) String() string {
 	bz, _ := json.Marshal(v)
 	return string(bz)
 }
 
 // ExchangeRate is type for storing rate and timestamp of denom into store without denom.
+// Tallied exchange rates also store the dispersion of their ballot.
 type ExchangeRate struct {
-	Rate      sdk.Dec   `json:"rate"`
-	Timestamp time.Time `json:"timestamp"`
+	Rate       sdk.Dec          `json:"rate"`
+	Timestamp  time.Time        `json:"timestamp"`
+	Confidence *PriceConfidence `json:"confidence,omitempty"`
 }
 
 // Marshal implements store.Marshalable.
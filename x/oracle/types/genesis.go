@@ -19,6 +19,13 @@ func NewGenesisState(
 	medianPrices []Price,
 	medianDeviationPrices []Price,
 	acp AvgCounterParams,
+	derivedFeeds []DerivedFeed,
+	validatorPerformances []ValidatorPerformance,
+	priceOverrides []PriceOverride,
+	rewardParams RewardParams,
+	penaltyParams PenaltyParams,
+	offenceHistories []OffenceHistory,
+	priceVolatilities []PriceVolatility,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -31,6 +38,13 @@ func NewGenesisState(
 		Medians:                       medianPrices,
 		MedianDeviations:              medianDeviationPrices,
 		AvgCounterParams:              acp,
+		DerivedFeeds:                  derivedFeeds,
+		ValidatorPerformances:         validatorPerformances,
+		PriceOverrides:                priceOverrides,
+		RewardParams:                  rewardParams,
+		PenaltyParams:                 penaltyParams,
+		OffenceHistories:              offenceHistories,
+		PriceVolatilities:             priceVolatilities,
 	}
 }
 
@@ -48,6 +62,13 @@ func DefaultGenesisState() *GenesisState {
 		Medians:                       []Price{},
 		MedianDeviations:              []Price{},
 		AvgCounterParams:              DefaultAvgCounterParams(),
+		DerivedFeeds:                  []DerivedFeed{},
+		ValidatorPerformances:         []ValidatorPerformance{},
+		PriceOverrides:                []PriceOverride{},
+		RewardParams:                  DefaultRewardParams(),
+		PenaltyParams:                 DefaultPenaltyParams(),
+		OffenceHistories:              []OffenceHistory{},
+		PriceVolatilities:             []PriceVolatility{},
 	}
 }
 
@@ -57,7 +78,37 @@ func ValidateGenesis(data *GenesisState) error {
 		return err
 	}
 
-	return data.AvgCounterParams.Validate()
+	if err := data.AvgCounterParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.RewardParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.PenaltyParams.Validate(); err != nil {
+		return err
+	}
+
+	for _, h := range data.OffenceHistories {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range data.PriceVolatilities {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range data.PriceOverrides {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateDerivedFeeds(data.DerivedFeeds, data.Params.AcceptList)
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application
@@ -35,7 +35,14 @@ type GenesisState struct {
 	HistoricPrices                []Price                        `protobuf:"bytes,8,rep,name=historic_prices,json=historicPrices,proto3" json:"historic_prices"`
 	MedianDeviations              []Price                        `protobuf:"bytes,9,rep,name=medianDeviations,proto3" json:"medianDeviations"`
 	// Historic Avg Counter params
-	AvgCounterParams AvgCounterParams `protobuf:"bytes,10,opt,name=avg_counter_params,json=avgCounterParams,proto3" json:"avg_counter_params" yaml:"avg_counter_params"`
+	AvgCounterParams      AvgCounterParams       `protobuf:"bytes,10,opt,name=avg_counter_params,json=avgCounterParams,proto3" json:"avg_counter_params" yaml:"avg_counter_params"`
+	DerivedFeeds          []DerivedFeed          `protobuf:"bytes,11,rep,name=derived_feeds,json=derivedFeeds,proto3" json:"derived_feeds"`
+	ValidatorPerformances []ValidatorPerformance `protobuf:"bytes,12,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	PriceOverrides        []PriceOverride        `protobuf:"bytes,13,rep,name=price_overrides,json=priceOverrides,proto3" json:"price_overrides"`
+	RewardParams          RewardParams           `protobuf:"bytes,14,opt,name=reward_params,json=rewardParams,proto3" json:"reward_params"`
+	PenaltyParams         PenaltyParams          `protobuf:"bytes,15,opt,name=penalty_params,json=penaltyParams,proto3" json:"penalty_params"`
+	OffenceHistories      []OffenceHistory       `protobuf:"bytes,16,rep,name=offence_histories,json=offenceHistories,proto3" json:"offence_histories"`
+	PriceVolatilities     []PriceVolatility      `protobuf:"bytes,17,rep,name=price_volatilities,json=priceVolatilities,proto3" json:"price_volatilities"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,8 +82,9 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
 type FeederDelegation struct {
-	FeederAddress    string `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
-	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	FeederAddress          string   `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
+	ValidatorAddress       string   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StandbyFeederAddresses []string `protobuf:"bytes,3,rep,name=standby_feeder_addresses,json=standbyFeederAddresses,proto3" json:"standby_feeder_addresses,omitempty"`
 }
 
 func (m *FeederDelegation) Reset()         { *m = FeederDelegation{} }
@@ -201,46 +209,60 @@ func init() {
 func init() { proto.RegisterFile("umee/oracle/v1/genesis.proto", fileDescriptor_c99b4af40468acc1) }
 
 var fileDescriptor_c99b4af40468acc1 = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5d, 0x4f, 0xd4, 0x4e,
-	0x14, 0xc6, 0xb7, 0xbc, 0x2c, 0x30, 0x0b, 0xfb, 0x5f, 0xe6, 0xaf, 0xa6, 0x82, 0x94, 0xa5, 0x89,
-	0x09, 0x46, 0x6d, 0x03, 0xbe, 0x5c, 0x78, 0x07, 0xae, 0x70, 0xa3, 0x84, 0xac, 0x6f, 0x89, 0x89,
-	0x69, 0x86, 0xf6, 0x50, 0x1a, 0xda, 0x4e, 0x9d, 0x99, 0x56, 0x88, 0xf1, 0x3b, 0xf8, 0xb1, 0xb8,
-	0xe4, 0xd2, 0x1b, 0x89, 0xc2, 0x37, 0xf0, 0x13, 0x98, 0x4e, 0xa7, 0xd0, 0xed, 0x82, 0x78, 0x07,
-	0xe7, 0x3c, 0xe7, 0xf7, 0x9c, 0x6c, 0x9f, 0x33, 0xe8, 0x4e, 0x1a, 0x01, 0xd8, 0x94, 0x11, 0x37,
-	0x04, 0x3b, 0x5b, 0xb1, 0x7d, 0x88, 0x81, 0x07, 0xdc, 0x4a, 0x18, 0x15, 0x14, 0xb7, 0xf3, 0xae,
-	0x55, 0x74, 0xad, 0x6c, 0x65, 0xee, 0x86, 0x4f, 0x7d, 0x2a, 0x5b, 0x76, 0xfe, 0x57, 0xa1, 0x9a,
-	0x9b, 0xaf, 0x31, 0x94, 0x5e, 0x36, 0xcd, 0x1f, 0x4d, 0x34, 0xbd, 0x59, 0x40, 0x5f, 0x0b, 0x22,
-	0x00, 0x3f, 0x46, 0xcd, 0x84, 0x30, 0x12, 0x71, 0x5d, 0xeb, 0x6a, 0xcb, 0xad, 0xd5, 0x5b, 0xd6,
-	0xa0, 0x89, 0xb5, 0x2d, 0xbb, 0xeb, 0x63, 0x47, 0x27, 0x8b, 0x8d, 0xbe, 0xd2, 0xe2, 0xb7, 0x08,
-	0xef, 0x02, 0x78, 0xc0, 0x1c, 0x0f, 0x42, 0xf0, 0x89, 0x08, 0x68, 0xcc, 0xf5, 0x91, 0xee, 0xe8,
-	0x72, 0x6b, 0xb5, 0x5b, 0x27, 0x6c, 0x48, 0x65, 0xef, 0x5c, 0xa8, 0x58, 0xb3, 0xbb, 0xb5, 0x3a,
-	0xc7, 0x5b, 0xa8, 0x0d, 0x07, 0xee, 0x1e, 0x89, 0x7d, 0x70, 0x18, 0x11, 0xc0, 0xf5, 0x51, 0x89,
-	0x5c, 0xaa, 0x23, 0x7b, 0x10, 0xd3, 0xe8, 0x85, 0x92, 0xf6, 0x89, 0x00, 0xc5, 0x9c, 0x81, 0x4a,
-	0x8d, 0xe3, 0x0d, 0x34, 0x13, 0x05, 0x9c, 0x3b, 0x2e, 0x4d, 0x63, 0x01, 0x8c, 0xeb, 0x63, 0x12,
-	0x37, 0x5f, 0xc7, 0xbd, 0x0a, 0x38, 0x7f, 0x5e, 0x68, 0x14, 0x68, 0x3a, 0xba, 0x28, 0x71, 0xfc,
-	0x05, 0x75, 0x89, 0xef, 0xb3, 0x7c, 0x4f, 0x70, 0x06, 0x36, 0x74, 0x12, 0x06, 0x19, 0xcd, 0x37,
-	0x1d, 0x97, 0xe8, 0x07, 0x75, 0xf4, 0x5a, 0x39, 0x57, 0xdd, 0x76, 0xbb, 0x18, 0x52, 0x5e, 0x0b,
-	0xe4, 0x2f, 0x1a, 0x8e, 0x19, 0x5a, 0xb8, 0xca, 0xbc, 0x70, 0x6e, 0x4a, 0xe7, 0x7b, 0xff, 0xe4,
-	0xfc, 0xee, 0xc2, 0x76, 0x8e, 0x5c, 0x25, 0xe0, 0xf8, 0x09, 0x9a, 0x88, 0xc0, 0x0b, 0x48, 0xcc,
-	0xf5, 0x09, 0x49, 0xbf, 0x39, 0x14, 0x0b, 0x16, 0xb8, 0x25, 0xa9, 0xd4, 0xe2, 0x1e, 0xfa, 0x6f,
-	0x2f, 0xe0, 0x82, 0xb2, 0xc0, 0x75, 0x92, 0x5c, 0xc0, 0xf5, 0xc9, 0xeb, 0xc7, 0xdb, 0xe5, 0x8c,
-	0x2c, 0x72, 0xbc, 0x89, 0x3a, 0x05, 0xb0, 0x07, 0x59, 0xa0, 0xa2, 0x35, 0x75, 0x3d, 0x66, 0x68,
-	0x08, 0x7f, 0x42, 0x98, 0x64, 0x7e, 0xf9, 0xf5, 0x1d, 0x95, 0x73, 0x24, 0x73, 0x3e, 0x94, 0xd2,
-	0xb5, 0xcc, 0x57, 0xdf, 0x5b, 0x25, 0x7e, 0x29, 0xa7, 0xfe, 0x3e, 0x59, 0xbc, 0x7d, 0x48, 0xa2,
-	0xf0, 0x99, 0x39, 0x4c, 0x32, 0xfb, 0x1d, 0x52, 0x1b, 0x32, 0x77, 0x51, 0xa7, 0x1e, 0x77, 0x7c,
-	0x17, 0xb5, 0xd5, 0xb1, 0x10, 0xcf, 0x63, 0xc0, 0x8b, 0x53, 0x9b, 0xea, 0xcf, 0x14, 0xd5, 0xb5,
-	0xa2, 0x88, 0xef, 0xa3, 0xd9, 0x8c, 0x84, 0x81, 0x47, 0x04, 0xbd, 0x50, 0x8e, 0x48, 0x65, 0xe7,
-	0xbc, 0xa1, 0xc4, 0xe6, 0x47, 0xd4, 0xaa, 0x84, 0xf6, 0xf2, 0x59, 0xed, 0xf2, 0x59, 0xbc, 0x84,
-	0xa6, 0xab, 0x57, 0x21, 0x3d, 0xc6, 0xfa, 0xad, 0x4a, 0xe2, 0xcd, 0xaf, 0x68, 0x5c, 0xfe, 0xb4,
-	0xf8, 0x3d, 0xfa, 0x7f, 0x30, 0x72, 0x22, 0x4d, 0x42, 0x50, 0x6f, 0xc5, 0xd0, 0x59, 0x56, 0x83,
-	0xf4, 0x26, 0x17, 0x96, 0xa7, 0x0e, 0xf5, 0x06, 0x9e, 0x47, 0x53, 0x3b, 0x21, 0x75, 0xf7, 0x9d,
-	0x38, 0x8d, 0xd4, 0x06, 0x93, 0xb2, 0xb0, 0x95, 0x46, 0xeb, 0x2f, 0x8f, 0x7e, 0x19, 0x8d, 0xa3,
-	0x53, 0x43, 0x3b, 0x3e, 0x35, 0xb4, 0x9f, 0xa7, 0x86, 0xf6, 0xed, 0xcc, 0x68, 0x1c, 0x9f, 0x19,
-	0x8d, 0xef, 0x67, 0x46, 0xe3, 0x83, 0xe5, 0x07, 0x62, 0x2f, 0xdd, 0xb1, 0x5c, 0x1a, 0xd9, 0xf9,
-	0x02, 0x0f, 0x63, 0x10, 0x9f, 0x29, 0xdb, 0x97, 0xff, 0xd8, 0xd9, 0x53, 0xfb, 0xa0, 0x7c, 0xfd,
-	0xc4, 0x61, 0x02, 0x7c, 0xa7, 0x29, 0x9f, 0xbe, 0x47, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x24,
-	0x3f, 0x4a, 0x96, 0x5d, 0x05, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0xdd, 0x6e, 0x77, 0x33, 0xf9, 0x68, 0x32, 0xb0, 0x95, 0x69, 0xb7, 0x6e, 0x1a,
+	0x81, 0xb4, 0x08, 0x48, 0xb4, 0xcb, 0x87, 0x10, 0x77, 0x5d, 0x42, 0x17, 0xa1, 0x65, 0x5b, 0x42,
+	0x29, 0x12, 0x12, 0xb2, 0x26, 0xf6, 0x89, 0x3b, 0xaa, 0xed, 0x31, 0x33, 0x13, 0xb7, 0x11, 0xe2,
+	0x1d, 0xb8, 0xe0, 0x01, 0x78, 0x9c, 0x5e, 0xf6, 0x92, 0xab, 0x0a, 0xda, 0x37, 0xe0, 0x09, 0x90,
+	0xc7, 0x33, 0x8d, 0xe3, 0xa4, 0x74, 0xef, 0x92, 0xff, 0xf9, 0xcf, 0xef, 0x9c, 0x9c, 0x39, 0x39,
+	0x83, 0x9e, 0x4c, 0x22, 0x80, 0x3e, 0xe3, 0xc4, 0x0b, 0xa1, 0x9f, 0x3e, 0xeb, 0x07, 0x10, 0x83,
+	0xa0, 0xa2, 0x97, 0x70, 0x26, 0x19, 0x6e, 0x66, 0xd1, 0x5e, 0x1e, 0xed, 0xa5, 0xcf, 0x36, 0xde,
+	0x0e, 0x58, 0xc0, 0x54, 0xa8, 0x9f, 0x7d, 0xca, 0x5d, 0x1b, 0x9b, 0x25, 0x86, 0xf6, 0xab, 0x60,
+	0xf7, 0x8f, 0x1a, 0xaa, 0xbf, 0xcc, 0xa1, 0xdf, 0x4b, 0x22, 0x01, 0x7f, 0x82, 0x56, 0x13, 0xc2,
+	0x49, 0x24, 0x6c, 0xab, 0x63, 0x3d, 0xad, 0x3d, 0x5f, 0xef, 0xcd, 0x27, 0xe9, 0x1d, 0xa8, 0xe8,
+	0x8b, 0x95, 0xf3, 0xcb, 0xed, 0xca, 0x50, 0x7b, 0xf1, 0x0f, 0x08, 0x8f, 0x01, 0x7c, 0xe0, 0xae,
+	0x0f, 0x21, 0x04, 0x44, 0x52, 0x16, 0x0b, 0xfb, 0x5e, 0xe7, 0xfe, 0xd3, 0xda, 0xf3, 0x4e, 0x99,
+	0xb0, 0xa7, 0x9c, 0x83, 0x1b, 0xa3, 0x66, 0xb5, 0xc7, 0x25, 0x5d, 0xe0, 0xd7, 0xa8, 0x09, 0x67,
+	0xde, 0x31, 0x89, 0x03, 0x70, 0x39, 0x91, 0x20, 0xec, 0xfb, 0x0a, 0xb9, 0x53, 0x46, 0x0e, 0x20,
+	0x66, 0xd1, 0x57, 0xda, 0x3a, 0x24, 0x12, 0x34, 0xb3, 0x01, 0x05, 0x4d, 0xe0, 0x3d, 0xd4, 0x88,
+	0xa8, 0x10, 0xae, 0xc7, 0x26, 0xb1, 0x04, 0x2e, 0xec, 0x15, 0x85, 0xdb, 0x2c, 0xe3, 0xbe, 0xa5,
+	0x42, 0x7c, 0x99, 0x7b, 0x34, 0xa8, 0x1e, 0xcd, 0x24, 0x81, 0x7f, 0x45, 0x1d, 0x12, 0x04, 0x3c,
+	0xab, 0x13, 0xdc, 0xb9, 0x0a, 0xdd, 0x84, 0x43, 0xca, 0xb2, 0x4a, 0x1f, 0x28, 0xf4, 0x87, 0x65,
+	0xf4, 0xae, 0x39, 0x57, 0xac, 0xf6, 0x20, 0x3f, 0xa4, 0x73, 0x6d, 0x91, 0xff, 0xf1, 0x08, 0xcc,
+	0xd1, 0xd6, 0x6d, 0xc9, 0xf3, 0xcc, 0xab, 0x2a, 0xf3, 0xfb, 0x6f, 0x94, 0xf9, 0x68, 0x96, 0x76,
+	0x83, 0xdc, 0x66, 0x10, 0xf8, 0x53, 0xf4, 0x30, 0x02, 0x9f, 0x92, 0x58, 0xd8, 0x0f, 0x15, 0xfd,
+	0xf1, 0xc2, 0x58, 0x70, 0xea, 0x19, 0x92, 0xf1, 0xe2, 0x01, 0x5a, 0x3b, 0xa6, 0x42, 0x32, 0x4e,
+	0x3d, 0x37, 0xc9, 0x0c, 0xc2, 0x7e, 0x74, 0xf7, 0xf1, 0xa6, 0x39, 0xa3, 0x44, 0x81, 0x5f, 0xa2,
+	0x56, 0x0e, 0x1c, 0x40, 0x4a, 0xf5, 0x68, 0x55, 0xef, 0xc6, 0x2c, 0x1c, 0xc2, 0xbf, 0x20, 0x4c,
+	0xd2, 0xc0, 0xdc, 0xbe, 0xab, 0xe7, 0x1c, 0x75, 0xac, 0x65, 0x53, 0xba, 0x9b, 0x06, 0xfa, 0xbe,
+	0xf5, 0xc4, 0xef, 0x64, 0xd4, 0x7f, 0x2f, 0xb7, 0xdf, 0x99, 0x92, 0x28, 0xfc, 0xa2, 0xbb, 0x48,
+	0xea, 0x0e, 0x5b, 0xa4, 0x74, 0x28, 0x9b, 0x38, 0x1f, 0x38, 0x4d, 0xc1, 0x77, 0xb3, 0xf1, 0x16,
+	0x76, 0x6d, 0xf9, 0xc4, 0x0d, 0x72, 0x53, 0xf6, 0xd7, 0x30, 0x13, 0xe7, 0xcf, 0x24, 0x81, 0x09,
+	0x5a, 0x4f, 0x49, 0x48, 0x7d, 0x22, 0x19, 0x77, 0x13, 0xe0, 0x63, 0xc6, 0x23, 0x12, 0x67, 0x0d,
+	0xad, 0x2b, 0xe0, 0xbb, 0x65, 0xe0, 0x91, 0x71, 0x1f, 0xcc, 0xcc, 0x9a, 0xfc, 0x38, 0x5d, 0x12,
+	0x13, 0xf8, 0x15, 0x5a, 0x53, 0x77, 0xe4, 0xb2, 0x14, 0x38, 0xa7, 0x3e, 0x08, 0xbb, 0xa1, 0xd8,
+	0x5b, 0x4b, 0xbb, 0xbc, 0xaf, 0x5d, 0xe6, 0xd2, 0x92, 0xa2, 0x98, 0x5d, 0x5a, 0x83, 0xc3, 0x29,
+	0xe1, 0xbe, 0x69, 0x73, 0x53, 0xb5, 0xf9, 0x49, 0x99, 0x35, 0x54, 0xa6, 0xb9, 0xa5, 0x52, 0xe7,
+	0x05, 0x0d, 0x7f, 0x83, 0x9a, 0x09, 0xc4, 0x24, 0x94, 0x53, 0x43, 0x5a, 0xeb, 0x58, 0x4b, 0xab,
+	0xca, 0x5d, 0x73, 0xa8, 0x46, 0x52, 0x14, 0xf1, 0x77, 0xa8, 0xcd, 0xc6, 0x63, 0x88, 0x3d, 0x70,
+	0xf5, 0x8c, 0x81, 0xb0, 0x5b, 0xea, 0x47, 0x3a, 0x65, 0xdc, 0x7e, 0x6e, 0xfc, 0x5a, 0xf9, 0xa6,
+	0x66, 0xa6, 0x58, 0x51, 0xa5, 0x20, 0xf0, 0x21, 0xc2, 0x79, 0xd7, 0x52, 0x16, 0x12, 0x49, 0x43,
+	0x2a, 0x33, 0x66, 0x5b, 0x31, 0xb7, 0x97, 0x36, 0xee, 0xc8, 0x18, 0x0d, 0xb4, 0x9d, 0xcc, 0xc9,
+	0x14, 0x44, 0xf7, 0x4f, 0x0b, 0xb5, 0xca, 0x6b, 0x12, 0xbf, 0x87, 0x9a, 0x7a, 0xc9, 0x12, 0xdf,
+	0xe7, 0x20, 0xf2, 0x15, 0x5d, 0x1d, 0x36, 0x72, 0x75, 0x37, 0x17, 0xf1, 0x07, 0xa8, 0x3d, 0x1b,
+	0x15, 0xe3, 0xbc, 0xa7, 0x9c, 0xad, 0x9b, 0x80, 0x31, 0x7f, 0x8e, 0x6c, 0x21, 0x49, 0xec, 0x8f,
+	0xa6, 0xee, 0x3c, 0x5b, 0xef, 0xda, 0xea, 0x70, 0x5d, 0xc7, 0xf7, 0x8a, 0x49, 0x40, 0x74, 0x7f,
+	0x46, 0xb5, 0xc2, 0x9a, 0x5c, 0x9e, 0xd5, 0xba, 0x25, 0xeb, 0x0e, 0xaa, 0x17, 0xf7, 0xb0, 0xaa,
+	0x6e, 0x65, 0x58, 0x2b, 0xec, 0xd8, 0xee, 0x6f, 0xe8, 0x81, 0xea, 0x16, 0xfe, 0x11, 0xbd, 0x35,
+	0xbf, 0xe4, 0xe4, 0x24, 0x09, 0x41, 0xbf, 0x4e, 0x0b, 0x0f, 0x41, 0x71, 0x75, 0x1d, 0x66, 0x46,
+	0xd3, 0x63, 0x28, 0x07, 0xf0, 0x26, 0xaa, 0x8e, 0x42, 0xe6, 0x9d, 0xb8, 0xf1, 0x24, 0xd2, 0x15,
+	0x3c, 0x52, 0xc2, 0xeb, 0x49, 0xf4, 0xe2, 0xd5, 0xf9, 0x3f, 0x4e, 0xe5, 0xfc, 0xca, 0xb1, 0x2e,
+	0xae, 0x1c, 0xeb, 0xef, 0x2b, 0xc7, 0xfa, 0xfd, 0xda, 0xa9, 0x5c, 0x5c, 0x3b, 0x95, 0xbf, 0xae,
+	0x9d, 0xca, 0x4f, 0xbd, 0x80, 0xca, 0xe3, 0xc9, 0xa8, 0xe7, 0xb1, 0xa8, 0x9f, 0x15, 0xf0, 0x51,
+	0x0c, 0xf2, 0x94, 0xf1, 0x13, 0xf5, 0xa5, 0x9f, 0x7e, 0xd6, 0x3f, 0x33, 0xef, 0xad, 0x9c, 0x26,
+	0x20, 0x46, 0xab, 0xea, 0xb1, 0xfd, 0xf8, 0xbf, 0x01, 0x00, 0xd3, 0xf2, 0xd3, 0x00, 0xcf, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +285,100 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceVolatilities) > 0 {
+		for iNdEx := len(m.PriceVolatilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceVolatilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OffenceHistories) > 0 {
+		for iNdEx := len(m.OffenceHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OffenceHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.PenaltyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.RewardParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.PriceOverrides) > 0 {
+		for iNdEx := len(m.PriceOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DerivedFeeds) > 0 {
+		for iNdEx := len(m.DerivedFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	{
 		size, err := m.AvgCounterParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -418,6 +534,15 @@ func (m *FeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StandbyFeederAddresses) > 0 {
+		for iNdEx := len(m.StandbyFeederAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StandbyFeederAddresses[iNdEx])
+			copy(dAtA[i:], m.StandbyFeederAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StandbyFeederAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -577,6 +702,40 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.AvgCounterParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DerivedFeeds) > 0 {
+		for _, e := range m.DerivedFeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceOverrides) > 0 {
+		for _, e := range m.PriceOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PenaltyParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OffenceHistories) > 0 {
+		for _, e := range m.OffenceHistories {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceVolatilities) > 0 {
+		for _, e := range m.PriceVolatilities {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -594,6 +753,12 @@ func (m *FeederDelegation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.StandbyFeederAddresses) > 0 {
+		for _, s := range m.StandbyFeederAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1000,6 +1165,242 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedFeeds = append(m.DerivedFeeds, DerivedFeed{})
+			if err := m.DerivedFeeds[len(m.DerivedFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceOverrides = append(m.PriceOverrides, PriceOverride{})
+			if err := m.PriceOverrides[len(m.PriceOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffenceHistories = append(m.OffenceHistories, OffenceHistory{})
+			if err := m.OffenceHistories[len(m.OffenceHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceVolatilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceVolatilities = append(m.PriceVolatilities, PriceVolatility{})
+			if err := m.PriceVolatilities[len(m.PriceVolatilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1114,6 +1515,38 @@ func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyFeederAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyFeederAddresses = append(m.StandbyFeederAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
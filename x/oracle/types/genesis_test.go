@@ -57,6 +57,16 @@ func TestGenesisValidation(t *testing.T) {
 	genState = DefaultGenesisState()
 	genState.Params.AcceptList = DenomList{Denom{}}
 	require.Error(t, ValidateGenesis(genState))
+
+	// Invalid DerivedFeeds
+	genState = DefaultGenesisState()
+	genState.DerivedFeeds = []DerivedFeed{{
+		SymbolDenom: UmeeSymbol,
+		Formula:     DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT,
+		Components:  []DerivedFeedComponent{{SymbolDenom: "ATOM"}},
+		Multiplier:  sdk.OneDec(),
+	}}
+	require.ErrorContains(t, ValidateGenesis(genState), "is in the accept list")
 }
 
 func TestGetGenesisStateFromAppState(t *testing.T) {
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Kinds of price history records.
+const (
+	HistoryKindPrice           = "price"
+	HistoryKindMedian          = "median"
+	HistoryKindMedianDeviation = "median_deviation"
+)
+
+// HistoryRecord is a historic price, median or median deviation stamp, in the format used to
+// export the price history and to patch it into a genesis file.
+type HistoryRecord struct {
+	Kind  string  `json:"kind"`
+	Denom string  `json:"denom"`
+	Block uint64  `json:"block"`
+	Value sdk.Dec `json:"value"`
+}
+
+// NewHistoryRecords converts price stamps to history records of the given kind.
+func NewHistoryRecords(kind string, prices Prices) []HistoryRecord {
+	records := make([]HistoryRecord, len(prices))
+	for i, p := range prices {
+		records[i] = HistoryRecord{
+			Kind:  kind,
+			Denom: p.ExchangeRateTuple.Denom,
+			Block: p.BlockNum,
+			Value: p.ExchangeRateTuple.ExchangeRate,
+		}
+	}
+	return records
+}
+
+// Validate performs a basic validation of the history record.
+func (r HistoryRecord) Validate() error {
+	switch r.Kind {
+	case HistoryKindPrice, HistoryKindMedian, HistoryKindMedianDeviation:
+	default:
+		return fmt.Errorf("unknown history record kind: %q", r.Kind)
+	}
+	if r.Denom == "" {
+		return fmt.Errorf("history record denom can't be empty")
+	}
+	if r.Value.IsNil() || r.Value.IsNegative() {
+		return fmt.Errorf("%s of %s at block %d must be non negative", r.Kind, r.Denom, r.Block)
+	}
+	return nil
+}
+
+// PatchHistory replaces the historic prices, medians and median deviations of the denoms of
+// the records with the records. If endBlock is positive, the blocks of the records are first
+// shifted so that the last record lands at endBlock, and records shifted before the first block
+// are dropped.
+func (gs *GenesisState) PatchHistory(records []HistoryRecord, endBlock uint64) error {
+	var last uint64
+	denoms := map[string]bool{}
+	for _, r := range records {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		denoms[r.Denom] = true
+		if r.Block > last {
+			last = r.Block
+		}
+	}
+
+	keep := func(prices Prices) Prices {
+		kept := Prices{}
+		for _, p := range prices {
+			if !denoms[p.ExchangeRateTuple.Denom] {
+				kept = append(kept, p)
+			}
+		}
+		return kept
+	}
+	historicPrices, medians, deviations := keep(gs.HistoricPrices), keep(gs.Medians), keep(gs.MedianDeviations)
+
+	for _, r := range records {
+		block := r.Block
+		if endBlock > 0 {
+			if last-block >= endBlock {
+				continue
+			}
+			block = endBlock - (last - block)
+		}
+		p := NewPrice(r.Value, r.Denom, block)
+		switch r.Kind {
+		case HistoryKindPrice:
+			historicPrices = append(historicPrices, p)
+		case HistoryKindMedian:
+			medians = append(medians, p)
+		case HistoryKindMedianDeviation:
+			deviations = append(deviations, p)
+		}
+	}
+
+	gs.HistoricPrices, gs.Medians, gs.MedianDeviations = historicPrices, medians, deviations
+	return nil
+}
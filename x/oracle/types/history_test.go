@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestHistoryRecordValidate(t *testing.T) {
+	r := types.HistoryRecord{Kind: types.HistoryKindMedian, Denom: "UMEE", Block: 10, Value: sdk.OneDec()}
+	assert.NilError(t, r.Validate())
+
+	r.Kind = "candle"
+	assert.ErrorContains(t, r.Validate(), `unknown history record kind: "candle"`)
+	r = types.HistoryRecord{Kind: types.HistoryKindPrice, Block: 10, Value: sdk.OneDec()}
+	assert.ErrorContains(t, r.Validate(), "denom can't be empty")
+	r = types.HistoryRecord{Kind: types.HistoryKindPrice, Denom: "UMEE", Block: 10, Value: sdk.NewDec(-1)}
+	assert.ErrorContains(t, r.Validate(), "price of UMEE at block 10 must be non negative")
+}
+
+func TestGenesisPatchHistory(t *testing.T) {
+	one, two := sdk.OneDec(), sdk.NewDec(2)
+	gs := types.DefaultGenesisState()
+	gs.HistoricPrices = types.Prices{types.NewPrice(one, "UMEE", 5), types.NewPrice(one, "ATOM", 5)}
+	gs.Medians = types.Prices{types.NewPrice(one, "UMEE", 5)}
+
+	records := []types.HistoryRecord{
+		{Kind: types.HistoryKindPrice, Denom: "UMEE", Block: 100, Value: two},
+		{Kind: types.HistoryKindPrice, Denom: "UMEE", Block: 200, Value: two},
+		{Kind: types.HistoryKindMedianDeviation, Denom: "UMEE", Block: 200, Value: one},
+	}
+	assert.NilError(t, gs.PatchHistory(records, 0))
+	// UMEE stamps are replaced, ATOM ones are kept
+	assert.DeepEqual(t, []types.Price{
+		types.NewPrice(one, "ATOM", 5), types.NewPrice(two, "UMEE", 100), types.NewPrice(two, "UMEE", 200),
+	}, gs.HistoricPrices)
+	assert.DeepEqual(t, []types.Price{}, gs.Medians)
+	assert.DeepEqual(t, []types.Price{types.NewPrice(one, "UMEE", 200)}, gs.MedianDeviations)
+
+	// shifted to end at block 50: the stamp of block 100 is shifted before the first block
+	assert.NilError(t, gs.PatchHistory(records, 50))
+	assert.DeepEqual(t, []types.Price{types.NewPrice(one, "ATOM", 5), types.NewPrice(two, "UMEE", 50)},
+		gs.HistoricPrices)
+	assert.DeepEqual(t, []types.Price{types.NewPrice(one, "UMEE", 50)}, gs.MedianDeviations)
+
+	records[0].Kind = "candle"
+	assert.ErrorContains(t, gs.PatchHistory(records, 0), "unknown history record kind")
+}
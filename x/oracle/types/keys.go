@@ -29,8 +29,17 @@ var (
 	KeyPrefixHistoricPrice                = []byte{8} // prefix for each key to a historic price
 	KeyPrefixAvgCounter                   = []byte{9} // prefix for each key to a historic avg price counter
 	KeyAvgCounterParams                   = []byte{10}
+	KeyPrefixDerivedFeed                  = []byte{11} // prefix for each key to a derived feed
+	KeyPrefixValidatorPerformance         = []byte{12} // prefix for each key to a validator performance
+	KeyPrefixPriceOverride                = []byte{13} // prefix for each key to a price override
+	KeyPrefixStandbyFeeder                = []byte{14} // prefix for each key to a standby feeder
+	KeyRewardParams                       = []byte{15}
 
 	KeyLatestAvgCounter = []byte{16} // it was set as 0x10 and breaking the order
+
+	KeyPenaltyParams         = []byte{17}
+	KeyPrefixOffenceHistory  = []byte{18} // prefix for each key to a validator offence history
+	KeyPrefixPriceVolatility = []byte{19} // prefix for each key to a price volatility
 )
 
 // KeyExchangeRate - stored by *denom*
@@ -39,16 +48,51 @@ func KeyExchangeRate(denom string) []byte {
 	return util.ConcatBytes(1, KeyPrefixExchangeRate, []byte(strings.ToUpper(denom)))
 }
 
+// KeyDerivedFeed - stored by *denom*
+func KeyDerivedFeed(denom string) []byte {
+	return util.ConcatBytes(0, KeyPrefixDerivedFeed, []byte(strings.ToUpper(denom)))
+}
+
+// KeyPriceOverride - stored by *denom*
+func KeyPriceOverride(denom string) []byte {
+	return util.ConcatBytes(0, KeyPrefixPriceOverride, []byte(strings.ToUpper(denom)))
+}
+
+// KeyPriceVolatility - stored by *denom*
+func KeyPriceVolatility(denom string) []byte {
+	return util.ConcatBytes(0, KeyPrefixPriceVolatility, []byte(strings.ToUpper(denom)))
+}
+
 // KeyFeederDelegation - stored by *Validator* address
 func KeyFeederDelegation(v sdk.ValAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixFeederDelegation, address.MustLengthPrefix(v))
 }
 
+// KeyStandbyFeeder - stored by *Validator* and *Feeder* addresses
+func KeyStandbyFeeder(v sdk.ValAddress, feeder sdk.AccAddress) []byte {
+	return util.ConcatBytes(0, KeyStandbyFeederPrefix(v), address.MustLengthPrefix(feeder))
+}
+
+// KeyStandbyFeederPrefix is the prefix of all the standby feeders of a validator
+func KeyStandbyFeederPrefix(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixStandbyFeeder, address.MustLengthPrefix(v))
+}
+
 // KeyMissCounter - stored by *Validator* address
 func KeyMissCounter(v sdk.ValAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixMissCounter, address.MustLengthPrefix(v))
 }
 
+// KeyValidatorPerformance - stored by *Validator* address
+func KeyValidatorPerformance(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixValidatorPerformance, address.MustLengthPrefix(v))
+}
+
+// KeyOffenceHistory - stored by *Validator* address
+func KeyOffenceHistory(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixOffenceHistory, address.MustLengthPrefix(v))
+}
+
 // KeyAggregateExchangeRatePrevote - stored by *Validator* address
 func KeyAggregateExchangeRatePrevote(v sdk.ValAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixAggregateExchangeRatePrevote, address.MustLengthPrefix(v))
@@ -82,15 +126,19 @@ func KeyAvgCounter(denom string, counterID byte) (key []byte) {
 // ParseDenomAndBlockFromKey returns the denom and block contained in the *key*
 // that has a uint64 at the end with a null prefix (length 9).
 func ParseDenomAndBlockFromKey(key []byte, prefix []byte) (string, uint64) {
+	return string(key[len(prefix) : len(key)-9]), binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// ParseDenomAndBlockFromLegacyKey is ParseDenomAndBlockFromKey for the keys stored before v6.8,
+// which serialized the block with LittleEndian.
+func ParseDenomAndBlockFromLegacyKey(key []byte, prefix []byte) (string, uint64) {
 	return string(key[len(prefix) : len(key)-9]), binary.LittleEndian.Uint64(key[len(key)-8:])
 }
 
-// uintWithNullPrefix efficiently serializes uint using LittleEndian and
-// prepends zero byte (null prefix).
-// TODO: ideally we use BigEndian here (for prefix ordering), but it will require
-// data migration.
+// uintWithNullPrefix serializes uint using BigEndian, so that the keys of a denom are sorted by
+// block, and prepends zero byte (null prefix).
 func uintWithNullPrefix(n uint64) []byte {
 	bz := make([]byte, 9)
-	binary.LittleEndian.PutUint64(bz[1:], n)
+	binary.BigEndian.PutUint64(bz[1:], n)
 	return bz
 }
@@ -144,9 +144,19 @@ func TestParseDenomAndBlockFromMedianDeviationKey(t *testing.T) {
 func TestUintWithNullPrefix(t *testing.T) {
 	expected := []byte{0}
 	num := make([]byte, 8)
-	binary.LittleEndian.PutUint64(num, math.MaxUint64)
+	binary.BigEndian.PutUint64(num, math.MaxUint64)
 	expected = append(expected, num...)
 
 	out := uintWithNullPrefix(math.MaxUint64)
 	assert.Equal(t, expected, out)
+
+	// keys are sorted by block
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0x1, 0x2}, uintWithNullPrefix(0x102))
+}
+
+func TestParseDenomAndBlockFromLegacyKey(t *testing.T) {
+	key := []byte{0x8, 'u', 'm', 'e', 'e', 0, 0xd7, 0x11, 0, 0, 0, 0, 0, 0}
+	denom, block := ParseDenomAndBlockFromLegacyKey(key, KeyPrefixHistoricPrice)
+	assert.Equal(t, "umee", denom)
+	assert.Equal(t, uint64(4567), block)
 }
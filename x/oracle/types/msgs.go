@@ -1,10 +1,14 @@
 package types
 
 import (
+	"strings"
+	"time"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"gopkg.in/yaml.v3"
 
 	"github.com/umee-network/umee/v6/util/checkers"
 )
@@ -13,8 +17,16 @@ var (
 	_ legacytx.LegacyMsg = &MsgDelegateFeedConsent{}
 	_ legacytx.LegacyMsg = &MsgAggregateExchangeRatePrevote{}
 	_ legacytx.LegacyMsg = &MsgAggregateExchangeRateVote{}
+	_ legacytx.LegacyMsg = &MsgGovUpdateDerivedFeeds{}
+	_ legacytx.LegacyMsg = &MsgGovSetPriceOverride{}
+	_ legacytx.LegacyMsg = &MsgGovCancelPriceOverride{}
+	_ legacytx.LegacyMsg = &MsgGovUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgGovUpdateAcceptList{}
 )
 
+// SaltLength is the length of the hex encoded salt of MsgAggregateExchangeRateVote.
+const SaltLength = 64
+
 func NewMsgAggregateExchangeRatePrevote(
 	hash AggregateVoteHash,
 	feeder sdk.AccAddress,
@@ -128,7 +140,7 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 		}
 	}
 
-	if len(msg.Salt) != 64 {
+	if len(msg.Salt) != SaltLength {
 		return ErrInvalidSaltLength
 	}
 	_, err = AggregateVoteHashFromHex(msg.Salt)
@@ -140,10 +152,19 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 }
 
 // NewMsgDelegateFeedConsent creates a MsgDelegateFeedConsent instance
-func NewMsgDelegateFeedConsent(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgDelegateFeedConsent {
+func NewMsgDelegateFeedConsent(
+	operatorAddress sdk.ValAddress,
+	feederAddress sdk.AccAddress,
+	standbyFeeders ...sdk.AccAddress,
+) *MsgDelegateFeedConsent {
+	var standby []string
+	for _, f := range standbyFeeders {
+		standby = append(standby, f.String())
+	}
 	return &MsgDelegateFeedConsent{
-		Operator: operatorAddress.String(),
-		Delegate: feederAddress.String(),
+		Operator:         operatorAddress.String(),
+		Delegate:         feederAddress.String(),
+		StandbyDelegates: standby,
 	}
 }
 
@@ -176,5 +197,316 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address (%s)", err)
 	}
 
+	if len(msg.StandbyDelegates) > MaxStandbyFeeders {
+		return sdkerrors.ErrInvalidRequest.Wrapf("at most %d standby delegates are allowed", MaxStandbyFeeders)
+	}
+	delegates := map[string]bool{msg.Delegate: true}
+	for _, d := range msg.StandbyDelegates {
+		if _, err = sdk.AccAddressFromBech32(d); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid standby delegate address (%s)", err)
+		}
+		if delegates[d] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated delegate %s", d)
+		}
+		delegates[d] = true
+	}
+
+	return nil
+}
+
+// NewMsgGovUpdateDerivedFeeds creates a MsgGovUpdateDerivedFeeds instance
+func NewMsgGovUpdateDerivedFeeds(authority string, set []DerivedFeed, del []string) *MsgGovUpdateDerivedFeeds {
+	return &MsgGovUpdateDerivedFeeds{
+		Authority:   authority,
+		SetFeeds:    set,
+		DeleteFeeds: del,
+	}
+}
+
+// String implements the Stringer interface.
+func (msg MsgGovUpdateDerivedFeeds) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route implements LegacyMsg interface
+func (msg MsgGovUpdateDerivedFeeds) Route() string { return "" }
+
+// Type implements LegacyMsg interface
+func (msg MsgGovUpdateDerivedFeeds) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGovUpdateDerivedFeeds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGovUpdateDerivedFeeds) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGovUpdateDerivedFeeds) ValidateBasic() error {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if len(msg.SetFeeds) == 0 && len(msg.DeleteFeeds) == 0 {
+		return ErrInvalidDerivedFeed.Wrap("no derived feeds to set or delete")
+	}
+
+	symbols := map[string]bool{}
+	for _, f := range msg.SetFeeds {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		symbol := strings.ToUpper(f.SymbolDenom)
+		if symbols[symbol] {
+			return ErrInvalidDerivedFeed.Wrapf("duplicated derived feed %s", f.SymbolDenom)
+		}
+		symbols[symbol] = true
+	}
+	for _, d := range msg.DeleteFeeds {
+		symbol := strings.ToUpper(d)
+		if len(symbol) == 0 {
+			return ErrInvalidDerivedFeed.Wrap("empty symbol denom to delete")
+		}
+		if symbols[symbol] {
+			return ErrInvalidDerivedFeed.Wrapf("%s is both set and deleted", d)
+		}
+		symbols[symbol] = true
+	}
+
+	return nil
+}
+
+// NewMsgGovSetPriceOverride creates a MsgGovSetPriceOverride instance
+func NewMsgGovSetPriceOverride(
+	authority, description, symbolDenom string,
+	rate sdk.Dec,
+	duration time.Duration,
+) *MsgGovSetPriceOverride {
+	return &MsgGovSetPriceOverride{
+		Authority:    authority,
+		Description:  description,
+		SymbolDenom:  symbolDenom,
+		ExchangeRate: rate,
+		Duration:     duration,
+	}
+}
+
+// String implements the Stringer interface.
+func (msg MsgGovSetPriceOverride) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route implements LegacyMsg interface
+func (msg MsgGovSetPriceOverride) Route() string { return "" }
+
+// Type implements LegacyMsg interface
+func (msg MsgGovSetPriceOverride) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGovSetPriceOverride) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGovSetPriceOverride) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGovSetPriceOverride) ValidateBasic() error {
+	if err := checkers.Proposal(msg.Authority, msg.Description); err != nil {
+		return err
+	}
+	if len(msg.SymbolDenom) == 0 {
+		return ErrInvalidPriceOverride.Wrap("empty symbol denom")
+	}
+	if msg.ExchangeRate.IsNil() || !msg.ExchangeRate.IsPositive() {
+		return ErrInvalidPriceOverride.Wrap("exchange rate must be positive")
+	}
+	if msg.Duration <= 0 || msg.Duration > MaxPriceOverrideDuration {
+		return ErrInvalidPriceOverride.Wrapf("duration must be positive and at most %s", MaxPriceOverrideDuration)
+	}
+	return nil
+}
+
+// NewMsgGovCancelPriceOverride creates a MsgGovCancelPriceOverride instance
+func NewMsgGovCancelPriceOverride(authority, symbolDenom string) *MsgGovCancelPriceOverride {
+	return &MsgGovCancelPriceOverride{
+		Authority:   authority,
+		SymbolDenom: symbolDenom,
+	}
+}
+
+// String implements the Stringer interface.
+func (msg MsgGovCancelPriceOverride) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route implements LegacyMsg interface
+func (msg MsgGovCancelPriceOverride) Route() string { return "" }
+
+// Type implements LegacyMsg interface
+func (msg MsgGovCancelPriceOverride) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGovCancelPriceOverride) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGovCancelPriceOverride) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGovCancelPriceOverride) ValidateBasic() error {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if len(msg.SymbolDenom) == 0 {
+		return ErrInvalidPriceOverride.Wrap("empty symbol denom")
+	}
+	return nil
+}
+
+// NewMsgGovUpdateParams creates a MsgGovUpdateParams instance
+func NewMsgGovUpdateParams(
+	authority string,
+	keys []string,
+	changes Params,
+	avgCounterChanges AvgCounterParams,
+	rewardParams RewardParams,
+	penaltyParams PenaltyParams,
+) *MsgGovUpdateParams {
+	return &MsgGovUpdateParams{
+		Authority:         authority,
+		Keys:              keys,
+		Changes:           changes,
+		AvgCounterChanges: avgCounterChanges,
+		RewardParams:      rewardParams,
+		PenaltyParams:     penaltyParams,
+	}
+}
+
+// String implements the Stringer interface.
+func (msg MsgGovUpdateParams) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route implements LegacyMsg interface
+func (msg MsgGovUpdateParams) Route() string { return "" }
+
+// Type implements LegacyMsg interface
+func (msg MsgGovUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGovUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGovUpdateParams) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGovUpdateParams) ValidateBasic() error {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if len(msg.Keys) == 0 {
+		return ErrInvalidParams.Wrap("no params to update")
+	}
+	keys := map[string]bool{}
+	for _, k := range msg.Keys {
+		if keys[k] {
+			return ErrInvalidParams.Wrapf("duplicated key %s", k)
+		}
+		keys[k] = true
+	}
+	if keys[RewardParamsKey] {
+		if err := msg.RewardParams.Validate(); err != nil {
+			return err
+		}
+	}
+	if keys[PenaltyParamsKey] {
+		if err := msg.PenaltyParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// params are validated against the current params by the keeper, here we only check
+	// that the keys are updatable
+	_, _, err := UpdateParams(DefaultParams(), DefaultAvgCounterParams(), msg.Keys, DefaultParams(),
+		DefaultAvgCounterParams())
+	return err
+}
+
+// NewMsgGovUpdateAcceptList creates a MsgGovUpdateAcceptList instance
+func NewMsgGovUpdateAcceptList(authority string, set DenomList, del []string) *MsgGovUpdateAcceptList {
+	return &MsgGovUpdateAcceptList{
+		Authority:    authority,
+		SetDenoms:    set,
+		DeleteDenoms: del,
+	}
+}
+
+// String implements the Stringer interface.
+func (msg MsgGovUpdateAcceptList) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route implements LegacyMsg interface
+func (msg MsgGovUpdateAcceptList) Route() string { return "" }
+
+// Type implements LegacyMsg interface
+func (msg MsgGovUpdateAcceptList) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGovUpdateAcceptList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGovUpdateAcceptList) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGovUpdateAcceptList) ValidateBasic() error {
+	if err := checkers.AssertGovAuthority(msg.Authority); err != nil {
+		return err
+	}
+	if len(msg.SetDenoms) == 0 && len(msg.DeleteDenoms) == 0 {
+		return ErrInvalidParams.Wrap("no denoms to set or delete")
+	}
+
+	denoms := map[string]bool{}
+	for _, d := range msg.SetDenoms {
+		if err := d.Validate(); err != nil {
+			return ErrInvalidParams.Wrap(err.Error())
+		}
+		if denoms[d.BaseDenom] {
+			return ErrInvalidParams.Wrapf("duplicated denom %s", d.BaseDenom)
+		}
+		denoms[d.BaseDenom] = true
+	}
+	for _, d := range msg.DeleteDenoms {
+		if len(d) == 0 {
+			return ErrInvalidParams.Wrap("empty base denom to delete")
+		}
+		if denoms[d] {
+			return ErrInvalidParams.Wrapf("%s is both set and deleted", d)
+		}
+		denoms[d] = true
+	}
+
 	return nil
 }
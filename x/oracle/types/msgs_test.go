@@ -1,10 +1,13 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/util/checkers"
 )
 
 func TestMsgFeederDelegation(t *testing.T) {
@@ -16,20 +19,30 @@ func TestMsgFeederDelegation(t *testing.T) {
 	msgInvalidOperatorAddr := "invalid operator address (empty address string is not allowed): invalid address"
 	msgInvalidDelegatorAddr := "invalid delegate address (empty address string is not allowed): invalid address"
 
+	standby := make([]sdk.AccAddress, MaxStandbyFeeders+1)
+	for i := range standby {
+		standby[i] = sdk.AccAddress([]byte(fmt.Sprintf("standby%d____________", i)))
+	}
+
 	tests := []struct {
 		delegator        sdk.ValAddress
 		delegate         sdk.AccAddress
+		standby          []sdk.AccAddress
 		expectPass       bool
 		expectedErrorMsg string
 	}{
-		{sdk.ValAddress(addrs[0]), addrs[1], true, "test should pass"},
-		{sdk.ValAddress{}, addrs[1], false, msgInvalidOperatorAddr},
-		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, false, msgInvalidDelegatorAddr},
-		{nil, nil, false, msgInvalidOperatorAddr},
+		{sdk.ValAddress(addrs[0]), addrs[1], nil, true, "test should pass"},
+		{sdk.ValAddress{}, addrs[1], nil, false, msgInvalidOperatorAddr},
+		{sdk.ValAddress(addrs[0]), sdk.AccAddress{}, nil, false, msgInvalidDelegatorAddr},
+		{nil, nil, nil, false, msgInvalidOperatorAddr},
+		{sdk.ValAddress(addrs[0]), addrs[1], standby[:MaxStandbyFeeders], true, "test should pass"},
+		{sdk.ValAddress(addrs[0]), addrs[1], standby, false, "at most 4 standby delegates"},
+		{sdk.ValAddress(addrs[0]), addrs[1], []sdk.AccAddress{addrs[1]}, false, "duplicated delegate"},
+		{sdk.ValAddress(addrs[0]), addrs[1], []sdk.AccAddress{{}}, false, "invalid standby delegate address"},
 	}
 
 	for i, tc := range tests {
-		msg := NewMsgDelegateFeedConsent(tc.delegator, tc.delegate)
+		msg := NewMsgDelegateFeedConsent(tc.delegator, tc.delegate, tc.standby...)
 		if tc.expectPass {
 			assert.NilError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -165,3 +178,53 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 
 	assert.DeepEqual(t, msgFeedConsent.GetSigners(), []sdk.AccAddress{sdk.AccAddress(vals[0])})
 }
+
+func TestMsgGovUpdateParams(t *testing.T) {
+	gov := checkers.GovModuleAddr
+	tcs := []struct {
+		keys   []string
+		errMsg string
+	}{
+		{[]string{"VotePeriod", KeyAvgShift}, ""},
+		{nil, "no params to update"},
+		{[]string{"VotePeriod", "VotePeriod"}, "duplicated key VotePeriod"},
+		{[]string{"AcceptList"}, "MsgGovUpdateAcceptList"},
+		{[]string{"Foo"}, "unknown param key Foo"},
+		{[]string{RewardParamsKey}, "full coverage bonus must be in [0, 1]"},
+		{[]string{PenaltyParamsKey}, "slash fraction #0 must be in (0, 1]"},
+		{[]string{"VotePeriod"}, ""}, // reward and penalty params are ignored
+	}
+	pp := PenaltyParams{SlashFractions: []sdk.Dec{sdk.ZeroDec()}}
+	for _, tc := range tcs {
+		err := NewMsgGovUpdateParams(gov, tc.keys, Params{}, AvgCounterParams{}, RewardParams{}, pp).ValidateBasic()
+		if tc.errMsg == "" {
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg)
+		}
+	}
+}
+
+func TestMsgGovUpdateAcceptList(t *testing.T) {
+	gov := checkers.GovModuleAddr
+	tcs := []struct {
+		set    DenomList
+		del    []string
+		errMsg string
+	}{
+		{DenomList{DenomAtom}, []string{DenomLuna.BaseDenom}, ""},
+		{nil, nil, "no denoms to set or delete"},
+		{DenomList{DenomAtom, DenomAtom}, nil, "duplicated denom"},
+		{DenomList{DenomAtom}, []string{DenomAtom.BaseDenom}, "is both set and deleted"},
+		{DenomList{{BaseDenom: "uatom"}}, nil, "must have SymbolDenom"},
+		{nil, []string{""}, "empty base denom"},
+	}
+	for _, tc := range tcs {
+		err := NewMsgGovUpdateAcceptList(gov, tc.set, tc.del).ValidateBasic()
+		if tc.errMsg == "" {
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -29,6 +30,149 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TallyStrategy defines how the votes of a denom are aggregated into an exchange rate.
+type TallyStrategy int32
+
+const (
+	// TALLY_STRATEGY_UNSPECIFIED defaults to the power weighted median.
+	TallyStrategy_TALLY_STRATEGY_UNSPECIFIED TallyStrategy = 0
+	// TALLY_STRATEGY_WEIGHTED_MEDIAN is the power weighted median of the votes.
+	TallyStrategy_TALLY_STRATEGY_WEIGHTED_MEDIAN TallyStrategy = 1
+	// TALLY_STRATEGY_TRIMMED_MEAN is the power weighted mean of the votes, after discarding
+	// trim_fraction of the voting power from each tail of the ballot.
+	TallyStrategy_TALLY_STRATEGY_TRIMMED_MEAN TallyStrategy = 2
+	// TALLY_STRATEGY_MAD_MEDIAN is the power weighted median of the votes, after discarding
+	// votes further than mad_multiplier median absolute deviations from the weighted median.
+	TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN TallyStrategy = 3
+)
+
+var TallyStrategy_name = map[int32]string{
+	0: "TALLY_STRATEGY_UNSPECIFIED",
+	1: "TALLY_STRATEGY_WEIGHTED_MEDIAN",
+	2: "TALLY_STRATEGY_TRIMMED_MEAN",
+	3: "TALLY_STRATEGY_MAD_MEDIAN",
+}
+
+var TallyStrategy_value = map[string]int32{
+	"TALLY_STRATEGY_UNSPECIFIED":     0,
+	"TALLY_STRATEGY_WEIGHTED_MEDIAN": 1,
+	"TALLY_STRATEGY_TRIMMED_MEAN":    2,
+	"TALLY_STRATEGY_MAD_MEDIAN":      3,
+}
+
+func (x TallyStrategy) String() string {
+	return proto.EnumName(TallyStrategy_name, int32(x))
+}
+
+func (TallyStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{0}
+}
+
+// DerivedFeedFormula defines how a DerivedFeed combines its components.
+type DerivedFeedFormula int32
+
+const (
+	// DERIVED_FEED_FORMULA_UNSPECIFIED is not a valid formula.
+	DerivedFeedFormula_DERIVED_FEED_FORMULA_UNSPECIFIED DerivedFeedFormula = 0
+	// DERIVED_FEED_FORMULA_PRODUCT is the multiplier times the product of the component
+	// exchange rates (or their inverse). Examples: LST price = ATOM * redemption rate,
+	// cross rate = A / B.
+	DerivedFeedFormula_DERIVED_FEED_FORMULA_PRODUCT DerivedFeedFormula = 1
+	// DERIVED_FEED_FORMULA_BASKET is the multiplier times the weighted sum of the component
+	// exchange rates.
+	DerivedFeedFormula_DERIVED_FEED_FORMULA_BASKET DerivedFeedFormula = 2
+)
+
+var DerivedFeedFormula_name = map[int32]string{
+	0: "DERIVED_FEED_FORMULA_UNSPECIFIED",
+	1: "DERIVED_FEED_FORMULA_PRODUCT",
+	2: "DERIVED_FEED_FORMULA_BASKET",
+}
+
+var DerivedFeedFormula_value = map[string]int32{
+	"DERIVED_FEED_FORMULA_UNSPECIFIED": 0,
+	"DERIVED_FEED_FORMULA_PRODUCT":     1,
+	"DERIVED_FEED_FORMULA_BASKET":      2,
+}
+
+func (x DerivedFeedFormula) String() string {
+	return proto.EnumName(DerivedFeedFormula_name, int32(x))
+}
+
+func (DerivedFeedFormula) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{1}
+}
+
+// RewardFormula defines how the oracle rewards of a vote period are split among the validators.
+type RewardFormula int32
+
+const (
+	// REWARD_FORMULA_UNSPECIFIED defaults to REWARD_FORMULA_CLAIM_WEIGHT.
+	RewardFormula_REWARD_FORMULA_UNSPECIFIED RewardFormula = 0
+	// REWARD_FORMULA_CLAIM_WEIGHT splits the rewards by claim weight: the sum of the validator
+	// power over its votes within the reward band.
+	RewardFormula_REWARD_FORMULA_CLAIM_WEIGHT RewardFormula = 1
+	// REWARD_FORMULA_ACCURACY_WEIGHTED splits the rewards by the claim weight, where each vote
+	// within the reward band is scaled by its accuracy: 1 - |vote - exchange rate| / reward spread.
+	// Abstentions are not rewarded.
+	RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED RewardFormula = 2
+)
+
+var RewardFormula_name = map[int32]string{
+	0: "REWARD_FORMULA_UNSPECIFIED",
+	1: "REWARD_FORMULA_CLAIM_WEIGHT",
+	2: "REWARD_FORMULA_ACCURACY_WEIGHTED",
+}
+
+var RewardFormula_value = map[string]int32{
+	"REWARD_FORMULA_UNSPECIFIED":       0,
+	"REWARD_FORMULA_CLAIM_WEIGHT":      1,
+	"REWARD_FORMULA_ACCURACY_WEIGHTED": 2,
+}
+
+func (x RewardFormula) String() string {
+	return proto.EnumName(RewardFormula_name, int32(x))
+}
+
+func (RewardFormula) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{2}
+}
+
+// OffencePenalty is the penalty applied to a validator for an oracle offence.
+type OffencePenalty int32
+
+const (
+	OffencePenalty_OFFENCE_PENALTY_UNSPECIFIED OffencePenalty = 0
+	// OFFENCE_PENALTY_WARNING is only a warning event.
+	OffencePenalty_OFFENCE_PENALTY_WARNING OffencePenalty = 1
+	// OFFENCE_PENALTY_JAIL jails the validator.
+	OffencePenalty_OFFENCE_PENALTY_JAIL OffencePenalty = 2
+	// OFFENCE_PENALTY_SLASH slashes and jails the validator.
+	OffencePenalty_OFFENCE_PENALTY_SLASH OffencePenalty = 3
+)
+
+var OffencePenalty_name = map[int32]string{
+	0: "OFFENCE_PENALTY_UNSPECIFIED",
+	1: "OFFENCE_PENALTY_WARNING",
+	2: "OFFENCE_PENALTY_JAIL",
+	3: "OFFENCE_PENALTY_SLASH",
+}
+
+var OffencePenalty_value = map[string]int32{
+	"OFFENCE_PENALTY_UNSPECIFIED": 0,
+	"OFFENCE_PENALTY_WARNING":     1,
+	"OFFENCE_PENALTY_JAIL":        2,
+	"OFFENCE_PENALTY_SLASH":       3,
+}
+
+func (x OffencePenalty) String() string {
+	return proto.EnumName(OffencePenalty_name, int32(x))
+}
+
+func (OffencePenalty) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{3}
+}
+
 // Params defines the parameters for the oracle module.
 type Params struct {
 	VotePeriod               uint64                                 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -132,6 +276,36 @@ type Denom struct {
 	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
 	SymbolDenom string `protobuf:"bytes,2,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty" yaml:"symbol_denom"`
 	Exponent    uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty" yaml:"exponent"`
+	// tally_strategy is the method used to aggregate the votes of the denom into an exchange rate.
+	TallyStrategy TallyStrategy `protobuf:"varint,4,opt,name=tally_strategy,json=tallyStrategy,proto3,enum=umee.oracle.v1.TallyStrategy" json:"tally_strategy,omitempty" yaml:"tally_strategy,omitempty"`
+	// trim_fraction is the portion of the ballot voting power, in [0, 0.5), which is discarded
+	// from each tail of the ballot before taking the mean. Only used by TALLY_STRATEGY_TRIMMED_MEAN,
+	// where nil means no votes are discarded.
+	TrimFraction *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trim_fraction,json=trimFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_fraction,omitempty" yaml:"trim_fraction,omitempty"`
+	// mad_multiplier is the number of median absolute deviations from the weighted median
+	// beyond which a vote is discarded as an outlier. Required by TALLY_STRATEGY_MAD_MEDIAN.
+	MadMultiplier *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=mad_multiplier,json=madMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mad_multiplier,omitempty" yaml:"mad_multiplier,omitempty"`
+	// vote_threshold overrides the vote_threshold param for the denom, when set.
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// reward_band overrides the reward_band param for the denom, when set.
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// min_voters is the minimum number of validators which must vote on the denom for its
+	// ballot to pass. Zero means no minimum.
+	MinVoters uint32 `protobuf:"varint,9,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
+	// max_price_age is the maximum time since the last update of the exchange rate of the
+	// denom, after which the exchange rate is stale. Zero means exchange rates never go stale.
+	MaxPriceAge time.Duration `protobuf:"bytes,10,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age" yaml:"max_price_age,omitempty"`
+	// price_move_threshold is the relative change of the exchange rate of the denom, from the
+	// previous exchange rate or from the latest historic median, above which EventPriceMove is
+	// emitted. Nil means no event is emitted.
+	PriceMoveThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=price_move_threshold,json=priceMoveThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_move_threshold,omitempty" yaml:"price_move_threshold,omitempty"`
+	// min_confidence_voters is the minimum number of voters of the ballot of a tallied exchange
+	// rate, under which the exchange rate has a low confidence. Zero means no minimum.
+	MinConfidenceVoters uint32 `protobuf:"varint,12,opt,name=min_confidence_voters,json=minConfidenceVoters,proto3" json:"min_confidence_voters,omitempty" yaml:"min_confidence_voters,omitempty"`
+	// max_confidence_spread is the maximum interquartile spread, relative to the exchange rate,
+	// of the ballot of a tallied exchange rate, above which the exchange rate has a low
+	// confidence. Nil means no maximum.
+	MaxConfidenceSpread *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_confidence_spread,json=maxConfidenceSpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_confidence_spread,omitempty" yaml:"max_confidence_spread,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -166,28 +340,27 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
-// AggregateExchangeRatePrevote -
-// struct for aggregate prevoting on the ExchangeRateVote.
-// The purpose of aggregate prevote is to hide vote exchange rates with hash
-// which is formatted as hex string in SHA256("{salt}:{exchange
-// rate}{denom},...,{exchange rate}{denom}:{voter}")
-type AggregateExchangeRatePrevote struct {
-	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
-	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
-	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+// DenomVoteSettings is the effective vote threshold, reward band and minimum number
+// of voters of a denom, after applying the overrides of its AcceptList entry to the params.
+type DenomVoteSettings struct {
+	SymbolDenom   string                                 `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
+	RewardBand    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band"`
+	MinVoters     uint32                                 `protobuf:"varint,4,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty"`
 }
 
-func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
-func (*AggregateExchangeRatePrevote) ProtoMessage() {}
-func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+func (m *DenomVoteSettings) Reset()         { *m = DenomVoteSettings{} }
+func (m *DenomVoteSettings) String() string { return proto.CompactTextString(m) }
+func (*DenomVoteSettings) ProtoMessage()    {}
+func (*DenomVoteSettings) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8893c9e0e94ceb54, []int{3}
 }
-func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+func (m *DenomVoteSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DenomVoteSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DenomVoteSettings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -197,36 +370,43 @@ func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+func (m *DenomVoteSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomVoteSettings.Merge(m, src)
 }
-func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+func (m *DenomVoteSettings) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+func (m *DenomVoteSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomVoteSettings.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+var xxx_messageInfo_DenomVoteSettings proto.InternalMessageInfo
 
-// AggregateExchangeRateVote - struct for voting on
-// the exchange rates of USD denominated in various assets.
-type AggregateExchangeRateVote struct {
-	ExchangeRateTuples ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rate_tuples,json=exchangeRateTuples,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rate_tuples" yaml:"exchange_rate_tuples"`
-	Voter              string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+// DerivedFeed is a governance defined price feed. Its exchange rate is not voted, but
+// computed with a formula over other exchange rates after every vote period. Its max price
+// age is the largest max_price_age of its components.
+type DerivedFeed struct {
+	// symbol_denom of the derived exchange rate. Must not be a symbol denom of the accept list.
+	SymbolDenom string             `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	Formula     DerivedFeedFormula `protobuf:"varint,2,opt,name=formula,proto3,enum=umee.oracle.v1.DerivedFeedFormula" json:"formula,omitempty"`
+	// components are the exchange rates used by the formula. They must not be derived feeds.
+	Components []DerivedFeedComponent `protobuf:"bytes,3,rep,name=components,proto3" json:"components"`
+	// multiplier scales the formula result, e.g. the redemption rate of a liquid staking token.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
 }
 
-func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
-func (*AggregateExchangeRateVote) ProtoMessage() {}
-func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
+func (m *DerivedFeed) Reset()         { *m = DerivedFeed{} }
+func (m *DerivedFeed) String() string { return proto.CompactTextString(m) }
+func (*DerivedFeed) ProtoMessage()    {}
+func (*DerivedFeed) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8893c9e0e94ceb54, []int{4}
 }
-func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
+func (m *DerivedFeed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregateExchangeRateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DerivedFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregateExchangeRateVote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DerivedFeed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -236,35 +416,40 @@ func (m *AggregateExchangeRateVote) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *AggregateExchangeRateVote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregateExchangeRateVote.Merge(m, src)
+func (m *DerivedFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedFeed.Merge(m, src)
 }
-func (m *AggregateExchangeRateVote) XXX_Size() int {
+func (m *DerivedFeed) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregateExchangeRateVote) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregateExchangeRateVote.DiscardUnknown(m)
+func (m *DerivedFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedFeed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
+var xxx_messageInfo_DerivedFeed proto.InternalMessageInfo
 
-// ExchangeRateTuple - struct to store interpreted exchange rates data to store
-type ExchangeRateTuple struct {
-	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+// DerivedFeedComponent is an exchange rate used by a DerivedFeed formula.
+type DerivedFeedComponent struct {
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	// weight of the exchange rate in a basket. Must be empty (zero) for products.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// inverse divides by the exchange rate in a product, instead of multiplying by it.
+	// Must be false for baskets.
+	Inverse bool `protobuf:"varint,3,opt,name=inverse,proto3" json:"inverse,omitempty"`
 }
 
-func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
-func (*ExchangeRateTuple) ProtoMessage() {}
-func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
+func (m *DerivedFeedComponent) Reset()         { *m = DerivedFeedComponent{} }
+func (m *DerivedFeedComponent) String() string { return proto.CompactTextString(m) }
+func (*DerivedFeedComponent) ProtoMessage()    {}
+func (*DerivedFeedComponent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8893c9e0e94ceb54, []int{5}
 }
-func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
+func (m *DerivedFeedComponent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExchangeRateTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DerivedFeedComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExchangeRateTuple.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DerivedFeedComponent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -274,41 +459,38 @@ func (m *ExchangeRateTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ExchangeRateTuple) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExchangeRateTuple.Merge(m, src)
+func (m *DerivedFeedComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedFeedComponent.Merge(m, src)
 }
-func (m *ExchangeRateTuple) XXX_Size() int {
+func (m *DerivedFeedComponent) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExchangeRateTuple.DiscardUnknown(m)
+func (m *DerivedFeedComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedFeedComponent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
+var xxx_messageInfo_DerivedFeedComponent proto.InternalMessageInfo
 
-// AvgCounter is holds information about the active avg aggreator windows, used
-// to quickly compute average price of an asset.
-type AvgCounter struct {
-	// sum of prices in the counter
-	Sum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=sum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sum"`
-	// number of aggregated prices in the counter
-	Num uint32 `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
-	// Unix timestamp when the first price was aggregated in the counter
-	Start time.Time `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start"`
+// RewardParams defines how the oracle rewards of a vote period are split among the validators.
+type RewardParams struct {
+	Formula RewardFormula `protobuf:"varint,1,opt,name=formula,proto3,enum=umee.oracle.v1.RewardFormula" json:"formula,omitempty"`
+	// full_coverage_bonus, in [0, 1], scales up the reward weight of validators with a vote
+	// within the reward band for every vote target, by (1 + full_coverage_bonus).
+	FullCoverageBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=full_coverage_bonus,json=fullCoverageBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"full_coverage_bonus"`
 }
 
-func (m *AvgCounter) Reset()         { *m = AvgCounter{} }
-func (m *AvgCounter) String() string { return proto.CompactTextString(m) }
-func (*AvgCounter) ProtoMessage()    {}
-func (*AvgCounter) Descriptor() ([]byte, []int) {
+func (m *RewardParams) Reset()         { *m = RewardParams{} }
+func (m *RewardParams) String() string { return proto.CompactTextString(m) }
+func (*RewardParams) ProtoMessage()    {}
+func (*RewardParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8893c9e0e94ceb54, []int{6}
 }
-func (m *AvgCounter) XXX_Unmarshal(b []byte) error {
+func (m *RewardParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AvgCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RewardParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AvgCounter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RewardParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -318,36 +500,40 @@ func (m *AvgCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *AvgCounter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AvgCounter.Merge(m, src)
+func (m *RewardParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardParams.Merge(m, src)
 }
-func (m *AvgCounter) XXX_Size() int {
+func (m *RewardParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *AvgCounter) XXX_DiscardUnknown() {
-	xxx_messageInfo_AvgCounter.DiscardUnknown(m)
+func (m *RewardParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AvgCounter proto.InternalMessageInfo
+var xxx_messageInfo_RewardParams proto.InternalMessageInfo
 
-// DenomExchangeRate stores exchange rate with timestamp
-type DenomExchangeRate struct {
-	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	Rate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
-	Timestamp time.Time                              `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+// PriceVolatility is the rolling realized volatility estimate of the exchange rate of a denom.
+type PriceVolatility struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// variance is the exponentially weighted moving average of the squared relative changes of
+	// the exchange rate between consecutive vote periods.
+	Variance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=variance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"variance"`
+	// samples is the number of exchange rate changes included in the estimate.
+	Samples uint64 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
 }
 
-func (m *DenomExchangeRate) Reset()      { *m = DenomExchangeRate{} }
-func (*DenomExchangeRate) ProtoMessage() {}
-func (*DenomExchangeRate) Descriptor() ([]byte, []int) {
+func (m *PriceVolatility) Reset()         { *m = PriceVolatility{} }
+func (m *PriceVolatility) String() string { return proto.CompactTextString(m) }
+func (*PriceVolatility) ProtoMessage()    {}
+func (*PriceVolatility) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8893c9e0e94ceb54, []int{7}
 }
-func (m *DenomExchangeRate) XXX_Unmarshal(b []byte) error {
+func (m *PriceVolatility) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DenomExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PriceVolatility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DenomExchangeRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PriceVolatility.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -357,760 +543,4339 @@ func (m *DenomExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DenomExchangeRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DenomExchangeRate.Merge(m, src)
+func (m *PriceVolatility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceVolatility.Merge(m, src)
 }
-func (m *DenomExchangeRate) XXX_Size() int {
+func (m *PriceVolatility) XXX_Size() int {
 	return m.Size()
 }
-func (m *DenomExchangeRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_DenomExchangeRate.DiscardUnknown(m)
+func (m *PriceVolatility) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceVolatility.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DenomExchangeRate proto.InternalMessageInfo
+var xxx_messageInfo_PriceVolatility proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "umee.oracle.v1.Params")
-	proto.RegisterType((*AvgCounterParams)(nil), "umee.oracle.v1.AvgCounterParams")
-	proto.RegisterType((*Denom)(nil), "umee.oracle.v1.Denom")
-	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "umee.oracle.v1.AggregateExchangeRatePrevote")
-	proto.RegisterType((*AggregateExchangeRateVote)(nil), "umee.oracle.v1.AggregateExchangeRateVote")
-	proto.RegisterType((*ExchangeRateTuple)(nil), "umee.oracle.v1.ExchangeRateTuple")
-	proto.RegisterType((*AvgCounter)(nil), "umee.oracle.v1.AvgCounter")
-	proto.RegisterType((*DenomExchangeRate)(nil), "umee.oracle.v1.DenomExchangeRate")
+// PenaltyParams defines the graduated penalties of validators with a valid vote rate below
+// min_valid_per_window at the end of a slash window. Every such breach is an offence: the first
+// `warnings` offences are only warned, the next `jails` offences jail the validator, and the
+// following ones slash and jail the validator with escalating slash fractions.
+type PenaltyParams struct {
+	// warnings is the number of offences punished with a warning event only.
+	Warnings uint32 `protobuf:"varint,1,opt,name=warnings,proto3" json:"warnings,omitempty"`
+	// jails is the number of offences, following the warnings, punished by jailing the validator
+	// without slashing it.
+	Jails uint32 `protobuf:"varint,2,opt,name=jails,proto3" json:"jails,omitempty"`
+	// slash_fractions are the increasing fractions slashed for the following offences. The last
+	// one applies to every later offence. If empty, the slash_fraction param is used.
+	SlashFractions []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=slash_fractions,json=slashFractions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fractions"`
+	// grace_period is the number of blocks, since a validator first took part in a vote period,
+	// during which its breaches are only warned and not counted as offences.
+	GracePeriod uint64 `protobuf:"varint,4,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	// repeat_windows is the number of slash windows, after the last offence of a validator,
+	// within which a new offence counts as a repeated one. Past it, the offence level of the
+	// validator is reset. Zero means that offences never expire.
+	RepeatWindows uint64 `protobuf:"varint,5,opt,name=repeat_windows,json=repeatWindows,proto3" json:"repeat_windows,omitempty"`
 }
 
-func init() { proto.RegisterFile("umee/oracle/v1/oracle.proto", fileDescriptor_8893c9e0e94ceb54) }
-
-var fileDescriptor_8893c9e0e94ceb54 = []byte{
-	// 1079 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0xeb, 0x8f, 0x48, 0x27, 0x3b, 0xb1, 0x69, 0xb9, 0x55, 0xec, 0x42, 0x74, 0x58, 0x34,
-	0xf5, 0xd0, 0x50, 0x8d, 0xd3, 0x0f, 0xd4, 0x53, 0xc3, 0xba, 0xe9, 0x92, 0x00, 0x06, 0x63, 0xa4,
-	0x40, 0x17, 0xe2, 0x44, 0x9e, 0xa9, 0x83, 0x45, 0x9e, 0x70, 0x77, 0x94, 0xed, 0xa5, 0x73, 0xa7,
-	0x22, 0x63, 0xc6, 0x4c, 0x1d, 0xba, 0xb5, 0xe8, 0x1f, 0xe1, 0xa5, 0x40, 0xc6, 0xa2, 0x03, 0xd3,
-	0xda, 0x4b, 0xd1, 0xa9, 0xd0, 0x5f, 0x50, 0xdc, 0x07, 0xc5, 0x53, 0x6c, 0x14, 0x11, 0x32, 0x89,
-	0xef, 0x7e, 0xf7, 0x7b, 0xef, 0xf7, 0xde, 0xbd, 0xf7, 0x20, 0xb0, 0x99, 0xa7, 0x08, 0x75, 0x09,
-	0x85, 0xd1, 0x00, 0x75, 0x47, 0x77, 0xf5, 0x97, 0x37, 0xa4, 0x84, 0x13, 0xfb, 0xba, 0x00, 0x3d,
-	0x7d, 0x34, 0xba, 0xbb, 0xd1, 0x4a, 0x48, 0x42, 0x24, 0xd4, 0x15, 0x5f, 0xea, 0xd6, 0x86, 0x93,
-	0x10, 0x92, 0x0c, 0x50, 0x57, 0x5a, 0xbd, 0xfc, 0xb0, 0xcb, 0x71, 0x8a, 0x18, 0x87, 0xe9, 0x50,
-	0x5f, 0xe8, 0xbc, 0x7a, 0x21, 0xce, 0x29, 0xe4, 0x98, 0x64, 0x0a, 0x77, 0x8b, 0x6b, 0x60, 0x71,
-	0x1f, 0x52, 0x98, 0x32, 0xfb, 0x33, 0xd0, 0x1c, 0x11, 0x8e, 0xc2, 0x21, 0xa2, 0x98, 0xc4, 0x6d,
-	0x6b, 0xcb, 0xda, 0x9e, 0xf7, 0xdf, 0x1e, 0x17, 0x8e, 0x7d, 0x0a, 0xd3, 0xc1, 0xae, 0x6b, 0x80,
-	0x6e, 0x00, 0x84, 0xb5, 0x2f, 0x0d, 0x3b, 0x03, 0xd7, 0x25, 0xc6, 0xfb, 0x14, 0xb1, 0x3e, 0x19,
-	0xc4, 0xed, 0xb7, 0xb6, 0xac, 0xed, 0x86, 0xff, 0xf5, 0x59, 0xe1, 0xd4, 0xfe, 0x28, 0x9c, 0xdb,
-	0x09, 0xe6, 0xfd, 0xbc, 0xe7, 0x45, 0x24, 0xed, 0x46, 0x84, 0xa5, 0x84, 0xe9, 0x9f, 0x3b, 0x2c,
-	0x3e, 0xea, 0xf2, 0xd3, 0x21, 0x62, 0xde, 0x1e, 0x8a, 0xc6, 0x85, 0xb3, 0x6e, 0x44, 0x9a, 0x78,
-	0x73, 0x83, 0x65, 0x71, 0x70, 0x50, 0xda, 0x36, 0x02, 0x4d, 0x8a, 0x8e, 0x21, 0x8d, 0xc3, 0x1e,
-	0xcc, 0xe2, 0xf6, 0x9c, 0x0c, 0xb6, 0x37, 0x73, 0x30, 0x9d, 0x96, 0xe1, 0xca, 0x0d, 0x80, 0xb2,
-	0x7c, 0x98, 0xc5, 0x76, 0x04, 0x36, 0x34, 0x16, 0x63, 0xc6, 0x29, 0xee, 0xe5, 0xa2, 0x6e, 0xe1,
-	0x31, 0xce, 0x62, 0x72, 0xdc, 0x9e, 0x97, 0xe5, 0x79, 0x7f, 0x5c, 0x38, 0xb7, 0xa6, 0xfc, 0x5c,
-	0x71, 0xd7, 0x0d, 0xda, 0x0a, 0xdc, 0x33, 0xb0, 0x6f, 0x24, 0x64, 0x87, 0xa0, 0x09, 0xa3, 0x08,
-	0x0d, 0x79, 0x38, 0xc0, 0x8c, 0xb7, 0x17, 0xb6, 0xe6, 0xb6, 0x9b, 0x3b, 0xeb, 0xde, 0xf4, 0xe3,
-	0x7b, 0x7b, 0x28, 0x23, 0xa9, 0xff, 0x81, 0x48, 0xb1, 0x12, 0x6e, 0xf0, 0xdc, 0x9f, 0x5e, 0x3a,
-	0x0d, 0x79, 0xe9, 0x21, 0x66, 0x3c, 0x00, 0x0a, 0x12, 0xdf, 0xe2, 0x71, 0xd8, 0x00, 0xb2, 0x7e,
-	0x78, 0x48, 0x61, 0x24, 0x02, 0xb7, 0x17, 0xdf, 0xec, 0x71, 0xa6, 0xbd, 0xb9, 0xc1, 0xb2, 0x3c,
-	0x78, 0xa0, 0x6d, 0x7b, 0x17, 0x2c, 0xa9, 0x1b, 0xba, 0x4e, 0xd7, 0x64, 0x9d, 0xde, 0x19, 0x17,
-	0xce, 0x9a, 0xc9, 0x2f, 0x2b, 0xd3, 0x94, 0xa6, 0x2e, 0xc6, 0x77, 0xa0, 0x95, 0xe2, 0x2c, 0x1c,
-	0xc1, 0x01, 0x8e, 0x45, 0xa7, 0x95, 0x3e, 0xea, 0x52, 0xf1, 0xa3, 0x99, 0x15, 0x6f, 0xaa, 0x88,
-	0x57, 0xf9, 0x74, 0x83, 0xd5, 0x14, 0x67, 0x4f, 0xc4, 0xe9, 0x3e, 0xa2, 0x3a, 0xfe, 0x0e, 0x58,
-	0xef, 0x63, 0xc6, 0x09, 0xc5, 0x51, 0x28, 0x87, 0xa8, 0x9c, 0x85, 0x86, 0x48, 0x22, 0x58, 0x2b,
-	0xc1, 0xc7, 0x02, 0xd3, 0xcd, 0xef, 0x81, 0xb5, 0x14, 0xc5, 0x18, 0x66, 0xd3, 0x0c, 0x20, 0x19,
-	0xab, 0x0a, 0x32, 0xef, 0x7f, 0x04, 0x5a, 0x29, 0x3c, 0xc1, 0x69, 0x9e, 0x86, 0x43, 0x8a, 0x23,
-	0xa4, 0x68, 0xac, 0xdd, 0x94, 0x04, 0x5b, 0x63, 0xfb, 0x02, 0x92, 0x34, 0x26, 0x54, 0x95, 0x0c,
-	0x33, 0x12, 0x6b, 0x2f, 0x29, 0x55, 0x1a, 0x7c, 0x54, 0x85, 0x62, 0xbb, 0xf5, 0x67, 0xcf, 0x9d,
-	0xda, 0xdf, 0xcf, 0x1d, 0xcb, 0xfd, 0xd7, 0x02, 0x2b, 0xf7, 0x47, 0xc9, 0x97, 0x24, 0xcf, 0x38,
-	0xa2, 0x7a, 0xd4, 0x09, 0x00, 0x70, 0x94, 0x98, 0x93, 0xde, 0xdc, 0xb9, 0xe9, 0xa9, 0x55, 0xe1,
-	0x95, 0xab, 0xc2, 0xdb, 0xd3, 0xab, 0xc2, 0xff, 0x44, 0x54, 0xfe, 0x9f, 0xc2, 0x69, 0x55, 0xa4,
-	0x0f, 0x49, 0x8a, 0x39, 0x4a, 0x87, 0xfc, 0x74, 0x5c, 0x38, 0xab, 0xba, 0x21, 0x27, 0xa8, 0xfb,
-	0xec, 0xa5, 0x63, 0x05, 0x0d, 0x38, 0x4a, 0x74, 0xd6, 0x47, 0x40, 0x18, 0x21, 0xeb, 0xe3, 0x43,
-	0x2e, 0xb7, 0xc3, 0xff, 0xc6, 0xbb, 0xa7, 0xe3, 0xad, 0x4d, 0x38, 0x53, 0xe1, 0x56, 0xaa, 0x70,
-	0x12, 0x54, 0xd1, 0xea, 0x70, 0x94, 0x3c, 0x96, 0xe6, 0xaf, 0x16, 0x58, 0x90, 0xc3, 0x60, 0x7f,
-	0x0c, 0x40, 0x0f, 0x32, 0x14, 0xc6, 0xc2, 0x92, 0x79, 0x36, 0xfc, 0xf5, 0x4a, 0x70, 0x85, 0xb9,
-	0x41, 0x43, 0x18, 0x8a, 0x25, 0x5a, 0xf8, 0x34, 0xed, 0x91, 0x81, 0xe6, 0xa9, 0x6d, 0x66, 0xb6,
-	0xb0, 0x81, 0x8a, 0x16, 0x96, 0xa6, 0xe2, 0x76, 0x41, 0x1d, 0x9d, 0x0c, 0x49, 0x86, 0x32, 0x2e,
-	0x17, 0xd3, 0xb2, 0xbf, 0x36, 0x2e, 0x9c, 0x1b, 0x8a, 0x57, 0x22, 0x6e, 0x30, 0xb9, 0x34, 0x79,
-	0xa9, 0x9a, 0xfb, 0xb3, 0x05, 0xde, 0xbd, 0x9f, 0x24, 0x14, 0x25, 0x90, 0xa3, 0xaf, 0x4e, 0xa2,
-	0x3e, 0xcc, 0x12, 0x14, 0x40, 0x8e, 0xf6, 0x29, 0x12, 0x0b, 0xd0, 0x7e, 0x0f, 0xcc, 0xf7, 0x21,
-	0xeb, 0xeb, 0x3c, 0x6e, 0x8c, 0x0b, 0xa7, 0xa9, 0xfc, 0x8a, 0x53, 0x37, 0x90, 0xa0, 0x7d, 0x1b,
-	0x2c, 0x88, 0xcb, 0x54, 0xab, 0x5e, 0x19, 0x17, 0xce, 0x52, 0xb5, 0x55, 0xa9, 0x1b, 0x28, 0x58,
-	0x26, 0x99, 0xf7, 0x52, 0xcc, 0xc3, 0xde, 0x80, 0x44, 0x47, 0x52, 0xec, 0xf4, 0x9c, 0x1a, 0xa8,
-	0x48, 0x52, 0x9a, 0xbe, 0xb0, 0x0c, 0xcd, 0x85, 0x05, 0x6e, 0x5e, 0xa9, 0xf9, 0x89, 0x10, 0xfc,
-	0x83, 0x05, 0x5a, 0x48, 0x1f, 0x86, 0x14, 0x8a, 0xa5, 0x9e, 0x0f, 0x07, 0x88, 0xb5, 0x2d, 0xb9,
-	0xe6, 0x6e, 0xbd, 0xba, 0xe6, 0x4c, 0x07, 0x07, 0xe2, 0xa6, 0xff, 0xb9, 0x5e, 0x79, 0x9b, 0x65,
-	0x01, 0x2f, 0x3b, 0x13, 0xbb, 0xcf, 0xbe, 0xc4, 0x64, 0x81, 0x8d, 0x2e, 0x9d, 0xbd, 0x6e, 0x71,
-	0x8c, 0x04, 0x7f, 0xb1, 0xc0, 0xea, 0x25, 0xe7, 0xc2, 0x8f, 0xd9, 0x52, 0x86, 0x1f, 0xdd, 0x13,
-	0x0a, 0xb6, 0x8f, 0xc0, 0xf2, 0x94, 0x64, 0x1d, 0xf7, 0xc1, 0xcc, 0x9b, 0xac, 0x75, 0x45, 0xfe,
-	0x6e, 0xb0, 0x64, 0xa6, 0x68, 0x88, 0xfe, 0xd1, 0x02, 0xa0, 0x9a, 0x79, 0xfb, 0x0b, 0x30, 0xc7,
-	0xf2, 0x52, 0xab, 0x37, 0x5b, 0xec, 0x40, 0x50, 0xed, 0x15, 0x30, 0x97, 0xe5, 0x6a, 0x10, 0x96,
-	0x03, 0xf1, 0x69, 0xef, 0x82, 0x05, 0xc6, 0x21, 0x55, 0x4d, 0xde, 0xdc, 0xd9, 0xb8, 0x34, 0xcc,
-	0x07, 0xe5, 0x1f, 0x11, 0xbf, 0x2e, 0x22, 0x3e, 0x15, 0x23, 0xaa, 0x28, 0xbb, 0xf5, 0xef, 0x4b,
-	0xa1, 0xbf, 0x59, 0x60, 0x55, 0xce, 0x8d, 0x59, 0xe2, 0xd7, 0xae, 0xae, 0x0f, 0xe6, 0x8d, 0xa2,
-	0xce, 0x9a, 0x98, 0xe4, 0xda, 0x3e, 0x68, 0x4c, 0xfe, 0x32, 0xcd, 0x94, 0x4b, 0x45, 0xab, 0x0a,
-	0xef, 0x3f, 0x3c, 0xfb, 0xab, 0x53, 0x3b, 0x3b, 0xef, 0x58, 0x2f, 0xce, 0x3b, 0xd6, 0x9f, 0xe7,
-	0x1d, 0xeb, 0xe9, 0x45, 0xa7, 0xf6, 0xe2, 0xa2, 0x53, 0xfb, 0xfd, 0xa2, 0x53, 0xfb, 0xd6, 0x33,
-	0x94, 0x89, 0xce, 0xbf, 0x93, 0x21, 0x7e, 0x4c, 0xe8, 0x91, 0x34, 0xba, 0xa3, 0x4f, 0xbb, 0x27,
-	0xe5, 0x9f, 0x41, 0xa9, 0xb2, 0xb7, 0x28, 0x05, 0xdc, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x1b,
-	0x86, 0x5f, 0xfc, 0x28, 0x0a, 0x00, 0x00,
+func (m *PenaltyParams) Reset()         { *m = PenaltyParams{} }
+func (m *PenaltyParams) String() string { return proto.CompactTextString(m) }
+func (*PenaltyParams) ProtoMessage()    {}
+func (*PenaltyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{8}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *PenaltyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VotePeriod != that1.VotePeriod {
-		return false
-	}
-	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
-		return false
-	}
-	if !this.RewardBand.Equal(that1.RewardBand) {
-		return false
-	}
-	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
-		return false
-	}
-	if len(this.AcceptList) != len(that1.AcceptList) {
-		return false
-	}
-	for i := range this.AcceptList {
-		if !this.AcceptList[i].Equal(&that1.AcceptList[i]) {
-			return false
+}
+func (m *PenaltyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyParams.Merge(m, src)
+}
+func (m *PenaltyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyParams proto.InternalMessageInfo
+
+// Offence is a breach of the min_valid_per_window param by a validator.
+type Offence struct {
+	// height is the block height of the end of the slash window.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// valid_vote_rate is the valid vote rate of the validator over the slash window.
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate"`
+	// level is the offence level of the validator, counting repeated offences from 1.
+	Level   uint32         `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Penalty OffencePenalty `protobuf:"varint,4,opt,name=penalty,proto3,enum=umee.oracle.v1.OffencePenalty" json:"penalty,omitempty"`
+	// slash_fraction is the slashed fraction, zero unless the penalty is a slash.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *Offence) Reset()         { *m = Offence{} }
+func (m *Offence) String() string { return proto.CompactTextString(m) }
+func (*Offence) ProtoMessage()    {}
+func (*Offence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{9}
+}
+func (m *Offence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Offence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Offence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.SlashFraction.Equal(that1.SlashFraction) {
-		return false
-	}
-	if this.SlashWindow != that1.SlashWindow {
-		return false
-	}
-	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
-		return false
-	}
-	if this.HistoricStampPeriod != that1.HistoricStampPeriod {
-		return false
-	}
-	if this.MedianStampPeriod != that1.MedianStampPeriod {
-		return false
-	}
-	if this.MaximumPriceStamps != that1.MaximumPriceStamps {
-		return false
-	}
-	if this.MaximumMedianStamps != that1.MaximumMedianStamps {
-		return false
-	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Offence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offence.Merge(m, src)
+}
+func (m *Offence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Offence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offence.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Offence proto.InternalMessageInfo
+
+// OffenceHistory is the oracle offence history of a validator.
+type OffenceHistory struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// since_height is the block height of the first vote period the validator took part in,
+	// after the offence history was introduced. It's zero, without grace period, for validators
+	// bonded when the offence history was introduced.
+	SinceHeight int64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+	// level is the offence level of the last offence, zero if the validator has no offence.
+	Level uint32 `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	// offences are the latest offences of the validator (at most 20), from the oldest.
+	Offences []Offence `protobuf:"bytes,4,rep,name=offences,proto3" json:"offences"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaximumMedianStamps != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumMedianStamps))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.MaximumPriceStamps != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumPriceStamps))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MedianStampPeriod != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MedianStampPeriod))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.HistoricStampPeriod != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.HistoricStampPeriod))
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.SlashWindow != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.SlashFraction.Size()
-		i -= size
-		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.AcceptList) > 0 {
-		for iNdEx := len(m.AcceptList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AcceptList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.RewardDistributionWindow != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.RewardBand.Size()
-		i -= size
-		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.VoteThreshold.Size()
-		i -= size
-		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OffenceHistory) Reset()         { *m = OffenceHistory{} }
+func (m *OffenceHistory) String() string { return proto.CompactTextString(m) }
+func (*OffenceHistory) ProtoMessage()    {}
+func (*OffenceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{10}
+}
+func (m *OffenceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffenceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffenceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.VotePeriod != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AvgCounterParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OffenceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffenceHistory.Merge(m, src)
 }
-
-func (m *AvgCounterParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OffenceHistory) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *AvgCounterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AvgShift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgShift):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AvgPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgPeriod):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintOracle(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *OffenceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffenceHistory.DiscardUnknown(m)
 }
 
-func (m *Denom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_OffenceHistory proto.InternalMessageInfo
 
-func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorPerformance is the oracle performance of a validator over the current slash window.
+type ValidatorPerformance struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// denoms are the vote statistics of the validator, by denom.
+	Denoms []DenomPerformance `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms"`
+	// rewards are the oracle rewards earned by the validator.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exponent != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SymbolDenom) > 0 {
-		i -= len(m.SymbolDenom)
-		copy(dAtA[i:], m.SymbolDenom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{11}
 }
-
-func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubmitBlock != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+// DenomPerformance is the vote statistics of a validator for a denom. Only votes in tallied
+// ballots are counted, abstentions are not.
+type DenomPerformance struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	VotesCast uint64 `protobuf:"varint,2,opt,name=votes_cast,json=votesCast,proto3" json:"votes_cast,omitempty"`
+	// votes_in_band is the number of votes within the reward band of the exchange rate.
+	VotesInBand uint64 `protobuf:"varint,3,opt,name=votes_in_band,json=votesInBand,proto3" json:"votes_in_band,omitempty"`
+	// avg_abs_deviation is the average absolute deviation of the votes from the exchange rate.
+	AvgAbsDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=avg_abs_deviation,json=avgAbsDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"avg_abs_deviation"`
 }
 
-func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DenomPerformance) Reset()         { *m = DenomPerformance{} }
+func (m *DenomPerformance) String() string { return proto.CompactTextString(m) }
+func (*DenomPerformance) ProtoMessage()    {}
+func (*DenomPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{12}
+}
+func (m *DenomPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DenomPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPerformance.Merge(m, src)
+}
+func (m *DenomPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPerformance.DiscardUnknown(m)
 }
 
-func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DenomPerformance proto.InternalMessageInfo
+
+// PriceOverride is a manual exchange rate of a denom, set by the Emergency Group or governance.
+// While active, it replaces the tallied and derived exchange rates of the denom.
+type PriceOverride struct {
+	SymbolDenom  string                                 `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// expiry is the block time after which the override is removed.
+	Expiry time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// authority is the address which set the override.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ExchangeRateTuples) > 0 {
-		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PriceOverride) Reset()         { *m = PriceOverride{} }
+func (m *PriceOverride) String() string { return proto.CompactTextString(m) }
+func (*PriceOverride) ProtoMessage()    {}
+func (*PriceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{13}
+}
+func (m *PriceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PriceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceOverride.Merge(m, src)
+}
+func (m *PriceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceOverride.DiscardUnknown(m)
 }
 
-func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PriceOverride proto.InternalMessageInfo
+
+// AggregateExchangeRatePrevote -
+// struct for aggregate prevoting on the ExchangeRateVote.
+// The purpose of aggregate prevote is to hide vote exchange rates with hash
+// which is formatted as hex string in SHA256("{salt}:{exchange
+// rate}{denom},...,{exchange rate}{denom}:{voter}")
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
 }
 
-func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{14}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AvgCounter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
 }
 
-func (m *AvgCounter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
+// AggregateExchangeRateVote - struct for voting on
+// the exchange rates of USD denominated in various assets.
+type AggregateExchangeRateVote struct {
+	ExchangeRateTuples ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rate_tuples,json=exchangeRateTuples,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rate_tuples" yaml:"exchange_rate_tuples"`
+	Voter              string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
 }
 
-func (m *AvgCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintOracle(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x1a
-	if m.Num != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Num))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.Sum.Size()
-		i -= size
-		if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
+func (*AggregateExchangeRateVote) ProtoMessage() {}
+func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{15}
+}
+func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *DenomExchangeRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AggregateExchangeRateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRateVote.Merge(m, src)
+}
+func (m *AggregateExchangeRateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRateVote.DiscardUnknown(m)
 }
 
-func (m *DenomExchangeRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
+
+// ExchangeRateTuple - struct to store interpreted exchange rates data to store
+type ExchangeRateTuple struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
 }
 
-func (m *DenomExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintOracle(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
+func (*ExchangeRateTuple) ProtoMessage() {}
+func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{16}
+}
+func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateTuple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *ExchangeRateTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateTuple.Merge(m, src)
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+func (m *ExchangeRateTuple) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
+
+// AvgCounter is holds information about the active avg aggreator windows, used
+// to quickly compute average price of an asset.
+type AvgCounter struct {
+	// sum of prices in the counter
+	Sum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=sum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sum"`
+	// number of aggregated prices in the counter
+	Num uint32 `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+	// Unix timestamp when the first price was aggregated in the counter
+	Start time.Time `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start"`
+}
+
+func (m *AvgCounter) Reset()         { *m = AvgCounter{} }
+func (m *AvgCounter) String() string { return proto.CompactTextString(m) }
+func (*AvgCounter) ProtoMessage()    {}
+func (*AvgCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{17}
+}
+func (m *AvgCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AvgCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AvgCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AcceptList) > 0 {
-		for _, e := range m.AcceptList {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+}
+func (m *AvgCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AvgCounter.Merge(m, src)
+}
+func (m *AvgCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *AvgCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AvgCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AvgCounter proto.InternalMessageInfo
+
+// DenomExchangeRate stores exchange rate with timestamp
+type DenomExchangeRate struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Rate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	Timestamp time.Time                              `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// confidence is the dispersion of the ballot of the exchange rate. Nil for exchange rates
+	// which were not tallied: derived, overridden or imported from genesis.
+	Confidence *PriceConfidence `protobuf:"bytes,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (m *DenomExchangeRate) Reset()      { *m = DenomExchangeRate{} }
+func (*DenomExchangeRate) ProtoMessage() {}
+func (*DenomExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{18}
+}
+func (m *DenomExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+}
+func (m *DenomExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomExchangeRate.Merge(m, src)
+}
+func (m *DenomExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomExchangeRate proto.InternalMessageInfo
+
+// PriceConfidence is the dispersion of the ballot of a tallied exchange rate.
+type PriceConfidence struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// voters is the number of validators which voted for the denom, abstentions excluded.
+	Voters uint32 `protobuf:"varint,2,opt,name=voters,proto3" json:"voters,omitempty"`
+	// power_share is the share of the bonded voting power of the voters.
+	PowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=power_share,json=powerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_share"`
+	// spread is the power weighted interquartile range of the votes, relative to the exchange
+	// rate.
+	Spread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread"`
+}
+
+func (m *PriceConfidence) Reset()         { *m = PriceConfidence{} }
+func (m *PriceConfidence) String() string { return proto.CompactTextString(m) }
+func (*PriceConfidence) ProtoMessage()    {}
+func (*PriceConfidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{19}
+}
+func (m *PriceConfidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceConfidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceConfidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.HistoricStampPeriod != 0 {
-		n += 1 + sovOracle(uint64(m.HistoricStampPeriod))
+}
+func (m *PriceConfidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceConfidence.Merge(m, src)
+}
+func (m *PriceConfidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceConfidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceConfidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceConfidence proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("umee.oracle.v1.TallyStrategy", TallyStrategy_name, TallyStrategy_value)
+	proto.RegisterEnum("umee.oracle.v1.DerivedFeedFormula", DerivedFeedFormula_name, DerivedFeedFormula_value)
+	proto.RegisterEnum("umee.oracle.v1.RewardFormula", RewardFormula_name, RewardFormula_value)
+	proto.RegisterEnum("umee.oracle.v1.OffencePenalty", OffencePenalty_name, OffencePenalty_value)
+	proto.RegisterType((*Params)(nil), "umee.oracle.v1.Params")
+	proto.RegisterType((*AvgCounterParams)(nil), "umee.oracle.v1.AvgCounterParams")
+	proto.RegisterType((*Denom)(nil), "umee.oracle.v1.Denom")
+	proto.RegisterType((*DenomVoteSettings)(nil), "umee.oracle.v1.DenomVoteSettings")
+	proto.RegisterType((*DerivedFeed)(nil), "umee.oracle.v1.DerivedFeed")
+	proto.RegisterType((*DerivedFeedComponent)(nil), "umee.oracle.v1.DerivedFeedComponent")
+	proto.RegisterType((*RewardParams)(nil), "umee.oracle.v1.RewardParams")
+	proto.RegisterType((*PriceVolatility)(nil), "umee.oracle.v1.PriceVolatility")
+	proto.RegisterType((*PenaltyParams)(nil), "umee.oracle.v1.PenaltyParams")
+	proto.RegisterType((*Offence)(nil), "umee.oracle.v1.Offence")
+	proto.RegisterType((*OffenceHistory)(nil), "umee.oracle.v1.OffenceHistory")
+	proto.RegisterType((*ValidatorPerformance)(nil), "umee.oracle.v1.ValidatorPerformance")
+	proto.RegisterType((*DenomPerformance)(nil), "umee.oracle.v1.DenomPerformance")
+	proto.RegisterType((*PriceOverride)(nil), "umee.oracle.v1.PriceOverride")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "umee.oracle.v1.AggregateExchangeRatePrevote")
+	proto.RegisterType((*AggregateExchangeRateVote)(nil), "umee.oracle.v1.AggregateExchangeRateVote")
+	proto.RegisterType((*ExchangeRateTuple)(nil), "umee.oracle.v1.ExchangeRateTuple")
+	proto.RegisterType((*AvgCounter)(nil), "umee.oracle.v1.AvgCounter")
+	proto.RegisterType((*DenomExchangeRate)(nil), "umee.oracle.v1.DenomExchangeRate")
+	proto.RegisterType((*PriceConfidence)(nil), "umee.oracle.v1.PriceConfidence")
+}
+
+func init() { proto.RegisterFile("umee/oracle/v1/oracle.proto", fileDescriptor_8893c9e0e94ceb54) }
+
+var fileDescriptor_8893c9e0e94ceb54 = []byte{
+	// 2395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xd7, 0xe8, 0xad, 0x43, 0x51, 0xa2, 0xae, 0xa8, 0x98, 0x96, 0x6c, 0x8e, 0x3c, 0x89, 0xfd,
+	0x19, 0xc2, 0x17, 0x32, 0x56, 0xfa, 0x8a, 0x10, 0xa4, 0xe5, 0x4b, 0xb6, 0x5c, 0x3d, 0xd8, 0x21,
+	0x6d, 0x35, 0x59, 0x64, 0x70, 0x49, 0x5e, 0x91, 0x53, 0xcf, 0x83, 0x98, 0x19, 0x52, 0xd2, 0xa2,
+	0xdd, 0x74, 0xd3, 0x02, 0x6d, 0x60, 0xa0, 0x9b, 0x2c, 0x9d, 0x2e, 0xba, 0x08, 0xd0, 0x45, 0xfb,
+	0x4f, 0xd4, 0xcb, 0x2c, 0x83, 0x16, 0xa0, 0x5b, 0x7b, 0x53, 0x64, 0x55, 0x68, 0x5f, 0xa0, 0xb8,
+	0x8f, 0xe1, 0xcc, 0x90, 0x74, 0x22, 0xca, 0x1b, 0x9b, 0xe7, 0x9e, 0xe7, 0x3d, 0xf7, 0x9c, 0xdf,
+	0xbd, 0x67, 0x04, 0x1b, 0x1d, 0x93, 0x90, 0xac, 0xed, 0xe0, 0xba, 0x41, 0xb2, 0xdd, 0x7b, 0xe2,
+	0x57, 0xa6, 0xed, 0xd8, 0x9e, 0x8d, 0x96, 0x28, 0x33, 0x23, 0x96, 0xba, 0xf7, 0xd6, 0xd3, 0x75,
+	0xdb, 0x35, 0x6d, 0x37, 0x5b, 0xc3, 0x2e, 0x15, 0xae, 0x11, 0x0f, 0xdf, 0xcb, 0xd6, 0x6d, 0xdd,
+	0xe2, 0xf2, 0xeb, 0xc9, 0xa6, 0xdd, 0xb4, 0xd9, 0xcf, 0x2c, 0xfd, 0x25, 0x56, 0xe5, 0xa6, 0x6d,
+	0x37, 0x0d, 0x92, 0x65, 0x54, 0xad, 0x73, 0x92, 0xf5, 0x74, 0x93, 0xb8, 0x1e, 0x36, 0xdb, 0x42,
+	0x20, 0x3d, 0x28, 0xd0, 0xe8, 0x38, 0xd8, 0xd3, 0x6d, 0x61, 0x56, 0xe9, 0xcd, 0xc1, 0x6c, 0x19,
+	0x3b, 0xd8, 0x74, 0xd1, 0x0f, 0x21, 0xd6, 0xb5, 0x3d, 0xa2, 0xb5, 0x89, 0xa3, 0xdb, 0x8d, 0x94,
+	0xb4, 0x29, 0xdd, 0x9d, 0xce, 0xbf, 0x75, 0xd1, 0x93, 0xd1, 0x39, 0x36, 0x8d, 0x1d, 0x25, 0xc4,
+	0x54, 0x54, 0xa0, 0x54, 0x99, 0x11, 0xc8, 0x82, 0x25, 0xc6, 0xf3, 0x5a, 0x0e, 0x71, 0x5b, 0xb6,
+	0xd1, 0x48, 0x4d, 0x6e, 0x4a, 0x77, 0x17, 0xf2, 0xf7, 0x9f, 0xf7, 0xe4, 0x89, 0xbf, 0xf7, 0xe4,
+	0x3b, 0x4d, 0xdd, 0x6b, 0x75, 0x6a, 0x99, 0xba, 0x6d, 0x66, 0xc5, 0x2e, 0xf9, 0x7f, 0xef, 0xba,
+	0x8d, 0x27, 0x59, 0xef, 0xbc, 0x4d, 0xdc, 0x4c, 0x91, 0xd4, 0x2f, 0x7a, 0xf2, 0x5a, 0xc8, 0x53,
+	0xdf, 0x9a, 0xa2, 0xc6, 0xe9, 0x42, 0xd5, 0xa7, 0x11, 0x81, 0x98, 0x43, 0x4e, 0xb1, 0xd3, 0xd0,
+	0x6a, 0xd8, 0x6a, 0xa4, 0xa6, 0x98, 0xb3, 0xe2, 0xd8, 0xce, 0xc4, 0xb6, 0x42, 0xa6, 0x14, 0x15,
+	0x38, 0x95, 0xc7, 0x56, 0x03, 0xd5, 0x61, 0x5d, 0xf0, 0x1a, 0xba, 0xeb, 0x39, 0x7a, 0xad, 0x43,
+	0xf3, 0xa6, 0x9d, 0xea, 0x56, 0xc3, 0x3e, 0x4d, 0x4d, 0xb3, 0xf4, 0xdc, 0xbe, 0xe8, 0xc9, 0xb7,
+	0x22, 0x76, 0x46, 0xc8, 0x2a, 0x6a, 0x8a, 0x33, 0x8b, 0x21, 0xde, 0x31, 0x63, 0x21, 0x0d, 0x62,
+	0xb8, 0x5e, 0x27, 0x6d, 0x4f, 0x33, 0x74, 0xd7, 0x4b, 0xcd, 0x6c, 0x4e, 0xdd, 0x8d, 0x6d, 0xaf,
+	0x65, 0xa2, 0xc5, 0x91, 0x29, 0x12, 0xcb, 0x36, 0xf3, 0xff, 0x47, 0xb7, 0x18, 0x04, 0x1e, 0xd2,
+	0x53, 0xbe, 0x7c, 0x21, 0x2f, 0x30, 0xa1, 0x7d, 0xdd, 0xf5, 0x54, 0xe0, 0x2c, 0xfa, 0x9b, 0x1e,
+	0x8e, 0x6b, 0x60, 0xb7, 0xa5, 0x9d, 0x38, 0xb8, 0x4e, 0x1d, 0xa7, 0x66, 0xdf, 0xec, 0x70, 0xa2,
+	0xd6, 0x14, 0x35, 0xce, 0x16, 0x76, 0x05, 0x8d, 0x76, 0x60, 0x91, 0x4b, 0x88, 0x3c, 0xcd, 0xb1,
+	0x3c, 0x5d, 0xbb, 0xe8, 0xc9, 0xab, 0x61, 0x7d, 0x3f, 0x33, 0x31, 0x46, 0x8a, 0x64, 0xfc, 0x0a,
+	0x92, 0xa6, 0x6e, 0x69, 0x5d, 0x6c, 0xe8, 0x0d, 0x5a, 0x69, 0xbe, 0x8d, 0x79, 0x16, 0xf1, 0xc1,
+	0xd8, 0x11, 0x6f, 0x70, 0x8f, 0xa3, 0x6c, 0x2a, 0xea, 0x8a, 0xa9, 0x5b, 0x8f, 0xe9, 0x6a, 0x99,
+	0x38, 0xc2, 0xff, 0x36, 0xac, 0xb5, 0x74, 0xd7, 0xb3, 0x1d, 0xbd, 0xae, 0xb1, 0x26, 0xf2, 0x7b,
+	0x61, 0x81, 0x6e, 0x42, 0x5d, 0xf5, 0x99, 0x15, 0xca, 0x13, 0xc5, 0x9f, 0x81, 0x55, 0x93, 0x34,
+	0x74, 0x6c, 0x45, 0x35, 0x80, 0x69, 0xac, 0x70, 0x56, 0x58, 0xfe, 0x3d, 0x48, 0x9a, 0xf8, 0x4c,
+	0x37, 0x3b, 0xa6, 0xd6, 0x76, 0xf4, 0x3a, 0xe1, 0x6a, 0x6e, 0x2a, 0xc6, 0x14, 0x90, 0xe0, 0x95,
+	0x29, 0x8b, 0xa9, 0xb9, 0x34, 0x2a, 0x5f, 0x23, 0xec, 0xc9, 0x4d, 0x2d, 0xf2, 0xa8, 0x04, 0xf3,
+	0x20, 0x70, 0xe5, 0xee, 0xcc, 0x7f, 0xfe, 0x4c, 0x9e, 0xf8, 0xf7, 0x33, 0x59, 0x52, 0xfe, 0x23,
+	0x41, 0x22, 0xd7, 0x6d, 0x16, 0xec, 0x8e, 0xe5, 0x11, 0x47, 0xb4, 0xba, 0x0d, 0x80, 0xbb, 0xcd,
+	0x70, 0xa7, 0xc7, 0xb6, 0xaf, 0x67, 0x38, 0x54, 0x64, 0x7c, 0xa8, 0xc8, 0x14, 0x05, 0x54, 0xe4,
+	0xbf, 0x4f, 0x33, 0xff, 0x4d, 0x4f, 0x4e, 0x06, 0x4a, 0xff, 0x6f, 0x9b, 0xba, 0x47, 0xcc, 0xb6,
+	0x77, 0x7e, 0xd1, 0x93, 0x57, 0x44, 0x41, 0xf6, 0xb9, 0xca, 0xe7, 0x2f, 0x64, 0x49, 0x5d, 0xc0,
+	0xdd, 0xa6, 0xd8, 0xf5, 0x13, 0xa0, 0x84, 0xe6, 0xb6, 0xf4, 0x13, 0x2f, 0x35, 0xf9, 0x5d, 0xfe,
+	0xde, 0x17, 0xfe, 0x56, 0xfb, 0x3a, 0x11, 0x77, 0x89, 0xc0, 0x1d, 0x63, 0x72, 0x6f, 0xf3, 0xb8,
+	0xdb, 0xac, 0x30, 0xf2, 0x0b, 0x80, 0x19, 0xd6, 0x0c, 0xe8, 0x7b, 0x00, 0x14, 0x4f, 0xb5, 0x06,
+	0xa5, 0xd8, 0x3e, 0x17, 0xf2, 0x6b, 0x41, 0xc0, 0x01, 0x4f, 0x51, 0x17, 0x28, 0xc1, 0xb5, 0x68,
+	0x09, 0x9f, 0x9b, 0x35, 0xdb, 0x10, 0x7a, 0x1c, 0xcd, 0xc2, 0x25, 0x1c, 0xe2, 0xd2, 0x12, 0x66,
+	0x24, 0xd7, 0xcd, 0xc2, 0x3c, 0x39, 0x6b, 0xdb, 0x16, 0xb1, 0x3c, 0x06, 0x4c, 0xf1, 0xfc, 0xea,
+	0x45, 0x4f, 0x5e, 0xe6, 0x7a, 0x3e, 0x47, 0x51, 0xfb, 0x42, 0x48, 0x87, 0x25, 0x0f, 0x1b, 0xc6,
+	0xb9, 0xe6, 0x7a, 0x0e, 0xf6, 0x48, 0xf3, 0x9c, 0x21, 0xcb, 0xd2, 0xf6, 0xcd, 0x41, 0x0c, 0xa8,
+	0x52, 0xa9, 0x8a, 0x10, 0xca, 0xbf, 0x7d, 0xd1, 0x93, 0x65, 0x6e, 0x35, 0xaa, 0x1e, 0x64, 0x4a,
+	0x51, 0xe3, 0x5e, 0x58, 0x07, 0x75, 0x20, 0xee, 0x39, 0xba, 0x19, 0x20, 0xc1, 0x0c, 0xdb, 0x58,
+	0xf9, 0x79, 0x4f, 0x96, 0xc6, 0xea, 0xab, 0xb4, 0x70, 0x1c, 0x36, 0x16, 0xf6, 0xbb, 0x48, 0x39,
+	0x7d, 0x44, 0x38, 0x83, 0x25, 0x13, 0x37, 0x34, 0xb3, 0x63, 0x78, 0x7a, 0xdb, 0xd0, 0x89, 0x23,
+	0x10, 0xe8, 0x67, 0x63, 0xfb, 0x15, 0x1b, 0x8e, 0x5a, 0x8b, 0x6c, 0xd8, 0xc4, 0x8d, 0x83, 0x3e,
+	0x87, 0x7a, 0x1e, 0xb8, 0x98, 0xe6, 0xde, 0xcc, 0x73, 0xd4, 0x5a, 0xc4, 0x73, 0xf4, 0x8a, 0xb2,
+	0xa3, 0x57, 0x14, 0x07, 0xb0, 0xc3, 0xb1, 0xdd, 0xde, 0x18, 0xba, 0xa2, 0xc2, 0x3e, 0xc3, 0x97,
+	0xd5, 0x47, 0x00, 0x0c, 0xe6, 0x6c, 0x8f, 0x38, 0x2e, 0xc3, 0xab, 0x78, 0x5e, 0x1e, 0x80, 0x40,
+	0xc6, 0x0b, 0x1b, 0x58, 0xa0, 0x10, 0xc8, 0x56, 0x91, 0x0e, 0x71, 0x13, 0x9f, 0x09, 0x48, 0xc2,
+	0x4d, 0x92, 0x82, 0xef, 0x6a, 0xd2, 0x2d, 0x71, 0x1b, 0xa5, 0xfd, 0x43, 0x09, 0x69, 0x87, 0x9c,
+	0xb0, 0xde, 0x8c, 0x99, 0xf8, 0x8c, 0x41, 0x5a, 0xae, 0x49, 0xd0, 0x6f, 0x25, 0x48, 0x72, 0x49,
+	0xd3, 0xee, 0x86, 0x0f, 0x27, 0xc6, 0xb2, 0x74, 0x3c, 0x76, 0x96, 0x6e, 0xf3, 0x08, 0x46, 0xd9,
+	0x0c, 0xef, 0x16, 0x31, 0x81, 0x03, 0xbb, 0x1b, 0x3a, 0xa7, 0x4f, 0x61, 0x8d, 0xa6, 0xa6, 0x6e,
+	0x5b, 0x27, 0x7a, 0x83, 0x58, 0x75, 0xe2, 0x67, 0x70, 0x91, 0x65, 0x70, 0xeb, 0xa2, 0x27, 0xdf,
+	0x09, 0x32, 0x38, 0x24, 0x16, 0x36, 0xbf, 0x6a, 0xea, 0x56, 0xa1, 0x2f, 0x20, 0xd2, 0xfa, 0x3b,
+	0x89, 0x81, 0x77, 0x58, 0xd3, 0x6d, 0x3b, 0x04, 0x37, 0x52, 0x71, 0xb6, 0xd9, 0x9f, 0x8f, 0xbd,
+	0xd9, 0x3b, 0x41, 0xba, 0x87, 0x8c, 0x46, 0xc3, 0xc1, 0x67, 0x41, 0x38, 0x15, 0xc6, 0xef, 0x5f,
+	0x0b, 0x13, 0xca, 0x7f, 0x25, 0x58, 0x61, 0x88, 0x45, 0x03, 0xad, 0x10, 0xcf, 0xd3, 0xad, 0xa6,
+	0x8b, 0x6e, 0x0d, 0x20, 0x1f, 0x43, 0xcc, 0x28, 0xc0, 0x3d, 0x7a, 0xcd, 0x63, 0x2f, 0x33, 0xde,
+	0xed, 0x3c, 0xd8, 0x30, 0x47, 0xa3, 0xde, 0x74, 0xe3, 0xda, 0x0c, 0x37, 0xc4, 0xcd, 0x48, 0x43,
+	0x50, 0x4c, 0x8d, 0x87, 0xea, 0x5d, 0x79, 0x3a, 0x09, 0xb1, 0x22, 0x71, 0xf4, 0x2e, 0x69, 0xec,
+	0x12, 0xd2, 0xb8, 0xcc, 0xce, 0x3f, 0x84, 0xb9, 0x13, 0xdb, 0x31, 0x3b, 0x06, 0x66, 0x5b, 0x5e,
+	0xda, 0x56, 0x86, 0x9f, 0x69, 0x7d, 0x83, 0xbb, 0x5c, 0x52, 0xf5, 0x55, 0xd0, 0x43, 0x80, 0xba,
+	0x6d, 0x72, 0xd0, 0x77, 0x53, 0x53, 0xec, 0x9d, 0xf7, 0xce, 0xb7, 0x18, 0x28, 0xf8, 0xc2, 0xf9,
+	0x69, 0x9a, 0x05, 0x35, 0xa4, 0x8d, 0x0e, 0x01, 0x42, 0x68, 0x3a, 0x7d, 0xb5, 0x5c, 0x05, 0x16,
+	0x76, 0xa6, 0xd9, 0x4b, 0xe1, 0x0b, 0x09, 0x92, 0xa3, 0x02, 0xb8, 0x4c, 0x6e, 0x76, 0x61, 0xf6,
+	0x94, 0xe8, 0xcd, 0x96, 0x77, 0xc5, 0x6a, 0x10, 0xda, 0x28, 0x05, 0x73, 0xba, 0xd5, 0x25, 0x8e,
+	0x4b, 0x58, 0x09, 0xcc, 0xab, 0x3e, 0x29, 0x62, 0xfc, 0xb3, 0x04, 0x8b, 0x2a, 0x3b, 0xe4, 0xfe,
+	0xd0, 0xd2, 0x3f, 0x14, 0x69, 0xf4, 0xbd, 0xc9, 0xc5, 0x87, 0xce, 0xe3, 0x53, 0x58, 0x3d, 0xe9,
+	0x18, 0x86, 0x56, 0xb7, 0xbb, 0xc4, 0xc1, 0x4d, 0xa2, 0xd5, 0x6c, 0xab, 0xe3, 0x5e, 0x31, 0xfc,
+	0x15, 0x6a, 0xaa, 0x20, 0x2c, 0xe5, 0xa9, 0x21, 0x11, 0xef, 0xef, 0x25, 0x58, 0x66, 0xc0, 0xf7,
+	0xd8, 0x36, 0xb0, 0xa7, 0x1b, 0xba, 0x77, 0x8e, 0x92, 0x30, 0x13, 0xce, 0x23, 0x27, 0xd0, 0x43,
+	0x98, 0xef, 0x62, 0x47, 0xc7, 0x56, 0x9d, 0x5c, 0x31, 0x88, 0xbe, 0x3e, 0xcd, 0xa2, 0x8b, 0xcd,
+	0xb6, 0x41, 0x5c, 0x96, 0xc5, 0x69, 0xd5, 0x27, 0x95, 0x6f, 0x24, 0x88, 0x97, 0x89, 0x85, 0x0d,
+	0xef, 0x5c, 0x24, 0x70, 0x1d, 0xe6, 0x4f, 0xb1, 0x63, 0xd1, 0xf6, 0x67, 0x01, 0xc5, 0xd5, 0x3e,
+	0x4d, 0x23, 0xfd, 0x05, 0xd6, 0x0d, 0x9e, 0x95, 0xb8, 0xca, 0x09, 0x74, 0x0c, 0xcb, 0xd1, 0x19,
+	0x80, 0x97, 0xf3, 0xf8, 0x01, 0x2f, 0x45, 0x26, 0x07, 0x86, 0x3e, 0x4d, 0x07, 0xd7, 0xfb, 0x13,
+	0x28, 0x1b, 0xb1, 0xd4, 0x18, 0x5b, 0x13, 0xef, 0xc8, 0xdb, 0xb0, 0xe4, 0x90, 0x36, 0xc1, 0x9e,
+	0x78, 0xc6, 0xbb, 0xec, 0x0d, 0x33, 0xad, 0xc6, 0xf9, 0x2a, 0x7f, 0xc7, 0xfb, 0xc9, 0x7f, 0x36,
+	0x09, 0x73, 0x47, 0x27, 0x27, 0x14, 0xff, 0xd0, 0x5b, 0x30, 0xdb, 0xe2, 0x05, 0x4a, 0x37, 0x39,
+	0xa5, 0x0a, 0x0a, 0x3d, 0x86, 0x65, 0x3e, 0x1a, 0x30, 0x50, 0xa3, 0x0f, 0xa5, 0x2b, 0xe3, 0x19,
+	0x35, 0x43, 0xd1, 0x45, 0xc5, 0x1e, 0xa1, 0xa9, 0x33, 0x48, 0x97, 0x18, 0xfc, 0x11, 0xa8, 0x72,
+	0x02, 0xfd, 0x08, 0xe6, 0xda, 0x3c, 0xfb, 0xe2, 0x95, 0x97, 0x1e, 0xac, 0x56, 0x11, 0xaf, 0x38,
+	0x23, 0xd5, 0x17, 0xa7, 0xb0, 0x3b, 0x30, 0xc6, 0xcd, 0x5c, 0x2d, 0xcc, 0x48, 0xce, 0x95, 0x3f,
+	0x4a, 0xb0, 0x24, 0x5c, 0x3e, 0x60, 0xc3, 0xcd, 0x39, 0xba, 0x01, 0x0b, 0x6c, 0x2b, 0xd8, 0xb3,
+	0x1d, 0x51, 0xa2, 0xc1, 0x02, 0xc3, 0x02, 0x9d, 0xde, 0x38, 0xad, 0xa0, 0xdd, 0xa7, 0xd4, 0x18,
+	0x5b, 0x7b, 0xc0, 0x53, 0x3a, 0x7a, 0xeb, 0x1f, 0xc0, 0xbc, 0xcd, 0x1d, 0x51, 0x34, 0xa6, 0xe8,
+	0x77, 0xed, 0x35, 0x7b, 0x17, 0x80, 0xd7, 0x17, 0x57, 0xfe, 0x21, 0x41, 0xf2, 0xb1, 0x1f, 0x41,
+	0x99, 0x38, 0xb4, 0x85, 0x59, 0x9d, 0x7f, 0x7b, 0xa8, 0x1f, 0xc1, 0x2c, 0x6b, 0x2d, 0x5a, 0xbe,
+	0xd4, 0xdf, 0xe6, 0xc8, 0xa9, 0x3a, 0x64, 0x4f, 0x38, 0x16, 0x5a, 0x88, 0xc0, 0x1c, 0xbf, 0x4f,
+	0x7c, 0xb8, 0xbe, 0x9e, 0xe1, 0x29, 0xcd, 0xd0, 0x51, 0x21, 0x23, 0xbe, 0xd1, 0x64, 0x0a, 0xb6,
+	0x6e, 0xe5, 0xdf, 0xa3, 0x9a, 0x5f, 0xbe, 0x90, 0xef, 0x5e, 0xe2, 0x18, 0xa8, 0x82, 0xab, 0xfa,
+	0xb6, 0x95, 0xbf, 0x49, 0x90, 0x18, 0x8c, 0xe4, 0x35, 0x18, 0x71, 0x13, 0xd8, 0x67, 0x17, 0x57,
+	0xab, 0x63, 0x97, 0xa7, 0x7e, 0x5a, 0x5d, 0x60, 0x2b, 0x05, 0xec, 0x7a, 0x48, 0x81, 0x38, 0x67,
+	0xeb, 0x56, 0x70, 0x8b, 0x4e, 0xab, 0xec, 0xab, 0x8e, 0xbb, 0x67, 0xb1, 0x6b, 0xf1, 0x13, 0x58,
+	0xa1, 0x73, 0x13, 0xae, 0xb9, 0x5a, 0x83, 0x74, 0x75, 0xf6, 0x94, 0xbb, 0xe2, 0x0d, 0xb2, 0x8c,
+	0xbb, 0xcd, 0x5c, 0xcd, 0x2d, 0xfa, 0x66, 0x94, 0x57, 0x14, 0x5c, 0x28, 0xd8, 0x1d, 0x75, 0x89,
+	0xe3, 0xe8, 0x0d, 0x72, 0x99, 0x9b, 0xa3, 0x02, 0x71, 0x72, 0x56, 0x6f, 0x61, 0xab, 0xf9, 0x46,
+	0xed, 0xb7, 0xe8, 0x1b, 0x61, 0xdd, 0xf7, 0x21, 0xcc, 0x92, 0xb3, 0xb6, 0xee, 0x9c, 0xb3, 0x14,
+	0xc4, 0xb6, 0xd7, 0x87, 0x9e, 0xb1, 0x55, 0xff, 0x3b, 0x59, 0x7e, 0x9e, 0x7a, 0x7a, 0x4a, 0x5f,
+	0xa9, 0x42, 0x87, 0x96, 0x15, 0xee, 0x78, 0x2d, 0xdb, 0xd1, 0x45, 0x9f, 0x2e, 0xa8, 0xc1, 0x82,
+	0xf2, 0x17, 0x09, 0x6e, 0xe4, 0x9a, 0x4d, 0x87, 0x34, 0xb1, 0x47, 0x4a, 0x21, 0xaf, 0x65, 0x87,
+	0xd0, 0x4c, 0xa3, 0xb7, 0x61, 0xba, 0x85, 0xdd, 0x96, 0x18, 0x37, 0x97, 0x2f, 0x7a, 0x72, 0x8c,
+	0xbf, 0xd9, 0xe8, 0xaa, 0xa2, 0x32, 0x26, 0xba, 0x03, 0x33, 0x54, 0xd8, 0x11, 0xdb, 0x4d, 0x5c,
+	0xf4, 0xe4, 0xc5, 0x60, 0xc6, 0x70, 0x14, 0x95, 0xb3, 0xd9, 0x2c, 0xda, 0xa9, 0x99, 0xba, 0xa7,
+	0xd5, 0x0c, 0xbb, 0xfe, 0x84, 0x1f, 0x69, 0x64, 0x16, 0x0d, 0x71, 0xe9, 0x2c, 0xca, 0xc8, 0x3c,
+	0xa5, 0x42, 0xaf, 0xbd, 0x9e, 0x04, 0xd7, 0x47, 0xc6, 0x4c, 0xf1, 0x0a, 0x7d, 0x26, 0x41, 0x32,
+	0x72, 0x06, 0x9a, 0xd7, 0x61, 0x97, 0x87, 0xc4, 0xca, 0xfe, 0xd6, 0x60, 0xdf, 0x84, 0x0d, 0x54,
+	0xa9, 0x64, 0xfe, 0x03, 0x31, 0x0b, 0x6c, 0xf8, 0x73, 0xee, 0xb0, 0x31, 0xfa, 0x89, 0x0a, 0x0d,
+	0x69, 0xba, 0x2a, 0x22, 0x43, 0x6b, 0x97, 0x4d, 0x4e, 0x68, 0x83, 0x7f, 0x95, 0x60, 0x65, 0xc8,
+	0x38, 0xb5, 0x13, 0x9e, 0xfc, 0x43, 0x76, 0xc4, 0xe8, 0x2e, 0xfa, 0xea, 0xc9, 0xe8, 0x1a, 0xdc,
+	0x1d, 0xfb, 0x83, 0x53, 0x72, 0xc4, 0xfe, 0x95, 0x68, 0x6d, 0x86, 0x82, 0xfe, 0x93, 0x04, 0x10,
+	0x7c, 0x9a, 0x41, 0x3f, 0x81, 0x29, 0xb7, 0xe3, 0xc7, 0x3a, 0x6e, 0xfd, 0x53, 0x55, 0x94, 0x80,
+	0x29, 0xab, 0x63, 0x8a, 0xdb, 0x9a, 0xfe, 0x44, 0x3b, 0x30, 0xe3, 0x7a, 0xd8, 0xf1, 0xc6, 0xea,
+	0x03, 0xae, 0xb2, 0x33, 0xff, 0x1b, 0x3f, 0xd0, 0xcf, 0x26, 0xc5, 0xb0, 0x10, 0x4e, 0xf1, 0xa5,
+	0xb3, 0x9b, 0x87, 0xe9, 0x37, 0x68, 0x6c, 0xa6, 0x8b, 0xf2, 0xb0, 0xd0, 0xff, 0xb2, 0x3d, 0xd6,
+	0x5e, 0x02, 0x35, 0xf4, 0x63, 0xfa, 0x02, 0xf7, 0x07, 0x22, 0xd6, 0xd7, 0xb1, 0x6d, 0x79, 0xb0,
+	0xb6, 0x19, 0x7e, 0x05, 0x73, 0x93, 0x1a, 0x52, 0x09, 0x9d, 0xdc, 0xd7, 0xfe, 0xb3, 0x2e, 0x90,
+	0x7c, 0x0d, 0x64, 0xbf, 0x05, 0xb3, 0x62, 0x04, 0xe1, 0xa7, 0x22, 0x28, 0x3a, 0xef, 0xb4, 0xed,
+	0x53, 0xe2, 0x68, 0x6e, 0x0b, 0x3b, 0xe4, 0xaa, 0xf3, 0x0e, 0x33, 0x51, 0xa1, 0x16, 0xe8, 0x0b,
+	0x5c, 0x4c, 0x96, 0x57, 0x43, 0x73, 0xa1, 0xbd, 0xf5, 0x07, 0x09, 0xe2, 0x91, 0x4f, 0x4d, 0x28,
+	0x0d, 0xeb, 0xd5, 0xdc, 0xfe, 0xfe, 0xc7, 0x5a, 0xa5, 0xaa, 0xe6, 0xaa, 0xa5, 0xfb, 0x1f, 0x6b,
+	0x8f, 0x0e, 0x2b, 0xe5, 0x52, 0x61, 0x6f, 0x77, 0xaf, 0x54, 0x4c, 0x4c, 0x20, 0x05, 0xd2, 0x03,
+	0xfc, 0xe3, 0xd2, 0xde, 0xfd, 0x07, 0xd5, 0x52, 0x51, 0x3b, 0x28, 0x15, 0xf7, 0x72, 0x87, 0x09,
+	0x09, 0xc9, 0xb0, 0x31, 0x20, 0x53, 0x55, 0xf7, 0x0e, 0x0e, 0x98, 0x48, 0xee, 0x30, 0x31, 0x89,
+	0x6e, 0xc2, 0xf5, 0x01, 0x81, 0x83, 0x5c, 0x5f, 0x7f, 0x6a, 0xeb, 0x97, 0x80, 0x86, 0x87, 0x2b,
+	0xf4, 0x0e, 0x6c, 0x16, 0x4b, 0xea, 0xde, 0xe3, 0x52, 0x51, 0xdb, 0x2d, 0xd1, 0x7f, 0x8e, 0xd4,
+	0x83, 0x47, 0xfb, 0xb9, 0x81, 0xf8, 0x36, 0xe1, 0xc6, 0x48, 0xa9, 0xb2, 0x7a, 0x54, 0x7c, 0x54,
+	0xa8, 0xf2, 0xe8, 0x46, 0x4a, 0xe4, 0x73, 0x95, 0x9f, 0x96, 0xaa, 0x89, 0xc9, 0xad, 0x2e, 0xc4,
+	0x23, 0x63, 0x04, 0xcd, 0x89, 0x5a, 0x3a, 0xce, 0xa9, 0xaf, 0xf3, 0x29, 0xc3, 0xc6, 0x00, 0xbf,
+	0xb0, 0x9f, 0xdb, 0x3b, 0x10, 0x99, 0x49, 0x48, 0x34, 0xf4, 0x01, 0x81, 0x5c, 0xa1, 0xf0, 0x48,
+	0xcd, 0x15, 0x82, 0xec, 0x25, 0x26, 0xb7, 0x7e, 0x1d, 0x3c, 0xcf, 0xc4, 0x8b, 0x90, 0x5a, 0x3e,
+	0xda, 0xdd, 0x2d, 0x1d, 0x16, 0x4a, 0x5a, 0xb9, 0x74, 0x98, 0xdb, 0xaf, 0x0e, 0x1e, 0xc7, 0x06,
+	0x5c, 0x1b, 0x14, 0x38, 0xce, 0xa9, 0x87, 0x7b, 0x87, 0xf7, 0x13, 0x12, 0x4a, 0x41, 0x72, 0x90,
+	0xf9, 0x30, 0xb7, 0xb7, 0x9f, 0x98, 0x44, 0xd7, 0x61, 0x6d, 0x90, 0x53, 0xd9, 0xcf, 0x55, 0x1e,
+	0x24, 0xa6, 0xf2, 0xfb, 0xcf, 0xff, 0x95, 0x9e, 0x78, 0xfe, 0x32, 0x2d, 0x7d, 0xf5, 0x32, 0x2d,
+	0xfd, 0xf3, 0x65, 0x5a, 0x7a, 0xfa, 0x2a, 0x3d, 0xf1, 0xd5, 0xab, 0xf4, 0xc4, 0xd7, 0xaf, 0xd2,
+	0x13, 0x9f, 0x64, 0x42, 0x05, 0x46, 0x9b, 0xe9, 0x5d, 0x8b, 0x78, 0xa7, 0xb6, 0xf3, 0x84, 0x11,
+	0xd9, 0xee, 0x0f, 0xb2, 0x67, 0xfe, 0x9f, 0xc0, 0x58, 0xb1, 0xd5, 0x66, 0x59, 0xbf, 0xbe, 0xff,
+	0xbf, 0x01, 0x00, 0xc3, 0xb9, 0x36, 0x22, 0x1e, 0x1b, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.MedianStampPeriod != 0 {
-		n += 1 + sovOracle(uint64(m.MedianStampPeriod))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.MaximumPriceStamps != 0 {
-		n += 1 + sovOracle(uint64(m.MaximumPriceStamps))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MaximumMedianStamps != 0 {
-		n += 1 + sovOracle(uint64(m.MaximumMedianStamps))
+	if this.VotePeriod != that1.VotePeriod {
+		return false
 	}
-	return n
-}
-
-func (m *AvgCounterParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
+		return false
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgPeriod)
-	n += 1 + l + sovOracle(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgShift)
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.RewardBand.Equal(that1.RewardBand) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
 	}
-	l = len(m.SymbolDenom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(this.AcceptList) != len(that1.AcceptList) {
+		return false
 	}
-	if m.Exponent != 0 {
-		n += 1 + sovOracle(uint64(m.Exponent))
+	for i := range this.AcceptList {
+		if !this.AcceptList[i].Equal(&that1.AcceptList[i]) {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if this.SlashWindow != that1.SlashWindow {
+		return false
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
+		return false
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	if this.HistoricStampPeriod != that1.HistoricStampPeriod {
+		return false
 	}
-	return n
+	if this.MedianStampPeriod != that1.MedianStampPeriod {
+		return false
+	}
+	if this.MaximumPriceStamps != that1.MaximumPriceStamps {
+		return false
+	}
+	if this.MaximumMedianStamps != that1.MaximumMedianStamps {
+		return false
+	}
+	return true
 }
+func (this *DerivedFeed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DerivedFeed)
+	if !ok {
+		that2, ok := that.(DerivedFeed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SymbolDenom != that1.SymbolDenom {
+		return false
+	}
+	if this.Formula != that1.Formula {
+		return false
+	}
+	if len(this.Components) != len(that1.Components) {
+		return false
+	}
+	for i := range this.Components {
+		if !this.Components[i].Equal(&that1.Components[i]) {
+			return false
+		}
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	return true
+}
+func (this *DerivedFeedComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DerivedFeedComponent)
+	if !ok {
+		that2, ok := that.(DerivedFeedComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SymbolDenom != that1.SymbolDenom {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	if this.Inverse != that1.Inverse {
+		return false
+	}
+	return true
+}
+func (this *RewardParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardParams)
+	if !ok {
+		that2, ok := that.(RewardParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Formula != that1.Formula {
+		return false
+	}
+	if !this.FullCoverageBonus.Equal(that1.FullCoverageBonus) {
+		return false
+	}
+	return true
+}
+func (this *PenaltyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PenaltyParams)
+	if !ok {
+		that2, ok := that.(PenaltyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Warnings != that1.Warnings {
+		return false
+	}
+	if this.Jails != that1.Jails {
+		return false
+	}
+	if len(this.SlashFractions) != len(that1.SlashFractions) {
+		return false
+	}
+	for i := range this.SlashFractions {
+		if !this.SlashFractions[i].Equal(that1.SlashFractions[i]) {
+			return false
+		}
+	}
+	if this.GracePeriod != that1.GracePeriod {
+		return false
+	}
+	if this.RepeatWindows != that1.RepeatWindows {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaximumMedianStamps != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumMedianStamps))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaximumPriceStamps != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumPriceStamps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MedianStampPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MedianStampPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HistoricStampPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HistoricStampPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.SlashWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.AcceptList) > 0 {
+		for iNdEx := len(m.AcceptList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RewardBand.Size()
+		i -= size
+		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvgCounterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvgCounterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvgCounterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AvgShift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgShift):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AvgPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Denom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxConfidenceSpread != nil {
+		{
+			size := m.MaxConfidenceSpread.Size()
+			i -= size
+			if _, err := m.MaxConfidenceSpread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MinConfidenceVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinConfidenceVoters))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PriceMoveThreshold != nil {
+		{
+			size := m.PriceMoveThreshold.Size()
+			i -= size
+			if _, err := m.PriceMoveThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MadMultiplier != nil {
+		{
+			size := m.MadMultiplier.Size()
+			i -= size
+			if _, err := m.MadMultiplier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TrimFraction != nil {
+		{
+			size := m.TrimFraction.Size()
+			i -= size
+			if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TallyStrategy != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TallyStrategy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomVoteSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomVoteSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomVoteSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RewardBand.Size()
+		i -= size
+		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Formula != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Formula))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedFeedComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedFeedComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedFeedComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inverse {
+		i--
+		if m.Inverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FullCoverageBonus.Size()
+		i -= size
+		if _, err := m.FullCoverageBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Formula != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Formula))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceVolatility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceVolatility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceVolatility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Variance.Size()
+		i -= size
+		if _, err := m.Variance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RepeatWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RepeatWindows))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SlashFractions) > 0 {
+		for iNdEx := len(m.SlashFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SlashFractions[iNdEx].Size()
+				i -= size
+				if _, err := m.SlashFractions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Jails != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Jails))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Warnings != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Warnings))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Offence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Offence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Offence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Penalty != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Penalty))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Level != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OffenceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffenceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffenceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offences) > 0 {
+		for iNdEx := len(m.Offences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Level != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SinceHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AvgAbsDeviation.Size()
+		i -= size
+		if _, err := m.AvgAbsDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.VotesInBand != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotesInBand))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotesCast != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotesCast))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExchangeRateTuples) > 0 {
+		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvgCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvgCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvgCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Num != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Sum.Size()
+		i -= size
+		if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confidence != nil {
+		{
+			size, err := m.Confidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintOracle(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceConfidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceConfidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceConfidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spread.Size()
+		i -= size
+		if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PowerShare.Size()
+		i -= size
+		if _, err := m.PowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Voters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Voters))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.AcceptList) > 0 {
+		for _, e := range m.AcceptList {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.HistoricStampPeriod != 0 {
+		n += 1 + sovOracle(uint64(m.HistoricStampPeriod))
+	}
+	if m.MedianStampPeriod != 0 {
+		n += 1 + sovOracle(uint64(m.MedianStampPeriod))
+	}
+	if m.MaximumPriceStamps != 0 {
+		n += 1 + sovOracle(uint64(m.MaximumPriceStamps))
+	}
+	if m.MaximumMedianStamps != 0 {
+		n += 1 + sovOracle(uint64(m.MaximumMedianStamps))
+	}
+	return n
+}
+
+func (m *AvgCounterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgPeriod)
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AvgShift)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovOracle(uint64(m.Exponent))
+	}
+	if m.TallyStrategy != 0 {
+		n += 1 + sovOracle(uint64(m.TallyStrategy))
+	}
+	if m.TrimFraction != nil {
+		l = m.TrimFraction.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MadMultiplier != nil {
+		l = m.MadMultiplier.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.PriceMoveThreshold != nil {
+		l = m.PriceMoveThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinConfidenceVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinConfidenceVoters))
+	}
+	if m.MaxConfidenceSpread != nil {
+		l = m.MaxConfidenceSpread.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomVoteSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	return n
+}
+
+func (m *DerivedFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Formula != 0 {
+		n += 1 + sovOracle(uint64(m.Formula))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DerivedFeedComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Inverse {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Formula != 0 {
+		n += 1 + sovOracle(uint64(m.Formula))
+	}
+	l = m.FullCoverageBonus.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceVolatility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Variance.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovOracle(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *PenaltyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Warnings != 0 {
+		n += 1 + sovOracle(uint64(m.Warnings))
+	}
+	if m.Jails != 0 {
+		n += 1 + sovOracle(uint64(m.Jails))
+	}
+	if len(m.SlashFractions) > 0 {
+		for _, e := range m.SlashFractions {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.GracePeriod))
+	}
+	if m.RepeatWindows != 0 {
+		n += 1 + sovOracle(uint64(m.RepeatWindows))
+	}
+	return n
+}
+
+func (m *Offence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Level != 0 {
+		n += 1 + sovOracle(uint64(m.Level))
+	}
+	if m.Penalty != 0 {
+		n += 1 + sovOracle(uint64(m.Penalty))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *OffenceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovOracle(uint64(m.SinceHeight))
+	}
+	if m.Level != 0 {
+		n += 1 + sovOracle(uint64(m.Level))
+	}
+	if len(m.Offences) > 0 {
+		for _, e := range m.Offences {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VotesCast != 0 {
+		n += 1 + sovOracle(uint64(m.VotesCast))
+	}
+	if m.VotesInBand != 0 {
+		n += 1 + sovOracle(uint64(m.VotesInBand))
+	}
+	l = m.AvgAbsDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *AvgCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sum.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Num != 0 {
+		n += 1 + sovOracle(uint64(m.Num))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DenomExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Confidence != nil {
+		l = m.Confidence.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceConfidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Voters != 0 {
+		n += 1 + sovOracle(uint64(m.Voters))
+	}
+	l = m.PowerShare.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Spread.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptList = append(m.AcceptList, Denom{})
+			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricStampPeriod", wireType)
+			}
+			m.HistoricStampPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricStampPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianStampPeriod", wireType)
+			}
+			m.MedianStampPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianStampPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+			}
+			m.MaximumPriceStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMedianStamps", wireType)
+			}
+			m.MaximumMedianStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumMedianStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AvgCounterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvgCounterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvgCounterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AvgPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgShift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AvgShift, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategy", wireType)
+			}
+			m.TallyStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyStrategy |= TallyStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TrimFraction = &v
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MadMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MadMultiplier = &v
+			if err := m.MadMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMoveThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.PriceMoveThreshold = &v
+			if err := m.PriceMoveThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConfidenceVoters", wireType)
+			}
+			m.MinConfidenceVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConfidenceVoters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConfidenceSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxConfidenceSpread = &v
+			if err := m.MaxConfidenceSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomVoteSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomVoteSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomVoteSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+			}
+			m.Formula = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formula |= DerivedFeedFormula(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, DerivedFeedComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedFeedComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedFeedComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedFeedComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inverse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+			}
+			m.Formula = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formula |= RewardFormula(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullCoverageBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FullCoverageBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceVolatility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceVolatility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Variance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			m.Warnings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Warnings |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jails", wireType)
+			}
+			m.Jails = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jails |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SlashFractions = append(m.SlashFractions, v)
+			if err := m.SlashFractions[len(m.SlashFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatWindows", wireType)
+			}
+			m.RepeatWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	return n
-}
-
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *AvgCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Sum.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.Num != 0 {
-		n += 1 + sovOracle(uint64(m.Num))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
 
-func (m *DenomExchangeRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Offence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,17 +4898,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Offence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Offence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.VotePeriod = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1153,14 +4918,170 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			m.Penalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Penalty |= OffencePenalty(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OffenceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffenceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffenceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1188,15 +5109,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
 			}
-			var stringLen uint64
+			m.SinceHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1206,31 +5125,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SinceHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.Level = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1240,14 +5144,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.Level |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1274,14 +5178,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptList = append(m.AcceptList, Denom{})
-			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offences = append(m.Offences, Offence{})
+			if err := m.Offences[len(m.Offences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1309,34 +5263,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
-			}
-			m.SlashWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1346,50 +5279,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, DenomPerformance{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricStampPeriod", wireType)
-			}
-			m.HistoricStampPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricStampPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianStampPeriod", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.MedianStampPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1399,49 +5313,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MedianStampPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.MaximumPriceStamps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMedianStamps", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaximumMedianStamps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumMedianStamps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1463,7 +5354,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvgCounterParams) Unmarshal(dAtA []byte) error {
+func (m *DenomPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,17 +5377,17 @@ func (m *AvgCounterParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvgCounterParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvgCounterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1506,30 +5397,67 @@ func (m *AvgCounterParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AvgPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesCast", wireType)
+			}
+			m.VotesCast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesCast |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesInBand", wireType)
+			}
+			m.VotesInBand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesInBand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgShift", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgAbsDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1539,22 +5467,23 @@ func (m *AvgCounterParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AvgShift, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AvgAbsDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1579,7 +5508,7 @@ func (m *AvgCounterParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *PriceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,15 +5531,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,11 +5567,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1670,13 +5599,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			m.Exponent = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1686,11 +5617,57 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2341,6 +6318,211 @@ func (m *DenomExchangeRate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confidence == nil {
+				m.Confidence = &PriceConfidence{}
+			}
+			if err := m.Confidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceConfidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceConfidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceConfidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			m.Voters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"reflect"
 	time "time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,9 @@ const (
 	MaxVoteThresholdMultiplier = 100 // must be 10^MaxVoteThresholdPrecision
 	DefaultAvgPeriod           = time.Hour * 16
 	DefaultAvgShift            = time.Hour * 2
+	// MaxStandbyFeeders is the maximum number of standby feeders of a validator, in addition to
+	// its feeder delegation.
+	MaxStandbyFeeders = 4
 )
 
 // Parameter keys
@@ -39,6 +43,14 @@ var (
 	KeyMaximumMedianStamps      = []byte("MedianStampAmount")
 )
 
+// Historic avg counter, reward and penalty param keys, used by MsgGovUpdateParams
+const (
+	KeyAvgPeriod     = "AvgPeriod"
+	KeyAvgShift      = "AvgShift"
+	RewardParamsKey  = "RewardParams"
+	PenaltyParamsKey = "PenaltyParams"
+)
+
 var _ paramstypes.ParamSet = &Params{}
 
 // DefaultParams creates default oracle module parameters
@@ -139,6 +151,59 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	}
 }
 
+// UpdateParams sets the params and historic avg counter params of the given keys to their value
+// in changes and acpChanges, and validates the result. AcceptList can't be updated with
+// UpdateParams, use DenomList.Update instead. The RewardParamsKey and PenaltyParamsKey keys are
+// skipped: the reward and penalty params are stored on their own, and validated with
+// RewardParams.Validate and PenaltyParams.Validate.
+func UpdateParams(
+	p Params,
+	acp AvgCounterParams,
+	keys []string,
+	changes Params,
+	acpChanges AvgCounterParams,
+) (Params, AvgCounterParams, error) {
+	pairs := map[string]paramstypes.ParamSetPair{}
+	for _, pair := range p.ParamSetPairs() {
+		pairs[string(pair.Key)] = pair
+	}
+	changedPairs := map[string]paramstypes.ParamSetPair{}
+	for _, pair := range changes.ParamSetPairs() {
+		changedPairs[string(pair.Key)] = pair
+	}
+
+	for _, key := range keys {
+		switch key {
+		case string(KeyAcceptList):
+			return p, acp, ErrInvalidParams.Wrap("AcceptList must be updated with MsgGovUpdateAcceptList")
+		case KeyAvgPeriod:
+			acp.AvgPeriod = acpChanges.AvgPeriod
+		case KeyAvgShift:
+			acp.AvgShift = acpChanges.AvgShift
+		case RewardParamsKey, PenaltyParamsKey:
+			continue
+		default:
+			pair, ok := pairs[key]
+			if !ok {
+				return p, acp, ErrInvalidParams.Wrapf("unknown param key %s", key)
+			}
+			v := reflect.ValueOf(changedPairs[key].Value).Elem()
+			if err := pair.ValidatorFn(v.Interface()); err != nil {
+				return p, acp, ErrInvalidParams.Wrapf("%s: %s", key, err)
+			}
+			reflect.ValueOf(pair.Value).Elem().Set(v)
+		}
+	}
+
+	if err := p.Validate(); err != nil {
+		return p, acp, ErrInvalidParams.Wrap(err.Error())
+	}
+	if err := acp.Validate(); err != nil {
+		return p, acp, ErrInvalidParams.Wrap(err.Error())
+	}
+	return p, acp, nil
+}
+
 // String implements fmt.Stringer interface
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -183,11 +248,8 @@ func (p Params) Validate() error {
 	}
 
 	for _, denom := range p.AcceptList {
-		if len(denom.BaseDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have BaseDenom")
-		}
-		if len(denom.SymbolDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
+		if err := denom.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -252,11 +314,8 @@ func validateAcceptList(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.BaseDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have BaseDenom")
-		}
-		if len(d.SymbolDenom) == 0 {
-			return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
+		if err := d.Validate(); err != nil {
+			return err
 		}
 	}
 
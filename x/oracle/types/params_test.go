@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 
@@ -72,6 +73,11 @@ func TestValidateAcceptList(t *testing.T) {
 	})
 	assert.ErrorContains(t, err, "oracle parameter AcceptList Denom must have SymbolDenom")
 
+	err = validateAcceptList(DenomList{
+		{BaseDenom: DenomUmee.BaseDenom, SymbolDenom: DenomUmee.SymbolDenom, TallyStrategy: TallyStrategy_TALLY_STRATEGY_MAD_MEDIAN},
+	})
+	assert.ErrorContains(t, err, "oracle parameter AcceptList Denom umee must have a MAD multiplier")
+
 	err = validateAcceptList(DenomList{
 		{BaseDenom: DenomUmee.BaseDenom, SymbolDenom: DenomUmee.SymbolDenom},
 	})
@@ -279,3 +285,27 @@ func TestParams_ParamSetPairs(t *testing.T) {
 	params := DefaultParams()
 	assert.Equal(t, 12, len(params.ParamSetPairs()))
 }
+
+func TestUpdateParams(t *testing.T) {
+	p, acp := DefaultParams(), DefaultAvgCounterParams()
+	changes := Params{VotePeriod: 7, SlashFraction: sdk.NewDecWithPrec(1, 3)}
+	acpChanges := AvgCounterParams{AvgShift: time.Hour}
+
+	updated, updatedAcp, err := UpdateParams(p, acp, []string{"SlashFraction", KeyAvgShift}, changes, acpChanges)
+	assert.NilError(t, err)
+	p.SlashFraction = changes.SlashFraction
+	assert.DeepEqual(t, p, updated)
+	assert.DeepEqual(t, AvgCounterParams{AvgPeriod: DefaultAvgPeriod, AvgShift: time.Hour}, updatedAcp)
+
+	_, _, err = UpdateParams(p, acp, []string{"AcceptList"}, changes, acpChanges)
+	assert.ErrorContains(t, err, "MsgGovUpdateAcceptList")
+	_, _, err = UpdateParams(p, acp, []string{"Foo"}, changes, acpChanges)
+	assert.ErrorContains(t, err, "unknown param key Foo")
+	_, _, err = UpdateParams(p, acp, []string{"MedianStampAmount"}, changes, acpChanges)
+	assert.ErrorContains(t, err, "MedianStampAmount: maximum median stamps must be positive")
+	// the vote period must divide the stamp periods
+	_, _, err = UpdateParams(p, acp, []string{"VotePeriod"}, changes, acpChanges)
+	assert.ErrorContains(t, err, "exact multiples of VotePeriod")
+	_, _, err = UpdateParams(p, acp, []string{KeyAvgPeriod}, changes, acpChanges)
+	assert.ErrorContains(t, err, "avg period must be positive")
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxOffences is the number of the latest offences kept in the offence history of a validator.
+const MaxOffences = 20
+
+// DefaultPenaltyParams returns the default penalty params: every offence slashes the
+// slash_fraction param and jails the validator, without grace period.
+func DefaultPenaltyParams() PenaltyParams {
+	return PenaltyParams{}
+}
+
+// Validate performs a basic validation of the penalty params.
+func (pp PenaltyParams) Validate() error {
+	prev := sdk.ZeroDec()
+	for i, f := range pp.SlashFractions {
+		if f.IsNil() || !f.IsPositive() || f.GT(sdk.OneDec()) {
+			return ErrInvalidParams.Wrapf("slash fraction #%d must be in (0, 1], got %s", i, f)
+		}
+		if f.LT(prev) {
+			return ErrInvalidParams.Wrapf("slash fractions must not decrease, got %s after %s", f, prev)
+		}
+		prev = f
+	}
+	return nil
+}
+
+// Penalty returns the penalty of an offence at the given level (counting from 1), and the
+// slashed fraction, which is zero unless the penalty is a slash. defaultSlash is used when no
+// slash fraction is set.
+func (pp PenaltyParams) Penalty(level uint32, defaultSlash sdk.Dec) (OffencePenalty, sdk.Dec) {
+	switch {
+	case level <= pp.Warnings:
+		return OffencePenalty_OFFENCE_PENALTY_WARNING, sdk.ZeroDec()
+	case level <= pp.Warnings+pp.Jails:
+		return OffencePenalty_OFFENCE_PENALTY_JAIL, sdk.ZeroDec()
+	case len(pp.SlashFractions) == 0:
+		return OffencePenalty_OFFENCE_PENALTY_SLASH, defaultSlash
+	}
+	i := int(level - pp.Warnings - pp.Jails - 1)
+	if i >= len(pp.SlashFractions) {
+		i = len(pp.SlashFractions) - 1
+	}
+	return OffencePenalty_OFFENCE_PENALTY_SLASH, pp.SlashFractions[i]
+}
+
+// InGracePeriod returns true if a validator, tracked since the given height, is still in its
+// grace period at the given height. Validators with an unknown (zero) since height have no
+// grace period.
+func (pp PenaltyParams) InGracePeriod(since, height int64) bool {
+	return since > 0 && pp.GracePeriod > 0 && height-since < int64(pp.GracePeriod)
+}
+
+// IsRepeated returns true if an offence at the given height is a repetition of the last
+// offence of the history, given the slash window.
+func (pp PenaltyParams) IsRepeated(h OffenceHistory, height int64, slashWindow uint64) bool {
+	if h.Level == 0 || len(h.Offences) == 0 {
+		return false
+	}
+	if pp.RepeatWindows == 0 {
+		return true
+	}
+	last := h.Offences[len(h.Offences)-1].Height
+	return height-last <= int64(pp.RepeatWindows*slashWindow)
+}
+
+// AddOffence appends an offence to the history, keeping only the latest MaxOffences offences.
+// The offence level is tracked by the history, so dropping older offences doesn't affect penalties.
+func (h *OffenceHistory) AddOffence(o Offence) {
+	h.Offences = append(h.Offences, o)
+	if n := len(h.Offences); n > MaxOffences {
+		h.Offences = append([]Offence{}, h.Offences[n-MaxOffences:]...)
+	}
+}
+
+// Validate performs a basic validation of the offence history.
+func (h OffenceHistory) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(h.Validator); err != nil {
+		return fmt.Errorf("invalid offence history validator: %w", err)
+	}
+	if len(h.Offences) == 0 {
+		if h.Level != 0 {
+			return fmt.Errorf("validator %s has offence level %d without offences", h.Validator, h.Level)
+		}
+		return nil
+	}
+	for _, o := range h.Offences {
+		if o.Level == 0 {
+			return fmt.Errorf("validator %s has an offence with zero level", h.Validator)
+		}
+	}
+	if last := h.Offences[len(h.Offences)-1]; last.Level != h.Level {
+		return fmt.Errorf("validator %s offence level %d doesn't match its last offence level %d",
+			h.Validator, h.Level, last.Level)
+	}
+	return nil
+}
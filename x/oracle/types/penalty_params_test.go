@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestPenaltyParamsValidate(t *testing.T) {
+	assert.NilError(t, types.DefaultPenaltyParams().Validate())
+
+	pp := types.PenaltyParams{SlashFractions: []sdk.Dec{sdk.ZeroDec()}}
+	assert.ErrorContains(t, pp.Validate(), "slash fraction #0 must be in (0, 1]")
+	pp.SlashFractions = []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(11, 1)}
+	assert.ErrorContains(t, pp.Validate(), "slash fraction #1 must be in (0, 1]")
+	pp.SlashFractions = []sdk.Dec{sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 2)}
+	assert.ErrorContains(t, pp.Validate(), "slash fractions must not decrease")
+	pp.SlashFractions = []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2), sdk.OneDec()}
+	assert.NilError(t, pp.Validate())
+}
+
+func TestPenalty(t *testing.T) {
+	defaultSlash := sdk.NewDecWithPrec(1, 4)
+	zero := sdk.ZeroDec()
+	warning, jail, slash := types.OffencePenalty_OFFENCE_PENALTY_WARNING, types.OffencePenalty_OFFENCE_PENALTY_JAIL,
+		types.OffencePenalty_OFFENCE_PENALTY_SLASH
+
+	pp := types.DefaultPenaltyParams()
+	p, f := pp.Penalty(1, defaultSlash)
+	assert.Equal(t, slash, p)
+	assert.DeepEqual(t, defaultSlash, f)
+
+	pp = types.PenaltyParams{
+		Warnings:       1,
+		Jails:          2,
+		SlashFractions: []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 2)},
+	}
+	tcs := []struct {
+		level    uint32
+		penalty  types.OffencePenalty
+		fraction sdk.Dec
+	}{
+		{1, warning, zero},
+		{2, jail, zero},
+		{3, jail, zero},
+		{4, slash, sdk.NewDecWithPrec(1, 2)},
+		{5, slash, sdk.NewDecWithPrec(5, 2)},
+		{10, slash, sdk.NewDecWithPrec(5, 2)},
+	}
+	for _, tc := range tcs {
+		p, f := pp.Penalty(tc.level, defaultSlash)
+		assert.Equal(t, tc.penalty, p, "level %d", tc.level)
+		assert.DeepEqual(t, tc.fraction, f)
+	}
+}
+
+func TestInGracePeriod(t *testing.T) {
+	pp := types.PenaltyParams{GracePeriod: 100}
+	assert.Equal(t, true, pp.InGracePeriod(50, 149))
+	assert.Equal(t, false, pp.InGracePeriod(50, 150))
+	// unknown since height
+	assert.Equal(t, false, pp.InGracePeriod(0, 10))
+	pp.GracePeriod = 0
+	assert.Equal(t, false, pp.InGracePeriod(50, 51))
+}
+
+func TestIsRepeated(t *testing.T) {
+	h := types.OffenceHistory{}
+	pp := types.PenaltyParams{RepeatWindows: 2}
+	assert.Equal(t, false, pp.IsRepeated(h, 1000, 100))
+
+	h.Level = 1
+	h.Offences = []types.Offence{{Height: 800, Level: 1}}
+	assert.Equal(t, true, pp.IsRepeated(h, 1000, 100))
+	assert.Equal(t, false, pp.IsRepeated(h, 1100, 100))
+	pp.RepeatWindows = 0
+	assert.Equal(t, true, pp.IsRepeated(h, 100000, 100))
+}
+
+func TestAddOffence(t *testing.T) {
+	h := types.OffenceHistory{}
+	for i := 1; i <= types.MaxOffences+5; i++ {
+		h.Level = uint32(i)
+		h.AddOffence(types.Offence{Height: int64(i), Level: h.Level})
+	}
+	assert.Equal(t, types.MaxOffences, len(h.Offences))
+	assert.Equal(t, int64(6), h.Offences[0].Height)
+	assert.Equal(t, h.Level, h.Offences[types.MaxOffences-1].Level)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomPerformance returns the performance of a single vote, compared to the tallied
+// exchange rate.
+func NewDenomPerformance(denom string, vote, exchangeRate sdk.Dec, inBand bool) DenomPerformance {
+	p := DenomPerformance{
+		Denom:           denom,
+		VotesCast:       1,
+		AvgAbsDeviation: vote.Sub(exchangeRate).Abs(),
+	}
+	if inBand {
+		p.VotesInBand = 1
+	}
+	return p
+}
+
+// Add merges the performance of the same denom over another set of votes.
+func (p DenomPerformance) Add(o DenomPerformance) DenomPerformance {
+	votes := p.VotesCast + o.VotesCast
+	if votes == 0 {
+		return p
+	}
+	sum := p.AvgAbsDeviation.MulInt64(int64(p.VotesCast)).Add(o.AvgAbsDeviation.MulInt64(int64(o.VotesCast)))
+	return DenomPerformance{
+		Denom:           p.Denom,
+		VotesCast:       votes,
+		VotesInBand:     p.VotesInBand + o.VotesInBand,
+		AvgAbsDeviation: sum.QuoInt64(int64(votes)),
+	}
+}
+
+// AddDenoms merges denom performances into the validator performance.
+func (vp *ValidatorPerformance) AddDenoms(denoms []DenomPerformance) {
+	for _, d := range denoms {
+		found := false
+		for i := range vp.Denoms {
+			if vp.Denoms[i].Denom == d.Denom {
+				vp.Denoms[i] = vp.Denoms[i].Add(d)
+				found = true
+				break
+			}
+		}
+		if !found {
+			vp.Denoms = append(vp.Denoms, d)
+		}
+	}
+}
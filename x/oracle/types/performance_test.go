@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestDenomPerformanceAdd(t *testing.T) {
+	p := types.NewDenomPerformance("UMEE", sdk.NewDec(9), sdk.NewDec(10), true)
+	assert.DeepEqual(t, types.DenomPerformance{
+		Denom: "UMEE", VotesCast: 1, VotesInBand: 1, AvgAbsDeviation: sdk.OneDec(),
+	}, p)
+
+	p = p.Add(types.NewDenomPerformance("UMEE", sdk.NewDec(14), sdk.NewDec(10), false))
+	p = p.Add(types.NewDenomPerformance("UMEE", sdk.NewDec(11), sdk.NewDec(10), true))
+	assert.DeepEqual(t, types.DenomPerformance{
+		Denom: "UMEE", VotesCast: 3, VotesInBand: 2, AvgAbsDeviation: sdk.NewDec(2),
+	}, p)
+
+	// adding an empty performance is a no-op
+	assert.DeepEqual(t, p, p.Add(types.DenomPerformance{Denom: "UMEE", AvgAbsDeviation: sdk.ZeroDec()}))
+}
+
+func TestValidatorPerformanceAddDenoms(t *testing.T) {
+	vp := types.ValidatorPerformance{Validator: "val"}
+	vp.AddDenoms([]types.DenomPerformance{
+		types.NewDenomPerformance("UMEE", sdk.NewDec(9), sdk.NewDec(10), true),
+		types.NewDenomPerformance("ATOM", sdk.NewDec(10), sdk.NewDec(10), true),
+	})
+	vp.AddDenoms([]types.DenomPerformance{
+		types.NewDenomPerformance("UMEE", sdk.NewDec(13), sdk.NewDec(10), false),
+	})
+	assert.DeepEqual(t, []types.DenomPerformance{
+		{Denom: "UMEE", VotesCast: 2, VotesInBand: 1, AvgAbsDeviation: sdk.NewDec(2)},
+		{Denom: "ATOM", VotesCast: 1, VotesInBand: 1, AvgAbsDeviation: sdk.ZeroDec()},
+	}, vp.Denoms)
+}
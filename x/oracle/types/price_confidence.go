@@ -0,0 +1,76 @@
+package types
+
+import (
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	firstQuartile = sdk.NewDecWithPrec(25, 2)
+	thirdQuartile = sdk.NewDecWithPrec(75, 2)
+)
+
+// NewPriceConfidence computes the dispersion of a ballot tallied into the given exchange rate.
+// Abstentions are not counted. totalBondedPower must be positive.
+func NewPriceConfidence(
+	denom string,
+	ballot ExchangeRateBallot,
+	exchangeRate sdk.Dec,
+	totalBondedPower int64,
+) (PriceConfidence, error) {
+	votes := make(ExchangeRateBallot, 0, len(ballot))
+	for _, v := range ballot {
+		if v.ExchangeRate.IsPositive() {
+			votes = append(votes, v)
+		}
+	}
+	sort.Sort(votes)
+
+	c := PriceConfidence{
+		Denom:      denom,
+		Voters:     uint32(len(votes)),
+		PowerShare: sdk.NewDec(votes.Power()).QuoInt64(totalBondedPower),
+		Spread:     sdk.ZeroDec(),
+	}
+	if len(votes) == 0 || !exchangeRate.IsPositive() {
+		return c, nil
+	}
+	q1, err := votes.WeightedQuantile(firstQuartile)
+	if err != nil {
+		return PriceConfidence{}, err
+	}
+	q3, err := votes.WeightedQuantile(thirdQuartile)
+	if err != nil {
+		return PriceConfidence{}, err
+	}
+	c.Spread = q3.Sub(q1).Quo(exchangeRate)
+	return c, nil
+}
+
+// CheckConfidence returns ErrLowConfidence if the confidence doesn't meet the requirements
+// of the denom. Exchange rates without confidence (not tallied) are always accepted.
+func (d Denom) CheckConfidence(c *PriceConfidence) error {
+	if c == nil {
+		return nil
+	}
+	if c.Voters < d.MinConfidenceVoters {
+		return ErrLowConfidence.Wrapf("%s: %d voters, min %d", d.SymbolDenom, c.Voters, d.MinConfidenceVoters)
+	}
+	if d.MaxConfidenceSpread != nil && c.Spread.GT(*d.MaxConfidenceSpread) {
+		return ErrLowConfidence.Wrapf("%s: spread %s, max %s", d.SymbolDenom, c.Spread, d.MaxConfidenceSpread)
+	}
+	return nil
+}
+
+// CheckConfidence checks the confidence of an exchange rate against the requirements of the
+// first denom with the given symbol denom. Denoms outside of the list have no requirements.
+func (dl DenomList) CheckConfidence(symbolDenom string, c *PriceConfidence) error {
+	for _, d := range dl {
+		if strings.EqualFold(d.SymbolDenom, symbolDenom) {
+			return d.CheckConfidence(c)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestNewPriceConfidence(t *testing.T) {
+	vote := func(rate string, power int64) types.VoteForTally {
+		addr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+		return types.NewVoteForTally(sdk.MustNewDecFromStr(rate), "UMEE", addr, power)
+	}
+	ballot := types.ExchangeRateBallot{
+		vote("1.2", 10), vote("1.0", 10), vote("0.8", 10), vote("1.1", 10), vote("0", 10),
+	}
+
+	// abstentions are not counted, and the quartiles are 0.8 and 1.1
+	c, err := types.NewPriceConfidence("UMEE", ballot, sdk.NewDec(1), 100)
+	assert.NilError(t, err)
+	assert.Equal(t, "UMEE", c.Denom)
+	assert.Equal(t, uint32(4), c.Voters)
+	assert.DeepEqual(t, sdk.NewDecWithPrec(4, 1), c.PowerShare)
+	assert.DeepEqual(t, sdk.NewDecWithPrec(3, 1), c.Spread)
+
+	// a single voter has no spread
+	c, err = types.NewPriceConfidence("UMEE", ballot[:1], sdk.MustNewDecFromStr("1.2"), 100)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(1), c.Voters)
+	assert.DeepEqual(t, sdk.ZeroDec(), c.Spread)
+
+	c, err = types.NewPriceConfidence("UMEE", types.ExchangeRateBallot{}, sdk.ZeroDec(), 100)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(0), c.Voters)
+	assert.DeepEqual(t, sdk.ZeroDec(), c.PowerShare)
+}
+
+func TestCheckConfidence(t *testing.T) {
+	maxSpread := sdk.NewDecWithPrec(1, 2)
+	acceptList := types.DenomList{
+		{BaseDenom: "uumee", SymbolDenom: "UMEE", MinConfidenceVoters: 3, MaxConfidenceSpread: &maxSpread},
+		{BaseDenom: "uatom", SymbolDenom: "ATOM"},
+	}
+	confidence := func(voters uint32, spread string) *types.PriceConfidence {
+		return &types.PriceConfidence{Voters: voters, Spread: sdk.MustNewDecFromStr(spread)}
+	}
+
+	assert.NilError(t, acceptList.CheckConfidence("umee", confidence(3, "0.01")))
+	assert.ErrorIs(t, acceptList.CheckConfidence("UMEE", confidence(2, "0.01")), types.ErrLowConfidence)
+	assert.ErrorIs(t, acceptList.CheckConfidence("UMEE", confidence(3, "0.011")), types.ErrLowConfidence)
+	// exchange rates without confidence, and denoms without requirements are always accepted
+	assert.NilError(t, acceptList.CheckConfidence("UMEE", nil))
+	assert.NilError(t, acceptList.CheckConfidence("ATOM", confidence(1, "1")))
+	assert.NilError(t, acceptList.CheckConfidence("BTC", confidence(1, "1")))
+}
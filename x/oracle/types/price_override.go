@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+)
+
+// MaxPriceOverrideDuration is the maximum duration of a price override. Longer overrides must
+// be renewed.
+const MaxPriceOverrideDuration = 72 * time.Hour
+
+// Validate performs a basic validation of the price override.
+func (o PriceOverride) Validate() error {
+	if len(o.SymbolDenom) == 0 {
+		return ErrInvalidPriceOverride.Wrap("empty symbol denom")
+	}
+	if o.ExchangeRate.IsNil() || !o.ExchangeRate.IsPositive() {
+		return ErrInvalidPriceOverride.Wrap("exchange rate must be positive")
+	}
+	return nil
+}
+
+// IsExpired returns true if the price override is expired at the given block time.
+func (o PriceOverride) IsExpired(now time.Time) bool {
+	return !now.Before(o.Expiry)
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/util/checkers"
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestMsgGovSetPriceOverride(t *testing.T) {
+	gov := checkers.GovModuleAddr
+	eg := sdk.AccAddress([]byte("addr1_______________")).String()
+	rate := sdk.NewDec(2)
+	tcs := []struct {
+		name   string
+		msg    *types.MsgGovSetPriceOverride
+		errMsg string
+	}{
+		{"gov", types.NewMsgGovSetPriceOverride(gov, "", "ATOM", rate, time.Hour), ""},
+		{"emergency group", types.NewMsgGovSetPriceOverride(eg, "depeg", "ATOM", rate, time.Hour), ""},
+		{"gov description", types.NewMsgGovSetPriceOverride(gov, "depeg", "ATOM", rate, time.Hour), "description must be empty"},
+		{"no description", types.NewMsgGovSetPriceOverride(eg, "", "ATOM", rate, time.Hour), "description must be not empty"},
+		{"empty denom", types.NewMsgGovSetPriceOverride(gov, "", "", rate, time.Hour), "empty symbol denom"},
+		{"nil rate", types.NewMsgGovSetPriceOverride(gov, "", "ATOM", sdk.Dec{}, time.Hour), "must be positive"},
+		{"zero rate", types.NewMsgGovSetPriceOverride(gov, "", "ATOM", sdk.ZeroDec(), time.Hour), "must be positive"},
+		{"no duration", types.NewMsgGovSetPriceOverride(gov, "", "ATOM", rate, 0), "duration must be positive"},
+		{
+			"long duration",
+			types.NewMsgGovSetPriceOverride(gov, "", "ATOM", rate, types.MaxPriceOverrideDuration+time.Second),
+			"duration must be positive and at most",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := tc.msg.ValidateBasic()
+		if tc.errMsg == "" {
+			assert.NilError(t, err, tc.name)
+		} else {
+			assert.ErrorContains(t, err, tc.errMsg, tc.name)
+		}
+	}
+}
+
+func TestMsgGovCancelPriceOverride(t *testing.T) {
+	gov := checkers.GovModuleAddr
+	assert.NilError(t, types.NewMsgGovCancelPriceOverride(gov, "ATOM").ValidateBasic())
+	assert.ErrorContains(t, types.NewMsgGovCancelPriceOverride(gov, "").ValidateBasic(), "empty symbol denom")
+	err := types.NewMsgGovCancelPriceOverride(sdk.AccAddress([]byte("addr1_______________")).String(), "ATOM").
+		ValidateBasic()
+	assert.ErrorContains(t, err, "expected "+gov)
+}
+
+func TestPriceOverrideIsExpired(t *testing.T) {
+	now := time.Now()
+	o := types.PriceOverride{SymbolDenom: "ATOM", ExchangeRate: sdk.OneDec(), Expiry: now}
+	assert.Assert(t, !o.IsExpired(now.Add(-time.Second)))
+	assert.Assert(t, o.IsExpired(now))
+}
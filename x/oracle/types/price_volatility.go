@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VolatilityDecay is the decay factor of the exponentially weighted moving average of the
+// squared exchange rate changes.
+var VolatilityDecay = sdk.NewDecWithPrec(94, 2)
+
+// RelativeChange returns (to - from) / from. from must be positive.
+func RelativeChange(from, to sdk.Dec) sdk.Dec {
+	return to.Sub(from).Quo(from)
+}
+
+// Update adds a relative exchange rate change to the volatility estimate. The first change
+// initializes the variance.
+func (pv PriceVolatility) Update(change sdk.Dec) PriceVolatility {
+	sq := change.Mul(change)
+	if pv.Samples == 0 || pv.Variance.IsNil() {
+		pv.Variance = sq
+	} else {
+		pv.Variance = VolatilityDecay.Mul(pv.Variance).Add(sdk.OneDec().Sub(VolatilityDecay).Mul(sq))
+	}
+	pv.Samples++
+	return pv
+}
+
+// Volatility returns the estimated standard deviation of the exchange rate change over a
+// vote period.
+func (pv PriceVolatility) Volatility() (sdk.Dec, error) {
+	return pv.Variance.ApproxSqrt()
+}
+
+// Validate performs a basic validation of the price volatility.
+func (pv PriceVolatility) Validate() error {
+	if pv.Denom == "" {
+		return fmt.Errorf("price volatility denom can't be empty")
+	}
+	if pv.Variance.IsNil() || pv.Variance.IsNegative() {
+		return fmt.Errorf("price volatility of %s must have a non negative variance", pv.Denom)
+	}
+	return nil
+}
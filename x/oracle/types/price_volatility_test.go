@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestRelativeChange(t *testing.T) {
+	assert.DeepEqual(t, sdk.NewDecWithPrec(1, 1), types.RelativeChange(sdk.NewDec(10), sdk.NewDec(11)))
+	assert.DeepEqual(t, sdk.NewDecWithPrec(-5, 1), types.RelativeChange(sdk.NewDec(10), sdk.NewDec(5)))
+}
+
+func TestPriceVolatilityUpdate(t *testing.T) {
+	pv := types.PriceVolatility{Denom: "UMEE", Variance: sdk.ZeroDec()}
+
+	// the first change initializes the variance
+	pv = pv.Update(sdk.NewDecWithPrec(1, 1))
+	assert.Equal(t, uint64(1), pv.Samples)
+	assert.DeepEqual(t, sdk.NewDecWithPrec(1, 2), pv.Variance)
+	v, err := pv.Volatility()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewDecWithPrec(1, 1), v)
+
+	// 0.94 * 0.01 + 0.06 * 0
+	pv = pv.Update(sdk.ZeroDec())
+	assert.Equal(t, uint64(2), pv.Samples)
+	assert.DeepEqual(t, sdk.MustNewDecFromStr("0.0094"), pv.Variance)
+
+	// 0.94 * 0.0094 + 0.06 * 0.04
+	pv = pv.Update(sdk.NewDecWithPrec(-2, 1))
+	assert.DeepEqual(t, sdk.MustNewDecFromStr("0.011236"), pv.Variance)
+	v, err = pv.Volatility()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.MustNewDecFromStr("0.106"), v)
+}
+
+func TestPriceVolatilityValidate(t *testing.T) {
+	assert.NilError(t, types.PriceVolatility{Denom: "UMEE", Variance: sdk.ZeroDec()}.Validate())
+	assert.ErrorContains(t, types.PriceVolatility{Variance: sdk.ZeroDec()}.Validate(), "denom can't be empty")
+	assert.ErrorContains(t, types.PriceVolatility{Denom: "UMEE"}.Validate(), "non negative variance")
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceWindow summarizes the prices over the blocks (start, end]. Prices must be sorted by
+// block. Returns false if there is no price in effect in the window.
+func NewPriceWindow(prices Prices, start, end uint64) (PriceWindow, bool) {
+	w := PriceWindow{StartBlock: start, EndBlock: end}
+
+	var open *Price
+	inWindow := Prices{}
+	for i := range prices {
+		switch {
+		case prices[i].BlockNum <= start:
+			open = &prices[i]
+		case prices[i].BlockNum <= end:
+			inWindow = append(inWindow, prices[i])
+		}
+	}
+	if open == nil {
+		if len(inWindow) == 0 {
+			return w, false
+		}
+		open = &inWindow[0]
+	}
+
+	// from is the first block with a price in effect
+	from := open.BlockNum
+	if from < start {
+		from = start
+	}
+	current, since := open.ExchangeRateTuple.ExchangeRate, from
+	w.Open, w.Min, w.Max, w.Close = current, current, current, current
+
+	weighted := sdk.ZeroDec()
+	for _, p := range inWindow {
+		rate := p.ExchangeRateTuple.ExchangeRate
+		weighted = weighted.Add(current.MulInt64(int64(p.BlockNum - since)))
+		current, since = rate, p.BlockNum
+		w.Min = sdk.MinDec(w.Min, rate)
+		w.Max = sdk.MaxDec(w.Max, rate)
+		w.Close = rate
+	}
+	weighted = weighted.Add(current.MulInt64(int64(end - since)))
+
+	if end == from {
+		w.Twap = current
+	} else {
+		w.Twap = weighted.QuoInt64(int64(end - from))
+	}
+	w.NumStamps = uint32(len(inWindow))
+
+	return w, true
+}
+
+// NewPriceCandles splits the blocks (start, end] into candles of resolution blocks, and
+// summarizes the prices over each of them. The last candle is truncated at end. Prices must be
+// sorted by block. Candles without a price in effect are omitted.
+func NewPriceCandles(prices Prices, start, end, resolution uint64) []PriceWindow {
+	candles := []PriceWindow{}
+	for from := start; from < end; from += resolution {
+		to := from + resolution
+		if to > end {
+			to = end
+		}
+		if c, ok := NewPriceWindow(prices, from, to); ok {
+			candles = append(candles, c)
+		}
+	}
+	return candles
+}
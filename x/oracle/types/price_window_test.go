@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestNewPriceWindow(t *testing.T) {
+	prices := types.Prices{
+		types.NewPrice(sdk.NewDec(10), "ATOM", 10),
+		types.NewPrice(sdk.NewDec(20), "ATOM", 20),
+		types.NewPrice(sdk.NewDec(4), "ATOM", 30),
+	}
+	window := func(start, end uint64, twap, min, max, open, close int64, n uint32) types.PriceWindow {
+		return types.PriceWindow{
+			StartBlock: start, EndBlock: end, Twap: sdk.NewDec(twap), Min: sdk.NewDec(min),
+			Max: sdk.NewDec(max), Open: sdk.NewDec(open), Close: sdk.NewDec(close), NumStamps: n,
+		}
+	}
+
+	tcs := []struct {
+		name       string
+		start, end uint64
+		expected   types.PriceWindow
+		ok         bool
+	}{
+		{"before the first stamp", 0, 5, types.PriceWindow{}, false},
+		{"starts before the first stamp", 0, 30, window(0, 30, 15, 4, 20, 10, 4, 3), true},
+		{"carried price", 15, 25, window(15, 25, 15, 10, 20, 10, 20, 1), true},
+		{"no stamp in the window", 31, 40, window(31, 40, 4, 4, 4, 4, 4, 0), true},
+		{"single block", 19, 20, window(19, 20, 10, 10, 20, 10, 20, 1), true},
+	}
+
+	for _, tc := range tcs {
+		w, ok := types.NewPriceWindow(prices, tc.start, tc.end)
+		assert.Equal(t, tc.ok, ok, tc.name)
+		if tc.ok {
+			assert.DeepEqual(t, tc.expected, w)
+		}
+	}
+
+	candles := types.NewPriceCandles(prices, 0, 35, 10)
+	assert.DeepEqual(t, []types.PriceWindow{
+		window(0, 10, 10, 10, 10, 10, 10, 1),
+		window(10, 20, 10, 10, 20, 10, 20, 1),
+		window(20, 30, 20, 4, 20, 20, 4, 1),
+		window(30, 35, 4, 4, 4, 4, 4, 0),
+	}, candles)
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -275,6 +276,11 @@ type QueryExchangeRatesResponse struct {
 	// exchange_rates defines a list of the exchange rate for all whitelisted
 	// denoms.
 	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+	// overrides are the active price overrides of the returned exchange rates. Overridden
+	// exchange rates are set manually, instead of being tallied.
+	Overrides []PriceOverride `protobuf:"bytes,2,rep,name=overrides,proto3" json:"overrides"`
+	// confidences are the ballot dispersions of the returned exchange rates which were tallied.
+	Confidences []PriceConfidence `protobuf:"bytes,3,rep,name=confidences,proto3" json:"confidences"`
 }
 
 func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesResponse{} }
@@ -469,6 +475,86 @@ func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
 
+// QueryFeeders is the request type for the Query/Feeders RPC method.
+type QueryFeeders struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryFeeders) Reset()         { *m = QueryFeeders{} }
+func (m *QueryFeeders) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeders) ProtoMessage()    {}
+func (*QueryFeeders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{11}
+}
+func (m *QueryFeeders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeders.Merge(m, src)
+}
+func (m *QueryFeeders) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeders) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeders proto.InternalMessageInfo
+
+// QueryFeedersResponse is response type for the Query/Feeders RPC method.
+type QueryFeedersResponse struct {
+	// feeder_addr is the feeder delegation of the validator (the validator itself by default).
+	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
+	// standby_feeder_addrs are the standby feeders of the validator.
+	StandbyFeederAddrs []string `protobuf:"bytes,2,rep,name=standby_feeder_addrs,json=standbyFeederAddrs,proto3" json:"standby_feeder_addrs,omitempty"`
+}
+
+func (m *QueryFeedersResponse) Reset()         { *m = QueryFeedersResponse{} }
+func (m *QueryFeedersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedersResponse) ProtoMessage()    {}
+func (*QueryFeedersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{12}
+}
+func (m *QueryFeedersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedersResponse.Merge(m, src)
+}
+func (m *QueryFeedersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedersResponse proto.InternalMessageInfo
+
 // QueryMissCounter is the request type for the Query/MissCounter RPC
 // method.
 type QueryMissCounter struct {
@@ -480,7 +566,7 @@ func (m *QueryMissCounter) Reset()         { *m = QueryMissCounter{} }
 func (m *QueryMissCounter) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounter) ProtoMessage()    {}
 func (*QueryMissCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{11}
+	return fileDescriptor_710e319bc1815d33, []int{13}
 }
 func (m *QueryMissCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +606,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{12}
+	return fileDescriptor_710e319bc1815d33, []int{14}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +644,7 @@ func (m *QuerySlashWindow) Reset()         { *m = QuerySlashWindow{} }
 func (m *QuerySlashWindow) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindow) ProtoMessage()    {}
 func (*QuerySlashWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{13}
+	return fileDescriptor_710e319bc1815d33, []int{15}
 }
 func (m *QuerySlashWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +685,7 @@ func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowRespon
 func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowResponse) ProtoMessage()    {}
 func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{14}
+	return fileDescriptor_710e319bc1815d33, []int{16}
 }
 func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +725,7 @@ func (m *QueryAggregatePrevote) Reset()         { *m = QueryAggregatePrevote{} }
 func (m *QueryAggregatePrevote) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevote) ProtoMessage()    {}
 func (*QueryAggregatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{15}
+	return fileDescriptor_710e319bc1815d33, []int{17}
 }
 func (m *QueryAggregatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +766,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{16}
+	return fileDescriptor_710e319bc1815d33, []int{18}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +804,7 @@ func (m *QueryAggregatePrevotes) Reset()         { *m = QueryAggregatePrevotes{}
 func (m *QueryAggregatePrevotes) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotes) ProtoMessage()    {}
 func (*QueryAggregatePrevotes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{17}
+	return fileDescriptor_710e319bc1815d33, []int{19}
 }
 func (m *QueryAggregatePrevotes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +845,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{18}
+	return fileDescriptor_710e319bc1815d33, []int{20}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +885,7 @@ func (m *QueryAggregateVote) Reset()         { *m = QueryAggregateVote{} }
 func (m *QueryAggregateVote) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVote) ProtoMessage()    {}
 func (*QueryAggregateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{19}
+	return fileDescriptor_710e319bc1815d33, []int{21}
 }
 func (m *QueryAggregateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +926,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{20}
+	return fileDescriptor_710e319bc1815d33, []int{22}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +964,7 @@ func (m *QueryAggregateVotes) Reset()         { *m = QueryAggregateVotes{} }
 func (m *QueryAggregateVotes) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotes) ProtoMessage()    {}
 func (*QueryAggregateVotes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{21}
+	return fileDescriptor_710e319bc1815d33, []int{23}
 }
 func (m *QueryAggregateVotes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1005,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{22}
+	return fileDescriptor_710e319bc1815d33, []int{24}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,7 +1042,7 @@ func (m *QueryParams) Reset()         { *m = QueryParams{} }
 func (m *QueryParams) String() string { return proto.CompactTextString(m) }
 func (*QueryParams) ProtoMessage()    {}
 func (*QueryParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{23}
+	return fileDescriptor_710e319bc1815d33, []int{25}
 }
 func (m *QueryParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,7 +1081,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{24}
+	return fileDescriptor_710e319bc1815d33, []int{26}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1123,7 @@ func (m *QueryMedians) Reset()         { *m = QueryMedians{} }
 func (m *QueryMedians) String() string { return proto.CompactTextString(m) }
 func (*QueryMedians) ProtoMessage()    {}
 func (*QueryMedians) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{25}
+	return fileDescriptor_710e319bc1815d33, []int{27}
 }
 func (m *QueryMedians) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1163,7 @@ func (m *QueryMediansResponse) Reset()         { *m = QueryMediansResponse{} }
 func (m *QueryMediansResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMediansResponse) ProtoMessage()    {}
 func (*QueryMediansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{26}
+	return fileDescriptor_710e319bc1815d33, []int{28}
 }
 func (m *QueryMediansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1202,7 @@ func (m *QueryMedianDeviations) Reset()         { *m = QueryMedianDeviations{} }
 func (m *QueryMedianDeviations) String() string { return proto.CompactTextString(m) }
 func (*QueryMedianDeviations) ProtoMessage()    {}
 func (*QueryMedianDeviations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{27}
+	return fileDescriptor_710e319bc1815d33, []int{29}
 }
 func (m *QueryMedianDeviations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,7 +1242,7 @@ func (m *QueryMedianDeviationsResponse) Reset()         { *m = QueryMedianDeviat
 func (m *QueryMedianDeviationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMedianDeviationsResponse) ProtoMessage()    {}
 func (*QueryMedianDeviationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{28}
+	return fileDescriptor_710e319bc1815d33, []int{30}
 }
 func (m *QueryMedianDeviationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1280,7 @@ func (m *QueryAvgPrice) Reset()         { *m = QueryAvgPrice{} }
 func (m *QueryAvgPrice) String() string { return proto.CompactTextString(m) }
 func (*QueryAvgPrice) ProtoMessage()    {}
 func (*QueryAvgPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{29}
+	return fileDescriptor_710e319bc1815d33, []int{31}
 }
 func (m *QueryAvgPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,7 +1318,7 @@ func (m *QueryAvgPriceResponse) Reset()         { *m = QueryAvgPriceResponse{} }
 func (m *QueryAvgPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAvgPriceResponse) ProtoMessage()    {}
 func (*QueryAvgPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{30}
+	return fileDescriptor_710e319bc1815d33, []int{32}
 }
 func (m *QueryAvgPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,1519 +1347,2126 @@ func (m *QueryAvgPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAvgPriceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryMissCounters)(nil), "umee.oracle.v1.QueryMissCounters")
-	proto.RegisterType((*QueryMissCountersResponse)(nil), "umee.oracle.v1.QueryMissCountersResponse")
-	proto.RegisterType((*PriceMissCounter)(nil), "umee.oracle.v1.PriceMissCounter")
-	proto.RegisterType((*QueryExgRatesWithTimestamp)(nil), "umee.oracle.v1.QueryExgRatesWithTimestamp")
-	proto.RegisterType((*QueryExgRatesWithTimestampResponse)(nil), "umee.oracle.v1.QueryExgRatesWithTimestampResponse")
-	proto.RegisterType((*QueryExchangeRates)(nil), "umee.oracle.v1.QueryExchangeRates")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "umee.oracle.v1.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActiveExchangeRates)(nil), "umee.oracle.v1.QueryActiveExchangeRates")
-	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "umee.oracle.v1.QueryActiveExchangeRatesResponse")
-	proto.RegisterType((*QueryFeederDelegation)(nil), "umee.oracle.v1.QueryFeederDelegation")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "umee.oracle.v1.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounter)(nil), "umee.oracle.v1.QueryMissCounter")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "umee.oracle.v1.QueryMissCounterResponse")
-	proto.RegisterType((*QuerySlashWindow)(nil), "umee.oracle.v1.QuerySlashWindow")
-	proto.RegisterType((*QuerySlashWindowResponse)(nil), "umee.oracle.v1.QuerySlashWindowResponse")
-	proto.RegisterType((*QueryAggregatePrevote)(nil), "umee.oracle.v1.QueryAggregatePrevote")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "umee.oracle.v1.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotes)(nil), "umee.oracle.v1.QueryAggregatePrevotes")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "umee.oracle.v1.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVote)(nil), "umee.oracle.v1.QueryAggregateVote")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "umee.oracle.v1.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotes)(nil), "umee.oracle.v1.QueryAggregateVotes")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "umee.oracle.v1.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParams)(nil), "umee.oracle.v1.QueryParams")
-	proto.RegisterType((*QueryParamsResponse)(nil), "umee.oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryMedians)(nil), "umee.oracle.v1.QueryMedians")
-	proto.RegisterType((*QueryMediansResponse)(nil), "umee.oracle.v1.QueryMediansResponse")
-	proto.RegisterType((*QueryMedianDeviations)(nil), "umee.oracle.v1.QueryMedianDeviations")
-	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "umee.oracle.v1.QueryMedianDeviationsResponse")
-	proto.RegisterType((*QueryAvgPrice)(nil), "umee.oracle.v1.QueryAvgPrice")
-	proto.RegisterType((*QueryAvgPriceResponse)(nil), "umee.oracle.v1.QueryAvgPriceResponse")
+// QueryDenomVoteSettings is the request type for the Query/DenomVoteSettings RPC method.
+type QueryDenomVoteSettings struct {
+	// denom is the symbol denom to query for. All accepted denoms are returned if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("umee/oracle/v1/query.proto", fileDescriptor_710e319bc1815d33) }
-
-var fileDescriptor_710e319bc1815d33 = []byte{
-	// 1398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x98, 0xcd, 0x6f, 0x13, 0xc7,
-	0x1b, 0xc7, 0xbd, 0xfc, 0x78, 0xcb, 0xe3, 0xd8, 0x38, 0x03, 0x41, 0xf9, 0x2d, 0xc1, 0x4e, 0x06,
-	0x02, 0xe1, 0x25, 0xbb, 0x60, 0x42, 0x5b, 0x51, 0x50, 0x4b, 0x5e, 0xda, 0x4a, 0x80, 0x94, 0x9a,
-	0x0a, 0xaa, 0x5e, 0xac, 0x8d, 0x3d, 0x5d, 0x2f, 0xc4, 0xbb, 0xee, 0xce, 0xc6, 0x09, 0x42, 0x88,
-	0xaa, 0x5c, 0x7a, 0xac, 0x84, 0xc4, 0xb1, 0x42, 0x6d, 0xa5, 0x4a, 0xed, 0xa1, 0xff, 0x06, 0x47,
-	0xa4, 0x5e, 0xaa, 0x56, 0xa2, 0x2d, 0xf4, 0xd0, 0x3f, 0xa3, 0xda, 0x99, 0xf1, 0x78, 0xf6, 0xcd,
-	0x76, 0x72, 0x02, 0xcf, 0xf3, 0xcc, 0xf7, 0xf9, 0xcc, 0xb3, 0x33, 0xf3, 0xdd, 0x2c, 0xe8, 0x9b,
-	0x6d, 0x42, 0x4c, 0xcf, 0xb7, 0x1a, 0x1b, 0xc4, 0xec, 0x5e, 0x34, 0xbf, 0xd8, 0x24, 0xfe, 0x03,
-	0xa3, 0xe3, 0x7b, 0x81, 0x87, 0x8a, 0x61, 0xcc, 0xe0, 0x31, 0xa3, 0x7b, 0x51, 0x3f, 0x62, 0x7b,
-	0xb6, 0xc7, 0x42, 0x66, 0xf8, 0x3f, 0x9e, 0xa5, 0x4f, 0xdb, 0x9e, 0x67, 0x6f, 0x10, 0xd3, 0xea,
-	0x38, 0xa6, 0xe5, 0xba, 0x5e, 0x60, 0x05, 0x8e, 0xe7, 0x52, 0x11, 0x3d, 0x16, 0xd3, 0x17, 0x6a,
-	0x62, 0x6a, 0x2c, 0x68, 0x13, 0x97, 0x50, 0xa7, 0x37, 0xb5, 0xdc, 0xf0, 0x68, 0xdb, 0xa3, 0xe6,
-	0xba, 0x45, 0xc3, 0xe8, 0x3a, 0x09, 0xac, 0x8b, 0x66, 0xc3, 0x73, 0x5c, 0x1e, 0xc7, 0xef, 0xc2,
-	0xc4, 0xc7, 0x21, 0xed, 0x2d, 0x87, 0xd2, 0x65, 0x6f, 0xd3, 0x0d, 0x88, 0x4f, 0xd1, 0x34, 0x8c,
-	0x75, 0xad, 0x0d, 0xa7, 0x69, 0x05, 0x9e, 0x3f, 0xa5, 0xcd, 0x68, 0xf3, 0x63, 0xb5, 0xfe, 0xc0,
-	0x95, 0x83, 0x5f, 0x3f, 0xaf, 0xe4, 0xfe, 0x7d, 0x5e, 0xc9, 0xe1, 0x16, 0xfc, 0x3f, 0x31, 0xb9,
-	0x46, 0x68, 0xc7, 0x73, 0x29, 0x41, 0x37, 0xa0, 0xd0, 0x76, 0x28, 0xad, 0x37, 0x44, 0x60, 0x4a,
-	0x9b, 0xf9, 0xdf, 0x7c, 0xbe, 0x3a, 0x63, 0x44, 0x1b, 0x62, 0xac, 0xf9, 0x4e, 0x83, 0x28, 0x0a,
-	0x4b, 0x7b, 0x5f, 0xbc, 0xaa, 0xe4, 0x6a, 0xe3, 0x6d, 0x45, 0x14, 0xdf, 0x86, 0x52, 0x3c, 0x6f,
-	0x30, 0x25, 0x9a, 0x85, 0x71, 0xb5, 0xfc, 0xd4, 0x9e, 0x19, 0x6d, 0x7e, 0x6f, 0x2d, 0xaf, 0xa8,
-	0xe2, 0xab, 0xa0, 0x33, 0xfc, 0xd5, 0x6d, 0xbb, 0x66, 0x05, 0x84, 0xde, 0x75, 0x82, 0xd6, 0x27,
-	0x4e, 0x9b, 0xd0, 0xc0, 0x6a, 0x77, 0xd0, 0x11, 0xd8, 0xd7, 0x24, 0xae, 0xd7, 0x16, 0xd2, 0xfc,
-	0x87, 0xb2, 0xf8, 0x7b, 0x80, 0xb3, 0x67, 0xcb, 0x2e, 0xac, 0xc0, 0x18, 0xd9, 0xb6, 0xeb, 0x7e,
-	0x98, 0x21, 0x3a, 0x30, 0x1b, 0xef, 0xc0, 0x4a, 0xa8, 0xbc, 0xba, 0xdd, 0x68, 0x59, 0xae, 0x4d,
-	0x42, 0x2d, 0xd1, 0x82, 0x83, 0x44, 0x48, 0xe3, 0x45, 0x40, 0xa2, 0x56, 0x3f, 0x89, 0x0e, 0x25,
-	0x7c, 0xa6, 0xc9, 0x05, 0x2a, 0xd3, 0x24, 0xda, 0x36, 0x14, 0x89, 0x08, 0x44, 0xf8, 0xa6, 0x0d,
-	0xbe, 0x67, 0x8c, 0x70, 0xcf, 0x18, 0x62, 0xcf, 0x18, 0x2b, 0xa4, 0xb1, 0xec, 0x39, 0xee, 0xd2,
-	0xa5, 0x10, 0xed, 0xa7, 0x3f, 0x2b, 0xe7, 0x6c, 0x27, 0x68, 0x6d, 0xae, 0x1b, 0x0d, 0xaf, 0x6d,
-	0x8a, 0x3d, 0xc6, 0xff, 0x59, 0xa0, 0xcd, 0xfb, 0x66, 0xf0, 0xa0, 0x43, 0x68, 0x6f, 0x0e, 0xad,
-	0x15, 0x88, 0x4a, 0x80, 0x75, 0x98, 0x62, 0x5c, 0xd7, 0x1b, 0x81, 0xd3, 0x25, 0x11, 0x3a, 0xbc,
-	0x0a, 0x33, 0x59, 0x31, 0x49, 0x3e, 0x0b, 0xe3, 0x16, 0x0b, 0x2b, 0xdc, 0x63, 0xb5, 0x3c, 0x1f,
-	0xe3, 0x32, 0x1f, 0xc1, 0x24, 0x93, 0xf9, 0x80, 0x90, 0x26, 0xf1, 0x57, 0xc8, 0x06, 0xb1, 0xd9,
-	0x91, 0x42, 0x73, 0x50, 0x94, 0x9b, 0xa4, 0x6e, 0x35, 0x9b, 0xbd, 0xad, 0x53, 0x90, 0xa3, 0xd7,
-	0x9b, 0x4d, 0x75, 0x93, 0xbf, 0x0f, 0xc7, 0x53, 0x95, 0x24, 0x4d, 0x05, 0xf2, 0x9f, 0xb3, 0x98,
-	0x2a, 0x07, 0x7c, 0x28, 0xd4, 0xc2, 0xcb, 0x50, 0x8a, 0x1f, 0x93, 0x9d, 0x63, 0x5c, 0x13, 0x3d,
-	0x53, 0x44, 0xd4, 0x7e, 0x44, 0xf6, 0xba, 0x96, 0xdc, 0xeb, 0x48, 0x30, 0xdc, 0xde, 0xb0, 0x68,
-	0xeb, 0xae, 0xe3, 0x36, 0xbd, 0x2d, 0xbc, 0x2c, 0x24, 0x95, 0x31, 0x29, 0x79, 0x1a, 0x0e, 0x6d,
-	0xb1, 0x91, 0x7a, 0xc7, 0xf7, 0x6c, 0x9f, 0x50, 0x2a, 0x54, 0x8b, 0x7c, 0x78, 0x4d, 0x8c, 0xca,
-	0x46, 0x5f, 0xb7, 0x6d, 0x3f, 0xec, 0x0c, 0x59, 0xf3, 0x49, 0xd7, 0x0b, 0xc8, 0xce, 0x57, 0xf8,
-	0xa5, 0x26, 0x3a, 0x1d, 0x97, 0x92, 0x50, 0x75, 0x98, 0xb0, 0x7a, 0xb1, 0x7a, 0x87, 0x07, 0x99,
-	0x6a, 0xbe, 0x7a, 0x3e, 0x7e, 0xa8, 0xa4, 0x88, 0xba, 0x85, 0x84, 0xa0, 0x38, 0x5f, 0x25, 0x2b,
-	0x56, 0x08, 0x4f, 0xc1, 0xd1, 0x54, 0x02, 0x8a, 0x9f, 0x68, 0x50, 0x4e, 0x0f, 0x49, 0x3a, 0x0b,
-	0x50, 0x82, 0xae, 0x77, 0xa6, 0x76, 0x83, 0x37, 0x61, 0x25, 0x28, 0x56, 0xc5, 0x3d, 0x20, 0x67,
-	0xdf, 0xd9, 0x55, 0xa7, 0x03, 0x71, 0x2f, 0x44, 0x64, 0xe4, 0x3a, 0xee, 0x40, 0xb1, 0xbf, 0x0e,
-	0xa5, 0xc5, 0x67, 0x46, 0x5a, 0xc3, 0x9d, 0xfe, 0x02, 0x0a, 0x96, 0xaa, 0x8f, 0x27, 0xe1, 0x70,
-	0xb2, 0x2a, 0xc5, 0x5b, 0x70, 0x2c, 0x65, 0x58, 0xd2, 0x7c, 0x0a, 0x87, 0xa2, 0x34, 0xbd, 0x96,
-	0xee, 0x18, 0xa7, 0x68, 0x45, 0x0b, 0x17, 0x20, 0xcf, 0x0a, 0xaf, 0x59, 0xbe, 0xd5, 0xa6, 0xf8,
-	0x86, 0xc0, 0xe3, 0x3f, 0x65, 0xfd, 0x45, 0xd8, 0xdf, 0x61, 0x23, 0xa2, 0x0b, 0x47, 0x13, 0xfe,
-	0xc5, 0xa2, 0xa2, 0x86, 0xc8, 0xc5, 0x37, 0x61, 0x9c, 0x9f, 0x56, 0xd2, 0x74, 0x2c, 0x37, 0xe3,
-	0xaa, 0x0e, 0x1d, 0xcc, 0xdd, 0x6c, 0xdf, 0x0e, 0x0d, 0x83, 0x32, 0x83, 0x2a, 0xd4, 0xfa, 0x03,
-	0xca, 0xf3, 0xba, 0x05, 0x47, 0x54, 0x35, 0xc9, 0x76, 0x19, 0x0e, 0xb4, 0xf9, 0x90, 0xe8, 0xc9,
-	0x64, 0xaa, 0xb9, 0x0a, 0xb6, 0x5e, 0x2e, 0x7e, 0x5b, 0x1c, 0x59, 0x2e, 0xb7, 0x42, 0xba, 0x0e,
-	0x7f, 0xdb, 0x18, 0x6a, 0x28, 0x2d, 0x71, 0x40, 0xe3, 0x13, 0x25, 0xd0, 0x87, 0x50, 0x6a, 0xc7,
-	0x62, 0xa3, 0x90, 0x25, 0x26, 0x61, 0x13, 0x0a, 0x7c, 0x53, 0x74, 0x6d, 0x96, 0x38, 0x14, 0xcd,
-	0xee, 0x5d, 0x43, 0x62, 0x82, 0x62, 0xc0, 0xfb, 0x3a, 0xe1, 0x00, 0x9f, 0xb8, 0x64, 0x84, 0x05,
-	0x7f, 0x7f, 0x55, 0x39, 0x35, 0x9a, 0x7d, 0xd5, 0xf8, 0xe4, 0x7e, 0xa1, 0xea, 0x1f, 0x13, 0xb0,
-	0x8f, 0x55, 0x42, 0xcf, 0x34, 0x28, 0x44, 0x0d, 0x19, 0xc7, 0x17, 0x99, 0x74, 0x5f, 0xfd, 0xec,
-	0xf0, 0x9c, 0x1e, 0x3b, 0xbe, 0xfc, 0xd5, 0xaf, 0xff, 0x3c, 0xdd, 0x63, 0xa2, 0x05, 0x33, 0xf6,
-	0x8e, 0xc7, 0x56, 0x4f, 0xcd, 0xa8, 0x7d, 0x9b, 0x0f, 0xd9, 0xf0, 0x23, 0xf4, 0xa3, 0x06, 0x87,
-	0x53, 0xec, 0x13, 0xcd, 0xa7, 0x96, 0x4e, 0xc9, 0xd4, 0x2f, 0x8c, 0x9a, 0x29, 0x51, 0x17, 0x19,
-	0xaa, 0x81, 0xce, 0x67, 0xa0, 0x0a, 0xbf, 0x8e, 0x12, 0xa3, 0x1f, 0x34, 0x28, 0x25, 0x1d, 0x3a,
-	0xb5, 0x78, 0x3c, 0x4d, 0x5f, 0x18, 0x29, 0x4d, 0x02, 0x5e, 0x61, 0x80, 0x8b, 0xa8, 0x1a, 0x07,
-	0x94, 0x97, 0x24, 0x35, 0x1f, 0x46, 0xaf, 0xd1, 0x47, 0x26, 0x37, 0x71, 0xf4, 0x54, 0x83, 0xbc,
-	0x6a, 0xde, 0x33, 0xa9, 0xa5, 0x95, 0x0c, 0x7d, 0x7e, 0x58, 0x86, 0xe4, 0x7a, 0x87, 0x71, 0x55,
-	0xd1, 0x85, 0x9d, 0x70, 0x85, 0xce, 0x8e, 0x1e, 0x43, 0x5e, 0x71, 0xee, 0x0c, 0x28, 0x25, 0x23,
-	0x03, 0x2a, 0xc5, 0xfd, 0xf1, 0x49, 0x06, 0x55, 0x46, 0xd3, 0x71, 0x28, 0x1a, 0x26, 0xd7, 0xf9,
-	0x2b, 0x00, 0xfa, 0x45, 0x83, 0x52, 0xd2, 0xf6, 0xd3, 0xb7, 0x4e, 0x2c, 0x2d, 0xe3, 0xe9, 0x65,
-	0x39, 0x3f, 0x5e, 0x65, 0x40, 0xef, 0xa1, 0x6b, 0x3b, 0xe9, 0x52, 0xc2, 0x8d, 0xd1, 0x77, 0x1a,
-	0x4c, 0x24, 0x0c, 0x1c, 0x9d, 0x1a, 0x89, 0x85, 0xea, 0xc6, 0x68, 0x79, 0xc3, 0x8f, 0xaf, 0x02,
-	0x9d, 0x7c, 0x63, 0x40, 0xdf, 0x6b, 0x50, 0x88, 0x1a, 0x3c, 0x1e, 0x5c, 0x38, 0xcc, 0xc9, 0xb8,
-	0x57, 0x52, 0x1d, 0x1e, 0x2f, 0x31, 0xb0, 0xab, 0xe8, 0xca, 0xee, 0xba, 0xc9, 0x5a, 0xf9, 0x4c,
-	0x83, 0x62, 0xd4, 0xb2, 0xd1, 0x89, 0xe1, 0x08, 0x54, 0x3f, 0x37, 0x42, 0x92, 0x04, 0xad, 0x32,
-	0xd0, 0xf3, 0xe8, 0xec, 0x48, 0x1d, 0xe4, 0xed, 0xbb, 0x07, 0xfb, 0xb9, 0x25, 0xa3, 0x63, 0xa9,
-	0xa5, 0x78, 0x50, 0x3f, 0x31, 0x20, 0x28, 0xeb, 0x97, 0x59, 0xfd, 0x29, 0x74, 0x34, 0x5e, 0x9f,
-	0xdb, 0x3c, 0x7a, 0x00, 0x07, 0x7a, 0x0e, 0x3f, 0x9d, 0x7e, 0xe2, 0x79, 0x54, 0x3f, 0x39, 0x28,
-	0x2a, 0xcb, 0x9d, 0x65, 0xe5, 0x4e, 0x22, 0xcc, 0xcb, 0xb5, 0x1c, 0x1a, 0x24, 0x2e, 0x52, 0x61,
-	0xe2, 0xe8, 0x5b, 0x0d, 0x4a, 0x09, 0x03, 0x9f, 0x1b, 0x50, 0xa6, 0x9f, 0x96, 0x71, 0xf8, 0xb2,
-	0x5c, 0x3d, 0x7e, 0xb7, 0x0f, 0xc0, 0xaa, 0x37, 0xfb, 0x2c, 0x8f, 0xe1, 0xa0, 0x74, 0xef, 0xe3,
-	0xe9, 0x0f, 0x5d, 0x84, 0xf5, 0xb9, 0x81, 0x61, 0xc9, 0xb1, 0xc0, 0x38, 0x4e, 0xa3, 0xb9, 0x34,
-	0x0e, 0xab, 0x6b, 0xd7, 0x99, 0x57, 0x4b, 0x1b, 0xfc, 0x59, 0x83, 0xc9, 0xf4, 0x3f, 0xed, 0xb3,
-	0x3c, 0x38, 0x25, 0x57, 0xaf, 0x8e, 0x9e, 0x3b, 0x7c, 0xdb, 0x4a, 0xdf, 0x16, 0x5f, 0x04, 0xea,
-	0x81, 0x64, 0x7a, 0xa2, 0xc1, 0x78, 0xe4, 0x23, 0xcc, 0xec, 0x30, 0x0b, 0xa1, 0xfa, 0x99, 0xa1,
-	0x29, 0x12, 0x69, 0x8e, 0x21, 0x55, 0xd0, 0xf1, 0x38, 0x52, 0xe4, 0x1b, 0xcd, 0xd2, 0xcd, 0x17,
-	0x7f, 0x97, 0x73, 0x2f, 0x5e, 0x97, 0xb5, 0x97, 0xaf, 0xcb, 0xda, 0x5f, 0xaf, 0xcb, 0xda, 0x37,
-	0x6f, 0xca, 0xb9, 0x97, 0x6f, 0xca, 0xb9, 0xdf, 0xde, 0x94, 0x73, 0x9f, 0x19, 0xca, 0x4b, 0x53,
-	0x28, 0xb3, 0xe0, 0x92, 0x60, 0xcb, 0xf3, 0xef, 0x73, 0xcd, 0xee, 0x5b, 0xe6, 0x76, 0x4f, 0x98,
-	0xbd, 0x40, 0xad, 0xef, 0x67, 0xdf, 0x98, 0x2e, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xad, 0x9f,
-	0x87, 0x1c, 0x20, 0x13, 0x00, 0x00,
+func (m *QueryDenomVoteSettings) Reset()         { *m = QueryDenomVoteSettings{} }
+func (m *QueryDenomVoteSettings) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomVoteSettings) ProtoMessage()    {}
+func (*QueryDenomVoteSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{33}
+}
+func (m *QueryDenomVoteSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomVoteSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomVoteSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomVoteSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomVoteSettings.Merge(m, src)
+}
+func (m *QueryDenomVoteSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomVoteSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomVoteSettings.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDenomVoteSettings proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRates returns exchange rates of all denoms,
-	// or, if specified, returns a single denom
-	ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Medians returns medians of all denoms,
-	// or, if specified, returns a single median
-	Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error)
-	// MedianDeviations returns median deviations of all denoms,
-	// or, if specified, returns a single median deviation
-	MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error)
-	// QueryAvgPrice returns avg price of a given denom (required).
-	AvgPrice(ctx context.Context, in *QueryAvgPrice, opts ...grpc.CallOption) (*QueryAvgPriceResponse, error)
-	// ExgRatesWithTimestamp returns exchange rates of all denoms with timestamp,
-	// or, if specified, returns a single denom
-	ExgRatesWithTimestamp(ctx context.Context, in *QueryExgRatesWithTimestamp, opts ...grpc.CallOption) (*QueryExgRatesWithTimestampResponse, error)
-	// MissCounters returns oracle missing votes count of validators.
-	MissCounters(ctx context.Context, in *QueryMissCounters, opts ...grpc.CallOption) (*QueryMissCountersResponse, error)
+// QueryDenomVoteSettingsResponse is response type for the Query/DenomVoteSettings RPC method.
+type QueryDenomVoteSettingsResponse struct {
+	Settings []DenomVoteSettings `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDenomVoteSettingsResponse) Reset()         { *m = QueryDenomVoteSettingsResponse{} }
+func (m *QueryDenomVoteSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomVoteSettingsResponse) ProtoMessage()    {}
+func (*QueryDenomVoteSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{34}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDenomVoteSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDenomVoteSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomVoteSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
-	out := new(QueryActiveExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ActiveExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDenomVoteSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomVoteSettingsResponse.Merge(m, src)
+}
+func (m *QueryDenomVoteSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomVoteSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomVoteSettingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryDenomVoteSettingsResponse proto.InternalMessageInfo
+
+// QueryDerivedFeeds is the request type for the Query/DerivedFeeds RPC method.
+type QueryDerivedFeeds struct {
 }
 
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
-	out := new(QueryMissCounterResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MissCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDerivedFeeds) Reset()         { *m = QueryDerivedFeeds{} }
+func (m *QueryDerivedFeeds) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedFeeds) ProtoMessage()    {}
+func (*QueryDerivedFeeds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{35}
+}
+func (m *QueryDerivedFeeds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedFeeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedFeeds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDerivedFeeds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedFeeds.Merge(m, src)
+}
+func (m *QueryDerivedFeeds) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedFeeds) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedFeeds.DiscardUnknown(m)
 }
 
-func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
-	out := new(QuerySlashWindowResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/SlashWindow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryDerivedFeeds proto.InternalMessageInfo
+
+// QueryDerivedFeedsResponse is response type for the Query/DerivedFeeds RPC method.
+type QueryDerivedFeedsResponse struct {
+	Feeds []DerivedFeed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds"`
 }
 
-func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
-	out := new(QueryAggregatePrevoteResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregatePrevote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDerivedFeedsResponse) Reset()         { *m = QueryDerivedFeedsResponse{} }
+func (m *QueryDerivedFeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedFeedsResponse) ProtoMessage()    {}
+func (*QueryDerivedFeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{36}
+}
+func (m *QueryDerivedFeedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedFeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedFeedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDerivedFeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedFeedsResponse.Merge(m, src)
+}
+func (m *QueryDerivedFeedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedFeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedFeedsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
-	out := new(QueryAggregatePrevotesResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregatePrevotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryDerivedFeedsResponse proto.InternalMessageInfo
+
+// QueryValidatorPerformance is the request type for the Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformance struct {
+	// validator is the validator operator address to query for. All validators with recorded
+	// performance are returned if empty.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
-	out := new(QueryAggregateVoteResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformance) Reset()         { *m = QueryValidatorPerformance{} }
+func (m *QueryValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformance) ProtoMessage()    {}
+func (*QueryValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{37}
+}
+func (m *QueryValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformance.Merge(m, src)
+}
+func (m *QueryValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformance.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
-	out := new(QueryAggregateVotesResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregateVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryValidatorPerformance proto.InternalMessageInfo
+
+// QueryValidatorPerformanceResponse is response type for the Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceResponse struct {
+	Performances []ValidatorPerformance `protobuf:"bytes,1,rep,name=performances,proto3" json:"performances"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPerformanceResponse) Reset()         { *m = QueryValidatorPerformanceResponse{} }
+func (m *QueryValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{38}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error) {
-	out := new(QueryMediansResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/Medians", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPerformanceResponse proto.InternalMessageInfo
+
+// QueryPriceWindow is the request type for the Query/PriceWindow RPC method.
+type QueryPriceWindow struct {
+	// denom is the symbol denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window_blocks is the number of blocks of the window, which ends at the current block.
+	// Historic prices are stamped every HistoricStampPeriod blocks, and kept for
+	// MaximumPriceStamps stamps, so the window can't be longer than their product.
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// resolution_blocks is the number of blocks of each candle. The window must be a multiple
+	// of it. No candles are returned if zero.
+	ResolutionBlocks uint64 `protobuf:"varint,3,opt,name=resolution_blocks,json=resolutionBlocks,proto3" json:"resolution_blocks,omitempty"`
+}
+
+func (m *QueryPriceWindow) Reset()         { *m = QueryPriceWindow{} }
+func (m *QueryPriceWindow) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceWindow) ProtoMessage()    {}
+func (*QueryPriceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{39}
+}
+func (m *QueryPriceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceWindow.Merge(m, src)
+}
+func (m *QueryPriceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceWindow.DiscardUnknown(m)
 }
 
-func (c *queryClient) MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error) {
-	out := new(QueryMedianDeviationsResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MedianDeviations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPriceWindow proto.InternalMessageInfo
+
+// QueryPriceWindowResponse is response type for the Query/PriceWindow RPC method.
+type QueryPriceWindowResponse struct {
+	Window PriceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window"`
+	// candles are the OHLC candles of the window, in chronological order. Candles before the
+	// first historic price stamp are omitted.
+	Candles []PriceWindow `protobuf:"bytes,2,rep,name=candles,proto3" json:"candles"`
 }
 
-func (c *queryClient) AvgPrice(ctx context.Context, in *QueryAvgPrice, opts ...grpc.CallOption) (*QueryAvgPriceResponse, error) {
-	out := new(QueryAvgPriceResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AvgPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceWindowResponse) Reset()         { *m = QueryPriceWindowResponse{} }
+func (m *QueryPriceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceWindowResponse) ProtoMessage()    {}
+func (*QueryPriceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{40}
+}
+func (m *QueryPriceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceWindowResponse.Merge(m, src)
+}
+func (m *QueryPriceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceWindowResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ExgRatesWithTimestamp(ctx context.Context, in *QueryExgRatesWithTimestamp, opts ...grpc.CallOption) (*QueryExgRatesWithTimestampResponse, error) {
-	out := new(QueryExgRatesWithTimestampResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExgRatesWithTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPriceWindowResponse proto.InternalMessageInfo
+
+// PriceWindow is the summary of the historic prices of a denom over the blocks
+// (start_block, end_block]. The price at the start of the window is the last stamp at or
+// before start_block, or the first stamp of the window if there is none.
+type PriceWindow struct {
+	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock   uint64 `protobuf:"varint,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// twap is the average of the prices, weighted by the number of blocks they were in effect.
+	Twap  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	Min   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min"`
+	Max   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max"`
+	Open  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	Close github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+	// num_stamps is the number of historic price stamps in the window.
+	NumStamps uint32 `protobuf:"varint,8,opt,name=num_stamps,json=numStamps,proto3" json:"num_stamps,omitempty"`
 }
 
-func (c *queryClient) MissCounters(ctx context.Context, in *QueryMissCounters, opts ...grpc.CallOption) (*QueryMissCountersResponse, error) {
-	out := new(QueryMissCountersResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MissCounters", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PriceWindow) Reset()         { *m = PriceWindow{} }
+func (m *PriceWindow) String() string { return proto.CompactTextString(m) }
+func (*PriceWindow) ProtoMessage()    {}
+func (*PriceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{41}
+}
+func (m *PriceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRates returns exchange rates of all denoms,
-	// or, if specified, returns a single denom
-	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(context.Context, *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(context.Context, *QueryAggregateVote) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(context.Context, *QueryAggregateVotes) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
-	// Medians returns medians of all denoms,
-	// or, if specified, returns a single median
-	Medians(context.Context, *QueryMedians) (*QueryMediansResponse, error)
-	// MedianDeviations returns median deviations of all denoms,
-	// or, if specified, returns a single median deviation
-	MedianDeviations(context.Context, *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error)
-	// QueryAvgPrice returns avg price of a given denom (required).
-	AvgPrice(context.Context, *QueryAvgPrice) (*QueryAvgPriceResponse, error)
-	// ExgRatesWithTimestamp returns exchange rates of all denoms with timestamp,
-	// or, if specified, returns a single denom
-	ExgRatesWithTimestamp(context.Context, *QueryExgRatesWithTimestamp) (*QueryExgRatesWithTimestampResponse, error)
-	// MissCounters returns oracle missing votes count of validators.
-	MissCounters(context.Context, *QueryMissCounters) (*QueryMissCountersResponse, error)
+func (m *PriceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceWindow.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *PriceWindow) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRates) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+func (m *PriceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceWindow.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
+
+var xxx_messageInfo_PriceWindow proto.InternalMessageInfo
+
+// QueryRewardDistribution is the request type for the Query/RewardDistribution RPC method.
+type QueryRewardDistribution struct {
+	// formula to use instead of the current reward formula, if specified.
+	Formula RewardFormula `protobuf:"varint,1,opt,name=formula,proto3,enum=umee.oracle.v1.RewardFormula" json:"formula,omitempty"`
+	// full_coverage_bonus to use instead of the current one, if not empty.
+	FullCoverageBonus string `protobuf:"bytes,2,opt,name=full_coverage_bonus,json=fullCoverageBonus,proto3" json:"full_coverage_bonus,omitempty"`
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegation) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+
+func (m *QueryRewardDistribution) Reset()         { *m = QueryRewardDistribution{} }
+func (m *QueryRewardDistribution) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardDistribution) ProtoMessage()    {}
+func (*QueryRewardDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{42}
 }
-func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounter) (*QueryMissCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+func (m *QueryRewardDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+func (m *QueryRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+func (m *QueryRewardDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardDistribution.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+func (m *QueryRewardDistribution) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVote) (*QueryAggregateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+func (m *QueryRewardDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardDistribution.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotes) (*QueryAggregateVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+
+var xxx_messageInfo_QueryRewardDistribution proto.InternalMessageInfo
+
+// QueryRewardDistributionResponse is response type for the Query/RewardDistribution RPC method.
+type QueryRewardDistributionResponse struct {
+	// params are the reward params used for the distribution.
+	Params RewardParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// period_rewards is the share of the reward pool distributed at the end of the vote period.
+	PeriodRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=period_rewards,json=periodRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"period_rewards"`
+	// rewards are the rewards of each validator, by validator address.
+	Rewards []ValidatorReward `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards"`
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+func (m *QueryRewardDistributionResponse) Reset()         { *m = QueryRewardDistributionResponse{} }
+func (m *QueryRewardDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardDistributionResponse) ProtoMessage()    {}
+func (*QueryRewardDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{43}
 }
-func (*UnimplementedQueryServer) Medians(ctx context.Context, req *QueryMedians) (*QueryMediansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Medians not implemented")
+func (m *QueryRewardDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MedianDeviations(ctx context.Context, req *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MedianDeviations not implemented")
+func (m *QueryRewardDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AvgPrice(ctx context.Context, req *QueryAvgPrice) (*QueryAvgPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AvgPrice not implemented")
+func (m *QueryRewardDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardDistributionResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ExgRatesWithTimestamp(ctx context.Context, req *QueryExgRatesWithTimestamp) (*QueryExgRatesWithTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExgRatesWithTimestamp not implemented")
+func (m *QueryRewardDistributionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) MissCounters(ctx context.Context, req *QueryMissCounters) (*QueryMissCountersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounters not implemented")
+func (m *QueryRewardDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardDistributionResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryRewardDistributionResponse proto.InternalMessageInfo
+
+// ValidatorReward is the oracle reward of a validator for a vote period.
+type ValidatorReward struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// share of the period rewards, in [0, 1].
+	Share   github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRates))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ValidatorReward) Reset()         { *m = ValidatorReward{} }
+func (m *ValidatorReward) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReward) ProtoMessage()    {}
+func (*ValidatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{44}
 }
-
-func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveExchangeRates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/ActiveExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveExchangeRates(ctx, req.(*QueryActiveExchangeRates))
+func (m *ValidatorReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ValidatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReward.Merge(m, src)
+}
+func (m *ValidatorReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReward.DiscardUnknown(m)
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/FeederDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegation))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_ValidatorReward proto.InternalMessageInfo
+
+// QueryValidatorOffences is the request type for the Query/ValidatorOffences RPC method.
+type QueryValidatorOffences struct {
+	// validator is the validator operator address to query for. All validators with an offence
+	// history are returned if empty.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/MissCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounter))
+func (m *QueryValidatorOffences) Reset()         { *m = QueryValidatorOffences{} }
+func (m *QueryValidatorOffences) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOffences) ProtoMessage()    {}
+func (*QueryValidatorOffences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{45}
+}
+func (m *QueryValidatorOffences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOffences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOffences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorOffences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOffences.Merge(m, src)
+}
+func (m *QueryValidatorOffences) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOffences) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOffences.DiscardUnknown(m)
 }
 
-func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlashWindow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlashWindow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/SlashWindow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindow))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryValidatorOffences proto.InternalMessageInfo
+
+// QueryValidatorOffencesResponse is response type for the Query/ValidatorOffences RPC method.
+type QueryValidatorOffencesResponse struct {
+	Histories []OffenceHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories"`
+	Params    PenaltyParams    `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/AggregatePrevote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevote))
+func (m *QueryValidatorOffencesResponse) Reset()         { *m = QueryValidatorOffencesResponse{} }
+func (m *QueryValidatorOffencesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOffencesResponse) ProtoMessage()    {}
+func (*QueryValidatorOffencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{46}
+}
+func (m *QueryValidatorOffencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOffencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOffencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorOffencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOffencesResponse.Merge(m, src)
+}
+func (m *QueryValidatorOffencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOffencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOffencesResponse.DiscardUnknown(m)
 }
 
-func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevotes)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/AggregatePrevotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotes))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryValidatorOffencesResponse proto.InternalMessageInfo
+
+// QueryPriceVolatility is the request type for the Query/PriceVolatility RPC method.
+type QueryPriceVolatility struct {
+	// denom is the symbol denom to query for. All denoms are returned if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/AggregateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVote))
+func (m *QueryPriceVolatility) Reset()         { *m = QueryPriceVolatility{} }
+func (m *QueryPriceVolatility) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceVolatility) ProtoMessage()    {}
+func (*QueryPriceVolatility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{47}
+}
+func (m *QueryPriceVolatility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceVolatility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceVolatility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPriceVolatility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceVolatility.Merge(m, src)
+}
+func (m *QueryPriceVolatility) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceVolatility) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceVolatility.DiscardUnknown(m)
 }
 
-func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVotes)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/AggregateVotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotes))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPriceVolatility proto.InternalMessageInfo
+
+// QueryPriceVolatilityResponse is response type for the Query/PriceVolatility RPC method.
+type QueryPriceVolatilityResponse struct {
+	Volatilities []DenomVolatility `protobuf:"bytes,1,rep,name=volatilities,proto3" json:"volatilities"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+func (m *QueryPriceVolatilityResponse) Reset()         { *m = QueryPriceVolatilityResponse{} }
+func (m *QueryPriceVolatilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceVolatilityResponse) ProtoMessage()    {}
+func (*QueryPriceVolatilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{48}
+}
+func (m *QueryPriceVolatilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceVolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceVolatilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPriceVolatilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceVolatilityResponse.Merge(m, src)
+}
+func (m *QueryPriceVolatilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceVolatilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceVolatilityResponse.DiscardUnknown(m)
 }
 
-func _Query_Medians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMedians)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Medians(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/Medians",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Medians(ctx, req.(*QueryMedians))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPriceVolatilityResponse proto.InternalMessageInfo
+
+// DenomVolatility is the rolling realized volatility of the exchange rate of a denom.
+type DenomVolatility struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// volatility is the standard deviation of the relative change of the exchange rate over
+	// a vote period, estimated with an exponentially weighted moving average.
+	Volatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility"`
+	// samples is the number of exchange rate changes included in the estimate.
+	Samples uint64 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
 }
 
-func _Query_MedianDeviations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMedianDeviations)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MedianDeviations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/MedianDeviations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MedianDeviations(ctx, req.(*QueryMedianDeviations))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DenomVolatility) Reset()         { *m = DenomVolatility{} }
+func (m *DenomVolatility) String() string { return proto.CompactTextString(m) }
+func (*DenomVolatility) ProtoMessage()    {}
+func (*DenomVolatility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{49}
 }
-
-func _Query_AvgPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAvgPrice)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AvgPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/AvgPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AvgPrice(ctx, req.(*QueryAvgPrice))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DenomVolatility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_ExgRatesWithTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExgRatesWithTimestamp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExgRatesWithTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/ExgRatesWithTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExgRatesWithTimestamp(ctx, req.(*QueryExgRatesWithTimestamp))
+func (m *DenomVolatility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomVolatility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_MissCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounters)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/umee.oracle.v1.Query/MissCounters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounters(ctx, req.(*QueryMissCounters))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DenomVolatility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomVolatility.Merge(m, src)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "umee.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "ActiveExchangeRates",
-			Handler:    _Query_ActiveExchangeRates_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "MissCounter",
-			Handler:    _Query_MissCounter_Handler,
-		},
-		{
-			MethodName: "SlashWindow",
-			Handler:    _Query_SlashWindow_Handler,
-		},
-		{
-			MethodName: "AggregatePrevote",
-			Handler:    _Query_AggregatePrevote_Handler,
-		},
-		{
-			MethodName: "AggregatePrevotes",
-			Handler:    _Query_AggregatePrevotes_Handler,
-		},
-		{
-			MethodName: "AggregateVote",
-			Handler:    _Query_AggregateVote_Handler,
-		},
-		{
-			MethodName: "AggregateVotes",
-			Handler:    _Query_AggregateVotes_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Medians",
-			Handler:    _Query_Medians_Handler,
-		},
-		{
-			MethodName: "MedianDeviations",
-			Handler:    _Query_MedianDeviations_Handler,
-		},
-		{
-			MethodName: "AvgPrice",
-			Handler:    _Query_AvgPrice_Handler,
-		},
-		{
-			MethodName: "ExgRatesWithTimestamp",
-			Handler:    _Query_ExgRatesWithTimestamp_Handler,
-		},
-		{
-			MethodName: "MissCounters",
-			Handler:    _Query_MissCounters_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "umee/oracle/v1/query.proto",
+func (m *DenomVolatility) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMissCounters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DenomVolatility) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomVolatility.DiscardUnknown(m)
 }
 
-func (m *QueryMissCounters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_DenomVolatility proto.InternalMessageInfo
 
-func (m *QueryMissCounters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryHistoricPrices is the request type for the Query/HistoricPrices RPC method.
+type QueryHistoricPrices struct {
+	// denom is the symbol denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// from_block is the first block of the returned stamps.
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// to_block is the last block of the returned stamps. Zero means no upper bound.
+	ToBlock uint64 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	// pagination defines an optional pagination for the request. Stamps are paginated in block
+	// order, starting at from_block. Offsets can't be used with a range of blocks.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricPrices) Reset()         { *m = QueryHistoricPrices{} }
+func (m *QueryHistoricPrices) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricPrices) ProtoMessage()    {}
+func (*QueryHistoricPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{50}
+}
+func (m *QueryHistoricPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryMissCountersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryHistoricPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricPrices.Merge(m, src)
+}
+func (m *QueryHistoricPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricPrices.DiscardUnknown(m)
 }
 
-func (m *QueryMissCountersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryHistoricPrices proto.InternalMessageInfo
+
+// QueryHistoricPricesResponse is response type for the Query/HistoricPrices RPC method.
+type QueryHistoricPricesResponse struct {
+	Prices []Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMissCountersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissCounters) > 0 {
-		for iNdEx := len(m.MissCounters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MissCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHistoricPricesResponse) Reset()         { *m = QueryHistoricPricesResponse{} }
+func (m *QueryHistoricPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricPricesResponse) ProtoMessage()    {}
+func (*QueryHistoricPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{51}
+}
+func (m *QueryHistoricPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHistoricPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricPricesResponse.Merge(m, src)
+}
+func (m *QueryHistoricPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricPricesResponse.DiscardUnknown(m)
 }
 
-func (m *PriceMissCounter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHistoricPricesResponse proto.InternalMessageInfo
+
+// QueryHistoricMedians is the request type for the Query/HistoricMedians RPC method.
+type QueryHistoricMedians struct {
+	// denom is the symbol denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// from_block is the first block of the returned stamps.
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// to_block is the last block of the returned stamps. Zero means no upper bound.
+	ToBlock uint64 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	// pagination defines an optional pagination for the request, over the medians. Stamps are
+	// paginated in block order, starting at from_block. Offsets can't be used with a range of
+	// blocks.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricMedians) Reset()         { *m = QueryHistoricMedians{} }
+func (m *QueryHistoricMedians) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricMedians) ProtoMessage()    {}
+func (*QueryHistoricMedians) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{52}
+}
+func (m *QueryHistoricMedians) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricMedians) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricMedians.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryHistoricMedians) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricMedians.Merge(m, src)
+}
+func (m *QueryHistoricMedians) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricMedians) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricMedians.DiscardUnknown(m)
 }
 
-func (m *PriceMissCounter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryHistoricMedians proto.InternalMessageInfo
+
+// QueryHistoricMediansResponse is response type for the Query/HistoricMedians RPC method.
+type QueryHistoricMediansResponse struct {
+	Medians []Price `protobuf:"bytes,1,rep,name=medians,proto3" json:"medians"`
+	// median_deviations are the median deviations stamped at the blocks of the medians.
+	MedianDeviations []Price `protobuf:"bytes,2,rep,name=median_deviations,json=medianDeviations,proto3" json:"median_deviations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *PriceMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryHistoricMediansResponse) Reset()         { *m = QueryHistoricMediansResponse{} }
+func (m *QueryHistoricMediansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricMediansResponse) ProtoMessage()    {}
+func (*QueryHistoricMediansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{53}
+}
+func (m *QueryHistoricMediansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricMediansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricMediansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryHistoricMediansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricMediansResponse.Merge(m, src)
+}
+func (m *QueryHistoricMediansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricMediansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricMediansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricMediansResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryMissCounters)(nil), "umee.oracle.v1.QueryMissCounters")
+	proto.RegisterType((*QueryMissCountersResponse)(nil), "umee.oracle.v1.QueryMissCountersResponse")
+	proto.RegisterType((*PriceMissCounter)(nil), "umee.oracle.v1.PriceMissCounter")
+	proto.RegisterType((*QueryExgRatesWithTimestamp)(nil), "umee.oracle.v1.QueryExgRatesWithTimestamp")
+	proto.RegisterType((*QueryExgRatesWithTimestampResponse)(nil), "umee.oracle.v1.QueryExgRatesWithTimestampResponse")
+	proto.RegisterType((*QueryExchangeRates)(nil), "umee.oracle.v1.QueryExchangeRates")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "umee.oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryActiveExchangeRates)(nil), "umee.oracle.v1.QueryActiveExchangeRates")
+	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "umee.oracle.v1.QueryActiveExchangeRatesResponse")
+	proto.RegisterType((*QueryFeederDelegation)(nil), "umee.oracle.v1.QueryFeederDelegation")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "umee.oracle.v1.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryFeeders)(nil), "umee.oracle.v1.QueryFeeders")
+	proto.RegisterType((*QueryFeedersResponse)(nil), "umee.oracle.v1.QueryFeedersResponse")
+	proto.RegisterType((*QueryMissCounter)(nil), "umee.oracle.v1.QueryMissCounter")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "umee.oracle.v1.QueryMissCounterResponse")
+	proto.RegisterType((*QuerySlashWindow)(nil), "umee.oracle.v1.QuerySlashWindow")
+	proto.RegisterType((*QuerySlashWindowResponse)(nil), "umee.oracle.v1.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryAggregatePrevote)(nil), "umee.oracle.v1.QueryAggregatePrevote")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "umee.oracle.v1.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotes)(nil), "umee.oracle.v1.QueryAggregatePrevotes")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "umee.oracle.v1.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVote)(nil), "umee.oracle.v1.QueryAggregateVote")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "umee.oracle.v1.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotes)(nil), "umee.oracle.v1.QueryAggregateVotes")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "umee.oracle.v1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParams)(nil), "umee.oracle.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "umee.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMedians)(nil), "umee.oracle.v1.QueryMedians")
+	proto.RegisterType((*QueryMediansResponse)(nil), "umee.oracle.v1.QueryMediansResponse")
+	proto.RegisterType((*QueryMedianDeviations)(nil), "umee.oracle.v1.QueryMedianDeviations")
+	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "umee.oracle.v1.QueryMedianDeviationsResponse")
+	proto.RegisterType((*QueryAvgPrice)(nil), "umee.oracle.v1.QueryAvgPrice")
+	proto.RegisterType((*QueryAvgPriceResponse)(nil), "umee.oracle.v1.QueryAvgPriceResponse")
+	proto.RegisterType((*QueryDenomVoteSettings)(nil), "umee.oracle.v1.QueryDenomVoteSettings")
+	proto.RegisterType((*QueryDenomVoteSettingsResponse)(nil), "umee.oracle.v1.QueryDenomVoteSettingsResponse")
+	proto.RegisterType((*QueryDerivedFeeds)(nil), "umee.oracle.v1.QueryDerivedFeeds")
+	proto.RegisterType((*QueryDerivedFeedsResponse)(nil), "umee.oracle.v1.QueryDerivedFeedsResponse")
+	proto.RegisterType((*QueryValidatorPerformance)(nil), "umee.oracle.v1.QueryValidatorPerformance")
+	proto.RegisterType((*QueryValidatorPerformanceResponse)(nil), "umee.oracle.v1.QueryValidatorPerformanceResponse")
+	proto.RegisterType((*QueryPriceWindow)(nil), "umee.oracle.v1.QueryPriceWindow")
+	proto.RegisterType((*QueryPriceWindowResponse)(nil), "umee.oracle.v1.QueryPriceWindowResponse")
+	proto.RegisterType((*PriceWindow)(nil), "umee.oracle.v1.PriceWindow")
+	proto.RegisterType((*QueryRewardDistribution)(nil), "umee.oracle.v1.QueryRewardDistribution")
+	proto.RegisterType((*QueryRewardDistributionResponse)(nil), "umee.oracle.v1.QueryRewardDistributionResponse")
+	proto.RegisterType((*ValidatorReward)(nil), "umee.oracle.v1.ValidatorReward")
+	proto.RegisterType((*QueryValidatorOffences)(nil), "umee.oracle.v1.QueryValidatorOffences")
+	proto.RegisterType((*QueryValidatorOffencesResponse)(nil), "umee.oracle.v1.QueryValidatorOffencesResponse")
+	proto.RegisterType((*QueryPriceVolatility)(nil), "umee.oracle.v1.QueryPriceVolatility")
+	proto.RegisterType((*QueryPriceVolatilityResponse)(nil), "umee.oracle.v1.QueryPriceVolatilityResponse")
+	proto.RegisterType((*DenomVolatility)(nil), "umee.oracle.v1.DenomVolatility")
+	proto.RegisterType((*QueryHistoricPrices)(nil), "umee.oracle.v1.QueryHistoricPrices")
+	proto.RegisterType((*QueryHistoricPricesResponse)(nil), "umee.oracle.v1.QueryHistoricPricesResponse")
+	proto.RegisterType((*QueryHistoricMedians)(nil), "umee.oracle.v1.QueryHistoricMedians")
+	proto.RegisterType((*QueryHistoricMediansResponse)(nil), "umee.oracle.v1.QueryHistoricMediansResponse")
+}
+
+func init() { proto.RegisterFile("umee/oracle/v1/query.proto", fileDescriptor_710e319bc1815d33) }
+
+var fileDescriptor_710e319bc1815d33 = []byte{
+	// 2536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xd8, 0xf1, 0xd7, 0x59, 0x7f, 0xde, 0xd8, 0x61, 0x33, 0xb6, 0xd7, 0xf6, 0xc4, 0x76,
+	0x1c, 0xc7, 0xde, 0x4d, 0x9c, 0x84, 0x40, 0xd2, 0xaa, 0x8d, 0xed, 0x7c, 0xa0, 0x36, 0xad, 0xd9,
+	0x54, 0x29, 0xe2, 0x65, 0x35, 0xde, 0xb9, 0x5e, 0x4f, 0xb3, 0x3b, 0xb3, 0x9d, 0x3b, 0xbb, 0xb6,
+	0xa9, 0x4a, 0xa1, 0x7d, 0x41, 0xf0, 0x52, 0xa8, 0x54, 0x15, 0x81, 0xaa, 0x2a, 0x20, 0x21, 0x81,
+	0x80, 0x7f, 0xa0, 0x7f, 0x40, 0x1e, 0x2b, 0x78, 0x41, 0x08, 0x15, 0x48, 0x78, 0xa8, 0xf8, 0x2b,
+	0xd0, 0xdc, 0x7b, 0xe7, 0xee, 0x7c, 0xdc, 0xd9, 0x1d, 0x2f, 0x85, 0x3e, 0x25, 0xbe, 0xe7, 0x77,
+	0xce, 0xf9, 0xdd, 0x33, 0xe7, 0xde, 0x73, 0xee, 0xb1, 0x41, 0x6d, 0xd4, 0x30, 0x2e, 0xd8, 0x8e,
+	0x5e, 0xae, 0xe2, 0x42, 0xf3, 0x72, 0xe1, 0xcd, 0x06, 0x76, 0x8e, 0xf3, 0x75, 0xc7, 0x76, 0x6d,
+	0x34, 0xe6, 0xc9, 0xf2, 0x4c, 0x96, 0x6f, 0x5e, 0x56, 0xa7, 0x2a, 0x76, 0xc5, 0xa6, 0xa2, 0x82,
+	0xf7, 0x3f, 0x86, 0x52, 0x67, 0x2b, 0xb6, 0x5d, 0xa9, 0xe2, 0x82, 0x5e, 0x37, 0x0b, 0xba, 0x65,
+	0xd9, 0xae, 0xee, 0x9a, 0xb6, 0x45, 0xb8, 0x74, 0x26, 0x62, 0x9f, 0x5b, 0xe3, 0xaa, 0x11, 0x61,
+	0x05, 0x5b, 0x98, 0x98, 0xbe, 0x6a, 0xae, 0x6c, 0x93, 0x9a, 0x4d, 0x0a, 0x7b, 0x3a, 0xf1, 0xa4,
+	0x7b, 0xd8, 0xd5, 0x2f, 0x17, 0xca, 0xb6, 0x69, 0x71, 0xf9, 0x5a, 0x50, 0x4e, 0x79, 0x0b, 0x54,
+	0x5d, 0xaf, 0x98, 0x16, 0xe5, 0xc1, 0xb0, 0xda, 0x4d, 0x98, 0xfc, 0xb6, 0x87, 0xb8, 0x6f, 0x12,
+	0xb2, 0x6d, 0x37, 0x2c, 0x17, 0x3b, 0x04, 0xcd, 0xc2, 0x70, 0x53, 0xaf, 0x9a, 0x86, 0xee, 0xda,
+	0x4e, 0x56, 0x59, 0x50, 0x56, 0x87, 0x8b, 0xad, 0x85, 0x1b, 0x43, 0x3f, 0xfa, 0x64, 0xbe, 0xe7,
+	0x8b, 0x4f, 0xe6, 0x7b, 0xb4, 0x03, 0x38, 0x1b, 0x53, 0x2e, 0x62, 0x52, 0xb7, 0x2d, 0x82, 0xd1,
+	0x4b, 0x30, 0x5a, 0x33, 0x09, 0x29, 0x95, 0xb9, 0x20, 0xab, 0x2c, 0xf4, 0xad, 0x66, 0x36, 0x17,
+	0xf2, 0xe1, 0xe0, 0xe5, 0x77, 0x1d, 0xb3, 0x8c, 0x03, 0x16, 0xb6, 0x4e, 0x3d, 0xf9, 0x7c, 0xbe,
+	0xa7, 0x38, 0x52, 0x0b, 0x18, 0xd5, 0x1e, 0xc0, 0x44, 0x14, 0xd7, 0x9e, 0x25, 0x5a, 0x84, 0x91,
+	0xa0, 0xfb, 0x6c, 0xef, 0x82, 0xb2, 0x7a, 0xaa, 0x98, 0x09, 0x58, 0xd5, 0x9e, 0x03, 0x95, 0xd2,
+	0xbf, 0x7d, 0x54, 0x29, 0xea, 0x2e, 0x26, 0xaf, 0x9b, 0xee, 0xc1, 0x6b, 0x66, 0x0d, 0x13, 0x57,
+	0xaf, 0xd5, 0xd1, 0x14, 0xf4, 0x1b, 0xd8, 0xb2, 0x6b, 0xdc, 0x34, 0xfb, 0x21, 0xb0, 0xf9, 0x37,
+	0x40, 0x4b, 0xd6, 0x16, 0x51, 0xd8, 0x81, 0x61, 0x7c, 0x54, 0x29, 0x39, 0x1e, 0x82, 0x47, 0x60,
+	0x31, 0x1a, 0x81, 0x1d, 0xcf, 0xf2, 0xed, 0xa3, 0xf2, 0x81, 0x6e, 0x55, 0xb0, 0x67, 0x8b, 0x87,
+	0x60, 0x08, 0x73, 0xd3, 0xda, 0x55, 0x40, 0xdc, 0x57, 0x0b, 0x44, 0x3a, 0x32, 0x7c, 0xdc, 0x0b,
+	0x6a, 0x5c, 0x4d, 0x50, 0x3b, 0x82, 0x31, 0xcc, 0x05, 0x21, 0x7e, 0xb3, 0x79, 0x96, 0x3f, 0x79,
+	0x2f, 0x7f, 0xf2, 0x3c, 0x73, 0xf2, 0x3b, 0xb8, 0xbc, 0x6d, 0x9b, 0xd6, 0xd6, 0x15, 0x8f, 0xda,
+	0x6f, 0xff, 0x3e, 0x7f, 0xb1, 0x62, 0xba, 0x07, 0x8d, 0xbd, 0x7c, 0xd9, 0xae, 0x15, 0x78, 0xbe,
+	0xb1, 0x7f, 0x36, 0x88, 0xf1, 0xa8, 0xe0, 0x1e, 0xd7, 0x31, 0xf1, 0x75, 0x48, 0x71, 0x14, 0x87,
+	0x88, 0xdf, 0x82, 0x61, 0xbb, 0x89, 0x1d, 0xc7, 0x34, 0x30, 0xc9, 0xf6, 0x52, 0xa7, 0x73, 0xd2,
+	0xb4, 0x78, 0x95, 0xa3, 0x78, 0x40, 0x5a, 0x5a, 0xe8, 0x2e, 0x64, 0xca, 0xb6, 0xb5, 0x6f, 0x1a,
+	0xd8, 0x2a, 0x63, 0x92, 0xed, 0xa3, 0x46, 0xe6, 0xa5, 0x46, 0xb6, 0x05, 0x8e, 0x9b, 0x09, 0x6a,
+	0x6a, 0x2a, 0x64, 0x69, 0x8c, 0x6e, 0x95, 0x5d, 0xb3, 0x89, 0x43, 0x91, 0xd2, 0x6e, 0xc3, 0x42,
+	0x92, 0x4c, 0x44, 0x71, 0x11, 0x46, 0x74, 0x2a, 0x0e, 0xc4, 0x70, 0xb8, 0x98, 0x61, 0x6b, 0xcc,
+	0xcc, 0x3d, 0x98, 0xa6, 0x66, 0xee, 0x60, 0x6c, 0x60, 0x67, 0x07, 0x57, 0x71, 0x85, 0x1e, 0x41,
+	0xb4, 0x0c, 0x63, 0x22, 0x61, 0x4b, 0xba, 0x61, 0xf8, 0x69, 0x3c, 0x2a, 0x56, 0x6f, 0x19, 0x46,
+	0xf0, 0xc0, 0xbd, 0x08, 0x73, 0x52, 0x4b, 0x82, 0xcd, 0x3c, 0x64, 0xf6, 0xa9, 0x2c, 0x68, 0x0e,
+	0xd8, 0x92, 0x67, 0x4b, 0xbb, 0x06, 0x23, 0x01, 0x0b, 0x24, 0x25, 0x05, 0xcd, 0x84, 0xa9, 0xa0,
+	0x5a, 0x6a, 0x7f, 0xe8, 0x12, 0x4c, 0x11, 0x57, 0xb7, 0x8c, 0xbd, 0xe3, 0x52, 0x00, 0xc8, 0xbe,
+	0xfa, 0x70, 0x11, 0x71, 0xd9, 0x1d, 0xa1, 0x40, 0xb4, 0x6d, 0x98, 0x88, 0x5e, 0x2a, 0x27, 0x0f,
+	0xd4, 0xf3, 0x90, 0x8d, 0x1a, 0x09, 0x7e, 0xb1, 0xd0, 0xcd, 0xa0, 0xc4, 0x6f, 0x06, 0xc4, 0x39,
+	0x3c, 0xa8, 0xea, 0xe4, 0xe0, 0x75, 0xd3, 0x32, 0xec, 0x43, 0x6d, 0x1b, 0xb2, 0xd1, 0x35, 0x61,
+	0xf2, 0x3c, 0x8c, 0x1f, 0xd2, 0x95, 0x52, 0xdd, 0xb1, 0x2b, 0x0e, 0x26, 0x84, 0x5b, 0x1d, 0x63,
+	0xcb, 0xbb, 0x7c, 0x55, 0xa4, 0xc2, 0xad, 0x4a, 0xc5, 0xf1, 0xbe, 0x1d, 0xde, 0x75, 0x70, 0xd3,
+	0x76, 0xf1, 0xc9, 0x77, 0xf8, 0x03, 0x05, 0xe6, 0xa4, 0xa6, 0x04, 0xa9, 0x12, 0x4c, 0xea, 0xbe,
+	0xac, 0x54, 0x67, 0x42, 0x6a, 0x35, 0xb3, 0xb9, 0x1e, 0x3d, 0x28, 0xc2, 0x48, 0x30, 0xc9, 0xb9,
+	0x41, 0x7e, 0x6a, 0x26, 0xf4, 0x88, 0x23, 0x2d, 0x0b, 0x67, 0xa4, 0x0c, 0x88, 0xf6, 0x9e, 0x02,
+	0x39, 0xb9, 0x48, 0xb0, 0xd3, 0x01, 0xc5, 0xd8, 0xf9, 0x37, 0x50, 0x37, 0xf4, 0x26, 0xf5, 0x18,
+	0x8b, 0xdb, 0xfc, 0xd6, 0x14, 0xda, 0x0f, 0xbb, 0x8a, 0xb4, 0x0b, 0x6a, 0xdc, 0x8c, 0xd8, 0xc7,
+	0x43, 0x18, 0x6b, 0xed, 0x23, 0x10, 0xe2, 0x0b, 0xa9, 0xf6, 0xf0, 0xb0, 0xb5, 0x81, 0x51, 0x3d,
+	0x68, 0x5f, 0x9b, 0x86, 0xd3, 0x71, 0xaf, 0x44, 0x3b, 0x84, 0x19, 0xc9, 0xb2, 0x60, 0xf3, 0x1d,
+	0x18, 0x0f, 0xb3, 0xf1, 0x43, 0x7a, 0x62, 0x3a, 0x63, 0x7a, 0xd8, 0xf1, 0x28, 0x64, 0xa8, 0xe3,
+	0x5d, 0xdd, 0xd1, 0x6b, 0x44, 0x7b, 0x09, 0x4e, 0x07, 0x7e, 0x14, 0xfe, 0xaf, 0xc2, 0x40, 0x9d,
+	0xae, 0xf0, 0x28, 0x9c, 0x89, 0xdd, 0xc8, 0x54, 0xca, 0x7d, 0x70, 0xac, 0xf6, 0x32, 0xbf, 0x94,
+	0xee, 0x63, 0xc3, 0xd4, 0xad, 0x84, 0xc2, 0xe6, 0xd5, 0x7b, 0xab, 0x51, 0x7b, 0xe0, 0x95, 0x57,
+	0x42, 0xcb, 0xf9, 0x68, 0xb1, 0xb5, 0x10, 0xf8, 0x5e, 0xf7, 0x61, 0x2a, 0x68, 0x4d, 0x70, 0xbb,
+	0x06, 0x83, 0x35, 0xb6, 0xc4, 0x63, 0x32, 0x2d, 0x2d, 0x17, 0x9c, 0x9b, 0x8f, 0xd5, 0xae, 0xc3,
+	0x74, 0xc0, 0xdc, 0x0e, 0x6e, 0x9a, 0xac, 0x8f, 0xeb, 0x58, 0x7e, 0x0f, 0x60, 0x4e, 0xaa, 0x28,
+	0x08, 0xdd, 0x85, 0x89, 0x5a, 0x44, 0x96, 0x86, 0x59, 0x4c, 0x49, 0x2b, 0xc0, 0x28, 0x4b, 0x8a,
+	0x66, 0x85, 0x02, 0x3b, 0x52, 0xab, 0xc0, 0x74, 0x48, 0x21, 0xd0, 0xae, 0xf4, 0xd7, 0xbd, 0x05,
+	0xa6, 0xb8, 0x95, 0xf7, 0x1c, 0xfe, 0xf5, 0xf3, 0xf9, 0x95, 0x74, 0xc5, 0xbe, 0xc8, 0x94, 0x03,
+	0x8e, 0xf2, 0xfc, 0x8a, 0xa0, 0x2d, 0x8e, 0x97, 0x48, 0x0f, 0xb0, 0xeb, 0x9a, 0x56, 0x25, 0x21,
+	0x7a, 0x1a, 0x86, 0x9c, 0x1c, 0x2f, 0x18, 0x6e, 0xc3, 0x10, 0xe1, 0x6b, 0x6d, 0xfb, 0xa9, 0xa0,
+	0xb2, 0xdf, 0x4f, 0xf9, 0x8a, 0xda, 0x69, 0xde, 0xf5, 0xee, 0x60, 0xc7, 0x6c, 0x62, 0xc3, 0x2b,
+	0x3f, 0x44, 0x7b, 0x0d, 0xce, 0xc6, 0x16, 0x85, 0xdb, 0xeb, 0xd0, 0xef, 0xd5, 0x2f, 0xdf, 0xe7,
+	0x4c, 0xdc, 0xa7, 0x50, 0xe2, 0xde, 0x18, 0x5e, 0xfb, 0xa1, 0xc2, 0xcd, 0x3e, 0xf4, 0xaf, 0x97,
+	0x5d, 0xec, 0xec, 0xdb, 0x4e, 0x4d, 0xb7, 0xca, 0xb8, 0x43, 0x0f, 0x7b, 0x07, 0xa0, 0xd5, 0xb0,
+	0xd3, 0x94, 0xcf, 0x6c, 0xae, 0x84, 0xba, 0x33, 0xf6, 0x2a, 0xf1, 0x7b, 0xb4, 0x5d, 0xbd, 0x82,
+	0x8b, 0xf8, 0xcd, 0x06, 0x26, 0x6e, 0x31, 0xa0, 0xa9, 0x7d, 0xaa, 0xc0, 0x62, 0x22, 0x07, 0xb1,
+	0xc5, 0x57, 0x60, 0xa4, 0xde, 0x5a, 0xf6, 0x77, 0xba, 0x14, 0xdd, 0xa9, 0xcc, 0x86, 0xdf, 0xb3,
+	0x07, 0xf5, 0xd1, 0x5d, 0x09, 0xfb, 0xf3, 0x1d, 0xd9, 0x33, 0x32, 0x21, 0xfa, 0xdf, 0xe3, 0xd5,
+	0x98, 0xa6, 0x2a, 0xab, 0xbc, 0x09, 0x57, 0xc4, 0x39, 0x18, 0xe5, 0x75, 0x78, 0xaf, 0x6a, 0x97,
+	0x1f, 0x11, 0xde, 0xf5, 0x8f, 0xb0, 0xc5, 0x2d, 0xba, 0x86, 0x2e, 0xc2, 0xa4, 0x83, 0x89, 0x5d,
+	0x6d, 0x78, 0xc6, 0x7d, 0x60, 0x1f, 0x05, 0x4e, 0xb4, 0x04, 0x0c, 0xac, 0xfd, 0x4c, 0x81, 0x6c,
+	0xd4, 0xb9, 0x88, 0xd8, 0x37, 0x61, 0x80, 0x59, 0xe6, 0xb7, 0xdd, 0x8c, 0xf4, 0xd8, 0x32, 0x25,
+	0xff, 0xca, 0x63, 0x0a, 0xe8, 0x26, 0x0c, 0x96, 0x75, 0xcb, 0xa8, 0x8a, 0x06, 0x38, 0x85, 0xae,
+	0xaf, 0xa1, 0x7d, 0xda, 0x07, 0x99, 0x60, 0x30, 0xe6, 0x21, 0x43, 0x5c, 0xdd, 0x71, 0xd9, 0x66,
+	0x78, 0xeb, 0x01, 0x74, 0x89, 0x6e, 0x03, 0xcd, 0xc0, 0x30, 0xb6, 0x0c, 0x2e, 0x66, 0x31, 0x19,
+	0xc2, 0x96, 0xc1, 0x84, 0x5b, 0x70, 0xca, 0x3d, 0xd4, 0xeb, 0xd9, 0xbe, 0xae, 0x8e, 0x3c, 0xd5,
+	0x45, 0x2f, 0x42, 0x5f, 0xcd, 0xb4, 0xb2, 0xa7, 0xba, 0x32, 0xe1, 0xa9, 0x52, 0x0b, 0xfa, 0x51,
+	0xb6, 0xbf, 0x4b, 0x0b, 0xfa, 0x91, 0xb7, 0x0f, 0xbb, 0x8e, 0xad, 0xec, 0x40, 0x77, 0xfb, 0xf0,
+	0x74, 0xbd, 0xfb, 0xaf, 0x5c, 0xb5, 0x09, 0xce, 0x0e, 0x76, 0x77, 0xff, 0x51, 0x65, 0x34, 0x07,
+	0x60, 0x35, 0x6a, 0x25, 0xc2, 0x4a, 0xd5, 0x50, 0xa4, 0x54, 0x69, 0xef, 0x2a, 0xf0, 0x35, 0x9a,
+	0x53, 0x45, 0x7c, 0xa8, 0x3b, 0xc6, 0x8e, 0x49, 0x5c, 0xc7, 0xdc, 0xa3, 0x59, 0x87, 0xae, 0xc3,
+	0xa0, 0x77, 0x82, 0x1a, 0x55, 0x9d, 0x7e, 0xc6, 0xb1, 0xf8, 0xc3, 0x88, 0x29, 0xdd, 0x61, 0xa0,
+	0xa2, 0x8f, 0x46, 0x79, 0x38, 0xbd, 0xdf, 0xa8, 0x56, 0x4b, 0x65, 0xbb, 0x89, 0x1d, 0xbd, 0x82,
+	0x4b, 0x7b, 0xb6, 0xd5, 0x60, 0x07, 0x60, 0xb8, 0x38, 0xe9, 0x89, 0xb6, 0xb9, 0x64, 0xcb, 0x13,
+	0x68, 0x1f, 0xf5, 0xc2, 0x7c, 0x02, 0x09, 0x91, 0xdf, 0x37, 0x22, 0xd5, 0x7c, 0x56, 0xce, 0x45,
+	0x56, 0xd3, 0xbd, 0xd7, 0x65, 0x1d, 0x3b, 0xa6, 0x6d, 0x94, 0x1c, 0x0a, 0xf2, 0xf3, 0xfc, 0x7f,
+	0xf1, 0xba, 0x64, 0x8e, 0x18, 0x19, 0x82, 0x5e, 0x80, 0x41, 0xdf, 0x65, 0xc2, 0xb3, 0x50, 0x5c,
+	0x61, 0x4c, 0xc5, 0x3f, 0x5e, 0x5c, 0x4b, 0xfb, 0x9b, 0x02, 0xe3, 0x11, 0x48, 0x87, 0x8b, 0x7a,
+	0x07, 0xfa, 0xc9, 0x81, 0xee, 0xe0, 0x6c, 0x6f, 0x77, 0x69, 0x43, 0x95, 0x11, 0x8e, 0x12, 0x3f,
+	0x2b, 0x8d, 0x15, 0x0d, 0xd4, 0x25, 0x1e, 0xa8, 0xd5, 0x14, 0x2e, 0x58, 0x94, 0xc4, 0xf6, 0xbe,
+	0xcf, 0x6b, 0xb2, 0xd8, 0xe2, 0xab, 0xfb, 0xfb, 0xf4, 0x2d, 0xfc, 0x7f, 0xaa, 0x46, 0xff, 0xf6,
+	0x1f, 0x07, 0x31, 0x02, 0x22, 0xf1, 0xb6, 0x60, 0xf8, 0xc0, 0x24, 0xae, 0xed, 0x98, 0xa2, 0x0e,
+	0xe5, 0xa2, 0x1f, 0x91, 0x2b, 0xdd, 0xa3, 0xb8, 0x63, 0x7f, 0x42, 0x20, 0xd4, 0xd0, 0x4d, 0x91,
+	0xbc, 0x8c, 0x6a, 0x7c, 0xc2, 0x80, 0x2d, 0xbd, 0xea, 0x1e, 0x4b, 0xb3, 0x37, 0x5c, 0xbb, 0xfa,
+	0xba, 0xaf, 0x5d, 0xeb, 0xbc, 0x19, 0xa5, 0xd7, 0xf5, 0x43, 0xbb, 0xaa, 0xbb, 0x66, 0xd5, 0x74,
+	0x8f, 0x13, 0xda, 0x1f, 0x13, 0x66, 0x65, 0x68, 0x11, 0x97, 0x6f, 0xc1, 0x48, 0xd3, 0x5f, 0x6d,
+	0x85, 0x66, 0x3e, 0xa1, 0x01, 0xf2, 0xd5, 0xfd, 0xea, 0x1c, 0x54, 0xd5, 0x7e, 0xaa, 0xc0, 0x78,
+	0x04, 0x97, 0x50, 0x54, 0x5f, 0x01, 0x10, 0x9a, 0xc7, 0x5d, 0x66, 0x78, 0xc0, 0x02, 0xca, 0xc2,
+	0x20, 0xd1, 0x6b, 0xf5, 0x2a, 0xf6, 0xab, 0xae, 0xff, 0xa3, 0xf6, 0x7b, 0x85, 0xbf, 0x2a, 0xd8,
+	0x47, 0x35, 0xcb, 0x34, 0x0e, 0x49, 0xef, 0x81, 0x39, 0x80, 0x7d, 0xc7, 0xae, 0x85, 0xaa, 0xda,
+	0xb0, 0xb7, 0xc2, 0xca, 0xda, 0x59, 0x18, 0x72, 0x6d, 0x2e, 0xe4, 0x7e, 0x5c, 0x9b, 0x89, 0xc2,
+	0x99, 0x7c, 0xaa, 0xeb, 0x4c, 0xfe, 0x85, 0x02, 0x33, 0x12, 0xbe, 0xe2, 0x73, 0x5d, 0x81, 0x01,
+	0xda, 0x10, 0xa7, 0x6a, 0xeb, 0x39, 0xf4, 0xcb, 0x6b, 0x9b, 0xfe, 0xa0, 0xc0, 0x54, 0x88, 0x5d,
+	0xfb, 0xe7, 0xd5, 0x57, 0x1f, 0xce, 0x2f, 0x14, 0x98, 0x95, 0x11, 0xfe, 0x2f, 0x5f, 0x70, 0xe8,
+	0x1e, 0x4c, 0xb2, 0xff, 0x96, 0x8c, 0xd6, 0x43, 0xab, 0xb7, 0x8b, 0x87, 0xd6, 0x97, 0x76, 0x2d,
+	0x6c, 0xfe, 0x69, 0x0e, 0xfa, 0xe9, 0x56, 0xd1, 0x87, 0x0a, 0x8c, 0x86, 0xc7, 0xba, 0x5a, 0x94,
+	0x53, 0x7c, 0x86, 0xab, 0xae, 0x75, 0xc6, 0xf8, 0x7e, 0xb5, 0x6b, 0xef, 0xfe, 0xf9, 0x5f, 0x1f,
+	0xf4, 0x16, 0xd0, 0x46, 0x21, 0xf2, 0x5b, 0x05, 0xfa, 0xdd, 0x49, 0x21, 0x3c, 0x04, 0x2e, 0xbc,
+	0x45, 0x97, 0xdf, 0x46, 0xbf, 0x51, 0xe0, 0xb4, 0x64, 0xf0, 0x89, 0x56, 0xa5, 0xae, 0x25, 0x48,
+	0xf5, 0x52, 0x5a, 0xa4, 0xa0, 0x7a, 0x95, 0x52, 0xcd, 0xa3, 0xf5, 0x04, 0xaa, 0x7c, 0xd2, 0x1a,
+	0x66, 0x8c, 0x7e, 0xad, 0xc0, 0x44, 0x7c, 0xb6, 0x2a, 0x75, 0x1e, 0x85, 0xa9, 0x1b, 0xa9, 0x60,
+	0x82, 0xe0, 0x0d, 0x4a, 0xf0, 0x2a, 0xda, 0x8c, 0x12, 0x14, 0x65, 0x92, 0x14, 0xde, 0x0a, 0x8f,
+	0x97, 0xde, 0x2e, 0xb0, 0xc1, 0x27, 0xfa, 0xb1, 0x02, 0x83, 0xfe, 0xd8, 0x75, 0xb6, 0x8d, 0x5b,
+	0xa2, 0x2e, 0xb5, 0x93, 0x0a, 0x2e, 0x37, 0x29, 0x97, 0x6b, 0xe8, 0xca, 0xc9, 0xb9, 0x10, 0xf4,
+	0x81, 0x02, 0x99, 0xe0, 0x84, 0x75, 0x41, 0xea, 0x32, 0x80, 0x50, 0x57, 0x3b, 0x21, 0x04, 0xb1,
+	0x6f, 0x50, 0x62, 0x9b, 0xe8, 0xd2, 0x49, 0x88, 0xd5, 0x4c, 0x42, 0xd0, 0x3b, 0x90, 0x09, 0x8c,
+	0x57, 0x13, 0x48, 0x05, 0x10, 0xea, 0x6a, 0x27, 0x84, 0x20, 0xb5, 0x44, 0x49, 0xe5, 0xd0, 0x6c,
+	0x94, 0x14, 0xf1, 0xc0, 0x25, 0xfe, 0x2c, 0xfb, 0xa3, 0x02, 0x13, 0xf1, 0xd9, 0xac, 0x3c, 0x8f,
+	0x23, 0x30, 0x75, 0x23, 0x15, 0x4c, 0x10, 0xba, 0x4d, 0x09, 0xbd, 0x80, 0x9e, 0x3f, 0x49, 0x94,
+	0x62, 0x23, 0x53, 0xf4, 0x58, 0x81, 0xc9, 0xa8, 0x0f, 0x82, 0x56, 0x52, 0x71, 0x21, 0x6a, 0x3e,
+	0x1d, 0xae, 0xf3, 0x5d, 0x12, 0x20, 0x1d, 0xe3, 0x48, 0xd0, 0xaf, 0x14, 0x18, 0x0d, 0x4f, 0x61,
+	0xb5, 0xf6, 0x8e, 0x3d, 0x8c, 0xba, 0xd6, 0x19, 0x23, 0x88, 0x6d, 0x51, 0x62, 0xcf, 0xa1, 0x1b,
+	0xdd, 0x45, 0x93, 0x86, 0xf2, 0x43, 0x05, 0xc6, 0x42, 0xd6, 0x09, 0x3a, 0xd7, 0x99, 0x02, 0x51,
+	0x2f, 0xa6, 0x00, 0x09, 0xa2, 0x9b, 0x94, 0xe8, 0x3a, 0x5a, 0x4b, 0x15, 0x41, 0x16, 0xbe, 0x37,
+	0x60, 0x80, 0x75, 0xa9, 0x68, 0x46, 0xea, 0x8a, 0x09, 0xd5, 0x73, 0x6d, 0x84, 0xc2, 0x7f, 0x8e,
+	0xfa, 0xcf, 0xa2, 0x33, 0x51, 0xff, 0xbc, 0xf3, 0x3d, 0x86, 0x41, 0xbf, 0x4f, 0x90, 0x5f, 0x52,
+	0x5c, 0xaa, 0x2e, 0xb5, 0x93, 0x0a, 0x77, 0x6b, 0xd4, 0xdd, 0x12, 0xd2, 0x98, 0x3b, 0xd6, 0x9e,
+	0x47, 0x6e, 0x75, 0xbf, 0x4e, 0x7f, 0xac, 0xc0, 0x44, 0x6c, 0xca, 0xba, 0xdc, 0xc6, 0x4d, 0x0b,
+	0xa6, 0x6e, 0xa4, 0x82, 0x25, 0x15, 0x9a, 0x36, 0xb4, 0x02, 0x3d, 0x03, 0x7a, 0x07, 0x86, 0xc4,
+	0x88, 0x75, 0x4e, 0xfe, 0xd1, 0xb9, 0x58, 0x5d, 0x6e, 0x2b, 0x16, 0x3c, 0x36, 0x28, 0x8f, 0xf3,
+	0x68, 0x59, 0xc6, 0x43, 0x6f, 0x56, 0x4a, 0xb4, 0x29, 0x14, 0x35, 0xf9, 0x77, 0x0a, 0x4c, 0xcb,
+	0x7f, 0x5b, 0x9d, 0xd4, 0x10, 0x48, 0xb0, 0xea, 0x66, 0x7a, 0x6c, 0xe7, 0xb4, 0x15, 0x4d, 0x04,
+	0xff, 0x25, 0x77, 0xc9, 0x15, 0x9c, 0xde, 0x53, 0x60, 0x24, 0xf4, 0x77, 0x05, 0x8b, 0x9d, 0x4a,
+	0x08, 0x51, 0x2f, 0x74, 0x84, 0x08, 0x4a, 0xcb, 0x94, 0xd2, 0x3c, 0x9a, 0x8b, 0x52, 0x0a, 0xfd,
+	0xd9, 0x01, 0xfa, 0xb9, 0x02, 0x93, 0xf1, 0xf1, 0xb3, 0xfc, 0x82, 0x8c, 0xe1, 0xd4, 0x7c, 0x3a,
+	0x9c, 0x20, 0xb5, 0x4e, 0x49, 0xad, 0xa0, 0xa5, 0x84, 0x38, 0x79, 0x07, 0xba, 0xe4, 0xcf, 0xa1,
+	0x69, 0x84, 0x82, 0xe3, 0xe6, 0x84, 0x08, 0x05, 0x21, 0xea, 0x85, 0x8e, 0x90, 0xce, 0x11, 0x32,
+	0x18, 0x9a, 0xfe, 0x4a, 0x96, 0xf6, 0x4f, 0x53, 0xd2, 0xe9, 0xb4, 0xdc, 0x95, 0x0c, 0xaa, 0x5e,
+	0x4e, 0x0d, 0x15, 0xec, 0xf2, 0x94, 0xdd, 0x2a, 0x5a, 0x69, 0x73, 0x13, 0x06, 0x06, 0xca, 0xe8,
+	0x27, 0x4a, 0x78, 0xea, 0x29, 0xef, 0x0e, 0x02, 0x08, 0x75, 0xb5, 0x13, 0x42, 0x70, 0xb9, 0x44,
+	0xb9, 0xac, 0xa1, 0x55, 0xd9, 0x39, 0xa4, 0x67, 0x90, 0x77, 0x08, 0xe2, 0x28, 0xfe, 0x52, 0x01,
+	0x24, 0x99, 0xdf, 0x9d, 0x97, 0xba, 0x8c, 0x03, 0xd5, 0x42, 0x4a, 0x60, 0xe7, 0xcc, 0xe2, 0x73,
+	0x9d, 0x82, 0x11, 0xe4, 0xf1, 0x91, 0x02, 0x93, 0xf1, 0x01, 0xcf, 0x4a, 0xfb, 0xaf, 0xe4, 0xe3,
+	0xd4, 0x7c, 0x3a, 0x9c, 0xe0, 0x76, 0x91, 0x72, 0x5b, 0x46, 0xe7, 0xda, 0x7c, 0x4a, 0xdb, 0x27,
+	0xf1, 0xbe, 0x02, 0xe3, 0xd1, 0x71, 0xc8, 0x52, 0xf2, 0x97, 0x6a, 0xa1, 0xd4, 0xf5, 0x34, 0x28,
+	0x41, 0xea, 0x02, 0x25, 0x75, 0x0e, 0x2d, 0x26, 0x1e, 0x45, 0xe1, 0xfe, 0x63, 0x05, 0xc6, 0x22,
+	0x33, 0x07, 0x79, 0x31, 0x0d, 0x83, 0xd4, 0x8b, 0x29, 0x40, 0x49, 0x6f, 0x07, 0x69, 0xcd, 0x39,
+	0xe0, 0xaa, 0xec, 0xde, 0x6f, 0x3d, 0xc6, 0x1e, 0x2b, 0x30, 0x1e, 0x7d, 0xc6, 0x2f, 0xb5, 0x75,
+	0xee, 0x97, 0xe9, 0xf5, 0x34, 0xa8, 0xa4, 0x37, 0x45, 0x7b, 0x8e, 0xbc, 0x6e, 0xfb, 0x24, 0xb7,
+	0x5e, 0x7e, 0xf2, 0xcf, 0x5c, 0xcf, 0x93, 0xa7, 0x39, 0xe5, 0xb3, 0xa7, 0x39, 0xe5, 0x1f, 0x4f,
+	0x73, 0xca, 0xfb, 0xcf, 0x72, 0x3d, 0x9f, 0x3d, 0xcb, 0xf5, 0xfc, 0xe5, 0x59, 0xae, 0xe7, 0xbb,
+	0xf9, 0xc0, 0xa8, 0xc8, 0x33, 0xbe, 0x61, 0x61, 0xf7, 0xd0, 0x76, 0x1e, 0x31, 0x4f, 0xcd, 0xaf,
+	0x17, 0x8e, 0xfc, 0x4f, 0x44, 0xc7, 0x46, 0x7b, 0x03, 0xf4, 0x0f, 0xd4, 0xae, 0xfc, 0x67, 0x00,
+	0xc7, 0x26, 0xb1, 0x60, 0x89, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRates returns exchange rates of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// Feeders returns all the feeders of a validator: its feeder delegation and its standby
+	// feeders
+	Feeders(ctx context.Context, in *QueryFeeders, opts ...grpc.CallOption) (*QueryFeedersResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Medians returns medians of all denoms,
+	// or, if specified, returns a single median
+	Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error)
+	// MedianDeviations returns median deviations of all denoms,
+	// or, if specified, returns a single median deviation
+	MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error)
+	// QueryAvgPrice returns avg price of a given denom (required).
+	AvgPrice(ctx context.Context, in *QueryAvgPrice, opts ...grpc.CallOption) (*QueryAvgPriceResponse, error)
+	// ExgRatesWithTimestamp returns exchange rates of all denoms with timestamp,
+	// or, if specified, returns a single denom
+	ExgRatesWithTimestamp(ctx context.Context, in *QueryExgRatesWithTimestamp, opts ...grpc.CallOption) (*QueryExgRatesWithTimestampResponse, error)
+	// MissCounters returns oracle missing votes count of validators.
+	MissCounters(ctx context.Context, in *QueryMissCounters, opts ...grpc.CallOption) (*QueryMissCountersResponse, error)
+	// DenomVoteSettings returns the effective vote threshold, reward band and minimum
+	// number of voters of all accepted denoms, or, if specified, of a single denom.
+	DenomVoteSettings(ctx context.Context, in *QueryDenomVoteSettings, opts ...grpc.CallOption) (*QueryDenomVoteSettingsResponse, error)
+	// DerivedFeeds returns all derived price feeds.
+	DerivedFeeds(ctx context.Context, in *QueryDerivedFeeds, opts ...grpc.CallOption) (*QueryDerivedFeedsResponse, error)
+	// ValidatorPerformance returns the oracle performance of validators over the current
+	// slash window, or, if specified, of a single validator.
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformance, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// PriceWindow returns the time weighted average, min, max, open and close prices of a denom
+	// over a window of blocks, computed from the historic price stamps. If a resolution is
+	// given, it also returns the window split into candles.
+	PriceWindow(ctx context.Context, in *QueryPriceWindow, opts ...grpc.CallOption) (*QueryPriceWindowResponse, error)
+	// RewardDistribution is a dry run of the oracle rewards distribution of the current vote
+	// period: it tallies the votes submitted so far, and splits the vote period share of the
+	// reward pool among the validators.
+	RewardDistribution(ctx context.Context, in *QueryRewardDistribution, opts ...grpc.CallOption) (*QueryRewardDistributionResponse, error)
+	// ValidatorOffences returns the oracle offence history of validators and the penalty
+	// params, or, if specified, of a single validator.
+	ValidatorOffences(ctx context.Context, in *QueryValidatorOffences, opts ...grpc.CallOption) (*QueryValidatorOffencesResponse, error)
+	// PriceVolatility returns the rolling realized volatility of the exchange rates, or, if
+	// specified, of a single denom.
+	PriceVolatility(ctx context.Context, in *QueryPriceVolatility, opts ...grpc.CallOption) (*QueryPriceVolatilityResponse, error)
+	// HistoricPrices returns the historic price stamps of a denom, within an optional range of
+	// blocks, with pagination.
+	HistoricPrices(ctx context.Context, in *QueryHistoricPrices, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error)
+	// HistoricMedians returns the median and median deviation stamps of a denom, within an
+	// optional range of blocks, with pagination.
+	HistoricMedians(ctx context.Context, in *QueryHistoricMedians, opts ...grpc.CallOption) (*QueryHistoricMediansResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryExgRatesWithTimestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
+	out := new(QueryActiveExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ActiveExchangeRates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryExgRatesWithTimestamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryExgRatesWithTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Feeders(ctx context.Context, in *QueryFeeders, opts ...grpc.CallOption) (*QueryFeedersResponse, error) {
+	out := new(QueryFeedersResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/Feeders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryExgRatesWithTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+	out := new(QueryMissCounterResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MissCounter", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryExgRatesWithTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
+	out := new(QuerySlashWindowResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/SlashWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryExgRatesWithTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExgRates) > 0 {
-		for iNdEx := len(m.ExgRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExgRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryExchangeRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregatePrevotes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryExchangeRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
+	out := new(QueryAggregateVoteResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
+	out := new(QueryAggregateVotesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AggregateVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryActiveExchangeRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error) {
+	out := new(QueryMediansResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/Medians", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveExchangeRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryActiveExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryActiveExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error) {
+	out := new(QueryMedianDeviationsResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MedianDeviations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActiveRates) > 0 {
-		for iNdEx := len(m.ActiveRates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActiveRates[iNdEx])
-			copy(dAtA[i:], m.ActiveRates[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveRates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFeederDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AvgPrice(ctx context.Context, in *QueryAvgPrice, opts ...grpc.CallOption) (*QueryAvgPriceResponse, error) {
+	out := new(QueryAvgPriceResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AvgPrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFeederDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryFeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ExgRatesWithTimestamp(ctx context.Context, in *QueryExgRatesWithTimestamp, opts ...grpc.CallOption) (*QueryExgRatesWithTimestampResponse, error) {
+	out := new(QueryExgRatesWithTimestampResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExgRatesWithTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MissCounters(ctx context.Context, in *QueryMissCounters, opts ...grpc.CallOption) (*QueryMissCountersResponse, error) {
+	out := new(QueryMissCountersResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MissCounters", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DenomVoteSettings(ctx context.Context, in *QueryDenomVoteSettings, opts ...grpc.CallOption) (*QueryDenomVoteSettingsResponse, error) {
+	out := new(QueryDenomVoteSettingsResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/DenomVoteSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryMissCounter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DerivedFeeds(ctx context.Context, in *QueryDerivedFeeds, opts ...grpc.CallOption) (*QueryDerivedFeedsResponse, error) {
+	out := new(QueryDerivedFeedsResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/DerivedFeeds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMissCounter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformance, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceWindow(ctx context.Context, in *QueryPriceWindow, opts ...grpc.CallOption) (*QueryPriceWindowResponse, error) {
+	out := new(QueryPriceWindowResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/PriceWindow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) RewardDistribution(ctx context.Context, in *QueryRewardDistribution, opts ...grpc.CallOption) (*QueryRewardDistributionResponse, error) {
+	out := new(QueryRewardDistributionResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/RewardDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySlashWindow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorOffences(ctx context.Context, in *QueryValidatorOffences, opts ...grpc.CallOption) (*QueryValidatorOffencesResponse, error) {
+	out := new(QueryValidatorOffencesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ValidatorOffences", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySlashWindow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySlashWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceVolatility(ctx context.Context, in *QueryPriceVolatility, opts ...grpc.CallOption) (*QueryPriceVolatilityResponse, error) {
+	out := new(QueryPriceVolatilityResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/PriceVolatility", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) HistoricPrices(ctx context.Context, in *QueryHistoricPrices, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error) {
+	out := new(QueryHistoricPricesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/HistoricPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HistoricMedians(ctx context.Context, in *QueryHistoricMedians, opts ...grpc.CallOption) (*QueryHistoricMediansResponse, error) {
+	out := new(QueryHistoricMediansResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/HistoricMedians", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregatePrevote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRates returns exchange rates of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
+	// Feeders returns all the feeders of a validator: its feeder delegation and its standby
+	// feeders
+	Feeders(context.Context, *QueryFeeders) (*QueryFeedersResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(context.Context, *QueryAggregateVote) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(context.Context, *QueryAggregateVotes) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	// Medians returns medians of all denoms,
+	// or, if specified, returns a single median
+	Medians(context.Context, *QueryMedians) (*QueryMediansResponse, error)
+	// MedianDeviations returns median deviations of all denoms,
+	// or, if specified, returns a single median deviation
+	MedianDeviations(context.Context, *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error)
+	// QueryAvgPrice returns avg price of a given denom (required).
+	AvgPrice(context.Context, *QueryAvgPrice) (*QueryAvgPriceResponse, error)
+	// ExgRatesWithTimestamp returns exchange rates of all denoms with timestamp,
+	// or, if specified, returns a single denom
+	ExgRatesWithTimestamp(context.Context, *QueryExgRatesWithTimestamp) (*QueryExgRatesWithTimestampResponse, error)
+	// MissCounters returns oracle missing votes count of validators.
+	MissCounters(context.Context, *QueryMissCounters) (*QueryMissCountersResponse, error)
+	// DenomVoteSettings returns the effective vote threshold, reward band and minimum
+	// number of voters of all accepted denoms, or, if specified, of a single denom.
+	DenomVoteSettings(context.Context, *QueryDenomVoteSettings) (*QueryDenomVoteSettingsResponse, error)
+	// DerivedFeeds returns all derived price feeds.
+	DerivedFeeds(context.Context, *QueryDerivedFeeds) (*QueryDerivedFeedsResponse, error)
+	// ValidatorPerformance returns the oracle performance of validators over the current
+	// slash window, or, if specified, of a single validator.
+	ValidatorPerformance(context.Context, *QueryValidatorPerformance) (*QueryValidatorPerformanceResponse, error)
+	// PriceWindow returns the time weighted average, min, max, open and close prices of a denom
+	// over a window of blocks, computed from the historic price stamps. If a resolution is
+	// given, it also returns the window split into candles.
+	PriceWindow(context.Context, *QueryPriceWindow) (*QueryPriceWindowResponse, error)
+	// RewardDistribution is a dry run of the oracle rewards distribution of the current vote
+	// period: it tallies the votes submitted so far, and splits the vote period share of the
+	// reward pool among the validators.
+	RewardDistribution(context.Context, *QueryRewardDistribution) (*QueryRewardDistributionResponse, error)
+	// ValidatorOffences returns the oracle offence history of validators and the penalty
+	// params, or, if specified, of a single validator.
+	ValidatorOffences(context.Context, *QueryValidatorOffences) (*QueryValidatorOffencesResponse, error)
+	// PriceVolatility returns the rolling realized volatility of the exchange rates, or, if
+	// specified, of a single denom.
+	PriceVolatility(context.Context, *QueryPriceVolatility) (*QueryPriceVolatilityResponse, error)
+	// HistoricPrices returns the historic price stamps of a denom, within an optional range of
+	// blocks, with pagination.
+	HistoricPrices(context.Context, *QueryHistoricPrices) (*QueryHistoricPricesResponse, error)
+	// HistoricMedians returns the median and median deviation stamps of a denom, within an
+	// optional range of blocks, with pagination.
+	HistoricMedians(context.Context, *QueryHistoricMedians) (*QueryHistoricMediansResponse, error)
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRates) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
-
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
 }
-
-func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegation) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
 }
-
-func (m *QueryAggregatePrevotes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Feeders(ctx context.Context, req *QueryFeeders) (*QueryFeedersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feeders not implemented")
 }
-
-func (m *QueryAggregatePrevotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounter) (*QueryMissCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
 }
-
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
-
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVote) (*QueryAggregateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotes) (*QueryAggregateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Medians(ctx context.Context, req *QueryMedians) (*QueryMediansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Medians not implemented")
+}
+func (*UnimplementedQueryServer) MedianDeviations(ctx context.Context, req *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MedianDeviations not implemented")
+}
+func (*UnimplementedQueryServer) AvgPrice(ctx context.Context, req *QueryAvgPrice) (*QueryAvgPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvgPrice not implemented")
+}
+func (*UnimplementedQueryServer) ExgRatesWithTimestamp(ctx context.Context, req *QueryExgRatesWithTimestamp) (*QueryExgRatesWithTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExgRatesWithTimestamp not implemented")
+}
+func (*UnimplementedQueryServer) MissCounters(ctx context.Context, req *QueryMissCounters) (*QueryMissCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounters not implemented")
+}
+func (*UnimplementedQueryServer) DenomVoteSettings(ctx context.Context, req *QueryDenomVoteSettings) (*QueryDenomVoteSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomVoteSettings not implemented")
+}
+func (*UnimplementedQueryServer) DerivedFeeds(ctx context.Context, req *QueryDerivedFeeds) (*QueryDerivedFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedFeeds not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *QueryValidatorPerformance) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) PriceWindow(ctx context.Context, req *QueryPriceWindow) (*QueryPriceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceWindow not implemented")
+}
+func (*UnimplementedQueryServer) RewardDistribution(ctx context.Context, req *QueryRewardDistribution) (*QueryRewardDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDistribution not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOffences(ctx context.Context, req *QueryValidatorOffences) (*QueryValidatorOffencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOffences not implemented")
+}
+func (*UnimplementedQueryServer) PriceVolatility(ctx context.Context, req *QueryPriceVolatility) (*QueryPriceVolatilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceVolatility not implemented")
+}
+func (*UnimplementedQueryServer) HistoricPrices(ctx context.Context, req *QueryHistoricPrices) (*QueryHistoricPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricPrices not implemented")
+}
+func (*UnimplementedQueryServer) HistoricMedians(ctx context.Context, req *QueryHistoricMedians) (*QueryHistoricMediansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricMedians not implemented")
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRates)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/ExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveExchangeRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/ActiveExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveExchangeRates(ctx, req.(*QueryActiveExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Feeders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeders)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Feeders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/Feeders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feeders(ctx, req.(*QueryFeeders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/MissCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashWindow)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregateVotes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).SlashWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/SlashWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotes)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/AggregateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVotes)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/AggregateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Medians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMedians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Medians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/Medians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Medians(ctx, req.(*QueryMedians))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_MedianDeviations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMedianDeviations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MedianDeviations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/MedianDeviations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MedianDeviations(ctx, req.(*QueryMedianDeviations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AvgPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvgPrice)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AvgPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/AvgPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvgPrice(ctx, req.(*QueryAvgPrice))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ExgRatesWithTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExgRatesWithTimestamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExgRatesWithTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/ExgRatesWithTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExgRatesWithTimestamp(ctx, req.(*QueryExgRatesWithTimestamp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_MissCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounters)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/MissCounters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounters(ctx, req.(*QueryMissCounters))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMedians) Marshal() (dAtA []byte, err error) {
+func _Query_DenomVoteSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomVoteSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomVoteSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/DenomVoteSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomVoteSettings(ctx, req.(*QueryDenomVoteSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivedFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedFeeds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/DerivedFeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedFeeds(ctx, req.(*QueryDerivedFeeds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/PriceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceWindow(ctx, req.(*QueryPriceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/RewardDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardDistribution(ctx, req.(*QueryRewardDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOffences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOffences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOffences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/ValidatorOffences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOffences(ctx, req.(*QueryValidatorOffences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceVolatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceVolatility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceVolatility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/PriceVolatility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceVolatility(ctx, req.(*QueryPriceVolatility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/HistoricPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricPrices(ctx, req.(*QueryHistoricPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricMedians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricMedians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricMedians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/HistoricMedians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricMedians(ctx, req.(*QueryHistoricMedians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "umee.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "ActiveExchangeRates",
+			Handler:    _Query_ActiveExchangeRates_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "Feeders",
+			Handler:    _Query_Feeders_Handler,
+		},
+		{
+			MethodName: "MissCounter",
+			Handler:    _Query_MissCounter_Handler,
+		},
+		{
+			MethodName: "SlashWindow",
+			Handler:    _Query_SlashWindow_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "AggregateVote",
+			Handler:    _Query_AggregateVote_Handler,
+		},
+		{
+			MethodName: "AggregateVotes",
+			Handler:    _Query_AggregateVotes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Medians",
+			Handler:    _Query_Medians_Handler,
+		},
+		{
+			MethodName: "MedianDeviations",
+			Handler:    _Query_MedianDeviations_Handler,
+		},
+		{
+			MethodName: "AvgPrice",
+			Handler:    _Query_AvgPrice_Handler,
+		},
+		{
+			MethodName: "ExgRatesWithTimestamp",
+			Handler:    _Query_ExgRatesWithTimestamp_Handler,
+		},
+		{
+			MethodName: "MissCounters",
+			Handler:    _Query_MissCounters_Handler,
+		},
+		{
+			MethodName: "DenomVoteSettings",
+			Handler:    _Query_DenomVoteSettings_Handler,
+		},
+		{
+			MethodName: "DerivedFeeds",
+			Handler:    _Query_DerivedFeeds_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "PriceWindow",
+			Handler:    _Query_PriceWindow_Handler,
+		},
+		{
+			MethodName: "RewardDistribution",
+			Handler:    _Query_RewardDistribution_Handler,
+		},
+		{
+			MethodName: "ValidatorOffences",
+			Handler:    _Query_ValidatorOffences_Handler,
+		},
+		{
+			MethodName: "PriceVolatility",
+			Handler:    _Query_PriceVolatility_Handler,
+		},
+		{
+			MethodName: "HistoricPrices",
+			Handler:    _Query_HistoricPrices_Handler,
+		},
+		{
+			MethodName: "HistoricMedians",
+			Handler:    _Query_HistoricMedians_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "umee/oracle/v1/query.proto",
+}
+
+func (m *QueryMissCounters) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2783,32 +3476,27 @@ func (m *QueryMedians) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMedians) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMissCounters) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMissCounters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumStamps != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMediansResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMissCountersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2818,20 +3506,20 @@ func (m *QueryMediansResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMissCountersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMissCountersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Medians) > 0 {
-		for iNdEx := len(m.Medians) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MissCounters) > 0 {
+		for iNdEx := len(m.MissCounters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Medians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MissCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2845,7 +3533,7 @@ func (m *QueryMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviations) Marshal() (dAtA []byte, err error) {
+func (m *PriceMissCounter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2855,12 +3543,47 @@ func (m *QueryMedianDeviations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMedianDeviations) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceMissCounter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedianDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExgRatesWithTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExgRatesWithTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExgRatesWithTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2875,7 +3598,7 @@ func (m *QueryMedianDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExgRatesWithTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2885,20 +3608,20 @@ func (m *QueryMedianDeviationsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMedianDeviationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExgRatesWithTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedianDeviationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExgRatesWithTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MedianDeviations) > 0 {
-		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExgRates) > 0 {
+		for iNdEx := len(m.ExgRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MedianDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExgRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2912,7 +3635,7 @@ func (m *QueryMedianDeviationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAvgPrice) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2922,12 +3645,12 @@ func (m *QueryAvgPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAvgPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAvgPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2942,7 +3665,7 @@ func (m *QueryAvgPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAvgPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2952,442 +3675,5266 @@ func (m *QueryAvgPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAvgPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAvgPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Confidences) > 0 {
+		for iNdEx := len(m.Confidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryActiveExchangeRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryMissCounters) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryActiveExchangeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMissCountersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MissCounters) > 0 {
-		for _, e := range m.MissCounters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceMissCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+func (m *QueryActiveExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExgRatesWithTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryActiveExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExgRatesWithTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExgRates) > 0 {
-		for _, e := range m.ExgRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ActiveRates) > 0 {
+		for iNdEx := len(m.ActiveRates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveRates[iNdEx])
+			copy(dAtA[i:], m.ActiveRates[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveRates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeederDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeederDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ActiveRates) > 0 {
-		for _, s := range m.ActiveRates {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeederDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeeders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMissCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFeeders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashWindow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeedersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
-	}
-	return n
+func (m *QueryFeedersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeedersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StandbyFeederAddrs) > 0 {
+		for iNdEx := len(m.StandbyFeederAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StandbyFeederAddrs[iNdEx])
+			copy(dAtA[i:], m.StandbyFeederAddrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StandbyFeederAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
-}
-
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryMissCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.WindowProgress != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedians) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.NumStamps != 0 {
-		n += 1 + sovQuery(uint64(m.NumStamps))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMediansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Medians) > 0 {
-		for _, e := range m.Medians {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MedianDeviations) > 0 {
-		for _, e := range m.MedianDeviations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMedians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumStamps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMediansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Medians) > 0 {
+		for iNdEx := len(m.Medians) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Medians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMedianDeviations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedianDeviations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMedianDeviationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedianDeviationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianDeviationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MedianDeviations) > 0 {
+		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MedianDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomVoteSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomVoteSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomVoteSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomVoteSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomVoteSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomVoteSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Settings) > 0 {
+		for iNdEx := len(m.Settings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivedFeeds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedFeeds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedFeeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivedFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolutionBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResolutionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumStamps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FullCoverageBonus) > 0 {
+		i -= len(m.FullCoverageBonus)
+		copy(dAtA[i:], m.FullCoverageBonus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FullCoverageBonus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Formula != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Formula))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodRewards) > 0 {
+		for iNdEx := len(m.PeriodRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOffences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOffences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOffences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOffencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOffencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOffencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceVolatility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceVolatility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceVolatility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceVolatilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceVolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceVolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volatilities) > 0 {
+		for iNdEx := len(m.Volatilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volatilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomVolatility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomVolatility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomVolatility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricMedians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricMedians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricMediansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MedianDeviations) > 0 {
+		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MedianDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Medians) > 0 {
+		for iNdEx := len(m.Medians) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Medians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMissCounters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCountersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MissCounters) > 0 {
+		for _, e := range m.MissCounters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceMissCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QueryExgRatesWithTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExgRatesWithTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExgRates) > 0 {
+		for _, e := range m.ExgRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Confidences) > 0 {
+		for _, e := range m.Confidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveRates) > 0 {
+		for _, s := range m.ActiveRates {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeederDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.StandbyFeederAddrs) > 0 {
+		for _, s := range m.StandbyFeederAddrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMissCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QuerySlashWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMedians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	return n
+}
+
+func (m *QueryMediansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Medians) > 0 {
+		for _, e := range m.Medians {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMedianDeviations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMedianDeviationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MedianDeviations) > 0 {
+		for _, e := range m.MedianDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAvgPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAvgPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomVoteSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomVoteSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settings) > 0 {
+		for _, e := range m.Settings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDerivedFeeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDerivedFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.WindowBlocks))
+	}
+	if m.ResolutionBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.ResolutionBlocks))
+	}
+	return n
+}
+
+func (m *QueryPriceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Min.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	return n
+}
+
+func (m *QueryRewardDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Formula != 0 {
+		n += 1 + sovQuery(uint64(m.Formula))
+	}
+	l = len(m.FullCoverageBonus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PeriodRewards) > 0 {
+		for _, e := range m.PeriodRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorOffences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOffencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceVolatility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceVolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volatilities) > 0 {
+		for _, e := range m.Volatilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomVolatility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Volatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovQuery(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *QueryHistoricPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovQuery(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ToBlock))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricMedians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovQuery(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ToBlock))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricMediansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Medians) > 0 {
+		for _, e := range m.Medians {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MedianDeviations) > 0 {
+		for _, e := range m.MedianDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMissCounters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCountersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCountersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCountersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissCounters = append(m.MissCounters, PriceMissCounter{})
+			if err := m.MissCounters[len(m.MissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceMissCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMissCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExgRatesWithTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExgRatesWithTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExgRatesWithTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExgRatesWithTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExgRatesWithTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExgRatesWithTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExgRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExgRates = append(m.ExgRates, DenomExchangeRate{})
+			if err := m.ExgRates[len(m.ExgRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, PriceOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confidences = append(m.Confidences, PriceConfidence{})
+			if err := m.Confidences[len(m.Confidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeedersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyFeederAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyFeederAddrs = append(m.StandbyFeederAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			}
+			m.WindowProgress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProgress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMedians) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Medians = append(m.Medians, Price{})
+			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MedianDeviations = append(m.MedianDeviations, Price{})
+			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryAvgPrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryAvgPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryMissCounters) Unmarshal(dAtA []byte) error {
+func (m *QueryAvgPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3410,15 +8957,15 @@ func (m *QueryMissCounters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounters: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAvgPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAvgPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3446,7 +8993,9 @@ func (m *QueryMissCounters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3469,7 +9018,7 @@ func (m *QueryMissCounters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCountersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomVoteSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,17 +9041,17 @@ func (m *QueryMissCountersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCountersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomVoteSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCountersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomVoteSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3512,25 +9061,23 @@ func (m *QueryMissCountersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissCounters = append(m.MissCounters, PriceMissCounter{})
-			if err := m.MissCounters[len(m.MissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3553,7 +9100,7 @@ func (m *QueryMissCountersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceMissCounter) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomVoteSettingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3576,17 +9123,17 @@ func (m *PriceMissCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceMissCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomVoteSettingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomVoteSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3596,29 +9143,131 @@ func (m *PriceMissCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Settings = append(m.Settings, DenomVoteSettings{})
+			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MissCounter = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivedFeeds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivedFeeds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivedFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivedFeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivedFeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivedFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3628,11 +9277,26 @@ func (m *PriceMissCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeds = append(m.Feeds, DerivedFeed{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3654,7 +9318,7 @@ func (m *PriceMissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExgRatesWithTimestamp) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3677,17 +9341,49 @@ func (m *QueryExgRatesWithTimestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExgRatesWithTimestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExgRatesWithTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3697,23 +9393,27 @@ func (m *QueryExgRatesWithTimestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3736,7 +9436,7 @@ func (m *QueryExgRatesWithTimestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExgRatesWithTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3759,15 +9459,15 @@ func (m *QueryExgRatesWithTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExgRatesWithTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExgRatesWithTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExgRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3794,8 +9494,44 @@ func (m *QueryExgRatesWithTimestampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExgRates = append(m.ExgRates, DenomExchangeRate{})
-			if err := m.ExgRates[len(m.ExgRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Performances = append(m.Performances, ValidatorPerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3820,7 +9556,7 @@ func (m *QueryExgRatesWithTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3843,10 +9579,10 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3881,6 +9617,44 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionBlocks", wireType)
+			}
+			m.ResolutionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3902,7 +9676,7 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3925,15 +9699,15 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3960,61 +9734,44 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Candles = append(m.Candles, PriceWindow{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4036,7 +9793,7 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *PriceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4059,15 +9816,121 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4095,61 +9958,47 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4177,8 +10026,29 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4200,7 +10070,7 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4223,15 +10093,34 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+			}
+			m.Formula = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formula |= RewardFormula(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FullCoverageBonus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4259,7 +10148,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.FullCoverageBonus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4282,7 +10171,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4305,17 +10194,17 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4325,79 +10214,64 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodRewards", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PeriodRewards = append(m.PeriodRewards, types.DecCoin{})
+			if err := m.PeriodRewards[len(m.PeriodRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4407,61 +10281,26 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Rewards = append(m.Rewards, ValidatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4483,7 +10322,7 @@ func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4506,17 +10345,17 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.WindowProgress = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4526,64 +10365,27 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProgress |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4611,7 +10413,43 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4634,7 +10472,7 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOffences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4657,15 +10495,47 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOffences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOffences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4692,7 +10562,10 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4717,7 +10590,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOffencesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4740,65 +10613,82 @@ func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOffencesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOffencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Histories = append(m.Histories, OffenceHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4825,8 +10715,10 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4851,7 +10743,7 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceVolatility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4874,15 +10766,15 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceVolatility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4910,7 +10802,7 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4933,7 +10825,7 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceVolatilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4956,15 +10848,15 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceVolatilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceVolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatilities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4991,7 +10883,8 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volatilities = append(m.Volatilities, DenomVolatility{})
+			if err := m.Volatilities[len(m.Volatilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5016,7 +10909,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
+func (m *DenomVolatility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5039,67 +10932,49 @@ func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomVolatility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5109,76 +10984,45 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5200,7 +11044,7 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5223,17 +11067,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5243,80 +11087,67 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMedians) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5326,43 +11157,28 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.NumStamps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumStamps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5384,7 +11200,7 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5407,15 +11223,15 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5442,8 +11258,44 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Medians = append(m.Medians, Price{})
-			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5468,7 +11320,7 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricMedians) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5491,10 +11343,10 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricMedians: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricMedians: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5529,59 +11381,47 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5608,8 +11448,10 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MedianDeviations = append(m.MedianDeviations, Price{})
-			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5634,7 +11476,7 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgPrice) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricMediansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5657,17 +11499,17 @@ func (m *QueryAvgPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricMediansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5677,79 +11519,65 @@ func (m *QueryAvgPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Medians = append(m.Medians, Price{})
+			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAvgPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MedianDeviations = append(m.MedianDeviations, Price{})
+			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5759,23 +11587,25 @@ func (m *QueryAvgPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRewardParams returns the default reward params: rewards are split by claim weight,
+// without full coverage bonus.
+func DefaultRewardParams() RewardParams {
+	return RewardParams{
+		Formula:           RewardFormula_REWARD_FORMULA_CLAIM_WEIGHT,
+		FullCoverageBonus: sdk.ZeroDec(),
+	}
+}
+
+// Validate performs a basic validation of the reward params.
+func (rp RewardParams) Validate() error {
+	if _, ok := RewardFormula_name[int32(rp.Formula)]; !ok {
+		return ErrInvalidParams.Wrapf("unknown reward formula: %d", rp.Formula)
+	}
+	if rp.FullCoverageBonus.IsNil() || rp.FullCoverageBonus.IsNegative() || rp.FullCoverageBonus.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("full coverage bonus must be in [0, 1], got %s", rp.FullCoverageBonus)
+	}
+	return nil
+}
+
+// RewardWeight returns the reward weight of the claim, given the number of vote targets.
+func (rp RewardParams) RewardWeight(c Claim, voteTargets int) sdk.Dec {
+	var w sdk.Dec
+	if rp.Formula == RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED {
+		w = c.AccuracyWeight
+	} else {
+		w = sdk.NewDec(c.Weight)
+	}
+	if voteTargets > 0 && int(c.TokensVoted) >= voteTargets {
+		w = w.Mul(sdk.OneDec().Add(rp.FullCoverageBonus))
+	}
+	return w
+}
+
+// RewardShares returns the share of the period rewards of each claim, in the same order.
+// Returns nil when no claim has a positive reward weight.
+func (rp RewardParams) RewardShares(claims []Claim, voteTargets int) []sdk.Dec {
+	weights := make([]sdk.Dec, len(claims))
+	total := sdk.ZeroDec()
+	for i, c := range claims {
+		weights[i] = rp.RewardWeight(c, voteTargets)
+		total = total.Add(weights[i])
+	}
+	if !total.IsPositive() {
+		return nil
+	}
+	for i := range weights {
+		weights[i] = weights[i].Quo(total)
+	}
+	return weights
+}
+
+// VoteAccuracy returns the accuracy of a vote within the reward spread of the exchange rate:
+// 1 - |vote - exchange rate| / reward spread, clamped to [0, 1]. Exact votes have an
+// accuracy of 1, and abstentions of 0.
+func VoteAccuracy(vote, exchangeRate, rewardSpread sdk.Dec) sdk.Dec {
+	if !vote.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	diff := vote.Sub(exchangeRate).Abs()
+	if diff.IsZero() {
+		return sdk.OneDec()
+	}
+	if !rewardSpread.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.MaxDec(sdk.ZeroDec(), sdk.OneDec().Sub(diff.Quo(rewardSpread)))
+}
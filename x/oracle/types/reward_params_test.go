@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+func TestRewardParamsValidate(t *testing.T) {
+	assert.NilError(t, types.DefaultRewardParams().Validate())
+
+	rp := types.RewardParams{Formula: 3, FullCoverageBonus: sdk.ZeroDec()}
+	assert.ErrorContains(t, rp.Validate(), "unknown reward formula")
+	rp = types.RewardParams{Formula: types.RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED}
+	assert.ErrorContains(t, rp.Validate(), "full coverage bonus must be in [0, 1]")
+	rp.FullCoverageBonus = sdk.NewDecWithPrec(11, 1)
+	assert.ErrorContains(t, rp.Validate(), "full coverage bonus must be in [0, 1]")
+	rp.FullCoverageBonus = sdk.OneDec()
+	assert.NilError(t, rp.Validate())
+}
+
+func TestVoteAccuracy(t *testing.T) {
+	rate, spread := sdk.NewDec(10), sdk.NewDecWithPrec(5, 1)
+	tcs := []struct {
+		vote     sdk.Dec
+		accuracy sdk.Dec
+	}{
+		{sdk.NewDec(10), sdk.OneDec()},
+		{sdk.MustNewDecFromStr("10.25"), sdk.NewDecWithPrec(5, 1)},
+		{sdk.MustNewDecFromStr("9.6"), sdk.NewDecWithPrec(2, 1)},
+		{sdk.MustNewDecFromStr("10.5"), sdk.ZeroDec()},
+		{sdk.NewDec(11), sdk.ZeroDec()},
+		// abstention
+		{sdk.ZeroDec(), sdk.ZeroDec()},
+	}
+	for _, tc := range tcs {
+		assert.DeepEqual(t, tc.accuracy, types.VoteAccuracy(tc.vote, rate, spread))
+	}
+	assert.DeepEqual(t, sdk.OneDec(), types.VoteAccuracy(rate, rate, sdk.ZeroDec()))
+	assert.DeepEqual(t, sdk.ZeroDec(), types.VoteAccuracy(sdk.NewDec(11), rate, sdk.ZeroDec()))
+}
+
+func TestRewardShares(t *testing.T) {
+	claims := []types.Claim{
+		// full coverage, inaccurate
+		{Weight: 10, TokensVoted: 2, AccuracyWeight: sdk.NewDec(2)},
+		// partial coverage, accurate
+		{Weight: 10, TokensVoted: 1, AccuracyWeight: sdk.NewDec(6)},
+		// not rewarded
+		{Weight: 0, TokensVoted: 0, AccuracyWeight: sdk.ZeroDec()},
+	}
+	dec := sdk.MustNewDecFromStr
+
+	rp := types.DefaultRewardParams()
+	assert.DeepEqual(t, []sdk.Dec{dec("0.5"), dec("0.5"), sdk.ZeroDec()}, rp.RewardShares(claims, 2))
+	rp.FullCoverageBonus = sdk.OneDec()
+	assert.DeepEqual(t, []sdk.Dec{dec("0.666666666666666667"), dec("0.333333333333333333"), sdk.ZeroDec()},
+		rp.RewardShares(claims, 2))
+
+	rp.Formula = types.RewardFormula_REWARD_FORMULA_ACCURACY_WEIGHTED
+	assert.DeepEqual(t, []sdk.Dec{dec("0.4"), dec("0.6"), sdk.ZeroDec()}, rp.RewardShares(claims, 2))
+	rp.FullCoverageBonus = sdk.ZeroDec()
+	assert.DeepEqual(t, []sdk.Dec{dec("0.25"), dec("0.75"), sdk.ZeroDec()}, rp.RewardShares(claims, 2))
+
+	// no claim with a positive reward weight
+	assert.Assert(t, rp.RewardShares(claims[2:], 2) == nil)
+}
@@ -6,21 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -213,6 +220,10 @@ type MsgDelegateFeedConsent struct {
 	// Operator is the author and the signer of the message.
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
 	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty" yaml:"delegate"`
+	// standby_delegates are additional feeders, allowed to vote on behalf of the operator
+	// as well (e.g. for high availability or key rotation). They replace the previous
+	// standby delegates of the operator. At most MaxStandbyFeeders.
+	StandbyDelegates []string `protobuf:"bytes,3,rep,name=standby_delegates,json=standbyDelegates,proto3" json:"standby_delegates,omitempty" yaml:"standby_delegates"`
 }
 
 func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{} }
@@ -293,6 +304,454 @@ var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 func (*MsgDelegateFeedConsentResponse) XXX_MessageName() string {
 	return "umee.oracle.v1.MsgDelegateFeedConsentResponse"
 }
+
+// MsgGovUpdateDerivedFeeds defines the Msg/GovUpdateDerivedFeeds request type.
+type MsgGovUpdateDerivedFeeds struct {
+	// authority must be the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// set_feeds are new derived feeds or new settings of existing derived feeds.
+	SetFeeds []DerivedFeed `protobuf:"bytes,2,rep,name=set_feeds,json=setFeeds,proto3" json:"set_feeds"`
+	// delete_feeds are symbol denoms of derived feeds to remove. Their last exchange rate
+	// is removed as well.
+	DeleteFeeds []string `protobuf:"bytes,3,rep,name=delete_feeds,json=deleteFeeds,proto3" json:"delete_feeds,omitempty"`
+}
+
+func (m *MsgGovUpdateDerivedFeeds) Reset()      { *m = MsgGovUpdateDerivedFeeds{} }
+func (*MsgGovUpdateDerivedFeeds) ProtoMessage() {}
+func (*MsgGovUpdateDerivedFeeds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{6}
+}
+func (m *MsgGovUpdateDerivedFeeds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateDerivedFeeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateDerivedFeeds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateDerivedFeeds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateDerivedFeeds.Merge(m, src)
+}
+func (m *MsgGovUpdateDerivedFeeds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateDerivedFeeds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateDerivedFeeds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateDerivedFeeds proto.InternalMessageInfo
+
+func (*MsgGovUpdateDerivedFeeds) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovUpdateDerivedFeeds"
+}
+
+// MsgGovUpdateDerivedFeedsResponse defines the Msg/GovUpdateDerivedFeeds response type.
+type MsgGovUpdateDerivedFeedsResponse struct {
+}
+
+func (m *MsgGovUpdateDerivedFeedsResponse) Reset()         { *m = MsgGovUpdateDerivedFeedsResponse{} }
+func (m *MsgGovUpdateDerivedFeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateDerivedFeedsResponse) ProtoMessage()    {}
+func (*MsgGovUpdateDerivedFeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{7}
+}
+func (m *MsgGovUpdateDerivedFeedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateDerivedFeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateDerivedFeedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateDerivedFeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateDerivedFeedsResponse.Merge(m, src)
+}
+func (m *MsgGovUpdateDerivedFeedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateDerivedFeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateDerivedFeedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateDerivedFeedsResponse proto.InternalMessageInfo
+
+func (*MsgGovUpdateDerivedFeedsResponse) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovUpdateDerivedFeedsResponse"
+}
+
+// MsgGovSetPriceOverride sets a manual exchange rate of a denom, which replaces its tallied
+// exchange rate until the override expires or is cancelled.
+type MsgGovSetPriceOverride struct {
+	// authority is the address of the governance account or the Emergency Group.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// description motivating the change. Should be used only when executing by the
+	// Emergency Group. Otherwise the x/gov Proposal metadata should be used.
+	Description  string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	SymbolDenom  string                                 `protobuf:"bytes,3,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// duration of the override, after which it expires. Must be positive and at most
+	// MaxPriceOverrideDuration.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgGovSetPriceOverride) Reset()      { *m = MsgGovSetPriceOverride{} }
+func (*MsgGovSetPriceOverride) ProtoMessage() {}
+func (*MsgGovSetPriceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{8}
+}
+func (m *MsgGovSetPriceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetPriceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetPriceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetPriceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetPriceOverride.Merge(m, src)
+}
+func (m *MsgGovSetPriceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetPriceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetPriceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetPriceOverride proto.InternalMessageInfo
+
+func (*MsgGovSetPriceOverride) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovSetPriceOverride"
+}
+
+// MsgGovSetPriceOverrideResponse defines the Msg/GovSetPriceOverride response type.
+type MsgGovSetPriceOverrideResponse struct {
+}
+
+func (m *MsgGovSetPriceOverrideResponse) Reset()         { *m = MsgGovSetPriceOverrideResponse{} }
+func (m *MsgGovSetPriceOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetPriceOverrideResponse) ProtoMessage()    {}
+func (*MsgGovSetPriceOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{9}
+}
+func (m *MsgGovSetPriceOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetPriceOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetPriceOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetPriceOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetPriceOverrideResponse.Merge(m, src)
+}
+func (m *MsgGovSetPriceOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetPriceOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetPriceOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetPriceOverrideResponse proto.InternalMessageInfo
+
+func (*MsgGovSetPriceOverrideResponse) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovSetPriceOverrideResponse"
+}
+
+// MsgGovCancelPriceOverride removes the price override of a denom before its expiry.
+type MsgGovCancelPriceOverride struct {
+	// authority must be the address of the governance account.
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SymbolDenom string `protobuf:"bytes,2,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+}
+
+func (m *MsgGovCancelPriceOverride) Reset()      { *m = MsgGovCancelPriceOverride{} }
+func (*MsgGovCancelPriceOverride) ProtoMessage() {}
+func (*MsgGovCancelPriceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{10}
+}
+func (m *MsgGovCancelPriceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovCancelPriceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovCancelPriceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovCancelPriceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovCancelPriceOverride.Merge(m, src)
+}
+func (m *MsgGovCancelPriceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovCancelPriceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovCancelPriceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovCancelPriceOverride proto.InternalMessageInfo
+
+func (*MsgGovCancelPriceOverride) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovCancelPriceOverride"
+}
+
+// MsgGovCancelPriceOverrideResponse defines the Msg/GovCancelPriceOverride response type.
+type MsgGovCancelPriceOverrideResponse struct {
+}
+
+func (m *MsgGovCancelPriceOverrideResponse) Reset()         { *m = MsgGovCancelPriceOverrideResponse{} }
+func (m *MsgGovCancelPriceOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovCancelPriceOverrideResponse) ProtoMessage()    {}
+func (*MsgGovCancelPriceOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{11}
+}
+func (m *MsgGovCancelPriceOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovCancelPriceOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovCancelPriceOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovCancelPriceOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovCancelPriceOverrideResponse.Merge(m, src)
+}
+func (m *MsgGovCancelPriceOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovCancelPriceOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovCancelPriceOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovCancelPriceOverrideResponse proto.InternalMessageInfo
+
+func (*MsgGovCancelPriceOverrideResponse) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovCancelPriceOverrideResponse"
+}
+
+// MsgGovUpdateParams updates the oracle params, the historic avg counter params, the reward
+// params and the penalty params of the given keys.
+type MsgGovUpdateParams struct {
+	// authority must be the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// keys of the params to update: the Params store keys (e.g. "VotePeriod"), except
+	// "AcceptList", "AvgPeriod" and "AvgShift" for the historic avg counter params,
+	// "RewardParams" for the reward params and "PenaltyParams" for the penalty params.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// changes holds the new values of the updated params. Other params are ignored.
+	Changes Params `protobuf:"bytes,3,opt,name=changes,proto3" json:"changes"`
+	// avg_counter_changes holds the new values of the updated historic avg counter params.
+	AvgCounterChanges AvgCounterParams `protobuf:"bytes,4,opt,name=avg_counter_changes,json=avgCounterChanges,proto3" json:"avg_counter_changes"`
+	// reward_params holds the new reward params, used with the "RewardParams" key.
+	RewardParams RewardParams `protobuf:"bytes,5,opt,name=reward_params,json=rewardParams,proto3" json:"reward_params"`
+	// penalty_params holds the new penalty params, used with the "PenaltyParams" key.
+	PenaltyParams PenaltyParams `protobuf:"bytes,6,opt,name=penalty_params,json=penaltyParams,proto3" json:"penalty_params"`
+}
+
+func (m *MsgGovUpdateParams) Reset()      { *m = MsgGovUpdateParams{} }
+func (*MsgGovUpdateParams) ProtoMessage() {}
+func (*MsgGovUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{12}
+}
+func (m *MsgGovUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateParams.Merge(m, src)
+}
+func (m *MsgGovUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateParams proto.InternalMessageInfo
+
+func (*MsgGovUpdateParams) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovUpdateParams"
+}
+
+// MsgGovUpdateParamsResponse defines the Msg/GovUpdateParams response type.
+type MsgGovUpdateParamsResponse struct {
+}
+
+func (m *MsgGovUpdateParamsResponse) Reset()         { *m = MsgGovUpdateParamsResponse{} }
+func (m *MsgGovUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgGovUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{13}
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateParamsResponse proto.InternalMessageInfo
+
+func (*MsgGovUpdateParamsResponse) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovUpdateParamsResponse"
+}
+
+// MsgGovUpdateAcceptList adds, updates or removes denoms of the accept list.
+type MsgGovUpdateAcceptList struct {
+	// authority must be the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// set_denoms are new denoms, or new settings of accepted denoms, by base denom.
+	SetDenoms []Denom `protobuf:"bytes,2,rep,name=set_denoms,json=setDenoms,proto3" json:"set_denoms"`
+	// delete_denoms are base denoms to remove from the accept list. The exchange rate of a
+	// removed symbol denom is removed as well.
+	DeleteDenoms []string `protobuf:"bytes,3,rep,name=delete_denoms,json=deleteDenoms,proto3" json:"delete_denoms,omitempty"`
+}
+
+func (m *MsgGovUpdateAcceptList) Reset()      { *m = MsgGovUpdateAcceptList{} }
+func (*MsgGovUpdateAcceptList) ProtoMessage() {}
+func (*MsgGovUpdateAcceptList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{14}
+}
+func (m *MsgGovUpdateAcceptList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateAcceptList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateAcceptList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateAcceptList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateAcceptList.Merge(m, src)
+}
+func (m *MsgGovUpdateAcceptList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateAcceptList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateAcceptList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateAcceptList proto.InternalMessageInfo
+
+func (*MsgGovUpdateAcceptList) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovUpdateAcceptList"
+}
+
+// MsgGovUpdateAcceptListResponse defines the Msg/GovUpdateAcceptList response type.
+type MsgGovUpdateAcceptListResponse struct {
+}
+
+func (m *MsgGovUpdateAcceptListResponse) Reset()         { *m = MsgGovUpdateAcceptListResponse{} }
+func (m *MsgGovUpdateAcceptListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateAcceptListResponse) ProtoMessage()    {}
+func (*MsgGovUpdateAcceptListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5883b225aa8cf2e2, []int{15}
+}
+func (m *MsgGovUpdateAcceptListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateAcceptListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateAcceptListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateAcceptListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateAcceptListResponse.Merge(m, src)
+}
+func (m *MsgGovUpdateAcceptListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateAcceptListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateAcceptListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateAcceptListResponse proto.InternalMessageInfo
+
+func (*MsgGovUpdateAcceptListResponse) XXX_MessageName() string {
+	return "umee.oracle.v1.MsgGovUpdateAcceptListResponse"
+}
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "umee.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "umee.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -300,44 +759,95 @@ func init() {
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "umee.oracle.v1.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "umee.oracle.v1.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "umee.oracle.v1.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgGovUpdateDerivedFeeds)(nil), "umee.oracle.v1.MsgGovUpdateDerivedFeeds")
+	proto.RegisterType((*MsgGovUpdateDerivedFeedsResponse)(nil), "umee.oracle.v1.MsgGovUpdateDerivedFeedsResponse")
+	proto.RegisterType((*MsgGovSetPriceOverride)(nil), "umee.oracle.v1.MsgGovSetPriceOverride")
+	proto.RegisterType((*MsgGovSetPriceOverrideResponse)(nil), "umee.oracle.v1.MsgGovSetPriceOverrideResponse")
+	proto.RegisterType((*MsgGovCancelPriceOverride)(nil), "umee.oracle.v1.MsgGovCancelPriceOverride")
+	proto.RegisterType((*MsgGovCancelPriceOverrideResponse)(nil), "umee.oracle.v1.MsgGovCancelPriceOverrideResponse")
+	proto.RegisterType((*MsgGovUpdateParams)(nil), "umee.oracle.v1.MsgGovUpdateParams")
+	proto.RegisterType((*MsgGovUpdateParamsResponse)(nil), "umee.oracle.v1.MsgGovUpdateParamsResponse")
+	proto.RegisterType((*MsgGovUpdateAcceptList)(nil), "umee.oracle.v1.MsgGovUpdateAcceptList")
+	proto.RegisterType((*MsgGovUpdateAcceptListResponse)(nil), "umee.oracle.v1.MsgGovUpdateAcceptListResponse")
 }
 
 func init() { proto.RegisterFile("umee/oracle/v1/tx.proto", fileDescriptor_5883b225aa8cf2e2) }
 
 var fileDescriptor_5883b225aa8cf2e2 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbb, 0x6e, 0x13, 0x41,
-	0x14, 0x86, 0x77, 0x70, 0x14, 0x25, 0x83, 0x92, 0xc0, 0x26, 0x80, 0x63, 0x45, 0xb3, 0xd1, 0x80,
-	0x02, 0x91, 0x60, 0x47, 0x09, 0x08, 0xa4, 0x54, 0x10, 0x2e, 0x9d, 0xa5, 0x68, 0x0a, 0x0a, 0x1a,
-	0x34, 0xb1, 0x0f, 0xe3, 0x08, 0xdb, 0x63, 0xcd, 0x4c, 0x16, 0xa7, 0x82, 0x82, 0x22, 0x25, 0x8f,
-	0x90, 0x37, 0xe0, 0x35, 0x5c, 0xa6, 0xa4, 0x5a, 0x81, 0xdd, 0x50, 0x51, 0xec, 0x13, 0xa0, 0x9d,
-	0xbd, 0xe0, 0x08, 0xe7, 0xe2, 0xce, 0x3e, 0xff, 0x77, 0x6e, 0xff, 0x1e, 0x0d, 0xbe, 0x73, 0xd8,
-	0x01, 0x60, 0x4a, 0x8b, 0x46, 0x1b, 0x58, 0xb4, 0xc5, 0x6c, 0x3f, 0xec, 0x69, 0x65, 0x95, 0xbf,
-	0x98, 0x0a, 0x61, 0x26, 0x84, 0xd1, 0x56, 0x6d, 0x45, 0x2a, 0xa9, 0x9c, 0xc4, 0xd2, 0x5f, 0x19,
-	0x45, 0xbf, 0x23, 0x1c, 0xd4, 0x8d, 0x7c, 0x21, 0xa5, 0x06, 0x29, 0x2c, 0xbc, 0xee, 0x37, 0x5a,
-	0xa2, 0x2b, 0x81, 0x0b, 0x0b, 0x7b, 0x1a, 0x22, 0x65, 0xc1, 0xbf, 0x8b, 0x67, 0x5a, 0xc2, 0xb4,
-	0xaa, 0x68, 0x1d, 0x3d, 0x98, 0xdf, 0x5d, 0x4a, 0xe2, 0xe0, 0xfa, 0x91, 0xe8, 0xb4, 0x77, 0x68,
-	0x1a, 0xa5, 0xdc, 0x89, 0xfe, 0x26, 0x9e, 0xfd, 0x00, 0xd0, 0x04, 0x5d, 0xbd, 0xe6, 0xb0, 0x9b,
-	0x49, 0x1c, 0x2c, 0x64, 0x58, 0x16, 0xa7, 0x3c, 0x07, 0xfc, 0x6d, 0x3c, 0x1f, 0x89, 0xf6, 0x41,
-	0x53, 0x58, 0xa5, 0xab, 0x15, 0x47, 0xaf, 0x24, 0x71, 0x70, 0x23, 0xa3, 0x4b, 0x89, 0xf2, 0x7f,
-	0xd8, 0xce, 0xdc, 0xf1, 0x49, 0xe0, 0xfd, 0x3e, 0x09, 0x3c, 0xba, 0x89, 0xef, 0x5f, 0x32, 0x30,
-	0x07, 0xd3, 0x53, 0x5d, 0x03, 0xf4, 0x0f, 0xc2, 0x6b, 0xe7, 0xb1, 0x6f, 0xf3, 0xcd, 0x8c, 0x68,
-	0xdb, 0xff, 0x37, 0x4b, 0xa3, 0x94, 0x3b, 0xd1, 0x7f, 0x8e, 0x17, 0x21, 0x4f, 0x7c, 0xaf, 0x85,
-	0x05, 0x93, 0x6f, 0xb8, 0x9a, 0xc4, 0xc1, 0xad, 0x0c, 0x3f, 0xab, 0x53, 0xbe, 0x00, 0x63, 0x9d,
-	0xcc, 0x98, 0x37, 0x95, 0xa9, 0xbc, 0x99, 0x99, 0xd6, 0x9b, 0x0d, 0x7c, 0xef, 0xa2, 0x7d, 0x4b,
-	0x63, 0xbe, 0x22, 0x7c, 0xbb, 0x6e, 0xe4, 0x2b, 0x68, 0x3b, 0xee, 0x0d, 0x40, 0xf3, 0x65, 0x2a,
-	0x74, 0xad, 0xcf, 0xf0, 0x9c, 0xea, 0x81, 0x76, 0xfd, 0x33, 0x5b, 0x96, 0x93, 0x38, 0x58, 0xca,
-	0xfa, 0x17, 0x0a, 0xe5, 0x25, 0x94, 0x26, 0x34, 0xf3, 0x3a, 0xb9, 0x31, 0x63, 0x09, 0x85, 0x42,
-	0x79, 0x09, 0x8d, 0x8d, 0xbb, 0x8e, 0xc9, 0xe4, 0x29, 0x8a, 0x41, 0xb7, 0xbf, 0x54, 0x70, 0xa5,
-	0x6e, 0xa4, 0x7f, 0x8c, 0xf0, 0xda, 0x85, 0x37, 0xca, 0xc2, 0xb3, 0xe7, 0x1e, 0x5e, 0x72, 0x23,
-	0xb5, 0x67, 0x53, 0x26, 0x14, 0x23, 0xf9, 0x9f, 0xf1, 0xea, 0xf9, 0x07, 0xf5, 0xf0, 0xaa, 0x55,
-	0x53, 0xba, 0xf6, 0x64, 0x1a, 0xba, 0x1c, 0xa0, 0x83, 0x97, 0x27, 0x7d, 0xb8, 0x8d, 0x09, 0xc5,
-	0x26, 0x70, 0xb5, 0xf0, 0x6a, 0x5c, 0xd1, 0x6e, 0x77, 0x6f, 0xf0, 0x8b, 0x78, 0x83, 0x21, 0x41,
-	0xa7, 0x43, 0x82, 0x7e, 0x0e, 0x09, 0xfa, 0x36, 0x22, 0xde, 0x60, 0x44, 0xd0, 0xe9, 0x88, 0x78,
-	0x3f, 0x46, 0xc4, 0x7b, 0x17, 0xca, 0x03, 0xdb, 0x3a, 0xdc, 0x0f, 0x1b, 0xaa, 0xc3, 0xd2, 0xda,
-	0x8f, 0xba, 0x60, 0x3f, 0x29, 0xfd, 0xd1, 0xfd, 0x61, 0xd1, 0x53, 0xd6, 0x2f, 0xde, 0x27, 0x7b,
-	0xd4, 0x03, 0xb3, 0x3f, 0xeb, 0x9e, 0x9e, 0xc7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xd4,
-	0x86, 0xea, 0xbb, 0x04, 0x00, 0x00,
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0xa6, 0xec, 0x36, 0x93, 0xa6, 0xdd, 0xba, 0x3f, 0x36, 0xf5, 0x96, 0x38, 0xeb,
+	0xa2, 0xd2, 0xae, 0xa8, 0x4d, 0x0b, 0x2a, 0x52, 0x0f, 0x40, 0x7f, 0x40, 0x05, 0xa2, 0xa2, 0x72,
+	0xc5, 0x1e, 0xb8, 0x44, 0xd3, 0x78, 0xd6, 0x8d, 0x9a, 0x78, 0xa2, 0x99, 0x89, 0xb7, 0x39, 0x21,
+	0x71, 0xda, 0x23, 0xe2, 0xb4, 0xc7, 0xe5, 0x1f, 0x00, 0x0e, 0xfc, 0x0f, 0xf4, 0x82, 0x58, 0x38,
+	0x21, 0x0e, 0x01, 0xda, 0x03, 0x9c, 0x38, 0xe4, 0x2f, 0x40, 0x9e, 0x19, 0xbb, 0x8e, 0xe3, 0xa6,
+	0x69, 0xc5, 0xa9, 0xf1, 0x7b, 0x9f, 0x79, 0xef, 0x7d, 0xdf, 0xcc, 0x3c, 0xbb, 0xe0, 0x7e, 0xab,
+	0x81, 0x90, 0x85, 0x09, 0xac, 0xd6, 0x91, 0xe5, 0xaf, 0x59, 0xec, 0xd4, 0x6c, 0x12, 0xcc, 0xb0,
+	0x3a, 0x11, 0x38, 0x4c, 0xe1, 0x30, 0xfd, 0x35, 0xed, 0x7e, 0x15, 0xd3, 0x06, 0xa6, 0x56, 0x83,
+	0xba, 0x01, 0xd7, 0xa0, 0xae, 0x00, 0xb5, 0x79, 0xe1, 0xa8, 0xf0, 0x27, 0x4b, 0x3c, 0x48, 0xd7,
+	0x8c, 0x8b, 0x5d, 0x2c, 0xec, 0xc1, 0x2f, 0x69, 0x2d, 0xb9, 0x18, 0xbb, 0x75, 0x64, 0xf1, 0xa7,
+	0xa3, 0xd6, 0x13, 0xcb, 0x69, 0x11, 0xc8, 0x6a, 0xd8, 0x93, 0xfe, 0x07, 0x89, 0x92, 0x64, 0x0d,
+	0xdc, 0x69, 0x7c, 0xa7, 0x00, 0x7d, 0x9f, 0xba, 0x5b, 0xae, 0x4b, 0x90, 0x0b, 0x19, 0xfa, 0xe0,
+	0xb4, 0x7a, 0x0c, 0x3d, 0x17, 0xd9, 0x90, 0xa1, 0x03, 0x82, 0x7c, 0xcc, 0x90, 0xba, 0x08, 0x46,
+	0x8f, 0x21, 0x3d, 0x2e, 0x2a, 0x65, 0x65, 0x39, 0xb7, 0x3d, 0xd9, 0xed, 0xe8, 0xf9, 0x36, 0x6c,
+	0xd4, 0x37, 0x8d, 0xc0, 0x6a, 0xd8, 0xdc, 0xa9, 0xae, 0x80, 0x3b, 0x4f, 0x10, 0x72, 0x10, 0x29,
+	0x8e, 0x70, 0x6c, 0xaa, 0xdb, 0xd1, 0x0b, 0x02, 0x13, 0x76, 0xc3, 0x96, 0x80, 0xba, 0x0e, 0x72,
+	0x3e, 0xac, 0xd7, 0x1c, 0xc8, 0x30, 0x29, 0x66, 0x39, 0x3d, 0xd3, 0xed, 0xe8, 0xf7, 0x04, 0x1d,
+	0xb9, 0x0c, 0xfb, 0x12, 0xdb, 0x1c, 0x7b, 0xf6, 0x42, 0xcf, 0xfc, 0xf3, 0x42, 0xcf, 0x18, 0x2b,
+	0xe0, 0xf5, 0x6b, 0x0a, 0xb6, 0x11, 0x6d, 0x62, 0x8f, 0x22, 0xe3, 0x5f, 0x05, 0x2c, 0x5c, 0xc5,
+	0x3e, 0x96, 0xca, 0x28, 0xac, 0xb3, 0x7e, 0x65, 0x81, 0xd5, 0xb0, 0xb9, 0x53, 0x7d, 0x1f, 0x4c,
+	0x20, 0xb9, 0xb0, 0x42, 0x20, 0x43, 0x54, 0x2a, 0x9c, 0xef, 0x76, 0xf4, 0x59, 0x81, 0xf7, 0xfa,
+	0x0d, 0xbb, 0x80, 0x62, 0x99, 0x68, 0xac, 0x37, 0xd9, 0x1b, 0xf5, 0x66, 0xf4, 0xa6, 0xbd, 0x59,
+	0x02, 0xaf, 0x0d, 0xd2, 0x1b, 0x35, 0xe6, 0x67, 0x05, 0xcc, 0xed, 0x53, 0x77, 0x17, 0xd5, 0x39,
+	0xf7, 0x21, 0x42, 0xce, 0x4e, 0xe0, 0xf0, 0x98, 0x6a, 0x81, 0x31, 0xdc, 0x44, 0x84, 0xe7, 0x17,
+	0x6d, 0x99, 0xee, 0x76, 0xf4, 0x49, 0x91, 0x3f, 0xf4, 0x18, 0x76, 0x04, 0x05, 0x0b, 0x1c, 0x19,
+	0xa7, 0x38, 0x92, 0x5c, 0x10, 0x7a, 0x0c, 0x3b, 0x82, 0xd4, 0x8f, 0xc0, 0x14, 0x65, 0xd0, 0x73,
+	0x8e, 0xda, 0x95, 0xd0, 0x46, 0x8b, 0xd9, 0x72, 0x76, 0x39, 0xb7, 0xbd, 0xd0, 0xed, 0xe8, 0x45,
+	0xb9, 0x03, 0x49, 0xc4, 0xb0, 0xef, 0x49, 0x5b, 0x58, 0x36, 0x8d, 0x29, 0x2f, 0x83, 0x52, 0xba,
+	0xa0, 0x48, 0xf3, 0x2f, 0x0a, 0x28, 0xee, 0x53, 0x77, 0x0f, 0xfb, 0x9f, 0x35, 0x1d, 0xc8, 0xd0,
+	0x2e, 0x22, 0x35, 0x1f, 0x39, 0x01, 0x4a, 0xd5, 0x0d, 0x90, 0x83, 0x2d, 0x76, 0x8c, 0x49, 0x8d,
+	0xb5, 0xa5, 0xec, 0xe2, 0xaf, 0x3f, 0xac, 0xce, 0xc8, 0xeb, 0xb7, 0xe5, 0x38, 0x04, 0x51, 0x7a,
+	0xc8, 0x48, 0xcd, 0x73, 0xed, 0x4b, 0x54, 0x7d, 0x17, 0xe4, 0x28, 0x62, 0x95, 0x60, 0xf3, 0x82,
+	0x63, 0x91, 0x5d, 0xce, 0xaf, 0x3f, 0x30, 0x7b, 0x6f, 0xba, 0x19, 0x4b, 0xb4, 0x3d, 0x7a, 0xd6,
+	0xd1, 0x33, 0xf6, 0x18, 0x45, 0x4c, 0xe4, 0x7d, 0x08, 0xc6, 0x03, 0x81, 0x0c, 0xc9, 0x10, 0xbc,
+	0x0d, 0x76, 0x5e, 0xd8, 0x38, 0xb2, 0xa9, 0x05, 0x1a, 0x9f, 0x4b, 0x9d, 0x5f, 0xfe, 0xfd, 0xfd,
+	0xa3, 0xcb, 0xf4, 0x86, 0x01, 0xca, 0x57, 0x49, 0x8a, 0x74, 0xff, 0x38, 0xc2, 0xf7, 0x7a, 0x0f,
+	0xfb, 0x87, 0x88, 0x1d, 0x90, 0x5a, 0x15, 0x7d, 0xea, 0x23, 0x42, 0x6a, 0x0e, 0xba, 0xb5, 0xea,
+	0x32, 0xc8, 0x3b, 0x88, 0x56, 0x49, 0xad, 0x19, 0x8c, 0x19, 0xb1, 0xeb, 0x76, 0xdc, 0x14, 0xe8,
+	0xa2, 0xed, 0xc6, 0x11, 0xae, 0x57, 0x1c, 0xe4, 0xe1, 0x86, 0x38, 0xf7, 0x76, 0x5e, 0xd8, 0x76,
+	0x03, 0x93, 0x7a, 0x08, 0x0a, 0x3d, 0xd7, 0x46, 0x9e, 0x76, 0x33, 0xe8, 0xd0, 0xef, 0x1d, 0x7d,
+	0xc9, 0xad, 0xb1, 0xe3, 0xd6, 0x91, 0x59, 0xc5, 0x0d, 0x39, 0x04, 0xe5, 0x9f, 0x55, 0xea, 0x9c,
+	0x58, 0xac, 0xdd, 0x44, 0xd4, 0xdc, 0x45, 0x55, 0x7b, 0x3c, 0x7e, 0xd5, 0xd4, 0xf7, 0xc0, 0x58,
+	0x38, 0xfd, 0x8a, 0xaf, 0x94, 0x95, 0xe5, 0xfc, 0xfa, 0xbc, 0x29, 0xc6, 0xa3, 0x19, 0x8e, 0x47,
+	0x73, 0x57, 0x02, 0xdb, 0x63, 0x41, 0xaa, 0xe7, 0x7f, 0xe8, 0x8a, 0x1d, 0x2d, 0x1a, 0xd8, 0x6d,
+	0x71, 0xc6, 0x52, 0x1a, 0x19, 0xf5, 0xfa, 0x6b, 0x05, 0xcc, 0x0b, 0x64, 0x07, 0x7a, 0x55, 0x54,
+	0xff, 0x7f, 0xda, 0x9d, 0x6c, 0xe6, 0x48, 0x5f, 0x33, 0x07, 0x96, 0xbd, 0x08, 0x1e, 0x5e, 0x59,
+	0x53, 0x54, 0xf9, 0x37, 0x59, 0xa0, 0xc6, 0x8f, 0xd2, 0x01, 0x24, 0xb0, 0x71, 0xfb, 0x7b, 0xa1,
+	0x82, 0xd1, 0x13, 0xd4, 0x16, 0x57, 0x22, 0x67, 0xf3, 0xdf, 0xea, 0x06, 0xb8, 0x2b, 0x76, 0x8a,
+	0xf2, 0xe3, 0x90, 0x5f, 0x9f, 0x4b, 0xde, 0x14, 0x91, 0x54, 0x5e, 0x92, 0x10, 0x56, 0x1f, 0x83,
+	0x69, 0xe8, 0xbb, 0x95, 0x2a, 0x6e, 0x79, 0x0c, 0x91, 0x4a, 0x18, 0x63, 0x94, 0xc7, 0x28, 0x27,
+	0x63, 0x6c, 0xf9, 0xee, 0x8e, 0x20, 0x7b, 0xa2, 0x4d, 0xc1, 0xc8, 0xbe, 0x23, 0xe3, 0xee, 0x81,
+	0x02, 0x41, 0x4f, 0x21, 0x71, 0x2a, 0x4d, 0x4e, 0xca, 0x03, 0xb3, 0x90, 0x8c, 0x68, 0x73, 0xa8,
+	0x27, 0xda, 0x38, 0x89, 0xd9, 0xd4, 0x8f, 0xc1, 0x44, 0x13, 0x79, 0xb0, 0xce, 0xda, 0x61, 0xa4,
+	0x3b, 0x3c, 0xd2, 0xab, 0x7d, 0xfa, 0x04, 0xd5, 0x13, 0xaa, 0xd0, 0x8c, 0x1b, 0x07, 0x6e, 0xe4,
+	0x02, 0xd0, 0xfa, 0xb7, 0x28, 0xda, 0xc1, 0x9f, 0x14, 0x30, 0x17, 0x77, 0x6f, 0x55, 0xab, 0xa8,
+	0xc9, 0x3e, 0xa9, 0x51, 0x76, 0xeb, 0x5d, 0xdc, 0x04, 0x20, 0x98, 0x6e, 0xfc, 0xd4, 0x85, 0xe3,
+	0x6d, 0xb6, 0x7f, 0xbc, 0x79, 0xb8, 0x21, 0xc5, 0x04, 0xc3, 0x90, 0x3f, 0x53, 0x75, 0x11, 0x14,
+	0xe4, 0x64, 0x93, 0xcb, 0xc5, 0x68, 0x93, 0xe3, 0x4e, 0x40, 0xc3, 0xdd, 0xb6, 0xa4, 0x9c, 0x50,
+	0xf1, 0xfa, 0xb7, 0x77, 0x41, 0x76, 0x9f, 0xba, 0xea, 0x33, 0x05, 0x2c, 0x0c, 0xfc, 0x80, 0xb1,
+	0x92, 0x35, 0x5f, 0xf3, 0x01, 0xa1, 0xbd, 0x73, 0xc3, 0x05, 0x61, 0x49, 0xea, 0x17, 0x60, 0xfe,
+	0xea, 0xaf, 0x8d, 0x37, 0x86, 0x8d, 0x1a, 0xd0, 0xda, 0xdb, 0x37, 0xa1, 0xa3, 0x02, 0x1a, 0x60,
+	0x3a, 0xed, 0xad, 0xbe, 0x94, 0x12, 0x2c, 0x85, 0xd3, 0xcc, 0xe1, 0xb8, 0x28, 0x1d, 0x05, 0xb3,
+	0xe9, 0x2f, 0xd4, 0xe5, 0x94, 0x40, 0xa9, 0xa4, 0xf6, 0xe6, 0xb0, 0x64, 0x5c, 0x63, 0xda, 0xdb,
+	0x6c, 0x29, 0x3d, 0x50, 0x92, 0xd3, 0xcc, 0xe1, 0xb8, 0x28, 0x9d, 0x0f, 0xe6, 0xae, 0x18, 0xe8,
+	0x2b, 0xe9, 0x91, 0x52, 0x50, 0x6d, 0x6d, 0x68, 0x34, 0xca, 0x0b, 0xc1, 0x64, 0x72, 0x1c, 0x1b,
+	0x83, 0x7a, 0x25, 0x18, 0xed, 0xd1, 0xf5, 0x4c, 0xa2, 0x93, 0x7d, 0xf3, 0x62, 0x69, 0x50, 0x88,
+	0x4b, 0x4e, 0x33, 0x87, 0xe3, 0xc2, 0x74, 0xdb, 0x07, 0x67, 0x7f, 0x95, 0x32, 0x67, 0xe7, 0x25,
+	0xe5, 0xe5, 0x79, 0x49, 0xf9, 0xf3, 0xbc, 0xa4, 0x7c, 0x75, 0x51, 0xca, 0x9c, 0x5d, 0x94, 0x94,
+	0x97, 0x17, 0xa5, 0xcc, 0x6f, 0x17, 0xa5, 0xcc, 0xe7, 0x66, 0xec, 0xad, 0x1f, 0xc4, 0x5e, 0xf5,
+	0x10, 0x7b, 0x8a, 0xc9, 0x09, 0x7f, 0xb0, 0xfc, 0x0d, 0xeb, 0x34, 0xfc, 0x47, 0x86, 0x7f, 0x01,
+	0x1c, 0xdd, 0xe1, 0x6f, 0xf5, 0xb7, 0xfe, 0x1b, 0x00, 0x10, 0x57, 0xbc, 0xb7, 0x77, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -358,8 +868,19 @@ type MsgClient interface {
 	// AggregateExchangeRateVote defines a method for submitting an aggregate
 	// exchange rate vote.
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
-	// DelegateFeedConsent defines a method for setting the feeder delegation.
+	// DelegateFeedConsent defines a method for setting the feeder delegation, and the standby
+	// feeders of a validator.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// GovUpdateDerivedFeeds adds, updates or deletes derived price feeds.
+	GovUpdateDerivedFeeds(ctx context.Context, in *MsgGovUpdateDerivedFeeds, opts ...grpc.CallOption) (*MsgGovUpdateDerivedFeedsResponse, error)
+	// GovSetPriceOverride sets a manual exchange rate of a denom, until an expiry.
+	GovSetPriceOverride(ctx context.Context, in *MsgGovSetPriceOverride, opts ...grpc.CallOption) (*MsgGovSetPriceOverrideResponse, error)
+	// GovCancelPriceOverride removes the price override of a denom.
+	GovCancelPriceOverride(ctx context.Context, in *MsgGovCancelPriceOverride, opts ...grpc.CallOption) (*MsgGovCancelPriceOverrideResponse, error)
+	// GovUpdateParams updates a subset of the oracle params and historic avg counter params.
+	GovUpdateParams(ctx context.Context, in *MsgGovUpdateParams, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error)
+	// GovUpdateAcceptList adds, updates or removes denoms of the accept list.
+	GovUpdateAcceptList(ctx context.Context, in *MsgGovUpdateAcceptList, opts ...grpc.CallOption) (*MsgGovUpdateAcceptListResponse, error)
 }
 
 type msgClient struct {
@@ -397,31 +918,102 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AggregateExchangeRatePrevote defines a method for submitting an aggregate
-	// exchange rate prevote.
-	AggregateExchangeRatePrevote(context.Context, *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error)
-	// AggregateExchangeRateVote defines a method for submitting an aggregate
-	// exchange rate vote.
-	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
-	// DelegateFeedConsent defines a method for setting the feeder delegation.
-	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+func (c *msgClient) GovUpdateDerivedFeeds(ctx context.Context, in *MsgGovUpdateDerivedFeeds, opts ...grpc.CallOption) (*MsgGovUpdateDerivedFeedsResponse, error) {
+	out := new(MsgGovUpdateDerivedFeedsResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Msg/GovUpdateDerivedFeeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (c *msgClient) GovSetPriceOverride(ctx context.Context, in *MsgGovSetPriceOverride, opts ...grpc.CallOption) (*MsgGovSetPriceOverrideResponse, error) {
+	out := new(MsgGovSetPriceOverrideResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Msg/GovSetPriceOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context, req *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRatePrevote not implemented")
+func (c *msgClient) GovCancelPriceOverride(ctx context.Context, in *MsgGovCancelPriceOverride, opts ...grpc.CallOption) (*MsgGovCancelPriceOverrideResponse, error) {
+	out := new(MsgGovCancelPriceOverrideResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Msg/GovCancelPriceOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
+
+func (c *msgClient) GovUpdateParams(ctx context.Context, in *MsgGovUpdateParams, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error) {
+	out := new(MsgGovUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Msg/GovUpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovUpdateAcceptList(ctx context.Context, in *MsgGovUpdateAcceptList, opts ...grpc.CallOption) (*MsgGovUpdateAcceptListResponse, error) {
+	out := new(MsgGovUpdateAcceptListResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Msg/GovUpdateAcceptList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AggregateExchangeRatePrevote defines a method for submitting an aggregate
+	// exchange rate prevote.
+	AggregateExchangeRatePrevote(context.Context, *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error)
+	// AggregateExchangeRateVote defines a method for submitting an aggregate
+	// exchange rate vote.
+	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
+	// DelegateFeedConsent defines a method for setting the feeder delegation, and the standby
+	// feeders of a validator.
+	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// GovUpdateDerivedFeeds adds, updates or deletes derived price feeds.
+	GovUpdateDerivedFeeds(context.Context, *MsgGovUpdateDerivedFeeds) (*MsgGovUpdateDerivedFeedsResponse, error)
+	// GovSetPriceOverride sets a manual exchange rate of a denom, until an expiry.
+	GovSetPriceOverride(context.Context, *MsgGovSetPriceOverride) (*MsgGovSetPriceOverrideResponse, error)
+	// GovCancelPriceOverride removes the price override of a denom.
+	GovCancelPriceOverride(context.Context, *MsgGovCancelPriceOverride) (*MsgGovCancelPriceOverrideResponse, error)
+	// GovUpdateParams updates a subset of the oracle params and historic avg counter params.
+	GovUpdateParams(context.Context, *MsgGovUpdateParams) (*MsgGovUpdateParamsResponse, error)
+	// GovUpdateAcceptList adds, updates or removes denoms of the accept list.
+	GovUpdateAcceptList(context.Context, *MsgGovUpdateAcceptList) (*MsgGovUpdateAcceptListResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context, req *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRatePrevote not implemented")
+}
+func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) GovUpdateDerivedFeeds(ctx context.Context, req *MsgGovUpdateDerivedFeeds) (*MsgGovUpdateDerivedFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateDerivedFeeds not implemented")
+}
+func (*UnimplementedMsgServer) GovSetPriceOverride(ctx context.Context, req *MsgGovSetPriceOverride) (*MsgGovSetPriceOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSetPriceOverride not implemented")
+}
+func (*UnimplementedMsgServer) GovCancelPriceOverride(ctx context.Context, req *MsgGovCancelPriceOverride) (*MsgGovCancelPriceOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovCancelPriceOverride not implemented")
+}
+func (*UnimplementedMsgServer) GovUpdateParams(ctx context.Context, req *MsgGovUpdateParams) (*MsgGovUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) GovUpdateAcceptList(ctx context.Context, req *MsgGovUpdateAcceptList) (*MsgGovUpdateAcceptListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateAcceptList not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -481,6 +1073,96 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GovUpdateDerivedFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateDerivedFeeds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateDerivedFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Msg/GovUpdateDerivedFeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateDerivedFeeds(ctx, req.(*MsgGovUpdateDerivedFeeds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovSetPriceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovSetPriceOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovSetPriceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Msg/GovSetPriceOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovSetPriceOverride(ctx, req.(*MsgGovSetPriceOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovCancelPriceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovCancelPriceOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovCancelPriceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Msg/GovCancelPriceOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovCancelPriceOverride(ctx, req.(*MsgGovCancelPriceOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovUpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Msg/GovUpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateParams(ctx, req.(*MsgGovUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovUpdateAcceptList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateAcceptList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateAcceptList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Msg/GovUpdateAcceptList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateAcceptList(ctx, req.(*MsgGovUpdateAcceptList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "umee.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -497,6 +1179,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "GovUpdateDerivedFeeds",
+			Handler:    _Msg_GovUpdateDerivedFeeds_Handler,
+		},
+		{
+			MethodName: "GovSetPriceOverride",
+			Handler:    _Msg_GovSetPriceOverride_Handler,
+		},
+		{
+			MethodName: "GovCancelPriceOverride",
+			Handler:    _Msg_GovCancelPriceOverride_Handler,
+		},
+		{
+			MethodName: "GovUpdateParams",
+			Handler:    _Msg_GovUpdateParams_Handler,
+		},
+		{
+			MethodName: "GovUpdateAcceptList",
+			Handler:    _Msg_GovUpdateAcceptList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "umee/oracle/v1/tx.proto",
@@ -663,6 +1365,15 @@ func (m *MsgDelegateFeedConsent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.StandbyDelegates) > 0 {
+		for iNdEx := len(m.StandbyDelegates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StandbyDelegates[iNdEx])
+			copy(dAtA[i:], m.StandbyDelegates[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StandbyDelegates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Delegate) > 0 {
 		i -= len(m.Delegate)
 		copy(dAtA[i:], m.Delegate)
@@ -703,114 +1414,1714 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgGovUpdateDerivedFeeds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgGovUpdateDerivedFeeds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateDerivedFeeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DeleteFeeds) > 0 {
+		for iNdEx := len(m.DeleteFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeleteFeeds[iNdEx])
+			copy(dAtA[i:], m.DeleteFeeds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeleteFeeds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SetFeeds) > 0 {
+		for iNdEx := len(m.SetFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SetFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovUpdateDerivedFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateDerivedFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateDerivedFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovSetPriceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetPriceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetPriceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExchangeRates)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovSetPriceOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetPriceOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetPriceOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateFeedConsent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovCancelPriceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovCancelPriceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovCancelPriceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovCancelPriceOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovCancelPriceOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovCancelPriceOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PenaltyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.RewardParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AvgCounterChanges.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Changes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateAcceptList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateAcceptList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateAcceptList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeleteDenoms) > 0 {
+		for iNdEx := len(m.DeleteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeleteDenoms[iNdEx])
+			copy(dAtA[i:], m.DeleteDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeleteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SetDenoms) > 0 {
+		for iNdEx := len(m.SetDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SetDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateAcceptListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateAcceptListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateAcceptListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRates)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateFeedConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Delegate)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if len(m.StandbyDelegates) > 0 {
+		for _, s := range m.StandbyDelegates {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovUpdateDerivedFeeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SetFeeds) > 0 {
+		for _, e := range m.SetFeeds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeleteFeeds) > 0 {
+		for _, s := range m.DeleteFeeds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovUpdateDerivedFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovSetPriceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGovSetPriceOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovCancelPriceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovCancelPriceOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Changes.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AvgCounterChanges.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RewardParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PenaltyParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGovUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovUpdateAcceptList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SetDenoms) > 0 {
+		for _, e := range m.SetDenoms {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeleteDenoms) > 0 {
+		for _, s := range m.DeleteDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovUpdateAcceptListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyDelegates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyDelegates = append(m.StandbyDelegates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovUpdateDerivedFeeds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateDerivedFeeds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateDerivedFeeds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetFeeds = append(m.SetFeeds, DerivedFeed{})
+			if err := m.SetFeeds[len(m.SetFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteFeeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteFeeds = append(m.DeleteFeeds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovUpdateDerivedFeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateDerivedFeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateDerivedFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovSetPriceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovSetPriceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovSetPriceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *MsgGovSetPriceOverrideResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,47 +3144,65 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovSetPriceOverrideResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovSetPriceOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovCancelPriceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovCancelPriceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovCancelPriceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -901,11 +3230,11 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -933,7 +3262,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -956,7 +3285,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovCancelPriceOverrideResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -979,10 +3308,10 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovCancelPriceOverrideResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovCancelPriceOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1006,7 +3335,7 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgGovUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1029,15 +3358,15 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1065,11 +3394,11 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1097,13 +3426,13 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1113,29 +3442,30 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			if err := m.Changes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgCounterChanges", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1145,23 +3475,90 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if err := m.AvgCounterChanges.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1184,7 +3581,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,10 +3604,10 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1234,7 +3631,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *MsgGovUpdateAcceptList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1257,15 +3654,15 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovUpdateAcceptList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovUpdateAcceptList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1293,11 +3690,45 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetDenoms = append(m.SetDenoms, Denom{})
+			if err := m.SetDenoms[len(m.SetDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,7 +3756,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			m.DeleteDenoms = append(m.DeleteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1348,7 +3779,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovUpdateAcceptListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,10 +3802,10 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovUpdateAcceptListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovUpdateAcceptListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
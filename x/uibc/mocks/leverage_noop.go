@@ -60,6 +60,26 @@ func (l lvgNoop) SupplyCollateral(context.Context, *ltypes.MsgSupplyCollateral,
 	return nil, nil
 }
 
+func (l lvgNoop) ClaimReferralRewards(context.Context, *ltypes.MsgClaimReferralRewards,
+) (*ltypes.MsgClaimReferralRewardsResponse, error) {
+	return nil, nil
+}
+
+func (l lvgNoop) UpdateAllowList(context.Context, *ltypes.MsgUpdateAllowList,
+) (*ltypes.MsgUpdateAllowListResponse, error) {
+	return nil, nil
+}
+
+func (l lvgNoop) CreateDeleverageOrder(context.Context, *ltypes.MsgCreateDeleverageOrder,
+) (*ltypes.MsgCreateDeleverageOrderResponse, error) {
+	return nil, nil
+}
+
+func (l lvgNoop) CancelDeleverageOrder(context.Context, *ltypes.MsgCancelDeleverageOrder,
+) (*ltypes.MsgCancelDeleverageOrderResponse, error) {
+	return nil, nil
+}
+
 func (l lvgNoop) GovUpdateRegistry(context.Context, *ltypes.MsgGovUpdateRegistry,
 ) (*ltypes.MsgGovUpdateRegistryResponse, error) {
 	return nil, nil
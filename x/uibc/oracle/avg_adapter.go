@@ -3,11 +3,13 @@ package oracle
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
 	"github.com/umee-network/umee/v6/x/uibc"
 )
 
 type UmeeAvgPriceOracle interface {
 	HistoricAvgPrice(ctx sdk.Context, denom string) (sdk.Dec, error)
+	GetExchangeRate(ctx sdk.Context, symbol string) (otypes.ExchangeRate, error)
 }
 
 func FromUmeeAvgPriceOracle(o UmeeAvgPriceOracle) uibc.Oracle {
@@ -18,6 +20,13 @@ type umeeAvgPriceOracle struct {
 	o UmeeAvgPriceOracle
 }
 
+// Price returns the historic average price of the denom. The average is computed from the
+// exchange rates, so it returns otypes.ErrStalePrice or otypes.ErrLowConfidence if the exchange
+// rate is stale or doesn't meet the confidence requirements of the denom.
 func (o umeeAvgPriceOracle) Price(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	_, err := o.o.GetExchangeRate(ctx, denom)
+	if otypes.ErrStalePrice.Is(err) || otypes.ErrLowConfidence.Is(err) {
+		return sdk.Dec{}, err
+	}
 	return o.o.HistoricAvgPrice(ctx, denom)
 }
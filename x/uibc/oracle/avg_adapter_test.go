@@ -0,0 +1,48 @@
+package oracle
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
+)
+
+type avgPriceOracleMock struct {
+	avgPrice sdk.Dec
+	err      error
+}
+
+func (o avgPriceOracleMock) HistoricAvgPrice(_ sdk.Context, _ string) (sdk.Dec, error) {
+	return o.avgPrice, nil
+}
+
+func (o avgPriceOracleMock) GetExchangeRate(_ sdk.Context, _ string) (otypes.ExchangeRate, error) {
+	return otypes.ExchangeRate{Rate: sdk.OneDec()}, o.err
+}
+
+func TestAvgPriceOracle(t *testing.T) {
+	avgPrice := sdk.MustNewDecFromStr("1.2")
+	tcs := []struct {
+		name    string
+		rateErr error
+		err     error
+	}{
+		{"valid price", nil, nil},
+		{"unknown exchange rate", otypes.ErrUnknownDenom, nil},
+		{"stale price", otypes.ErrStalePrice.Wrap("UMEE"), otypes.ErrStalePrice},
+		{"low confidence", otypes.ErrLowConfidence.Wrap("UMEE"), otypes.ErrLowConfidence},
+	}
+
+	for _, tc := range tcs {
+		o := FromUmeeAvgPriceOracle(avgPriceOracleMock{avgPrice, tc.rateErr})
+		p, err := o.Price(sdk.Context{}, "UMEE")
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, tc.name)
+			continue
+		}
+		assert.NilError(t, err, tc.name)
+		assert.DeepEqual(t, avgPrice, p)
+	}
+}
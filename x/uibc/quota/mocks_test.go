@@ -9,6 +9,7 @@ import (
 
 	"github.com/umee-network/umee/v6/util/genmap"
 	ltypes "github.com/umee-network/umee/v6/x/leverage/types"
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
 )
 
 type LeverageKeeper struct {
@@ -47,10 +48,18 @@ func NewLeverageKeeperMock(denoms ...string) LeverageKeeper {
 }
 
 type Oracle struct {
-	prices map[string]sdk.Dec
+	prices        map[string]sdk.Dec
+	stale         map[string]bool
+	lowConfidence map[string]bool
 }
 
 func (o Oracle) Price(_ sdk.Context, denom string) (sdk.Dec, error) {
+	if o.stale[denom] {
+		return sdk.Dec{}, otypes.ErrStalePrice.Wrap(denom)
+	}
+	if o.lowConfidence[denom] {
+		return sdk.Dec{}, otypes.ErrLowConfidence.Wrap(denom)
+	}
 	p, ok := o.prices[denom]
 	if !ok {
 		// When token exists in leverage registry but price is not found we are returning `0`
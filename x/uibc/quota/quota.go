@@ -13,6 +13,7 @@ import (
 	"github.com/umee-network/umee/v6/util/coin"
 	"github.com/umee-network/umee/v6/util/store"
 	ltypes "github.com/umee-network/umee/v6/x/leverage/types"
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
 	"github.com/umee-network/umee/v6/x/uibc"
 )
 
@@ -132,7 +133,7 @@ func (k Keeper) ResetAllQuotas() error {
 }
 
 // CheckAndUpdateQuota checks if adding a newOutflow doesn't exceed the max quota and
-// updates the current quota metrics.
+// updates the current quota metrics. Outflows of tokens with stale prices are rejected.
 func (k Keeper) CheckAndUpdateQuota(denom string, newOutflow sdkmath.Int) error {
 	params := k.GetParams()
 	exchangePrice, err := k.getExchangePrice(denom, newOutflow)
@@ -207,8 +208,10 @@ func (k Keeper) UndoUpdateQuota(denom string, amount sdkmath.Int) error {
 	o := k.GetTokenOutflows(denom)
 	exchangePrice, err := k.getExchangePrice(denom, amount)
 	if err != nil {
-		// Note: skip the ibc-transfer quota checking if `denom` is not support by leverage
-		if ltypes.ErrNotRegisteredToken.Is(err) {
+		// Note: skip the ibc-transfer quota checking if `denom` is not support by leverage.
+		// Stale and low confidence prices are treated as missing: the outflow is not reverted,
+		// which can only make the quota stricter.
+		if ltypes.ErrNotRegisteredToken.Is(err) || isUnreliablePrice(err) {
 			return nil
 		} else if err != nil {
 			return err
@@ -243,6 +246,13 @@ func (k Keeper) RecordIBCInflow(packet channeltypes.Packet, denom, amount string
 	// get the exchange price (eg: UMEE) in USD from oracle using SYMBOL Denom eg: `UMEE`
 	exchangeRate, err := k.oracle.Price(*k.ctx, strings.ToUpper(ts.SymbolDenom))
 	if err != nil {
+		if isUnreliablePrice(err) {
+			// stale and low confidence prices are treated as missing: skipping the inflow can only
+			// make the quota stricter
+			k.ctx.Logger().Info("skipping ibc inflow recording: unreliable price", "denom", denom,
+				"error", err)
+			return nil
+		}
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 	// calculate total exchange rate
@@ -257,3 +267,8 @@ func (k Keeper) RecordIBCInflow(packet channeltypes.Packet, denom, amount string
 
 	return nil
 }
+
+// isUnreliablePrice returns true if the oracle error signals a stale or low confidence price.
+func isUnreliablePrice(err error) bool {
+	return otypes.ErrStalePrice.Is(err) || otypes.ErrLowConfidence.Is(err)
+}
@@ -8,6 +8,7 @@ import (
 	"gotest.tools/v3/assert"
 
 	ibcutil "github.com/umee-network/umee/v6/util/ibc"
+	otypes "github.com/umee-network/umee/v6/x/oracle/types"
 	"github.com/umee-network/umee/v6/x/uibc"
 )
 
@@ -177,3 +178,36 @@ func TestSetAndGetIBCInflows(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, inflows[0], inflowOfToken)
 }
+
+func TestUnitStalePrice(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		stale         bool
+		lowConfidence bool
+		err           error
+	}{
+		{"stale", true, false, otypes.ErrStalePrice},
+		{"low confidence", false, true, otypes.ErrLowConfidence},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lmock := NewLeverageKeeperMock(umee, atom)
+			omock := NewOracleMock(umee, sdk.NewDec(2))
+			omock.stale = map[string]bool{umee: tc.stale}
+			omock.lowConfidence = map[string]bool{umee: tc.lowConfidence}
+			k := initKeeper(t, lmock, omock)
+			k.setQuotaParams(10, 100)
+			k.SetTokenOutflow(sdk.NewInt64DecCoin(umee, 6))
+			k.SetOutflowSum(sdk.NewDec(50))
+
+			// outflows are blocked
+			err := k.CheckAndUpdateQuota(umee, sdk.NewInt(1))
+			assert.ErrorIs(t, err, tc.err)
+			k.checkOutflows(umee, 6, 50)
+
+			// reverting outflows is skipped
+			err = k.UndoUpdateQuota(umee, sdk.NewInt(1))
+			assert.NilError(t, err)
+			k.checkOutflows(umee, 6, 50)
+		})
+	}
+}